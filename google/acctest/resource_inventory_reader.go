@@ -20,11 +20,10 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
-	"slices"
 	"strings"
 	"sync"
 
-	"gopkg.in/yaml.v2"
+	"github.com/hashicorp/terraform-provider-google/google/cai"
 )
 
 var (
@@ -36,35 +35,9 @@ var (
 )
 
 // Metadata represents the structure of the metadata files
-type Metadata struct {
-	Resource            string          `yaml:"resource"`
-	GenerationType      string          `yaml:"generation_type"`
-	SourceFile          string          `yaml:"source_file"`
-	ApiServiceName      string          `yaml:"api_service_name"`
-	ApiVersion          string          `yaml:"api_version"`
-	ApiResourceTypeKind string          `yaml:"api_resource_type_kind"`
-	CaiAssetNameFormats []string        `yaml:"cai_asset_name_formats"`
-	ApiVariantPatterns  []string        `yaml:"api_variant_patterns"`
-	AutogenStatus       bool            `yaml:"autogen_status,omitempty"`
-	AutogenVersion      int             `yaml:"autogen_version,omitempty"`
-	Fields              []MetadataField `yaml:"fields"`
-
-	// These keys store information about the metadata file itself.
-
-	// Path is the absolute path of the loaded metadata file
-	Path string
-	// ServicePackage is the folder within services/ that the metadata file is in, for example compute
-	ServicePackage string
-	// FileName is the filename of the metadata file, for example resource_compute_instance_meta.yaml
-	FileName string
-}
+type Metadata = cai.Metadata
 
-type MetadataField struct {
-	ApiField     string `yaml:"api_field"`
-	Field        string `yaml:"field"`
-	ProviderOnly bool   `yaml:"provider_only"`
-	Json         bool   `yaml:"json"`
-}
+type MetadataField = cai.MetadataField
 
 type MetadataCache struct {
 	mutex          *sync.Mutex
@@ -86,58 +59,7 @@ func (mc *MetadataCache) Populate() error {
 		return fmt.Errorf("failed to find services directory: %v", err)
 	}
 
-	mc.cache = make(map[string]Metadata)
-
-	var malformed_yaml_errs []string
-
-	// Walk through all service directories once
-	err = filepath.Walk(baseDir, func(path string, info os.FileInfo, err error) error {
-		if err != nil {
-			return err // Fail immediately if there's an OS error.
-		}
-
-		// Skip non-metadata files
-		if info.IsDir() || !strings.HasPrefix(info.Name(), "resource_") || !strings.HasSuffix(info.Name(), "_meta.yaml") {
-			return nil
-		}
-
-		// Read the file
-		content, err := os.ReadFile(path)
-		if err != nil {
-			return err // Fail immediately if there's an OS error.
-		}
-
-		// Parse YAML
-		var metadata Metadata
-		if err := yaml.Unmarshal(content, &metadata); err != nil {
-			// note but keep walking
-			malformed_yaml_errs = append(malformed_yaml_errs, fmt.Sprintf("%s: %v", path, err.Error()))
-			return nil
-		}
-
-		if _, ok := mc.cache[metadata.Resource]; ok {
-			return fmt.Errorf("duplicate resource: %s in %s", metadata.Resource, path)
-		}
-
-		metadata.Path = path
-		metadata.FileName = filepath.Base(path)
-		pathParts := strings.Split(path, string(os.PathSeparator))
-		servicesIndex := slices.Index(pathParts, "services")
-		if servicesIndex == -1 {
-			return fmt.Errorf("no service found for %s (%s)", metadata.Resource, path)
-		}
-		metadata.ServicePackage = pathParts[servicesIndex+1]
-		mc.cache[metadata.Resource] = metadata
-		return nil
-	})
-
-	if err != nil {
-		mc.populatedError = fmt.Errorf("error walking directory: %v", err)
-	}
-
-	if len(malformed_yaml_errs) > 0 {
-		mc.populatedError = fmt.Errorf("YAML parsing errors encountered:\n%v", strings.Join(malformed_yaml_errs, "\n"))
-	}
+	mc.cache, mc.populatedError = cai.LoadMetadata(baseDir)
 
 	// Mark cache as populated
 	mc.populated = true
@@ -153,11 +75,7 @@ func (mc *MetadataCache) Get(key string) (Metadata, bool) {
 	// start generating separate IAM metadata in the future. This is primarily for
 	// backwards-compatibility with the previous cache behavior and a workaround for _not_
 	// generating IAM resource metadata.
-	key, _ = strings.CutSuffix(key, "_iam_member")
-	key, _ = strings.CutSuffix(key, "_iam_binding")
-	key, _ = strings.CutSuffix(key, "_iam_policy")
-	m, ok := mc.cache[key]
-	return m, ok
+	return cai.LookupMetadata(mc.cache, key)
 }
 
 func (mc *MetadataCache) Cache() map[string]Metadata {
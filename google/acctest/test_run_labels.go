@@ -0,0 +1,79 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/acctest/test_run_labels.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package acctest
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-google/google/envvar"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+)
+
+// The start of this test run, used to label resources so sweepers can select
+// resources left behind by runs older than a TTL
+var testRunStart = time.Now()
+
+var invalidLabelValueChars = regexp.MustCompile(`[^a-z0-9_-]`)
+
+// TestRunLabels returns the ownership labels added to the provider's default_labels for
+// resources created by the given test, or nil if ownership labels are disabled.
+//
+// Labels are only added when GOOGLE_TEST_RUN_LABELS is true, and never in VCR mode
+// because the labels would differ between recording and replaying. Tests that assert
+// on the exact contents of terraform_labels or effective_labels will see these labels.
+func TestRunLabels(testName string) map[string]string {
+	if enabled, _ := strconv.ParseBool(envvar.MultiEnvSearch(envvar.TestRunLabelsEnvVars)); !enabled || IsVcrEnabled() {
+		return nil
+	}
+
+	runId := envvar.GetTestRunIdFromEnv()
+	if runId == "" {
+		runId = envvar.TestRunTimeLabelValue(testRunStart)
+	}
+
+	return map[string]string{
+		envvar.TestNameLabel:    labelValue(testName),
+		envvar.TestRunIdLabel:   labelValue(runId),
+		envvar.TestRunTimeLabel: envvar.TestRunTimeLabelValue(testRunStart),
+	}
+}
+
+// addTestRunLabels adds the ownership labels to the default labels of a configured
+// provider. Labels set explicitly in the provider's default_labels take precedence.
+func addTestRunLabels(config *transport_tpg.Config, labels map[string]string) {
+	if config.DefaultLabels == nil {
+		config.DefaultLabels = make(map[string]string)
+	}
+	for k, v := range labels {
+		if _, ok := config.DefaultLabels[k]; !ok {
+			config.DefaultLabels[k] = v
+		}
+	}
+}
+
+// labelValue converts s to a valid label value: at most 63 lowercase letters,
+// digits, underscores and dashes.
+func labelValue(s string) string {
+	v := invalidLabelValueChars.ReplaceAllString(strings.ToLower(s), "_")
+	if len(v) > 63 {
+		v = v[:63]
+	}
+	return v
+}
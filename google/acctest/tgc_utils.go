@@ -21,7 +21,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
-	"net/url"
 	"regexp"
 	"strings"
 	"testing"
@@ -29,6 +28,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-google/google/cai"
 	"github.com/hashicorp/terraform-provider-google/google/envvar"
 	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
 )
@@ -57,32 +57,6 @@ type TgcMetadataPayload struct {
 	CaiReadTime      time.Time                   `json:"cai_read_time"`
 }
 
-// PROJECT_NUMBER instead of PROJECT_ID is in the CAI asset names for the resources in those services
-// https://cloud.google.com/asset-inventory/docs/asset-names
-var serviceWithProjectNumber = map[string]struct{}{
-	"apikeys":               {}, // DCL
-	"binaryauthorization":   {},
-	"cloudtasks":            {},
-	"cloudbuild":            {},
-	"colab":                 {},
-	"containerattached":     {},
-	"containeraws":          {},
-	"containerazure":        {},
-	"dialogflowcx":          {},
-	"discoveryengine":       {},
-	"documentai":            {},
-	"healthcare":            {},
-	"iambeta":               {},
-	"iap":                   {},
-	"identityplatform":      {},
-	"logging":               {},
-	"monitoring":            {},
-	"osconfig":              {},
-	"secretmanager":         {},
-	"secretmanagerregional": {},
-	"vpcaccess":             {},
-}
-
 // encodeToBase64JSON converts a struct to base64-encoded JSON
 func encodeToBase64JSON(data interface{}) (string, error) {
 	jsonData, err := json.Marshal(data)
@@ -144,7 +118,7 @@ func CollectAllTgcMetadata(tgcPayload TgcMetadataPayload) resource.TestCheckFunc
 						rName = rState.Primary.ID
 					}
 
-					if _, ok := serviceWithProjectNumber[metadata.Service]; ok {
+					if _, ok := cai.ServicesWithProjectNumber[metadata.Service]; ok {
 						rName = strings.Replace(rName, projectId, projectNumber, 1)
 					}
 
@@ -157,8 +131,8 @@ func CollectAllTgcMetadata(tgcPayload TgcMetadataPayload) resource.TestCheckFunc
 					caiAssetNameFormat := ""
 					if len(yamlMetadata.CaiAssetNameFormats) == 1 {
 						caiAssetNameFormat = yamlMetadata.CaiAssetNameFormats[0]
-						caiAssetName := formatCaiAssetName(caiAssetNameFormat, rState.Primary.Attributes)
-						if _, ok := serviceWithProjectNumber[metadata.Service]; ok {
+						caiAssetName := cai.FormatAssetName(caiAssetNameFormat, rState.Primary.Attributes)
+						if _, ok := cai.ServicesWithProjectNumber[metadata.Service]; ok {
 							caiAssetName = strings.Replace(caiAssetName, projectId, projectNumber, 1)
 						}
 						metadata.CaiAssetNames = []string{caiAssetName}
@@ -208,62 +182,7 @@ func resolveContainerCaiAssetName(caiAssetNameFormats []string, rName, location
 
 func getServiceDomain(caiAssetName string) string {
 	// caiAssetName format: //container.googleapis.com/projects/...
-	parts := strings.Split(caiAssetName, "/")
-	if len(parts) >= 3 {
-		return parts[2]
-	}
-	return ""
-}
-
-// FormatCaiAssetName constructs a fully qualified Cloud Asset Inventory (CAI) asset name
-// by interpolating values from the provided attributes map into a format template.
-// It extracts required placeholders (e.g., "{{project}}", "{{name}}") from the
-// caiAssetNameFormat string and replaces them with their corresponding values from the map.
-func formatCaiAssetName(caiAssetNameFormat string, attributes map[string]string) string {
-	paramsMap := make(map[string]any, 0)
-	params := extractIdentifiers(caiAssetNameFormat)
-	for _, param := range params {
-		v := attributes[param]
-		paramsMap[param] = v
-	}
-
-	caiAssetName := replacePlaceholders(caiAssetNameFormat, paramsMap)
-	return caiAssetName
-}
-
-// For example, for the url "projects/{{project}}/schemas/{{schema}}",
-// the identifiers are "project", "schema".
-func extractIdentifiers(url string) []string {
-	matches := regexp.MustCompile(`\{\{%?(\w+)\}\}`).FindAllStringSubmatch(url, -1)
-	var result []string
-	for _, match := range matches {
-		result = append(result, match[1])
-	}
-	return result
-}
-
-// It replaces all instances of {{key}} in the template with the
-// corresponding value from the parameters map.
-func replacePlaceholders(template string, params map[string]any) string {
-	re := regexp.MustCompile("{{([%[:word:]]+)}}")
-
-	result := re.ReplaceAllStringFunc(template, func(match string) string {
-		key := strings.Trim(match, "{}")
-
-		// The % indicates that the name value should be URL-encoded.
-		key, shouldBeEncoded := strings.CutPrefix(key, "%")
-		if value, ok := params[key]; ok {
-			v := value.(string)
-			if !shouldBeEncoded {
-				return v
-			}
-			return url.PathEscape(v)
-		}
-
-		return match
-	})
-
-	return result
+	return cai.ServiceDomain(caiAssetName)
 }
 
 // parseResources extracts all resources from a Terraform configuration string
@@ -416,31 +335,11 @@ func extendWithTGCData(t *testing.T, c resource.TestCase) resource.TestCase {
 }
 
 // Gets IAM resource Id by removing the IAM role and member binding information from id
-// id "projects/local-mediator-361721/zones/us-central1-a/instances/tf-test-my-instancev8xqssrek2/roles/compute.osLogin/user:admin@example.com"
-// will become "projects/local-mediator-361721/zones/us-central1-a/instances/tf-test-my-instancev8xqssrek2"
 func getIamResourceId(resourceType, id string) string {
-	parts := strings.Split(id, "/roles/")
-
-	if len(parts) > 0 {
-		return parts[0]
-	}
-
-	return id
-}
-
-var iamSuffixes = []string{
-	"_iam_member",
-	"_iam_policy",
-	"_iam_binding",
-	"_iam_audit_config",
+	return cai.IamResourceId(id)
 }
 
 // Checks if a resource is an IAM resource
 func IsIamResource(resourceType string) bool {
-	for _, suffix := range iamSuffixes {
-		if strings.HasSuffix(resourceType, suffix) {
-			return true
-		}
-	}
-	return false
+	return cai.IsIamResource(resourceType)
 }
@@ -0,0 +1,219 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/acctest/vcr_matcher.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package acctest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/url"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// vcrVolatileValue replaces the values of volatile fields on both sides of a comparison
+const vcrVolatileValue = "VOLATILE"
+
+// VcrVolatileFields describes request fields whose values differ between the recording
+// and the replay of a test, such as generated request IDs. The matcher only checks
+// that these fields are present on both requests.
+//
+// JSON paths use the same syntax as VcrRedactionRule.
+type VcrVolatileFields struct {
+	// Host limits the fields to requests whose host contains this value. An empty Host matches all requests.
+	Host string
+	// JsonPaths are fields of JSON request bodies, including JSON parts of multipart bodies.
+	JsonPaths []string
+	// QueryParams are request query parameters.
+	QueryParams []string
+}
+
+var (
+	vcrVolatileFieldsLock sync.RWMutex
+	vcrVolatileFields     = map[string][]VcrVolatileFields{
+		"core": {
+			{
+				JsonPaths: []string{"requestId", "etag", "policy.etag"},
+				// Page tokens are opaque, interactions are replayed in order so
+				// successive pages still match the right recording.
+				QueryParams: []string{"requestId", "pageToken"},
+			},
+		},
+	}
+)
+
+// RegisterVcrVolatileFields adds volatile request fields for a product. Fields apply to
+// every request matched in the test binary, limited by their Host.
+func RegisterVcrVolatileFields(product string, fields ...VcrVolatileFields) {
+	vcrVolatileFieldsLock.Lock()
+	defer vcrVolatileFieldsLock.Unlock()
+	vcrVolatileFields[product] = append(vcrVolatileFields[product], fields...)
+}
+
+// vcrVolatileFieldsForHost returns the registered volatile fields that apply to requests sent to host
+func vcrVolatileFieldsForHost(host string) []VcrVolatileFields {
+	vcrVolatileFieldsLock.RLock()
+	defer vcrVolatileFieldsLock.RUnlock()
+
+	products := make([]string, 0, len(vcrVolatileFields))
+	for product := range vcrVolatileFields {
+		products = append(products, product)
+	}
+	sort.Strings(products)
+
+	var fields []VcrVolatileFields
+	for _, product := range products {
+		for _, f := range vcrVolatileFields[product] {
+			if f.Host == "" || strings.Contains(host, f.Host) {
+				fields = append(fields, f)
+			}
+		}
+	}
+	return fields
+}
+
+// normalizeVcrUrl prepares a request URL for comparison: standard query parameters are
+// dropped, redacted and volatile parameters are replaced, and parameters are sorted by
+// name and value.
+func normalizeVcrUrl(host, rawurl string) string {
+	rawurl = stripStandardQueryParams(normalizeRedactedRequest(host, rawurl, nil))
+	u, err := url.Parse(rawurl)
+	if err != nil {
+		return rawurl
+	}
+	q := u.Query()
+	for _, f := range vcrVolatileFieldsForHost(host) {
+		for _, p := range f.QueryParams {
+			if q.Has(p) {
+				q.Set(p, vcrVolatileValue)
+			}
+		}
+	}
+	for _, values := range q {
+		sort.Strings(values)
+	}
+	// Encode sorts by parameter name
+	u.RawQuery = q.Encode()
+	return u.String()
+}
+
+// normalizeVcrJson decodes a JSON body and replaces its redacted and volatile fields
+func normalizeVcrJson(host, body string) (interface{}, error) {
+	var v interface{}
+	if err := json.Unmarshal([]byte(body), &v); err != nil {
+		return nil, err
+	}
+	normalizeRedactedRequest(host, "", v)
+	for _, f := range vcrVolatileFieldsForHost(host) {
+		redactJsonPaths(v, f.JsonPaths, vcrVolatileValue)
+	}
+	return v, nil
+}
+
+// vcrJsonBodiesMatch compares two JSON bodies ignoring field order, redacted and volatile fields
+func vcrJsonBodiesMatch(host, reqBody, cassetteBody string) (bool, error) {
+	reqJson, err := normalizeVcrJson(host, reqBody)
+	if err != nil {
+		return false, fmt.Errorf("failed to unmarshal request json: %w", err)
+	}
+	cassetteJson, err := normalizeVcrJson(host, cassetteBody)
+	if err != nil {
+		return false, fmt.Errorf("failed to unmarshal cassette json: %w", err)
+	}
+	return reflect.DeepEqual(reqJson, cassetteJson), nil
+}
+
+type vcrMultipartPart struct {
+	contentType string
+	body        []byte
+}
+
+// readVcrMultipartBody splits a multipart body into its parts. The boundary is taken
+// from contentType when it has one, otherwise from the first line of the body, since
+// recorded boundaries are random and are not always stored with the cassette headers.
+func readVcrMultipartBody(contentType, body string) ([]vcrMultipartPart, error) {
+	boundary := ""
+	if _, params, err := mime.ParseMediaType(contentType); err == nil {
+		boundary = params["boundary"]
+	}
+	if boundary == "" {
+		firstLine, _, _ := strings.Cut(body, "\n")
+		boundary = strings.TrimPrefix(strings.TrimSpace(firstLine), "--")
+	}
+	if boundary == "" {
+		return nil, fmt.Errorf("no multipart boundary found")
+	}
+
+	var parts []vcrMultipartPart
+	r := multipart.NewReader(strings.NewReader(body), boundary)
+	for {
+		p, err := r.NextPart()
+		if err == io.EOF {
+			return parts, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		var b bytes.Buffer
+		if _, err := b.ReadFrom(p); err != nil {
+			return nil, err
+		}
+		parts = append(parts, vcrMultipartPart{
+			contentType: p.Header.Get("Content-Type"),
+			body:        b.Bytes(),
+		})
+	}
+}
+
+// vcrMultipartBodiesMatch compares two multipart bodies part by part. JSON parts, such
+// as the metadata of a storage object upload, are compared as JSON bodies and other
+// parts byte for byte.
+func vcrMultipartBodiesMatch(host, reqContentType, reqBody, cassetteContentType, cassetteBody string) (bool, error) {
+	reqParts, err := readVcrMultipartBody(reqContentType, reqBody)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse multipart request body: %w", err)
+	}
+	cassetteParts, err := readVcrMultipartBody(cassetteContentType, cassetteBody)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse multipart cassette body: %w", err)
+	}
+	if len(reqParts) != len(cassetteParts) {
+		return false, nil
+	}
+	for i, reqPart := range reqParts {
+		cassettePart := cassetteParts[i]
+		if reqPart.contentType != cassettePart.contentType {
+			return false, nil
+		}
+		if bytes.Equal(reqPart.body, cassettePart.body) {
+			continue
+		}
+		if !strings.Contains(reqPart.contentType, "application/json") {
+			return false, nil
+		}
+		match, err := vcrJsonBodiesMatch(host, string(reqPart.body), string(cassettePart.body))
+		if err != nil || !match {
+			return false, err
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,403 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/acctest/vcr_redaction.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package acctest
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/dnaeon/go-vcr/cassette"
+	"github.com/dnaeon/go-vcr/recorder"
+)
+
+// VcrRedactedValue replaces redacted headers and query parameters in VCR cassettes. Redacted
+// JSON strings are replaced with a fake value derived from it, see VcrRedactionRule.
+const VcrRedactedValue = "REDACTED"
+
+// VcrRedactionRule describes secrets that are scrubbed from VCR cassettes before they are saved.
+//
+// JSON paths are dot separated field names. Arrays are traversed transparently, so
+// "users.password" matches the password of every element of a users list, and "*"
+// matches any field name.
+//
+// Redacted JSON strings are replaced with a deterministic fake value computed from the
+// original. When replaying, fake values in responses are mapped back to the live values
+// the test sent in its requests, so fields the provider stores in state (secret payloads,
+// passwords) still match the configuration. Fake values with no live counterpart, such as
+// generated private keys, are returned as recorded.
+type VcrRedactionRule struct {
+	// Host limits the rule to requests whose host contains this value. An empty Host matches all requests.
+	Host string
+	// Headers are request and response headers whose values are replaced.
+	Headers []string
+	// QueryParams are request query parameters whose values are replaced.
+	QueryParams []string
+	// RequestJsonPaths are fields of JSON request bodies whose values are replaced.
+	RequestJsonPaths []string
+	// ResponseJsonPaths are fields of JSON response bodies whose values are replaced.
+	ResponseJsonPaths []string
+	// Base64Encoded marks JSON fields that hold base64 data. Their fake values are base64
+	// encoded too, so the provider can still decode them.
+	Base64Encoded bool
+}
+
+var (
+	vcrRedactionRulesLock sync.RWMutex
+	vcrRedactionRules     = map[string][]VcrRedactionRule{
+		"core": {
+			{
+				Headers:     []string{"Authorization", "X-Goog-Api-Key", "Proxy-Authorization"},
+				QueryParams: []string{"key", "access_token"},
+			},
+			{
+				Host:              "oauth2.googleapis.com",
+				RequestJsonPaths:  []string{"client_secret", "refresh_token", "assertion"},
+				ResponseJsonPaths: []string{"access_token", "id_token", "refresh_token"},
+			},
+			{
+				Host:              "iamcredentials.googleapis.com",
+				ResponseJsonPaths: []string{"accessToken", "token", "signedJwt", "signedBlob"},
+			},
+			{
+				Host:              "sts.googleapis.com",
+				RequestJsonPaths:  []string{"subjectToken"},
+				ResponseJsonPaths: []string{"access_token"},
+			},
+		},
+		"iam": {
+			{
+				Host:              "iam.googleapis.com",
+				ResponseJsonPaths: []string{"privateKeyData"},
+				Base64Encoded:     true,
+			},
+		},
+		"secretmanager": {
+			{
+				Host:              "secretmanager.",
+				RequestJsonPaths:  []string{"payload.data"},
+				ResponseJsonPaths: []string{"payload.data"},
+				Base64Encoded:     true,
+			},
+		},
+		"sql": {
+			{
+				Host:              "sqladmin.googleapis.com",
+				RequestJsonPaths:  []string{"password", "rootPassword"},
+				ResponseJsonPaths: []string{"clientCert.certPrivateKey", "rootPassword"},
+			},
+		},
+		"alloydb": {
+			{
+				Host:             "alloydb.googleapis.com",
+				RequestJsonPaths: []string{"password", "initialUser.password"},
+			},
+		},
+	}
+)
+
+// RegisterVcrRedactionRules adds redaction rules for a product. Rules apply to every
+// cassette recorded in the test binary, limited by their Host.
+func RegisterVcrRedactionRules(product string, rules ...VcrRedactionRule) {
+	vcrRedactionRulesLock.Lock()
+	defer vcrRedactionRulesLock.Unlock()
+	vcrRedactionRules[product] = append(vcrRedactionRules[product], rules...)
+}
+
+// vcrRedactionRulesForHost returns the registered rules that apply to requests sent to host
+func vcrRedactionRulesForHost(host string) []VcrRedactionRule {
+	vcrRedactionRulesLock.RLock()
+	defer vcrRedactionRulesLock.RUnlock()
+
+	products := make([]string, 0, len(vcrRedactionRules))
+	for product := range vcrRedactionRules {
+		products = append(products, product)
+	}
+	sort.Strings(products)
+
+	var rules []VcrRedactionRule
+	for _, product := range products {
+		for _, rule := range vcrRedactionRules[product] {
+			if rule.Host == "" || strings.Contains(host, rule.Host) {
+				rules = append(rules, rule)
+			}
+		}
+	}
+	return rules
+}
+
+// fakeValue returns the value that replaces a redacted JSON value. Strings are replaced
+// with VcrRedactedValue followed by a short hash of the original, so equal values get
+// equal fakes across requests and responses.
+func (r VcrRedactionRule) fakeValue(original interface{}) (interface{}, bool) {
+	s, ok := original.(string)
+	if !ok {
+		return VcrRedactedValue, true
+	}
+	return r.fakeString(s), true
+}
+
+func (r VcrRedactionRule) fakeString(original string) string {
+	sum := sha256.Sum256([]byte(original))
+	fake := fmt.Sprintf("%s-%x", VcrRedactedValue, sum[:8])
+	if r.Base64Encoded {
+		return base64.StdEncoding.EncodeToString([]byte(fake))
+	}
+	return fake
+}
+
+// RedactCassette scrubs the cassette stored at path (without the .yaml extension)
+// using the registered redaction rules and saves it in place.
+func RedactCassette(path string) error {
+	c, err := cassette.Load(path)
+	if err != nil {
+		return fmt.Errorf("error loading cassette %s for redaction: %w", path, err)
+	}
+	for _, i := range c.Interactions {
+		if err := redactInteraction(i); err != nil {
+			return fmt.Errorf("error redacting cassette %s: %w", path, err)
+		}
+	}
+	if err := c.Save(); err != nil {
+		return fmt.Errorf("error saving redacted cassette %s: %w", path, err)
+	}
+	return nil
+}
+
+func redactInteraction(i *cassette.Interaction) error {
+	u, err := url.Parse(i.Request.URL)
+	if err != nil {
+		return err
+	}
+	for _, rule := range vcrRedactionRulesForHost(u.Host) {
+		redactHeaders(i.Request.Headers, rule)
+		redactHeaders(i.Response.Headers, rule)
+		i.Request.URL = redactQueryParams(i.Request.URL, rule)
+		i.Request.Body = rewriteJsonBody(i.Request.Body, rule.RequestJsonPaths, rule.fakeValue)
+		i.Response.Body = rewriteJsonBody(i.Response.Body, rule.ResponseJsonPaths, rule.fakeValue)
+	}
+	return nil
+}
+
+func redactHeaders(headers http.Header, rule VcrRedactionRule) {
+	for _, h := range rule.Headers {
+		if _, ok := headers[http.CanonicalHeaderKey(h)]; ok {
+			headers.Set(h, VcrRedactedValue)
+		}
+	}
+}
+
+func redactQueryParams(rawurl string, rule VcrRedactionRule) string {
+	if len(rule.QueryParams) == 0 {
+		return rawurl
+	}
+	u, err := url.Parse(rawurl)
+	if err != nil {
+		return rawurl
+	}
+	q := u.Query()
+	changed := false
+	for _, p := range rule.QueryParams {
+		if q.Has(p) {
+			q.Set(p, VcrRedactedValue)
+			changed = true
+		}
+	}
+	if !changed {
+		return rawurl
+	}
+	u.RawQuery = q.Encode()
+	return u.String()
+}
+
+// decodeJsonBody decodes a JSON body, keeping numbers as json.Number so that large
+// integers such as ids and sizes stay exact when the body is re-encoded
+func decodeJsonBody(body string) (interface{}, bool) {
+	dec := json.NewDecoder(strings.NewReader(body))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, false
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, false
+	}
+	return v, true
+}
+
+// rewriteJsonBody replaces the values at paths in a JSON body with the result of replace.
+// Bodies that are not JSON, or in which nothing changed, are returned unchanged.
+func rewriteJsonBody(body string, paths []string, replace func(interface{}) (interface{}, bool)) string {
+	if len(paths) == 0 || body == "" {
+		return body
+	}
+	v, ok := decodeJsonBody(body)
+	if !ok || !rewriteJsonPaths(v, paths, replace) {
+		return body
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return body
+	}
+	return string(b)
+}
+
+// redactJsonPaths replaces the values at paths in a decoded JSON value and reports whether any were found
+func redactJsonPaths(v interface{}, paths []string, replacement string) bool {
+	return rewriteJsonPaths(v, paths, func(old interface{}) (interface{}, bool) {
+		s, ok := old.(string)
+		return replacement, !ok || s != replacement
+	})
+}
+
+// rewriteJsonPaths replaces the values at paths in a decoded JSON value with the value
+// returned by replace, when it reports a change, and reports whether any changed
+func rewriteJsonPaths(v interface{}, paths []string, replace func(interface{}) (interface{}, bool)) bool {
+	changed := false
+	for _, path := range paths {
+		if rewriteJsonPath(v, strings.Split(path, "."), replace) {
+			changed = true
+		}
+	}
+	return changed
+}
+
+func rewriteJsonPath(v interface{}, parts []string, replace func(interface{}) (interface{}, bool)) bool {
+	changed := false
+	switch v := v.(type) {
+	case []interface{}:
+		for _, elem := range v {
+			if rewriteJsonPath(elem, parts, replace) {
+				changed = true
+			}
+		}
+	case map[string]interface{}:
+		for k, child := range v {
+			if parts[0] != "*" && parts[0] != k {
+				continue
+			}
+			if len(parts) > 1 {
+				if rewriteJsonPath(child, parts[1:], replace) {
+					changed = true
+				}
+				continue
+			}
+			if child == nil {
+				continue
+			}
+			if replacement, ok := replace(child); ok {
+				v[k] = replacement
+				changed = true
+			}
+		}
+	}
+	return changed
+}
+
+// normalizeRedactedRequest applies the request redaction rules for host to a decoded
+// JSON request body and URL, so that live requests match the scrubbed cassette on replay.
+func normalizeRedactedRequest(host, rawurl string, body interface{}) string {
+	for _, rule := range vcrRedactionRulesForHost(host) {
+		rawurl = redactQueryParams(rawurl, rule)
+		if body != nil {
+			redactJsonPaths(body, rule.RequestJsonPaths, VcrRedactedValue)
+		}
+	}
+	return rawurl
+}
+
+// vcrReplayTransport restores redacted values in replayed responses. It remembers the
+// values at the redacted request paths of every live request, and replaces fake values
+// in cassette responses with the live values they were derived from.
+type vcrReplayTransport struct {
+	*recorder.Recorder
+
+	mu        sync.Mutex
+	originals map[string]string
+}
+
+func newVcrReplayTransport(rec *recorder.Recorder) *vcrReplayTransport {
+	return &vcrReplayTransport{Recorder: rec, originals: map[string]string{}}
+}
+
+func (t *vcrReplayTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	rules := vcrRedactionRulesForHost(req.URL.Host)
+	if req.Body != nil && req.Body != http.NoBody {
+		b, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(b))
+		t.rememberOriginals(rules, string(b))
+	}
+
+	resp, err := t.Recorder.RoundTrip(req)
+	if err != nil || resp.Body == nil {
+		return resp, err
+	}
+	b, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	body := t.restoreOriginals(rules, string(b))
+	resp.Body = io.NopCloser(strings.NewReader(body))
+	resp.ContentLength = int64(len(body))
+	return resp, nil
+}
+
+func (t *vcrReplayTransport) rememberOriginals(rules []VcrRedactionRule, body string) {
+	v, ok := decodeJsonBody(body)
+	if !ok {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, rule := range rules {
+		rewriteJsonPaths(v, rule.RequestJsonPaths, func(original interface{}) (interface{}, bool) {
+			if s, ok := original.(string); ok {
+				t.originals[rule.fakeString(s)] = s
+			}
+			return nil, false
+		})
+	}
+}
+
+func (t *vcrReplayTransport) restoreOriginals(rules []VcrRedactionRule, body string) string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, rule := range rules {
+		body = rewriteJsonBody(body, rule.ResponseJsonPaths, func(fake interface{}) (interface{}, bool) {
+			if s, ok := fake.(string); ok {
+				if original, ok := t.originals[s]; ok {
+					return original, true
+				}
+			}
+			return nil, false
+		})
+	}
+	return body
+}
@@ -19,7 +19,6 @@ package acctest
 import (
 	"bytes"
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"io/ioutil"
@@ -29,7 +28,6 @@ import (
 	"net/url"
 	"os"
 	"path/filepath"
-	"reflect"
 	"regexp"
 	"slices"
 	"strconv"
@@ -221,12 +219,19 @@ func closeRecorder(t *testing.T) {
 		// We did not cache the config if it does not use VCR
 		if !t.Failed() && IsVcrEnabled() {
 			// If a test succeeds, write new seed/yaml to files
-			err := config.Client.Transport.(*recorder.Recorder).Stop()
+			err := config.Client.Transport.(interface{ Stop() error }).Stop()
 			if err != nil {
 				t.Error(err)
 			}
 			envPath := os.Getenv("VCR_PATH")
 
+			// Scrub secrets before the cassette is kept
+			if os.Getenv("VCR_MODE") == "RECORDING" {
+				if err := RedactCassette(filepath.Join(envPath, vcrFileName(t.Name()))); err != nil {
+					t.Error(err)
+				}
+			}
+
 			sourcesLock.RLock()
 			vcrSource, ok := sources[t.Name()]
 			sourcesLock.RUnlock()
@@ -283,29 +288,32 @@ func HandleVCRConfiguration(ctx context.Context, testName string, rndTripper htt
 	// Defines how VCR will match requests to responses.
 	rec.SetMatcher(NewVcrMatcherFunc(ctx))
 
+	if vcrMode == recorder.ModeReplaying {
+		// Map redacted response values back to the values the test sends
+		return pollInterval, newVcrReplayTransport(rec), diags
+	}
 	return pollInterval, rec, diags
 }
 
-// NewVcrMatcherFunc returns a function used for matching HTTP requests with data recorded in VCR cassettes
+// NewVcrMatcherFunc returns a function used for matching HTTP requests with data recorded in VCR cassettes.
+// Fields registered with RegisterVcrRedactionRules and RegisterVcrVolatileFields are normalized on both
+// requests before they are compared.
 func NewVcrMatcherFunc(ctx context.Context) func(r *http.Request, i cassette.Request) bool {
 	return func(r *http.Request, i cassette.Request) bool {
 		// Compare method and URL, normalizing standard Google API query
 		// params (alt, prettyPrint) so that cassettes recorded via the
-		// typed client match requests made via transport_tpg.SendRequest.
+		// typed client match requests made via transport_tpg.SendRequest,
+		// as well as query param order and volatile params.
 		if r.Method != i.Method {
 			return false
 		}
-		if stripStandardQueryParams(r.URL.String()) != stripStandardQueryParams(i.URL) {
+		host := r.URL.Host
+		if normalizeVcrUrl(host, r.URL.String()) != normalizeVcrUrl(host, i.URL) {
 			return false
 		}
 		if r.Body == nil {
 			return true
 		}
-		contentType := r.Header.Get("Content-Type")
-		// If body contains media, don't try to compare
-		if strings.Contains(contentType, "multipart/related") {
-			return true
-		}
 
 		var b bytes.Buffer
 		if _, err := b.ReadFrom(r.Body); err != nil {
@@ -314,23 +322,30 @@ func NewVcrMatcherFunc(ctx context.Context) func(r *http.Request, i cassette.Req
 		}
 		r.Body = ioutil.NopCloser(&b)
 		reqBody := b.String()
+		contentType := r.Header.Get("Content-Type")
+
+		// Multipart boundaries are random, so compare media uploads part by part
+		if strings.Contains(contentType, "multipart/related") {
+			match, err := vcrMultipartBodiesMatch(host, contentType, reqBody, i.Headers.Get("Content-Type"), i.Body)
+			if err != nil {
+				tflog.Debug(ctx, fmt.Sprintf("Failed to compare multipart bodies: %v", err))
+			}
+			return match
+		}
+
 		// If body matches identically, we are done
 		if reqBody == i.Body {
 			return true
 		}
 
-		// JSON might be the same, but reordered. Try parsing json and comparing
+		// JSON might be the same, but reordered or with different volatile fields.
+		// Try parsing json and comparing
 		if strings.Contains(contentType, "application/json") {
-			var reqJson, cassetteJson interface{}
-			if err := json.Unmarshal([]byte(reqBody), &reqJson); err != nil {
-				tflog.Debug(ctx, fmt.Sprintf("Failed to unmarshal request json: %v", err))
-				return false
-			}
-			if err := json.Unmarshal([]byte(i.Body), &cassetteJson); err != nil {
-				tflog.Debug(ctx, fmt.Sprintf("Failed to unmarshal cassette json: %v", err))
-				return false
+			match, err := vcrJsonBodiesMatch(host, reqBody, i.Body)
+			if err != nil {
+				tflog.Debug(ctx, err.Error())
 			}
-			return reflect.DeepEqual(reqJson, cassetteJson)
+			return match
 		}
 		return false
 	}
@@ -380,9 +395,20 @@ func (p *frameworkTestProvider) Configure(ctx context.Context, req provider.Conf
 	// When creating the frameworkTestProvider struct we took in a pointer to the the SDK provider.
 	// That SDK provider was configured using `GetSDKProvider` and `getCachedConfig`, so this framework provider will also
 	// use a cached client for the correct test name.
-	// No extra logic is required here, but in future when the SDK provider is removed this function will
-	// need to be updated with logic similar to that in `GetSDKProvider`.
+	// In future when the SDK provider is removed this function will need to be updated with logic
+	// similar to that in `GetSDKProvider`.
 	p.FrameworkProvider.Configure(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Plugin-framework resources read the default labels from this configuration, so the ownership
+	// labels are added here as well rather than relying on the SDK provider's configure function.
+	if labels := TestRunLabels(p.TestName); labels != nil {
+		if config, ok := resp.ResourceData.(*transport_tpg.Config); ok {
+			addTestRunLabels(config, labels)
+		}
+	}
 }
 
 // DataSources overrides the provider's DataSources function so that we can append test-specific data sources to the list of data sources on the provider.
@@ -410,6 +436,19 @@ func GetSDKProvider(testName string) *schema.Provider {
 	// This makes the data source(s) usable only in the context of acctests, and isn't available to users
 	prov.DataSourcesMap["google_provider_config_sdk"] = tpgprovider.DataSourceGoogleProviderConfigSdk()
 
+	if labels := TestRunLabels(testName); labels != nil {
+		configure := prov.ConfigureContextFunc
+		prov.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+			c, diags := configure(ctx, d)
+			if diags.HasError() {
+				return c, diags
+			}
+			config := c.(*transport_tpg.Config)
+			addTestRunLabels(config, labels)
+			return config, diags
+		}
+	}
+
 	if IsVcrEnabled() {
 		old := prov.ConfigureContextFunc
 		prov.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
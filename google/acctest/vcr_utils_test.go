@@ -19,11 +19,16 @@ package acctest_test
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/dnaeon/go-vcr/cassette"
 	"github.com/hashicorp/terraform-provider-google/google/acctest"
@@ -378,6 +383,336 @@ func TestNewVcrMatcherFunc_ignoresDifferentUserAgents(t *testing.T) {
 	}
 }
 
+func TestNewVcrMatcherFunc_matchesRedactedCassettes(t *testing.T) {
+	cases := map[string]struct {
+		httpRequest     requestDescription
+		cassetteRequest requestDescription
+	}{
+		"secret payloads are redacted before comparing bodies": {
+			httpRequest: requestDescription{
+				scheme:  "https",
+				method:  "POST",
+				host:    "secretmanager.googleapis.com",
+				path:    "v1/projects/my-project/secrets/my-secret:addVersion",
+				body:    "{\"payload\":{\"data\":\"c2VjcmV0\"}}",
+				headers: map[string]string{"Content-Type": "application/json"},
+			},
+			cassetteRequest: requestDescription{
+				scheme:  "https",
+				method:  "POST",
+				host:    "secretmanager.googleapis.com",
+				path:    "v1/projects/my-project/secrets/my-secret:addVersion",
+				body:    "{\"payload\":{\"data\":\"UkVEQUNURUQ=\"}}",
+				headers: map[string]string{"Content-Type": "application/json"},
+			},
+		},
+		"api keys are redacted before comparing URLs": {
+			httpRequest: requestDescription{
+				scheme: "https",
+				method: "GET",
+				host:   "example.googleapis.com",
+				path:   "v1/things",
+				query:  "key=abc123",
+			},
+			cassetteRequest: requestDescription{
+				scheme: "https",
+				method: "GET",
+				host:   "example.googleapis.com",
+				path:   "v1/things",
+				query:  "key=REDACTED",
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			req := prepareHttpRequest(tc.httpRequest)
+			cassetteReq := prepareCassetteRequest(tc.cassetteRequest)
+			matcher := acctest.NewVcrMatcherFunc(context.Background())
+
+			if !matcher(req, cassetteReq) {
+				t.Fatalf("expected matcher to match the requests")
+			}
+		})
+	}
+}
+
+func TestNewVcrMatcherFunc_normalizesVolatileFields(t *testing.T) {
+	upload := func(boundary, content string) string {
+		return "--" + boundary + "\r\n" +
+			"Content-Type: application/json\r\n\r\n" +
+			"{\"name\":\"obj\",\"bucket\":\"b\"}\r\n" +
+			"--" + boundary + "\r\n" +
+			"Content-Type: text/plain\r\n\r\n" +
+			content + "\r\n" +
+			"--" + boundary + "--\r\n"
+	}
+
+	cases := map[string]struct {
+		httpRequest     requestDescription
+		cassetteRequest requestDescription
+		want            bool
+	}{
+		"query params in a different order": {
+			httpRequest: requestDescription{
+				scheme: "https",
+				method: "GET",
+				host:   "example.com",
+				path:   "foobar",
+				query:  "b=2&a=1&a=0",
+			},
+			cassetteRequest: requestDescription{
+				scheme: "https",
+				method: "GET",
+				host:   "example.com",
+				path:   "foobar",
+				query:  "a=0&a=1&b=2",
+			},
+			want: true,
+		},
+		"different page tokens": {
+			httpRequest: requestDescription{
+				scheme: "https",
+				method: "GET",
+				host:   "example.com",
+				path:   "foobar",
+				query:  "pageToken=abc",
+			},
+			cassetteRequest: requestDescription{
+				scheme: "https",
+				method: "GET",
+				host:   "example.com",
+				path:   "foobar",
+				query:  "pageToken=def",
+			},
+			want: true,
+		},
+		"page token only on one request": {
+			httpRequest: requestDescription{
+				scheme: "https",
+				method: "GET",
+				host:   "example.com",
+				path:   "foobar",
+				query:  "pageToken=abc",
+			},
+			cassetteRequest: requestDescription{
+				scheme: "https",
+				method: "GET",
+				host:   "example.com",
+				path:   "foobar",
+			},
+			want: false,
+		},
+		"different request ids and etags in JSON bodies": {
+			httpRequest: requestDescription{
+				scheme:  "https",
+				method:  "POST",
+				host:    "example.com",
+				path:    "foobar",
+				body:    "{\"requestId\":\"1\",\"policy\":{\"etag\":\"BwA=\"},\"field\":\"value\"}",
+				headers: map[string]string{"Content-Type": "application/json"},
+			},
+			cassetteRequest: requestDescription{
+				scheme:  "https",
+				method:  "POST",
+				host:    "example.com",
+				path:    "foobar",
+				body:    "{\"requestId\":\"2\",\"policy\":{\"etag\":\"BwB=\"},\"field\":\"value\"}",
+				headers: map[string]string{"Content-Type": "application/json"},
+			},
+			want: true,
+		},
+		"multipart uploads with different boundaries": {
+			httpRequest: requestDescription{
+				scheme:  "https",
+				method:  "POST",
+				host:    "storage.googleapis.com",
+				path:    "upload/storage/v1/b/b/o",
+				body:    upload("aaa", "hello"),
+				headers: map[string]string{"Content-Type": "multipart/related; boundary=aaa"},
+			},
+			cassetteRequest: requestDescription{
+				scheme: "https",
+				method: "POST",
+				host:   "storage.googleapis.com",
+				path:   "upload/storage/v1/b/b/o",
+				body:   upload("bbb", "hello"),
+			},
+			want: true,
+		},
+		"multipart uploads with different content": {
+			httpRequest: requestDescription{
+				scheme:  "https",
+				method:  "POST",
+				host:    "storage.googleapis.com",
+				path:    "upload/storage/v1/b/b/o",
+				body:    upload("aaa", "hello"),
+				headers: map[string]string{"Content-Type": "multipart/related; boundary=aaa"},
+			},
+			cassetteRequest: requestDescription{
+				scheme:  "https",
+				method:  "POST",
+				host:    "storage.googleapis.com",
+				path:    "upload/storage/v1/b/b/o",
+				body:    upload("bbb", "goodbye"),
+				headers: map[string]string{"Content-Type": "multipart/related; boundary=bbb"},
+			},
+			want: false,
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			req := prepareHttpRequest(tc.httpRequest)
+			cassetteReq := prepareCassetteRequest(tc.cassetteRequest)
+			matcher := acctest.NewVcrMatcherFunc(context.Background())
+
+			if got := matcher(req, cassetteReq); got != tc.want {
+				t.Fatalf("expected match to be %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestRedactCassette(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "TestRedactCassette")
+	c := cassette.New(path)
+	c.AddInteraction(&cassette.Interaction{
+		Request: cassette.Request{
+			Method:  "POST",
+			URL:     "https://iam.googleapis.com/v1/projects/p/serviceAccounts/sa/keys",
+			Body:    "{}",
+			Headers: http.Header{"Authorization": []string{"Bearer ya29.secret"}},
+		},
+		Response: cassette.Response{
+			Body: "{\"name\":\"key\",\"privateKeyData\":\"c2VjcmV0\"}",
+			Code: 200,
+		},
+	})
+	if err := c.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := acctest.RedactCassette(path); err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := cassette.Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	i := c.Interactions[0]
+	if got := i.Request.Headers.Get("Authorization"); got != acctest.VcrRedactedValue {
+		t.Errorf("expected Authorization header to be redacted, got %q", got)
+	}
+	var resp map[string]string
+	if err := json.Unmarshal([]byte(i.Response.Body), &resp); err != nil {
+		t.Fatal(err)
+	}
+	if resp["name"] != "key" {
+		t.Errorf("expected only privateKeyData to be redacted, got %s", i.Response.Body)
+	}
+	// The provider decodes private keys, so the fake value must be valid base64
+	key, err := base64.StdEncoding.DecodeString(resp["privateKeyData"])
+	if err != nil || !strings.HasPrefix(string(key), acctest.VcrRedactedValue) {
+		t.Errorf("expected privateKeyData to be replaced with an encoded fake value, got %s", i.Response.Body)
+	}
+}
+
+func TestHandleVCRConfiguration_restoresRedactedResponses(t *testing.T) {
+	dir := t.TempDir()
+	name := "TestHandleVCRConfiguration_restoresRedactedResponses"
+	secret := base64.StdEncoding.EncodeToString([]byte("my-secret"))
+	c := cassette.New(filepath.Join(dir, name))
+	c.AddInteraction(&cassette.Interaction{
+		Request: cassette.Request{
+			Method:  "POST",
+			URL:     "https://secretmanager.googleapis.com/v1/projects/p/secrets/s:addVersion",
+			Body:    fmt.Sprintf("{\"payload\":{\"data\":%q}}", secret),
+			Headers: http.Header{"Content-Type": []string{"application/json"}},
+		},
+		Response: cassette.Response{Body: "{\"name\":\"projects/p/secrets/s/versions/1\"}", Code: 200},
+	})
+	c.AddInteraction(&cassette.Interaction{
+		Request: cassette.Request{
+			Method: "GET",
+			URL:    "https://secretmanager.googleapis.com/v1/projects/p/secrets/s/versions/1:access",
+		},
+		Response: cassette.Response{Body: fmt.Sprintf("{\"payload\":{\"data\":%q}}", secret), Code: 200},
+	})
+	if err := c.Save(); err != nil {
+		t.Fatal(err)
+	}
+	if err := acctest.RedactCassette(filepath.Join(dir, name)); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv("VCR_MODE", "REPLAYING")
+	t.Setenv("VCR_PATH", dir)
+	_, rt, diags := acctest.HandleVCRConfiguration(context.Background(), name, http.DefaultTransport, time.Second)
+	if diags.HasError() {
+		t.Fatalf("unexpected error configuring VCR: %v", diags)
+	}
+	client := &http.Client{Transport: rt}
+
+	req, err := http.NewRequest("POST", "https://secretmanager.googleapis.com/v1/projects/p/secrets/s:addVersion", strings.NewReader(fmt.Sprintf("{\"payload\":{\"data\":%q}}", secret)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if _, err := client.Do(req); err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := client.Get("https://secretmanager.googleapis.com/v1/projects/p/secrets/s/versions/1:access")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(body), secret) {
+		t.Errorf("expected the replayed payload to be restored to the value sent by the test, got %s", body)
+	}
+}
+
+func TestRedactCassette_keepsLargeNumbers(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "TestRedactCassette_keepsLargeNumbers")
+	c := cassette.New(path)
+	c.AddInteraction(&cassette.Interaction{
+		Request: cassette.Request{
+			Method: "POST",
+			URL:    "https://sqladmin.googleapis.com/v1/projects/p/instances",
+			Body:   "{\"rootPassword\":\"hunter2\",\"settings\":{\"dataDiskSizeGb\":9007199254740993}}",
+		},
+		Response: cassette.Response{
+			Body: "{}",
+			Code: 200,
+		},
+	})
+	if err := c.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := acctest.RedactCassette(path); err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := cassette.Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body := c.Interactions[0].Request.Body
+	if strings.Contains(body, "hunter2") {
+		t.Errorf("expected rootPassword to be redacted, got %s", body)
+	}
+	if !strings.Contains(body, "9007199254740993") {
+		t.Errorf("expected large integers to be preserved, got %s", body)
+	}
+}
+
 type requestDescription struct {
 	scheme  string
 	method  string
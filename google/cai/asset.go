@@ -0,0 +1,197 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/cai/asset.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package cai
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// https://cloud.google.com/asset-inventory/docs/reference/rest/v1/feeds#ContentType
+const (
+	ContentTypeResource  = "RESOURCE"
+	ContentTypeIamPolicy = "IAM_POLICY"
+)
+
+// Asset is a predicted Cloud Asset Inventory asset, using the same field
+// names as a CAI export so that existing policy tooling can consume it.
+type Asset struct {
+	Name         string     `json:"name"`
+	AssetType    string     `json:"asset_type"`
+	Ancestors    []string   `json:"ancestors,omitempty"`
+	Resource     *Resource  `json:"resource,omitempty"`
+	IamPolicy    *IamPolicy `json:"iam_policy,omitempty"`
+	ContentType  string     `json:"content_type"`
+	TerraformRef string     `json:"terraform_address,omitempty"`
+}
+
+// Resource is the RESOURCE content of an asset.
+type Resource struct {
+	Version       string                 `json:"version,omitempty"`
+	DiscoveryName string                 `json:"discovery_name,omitempty"`
+	Parent        string                 `json:"parent,omitempty"`
+	Data          map[string]interface{} `json:"data,omitempty"`
+}
+
+// IamPolicy is the IAM_POLICY content of an asset.
+type IamPolicy struct {
+	Bindings     []IamBinding     `json:"bindings"`
+	AuditConfigs []IamAuditConfig `json:"audit_configs,omitempty"`
+}
+
+type IamBinding struct {
+	Role      string                 `json:"role"`
+	Members   []string               `json:"members"`
+	Condition map[string]interface{} `json:"condition,omitempty"`
+}
+
+type IamAuditConfig struct {
+	Service         string              `json:"service"`
+	AuditLogConfigs []IamAuditLogConfig `json:"audit_log_configs"`
+}
+
+type IamAuditLogConfig struct {
+	LogType         string   `json:"log_type"`
+	ExemptedMembers []string `json:"exempted_members,omitempty"`
+}
+
+// PROJECT_NUMBER instead of PROJECT_ID is in the CAI asset names for the resources in those services
+// https://cloud.google.com/asset-inventory/docs/asset-names
+var ServicesWithProjectNumber = map[string]struct{}{
+	"apikeys":               {}, // DCL
+	"binaryauthorization":   {},
+	"cloudtasks":            {},
+	"cloudbuild":            {},
+	"colab":                 {},
+	"containerattached":     {},
+	"containeraws":          {},
+	"containerazure":        {},
+	"dialogflowcx":          {},
+	"discoveryengine":       {},
+	"documentai":            {},
+	"healthcare":            {},
+	"iambeta":               {},
+	"iap":                   {},
+	"identityplatform":      {},
+	"logging":               {},
+	"monitoring":            {},
+	"osconfig":              {},
+	"secretmanager":         {},
+	"secretmanagerregional": {},
+	"vpcaccess":             {},
+}
+
+var iamSuffixes = []string{
+	"_iam_member",
+	"_iam_policy",
+	"_iam_binding",
+	"_iam_audit_config",
+}
+
+// Checks if a resource is an IAM resource
+func IsIamResource(resourceType string) bool {
+	for _, suffix := range iamSuffixes {
+		if strings.HasSuffix(resourceType, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// iamParentResourceType strips the IAM suffix from an IAM resource type, for
+// example google_pubsub_topic_iam_member becomes google_pubsub_topic.
+func iamParentResourceType(resourceType string) string {
+	resourceType, _ = strings.CutSuffix(resourceType, "_iam_member")
+	resourceType, _ = strings.CutSuffix(resourceType, "_iam_binding")
+	resourceType, _ = strings.CutSuffix(resourceType, "_iam_policy")
+	resourceType, _ = strings.CutSuffix(resourceType, "_iam_audit_config")
+	return resourceType
+}
+
+// FormatAssetName constructs a fully qualified Cloud Asset Inventory (CAI) asset name
+// by interpolating values from the provided attributes map into a format template.
+// It extracts required placeholders (e.g., "{{project}}", "{{name}}") from the
+// caiAssetNameFormat string and replaces them with their corresponding values from the map.
+func FormatAssetName(caiAssetNameFormat string, attributes map[string]string) string {
+	paramsMap := make(map[string]any, 0)
+	params := ExtractIdentifiers(caiAssetNameFormat)
+	for _, param := range params {
+		v := attributes[param]
+		paramsMap[param] = v
+	}
+
+	return ReplacePlaceholders(caiAssetNameFormat, paramsMap)
+}
+
+// For example, for the url "projects/{{project}}/schemas/{{schema}}",
+// the identifiers are "project", "schema".
+func ExtractIdentifiers(url string) []string {
+	matches := regexp.MustCompile(`\{\{%?(\w+)\}\}`).FindAllStringSubmatch(url, -1)
+	var result []string
+	for _, match := range matches {
+		result = append(result, match[1])
+	}
+	return result
+}
+
+// It replaces all instances of {{key}} in the template with the
+// corresponding value from the parameters map.
+func ReplacePlaceholders(template string, params map[string]any) string {
+	re := regexp.MustCompile("{{([%[:word:]]+)}}")
+
+	result := re.ReplaceAllStringFunc(template, func(match string) string {
+		key := strings.Trim(match, "{}")
+
+		// The % indicates that the name value should be URL-encoded.
+		key, shouldBeEncoded := strings.CutPrefix(key, "%")
+		if value, ok := params[key]; ok {
+			v := value.(string)
+			if !shouldBeEncoded {
+				return v
+			}
+			return url.PathEscape(v)
+		}
+
+		return match
+	})
+
+	return result
+}
+
+// ServiceDomain returns the API service domain of a CAI asset name, for
+// example container.googleapis.com for //container.googleapis.com/projects/foo.
+func ServiceDomain(caiAssetName string) string {
+	parts := strings.Split(caiAssetName, "/")
+	if len(parts) >= 3 {
+		return parts[2]
+	}
+	return ""
+}
+
+// IamResourceId gets the IAM resource Id by removing the IAM role and member binding information from id
+// id "projects/local-mediator-361721/zones/us-central1-a/instances/tf-test-my-instancev8xqssrek2/roles/compute.osLogin/user:admin@example.com"
+// will become "projects/local-mediator-361721/zones/us-central1-a/instances/tf-test-my-instancev8xqssrek2"
+func IamResourceId(id string) string {
+	parts := strings.Split(id, "/roles/")
+
+	if len(parts) > 0 {
+		return parts[0]
+	}
+
+	return id
+}
@@ -0,0 +1,175 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/cai/metadata.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package cai
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Metadata represents the structure of the resource metadata files
+// (resource_*_meta.yaml) that live next to each resource implementation.
+type Metadata struct {
+	Resource            string          `yaml:"resource"`
+	GenerationType      string          `yaml:"generation_type"`
+	SourceFile          string          `yaml:"source_file"`
+	ApiServiceName      string          `yaml:"api_service_name"`
+	ApiVersion          string          `yaml:"api_version"`
+	ApiResourceTypeKind string          `yaml:"api_resource_type_kind"`
+	CaiAssetNameFormats []string        `yaml:"cai_asset_name_formats"`
+	ApiVariantPatterns  []string        `yaml:"api_variant_patterns"`
+	AutogenStatus       bool            `yaml:"autogen_status,omitempty"`
+	AutogenVersion      int             `yaml:"autogen_version,omitempty"`
+	Fields              []MetadataField `yaml:"fields"`
+
+	// These keys store information about the metadata file itself.
+
+	// Path is the absolute path of the loaded metadata file
+	Path string
+	// ServicePackage is the folder within services/ that the metadata file is in, for example compute
+	ServicePackage string
+	// FileName is the filename of the metadata file, for example resource_compute_instance_meta.yaml
+	FileName string
+}
+
+type MetadataField struct {
+	ApiField     string `yaml:"api_field"`
+	Field        string `yaml:"field"`
+	ProviderOnly bool   `yaml:"provider_only"`
+	Json         bool   `yaml:"json"`
+}
+
+// AssetType returns the CAI asset type for the resource, for example
+// compute.googleapis.com/Instance. It is empty if the metadata does not
+// declare both the API service name and the resource kind.
+func (m Metadata) AssetType() string {
+	if m.ApiServiceName == "" || m.ApiResourceTypeKind == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s/%s", m.ApiServiceName, m.ApiResourceTypeKind)
+}
+
+// TerraformField returns the dotted Terraform field path for a metadata
+// field. Fields that only declare api_field are named after the snake_case
+// form of each API path segment.
+func (f MetadataField) TerraformField() string {
+	if f.Field != "" {
+		return f.Field
+	}
+	parts := strings.Split(f.ApiField, ".")
+	for i, p := range parts {
+		parts[i] = camelToSnake(p)
+	}
+	return strings.Join(parts, ".")
+}
+
+// LoadMetadata walks servicesDir and parses every resource metadata file in
+// it, keyed by resource type. Malformed files are skipped and reported in the
+// returned error alongside the successfully parsed entries; duplicate
+// resource types and filesystem errors stop the walk.
+func LoadMetadata(servicesDir string) (map[string]Metadata, error) {
+	return loadMetadata(os.DirFS(servicesDir), servicesDir)
+}
+
+// LoadMetadataFS is like LoadMetadata, for metadata files laid out in fsys
+// like in the services directory, such as the files embedded in the provider.
+// The Path of each entry is relative to fsys.
+func LoadMetadataFS(fsys fs.FS) (map[string]Metadata, error) {
+	return loadMetadata(fsys, "")
+}
+
+func loadMetadata(fsys fs.FS, servicesDir string) (map[string]Metadata, error) {
+	result := make(map[string]Metadata)
+
+	var malformedYamlErrs []string
+
+	err := fs.WalkDir(fsys, ".", func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err // Fail immediately if there's an OS error.
+		}
+
+		// Skip non-metadata files
+		if entry.IsDir() || !strings.HasPrefix(entry.Name(), "resource_") || !strings.HasSuffix(entry.Name(), "_meta.yaml") {
+			return nil
+		}
+
+		content, err := fs.ReadFile(fsys, path)
+		if err != nil {
+			return err // Fail immediately if there's an OS error.
+		}
+
+		var metadata Metadata
+		if err := yaml.Unmarshal(content, &metadata); err != nil {
+			// note but keep walking
+			malformedYamlErrs = append(malformedYamlErrs, fmt.Sprintf("%s: %v", path, err.Error()))
+			return nil
+		}
+
+		if _, ok := result[metadata.Resource]; ok {
+			return fmt.Errorf("duplicate resource: %s in %s", metadata.Resource, path)
+		}
+
+		metadata.Path = filepath.Join(servicesDir, filepath.FromSlash(path))
+		metadata.FileName = entry.Name()
+		servicePackage, _, ok := strings.Cut(path, "/")
+		if !ok {
+			return fmt.Errorf("no service found for %s (%s)", metadata.Resource, metadata.Path)
+		}
+		metadata.ServicePackage = servicePackage
+		result[metadata.Resource] = metadata
+		return nil
+	})
+
+	if err != nil {
+		return result, fmt.Errorf("error walking directory: %v", err)
+	}
+
+	if len(malformedYamlErrs) > 0 {
+		return result, fmt.Errorf("YAML parsing errors encountered:\n%v", strings.Join(malformedYamlErrs, "\n"))
+	}
+
+	return result, nil
+}
+
+// LookupMetadata returns the metadata for a resource type. IAM resources
+// resolve to the metadata of the resource they manage the policy of, since
+// IAM metadata is not generated separately.
+func LookupMetadata(metadata map[string]Metadata, resourceType string) (Metadata, bool) {
+	resourceType = iamParentResourceType(resourceType)
+	m, ok := metadata[resourceType]
+	return m, ok
+}
+
+func camelToSnake(s string) string {
+	var b strings.Builder
+	for i, r := range s {
+		if r >= 'A' && r <= 'Z' {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			b.WriteRune(r + ('a' - 'A'))
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
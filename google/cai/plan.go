@@ -0,0 +1,655 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/cai/plan.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package cai
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+)
+
+// Plan is the subset of the `terraform show -json` plan representation
+// needed to predict the assets a plan will produce.
+type Plan struct {
+	FormatVersion   string           `json:"format_version"`
+	ResourceChanges []ResourceChange `json:"resource_changes"`
+}
+
+type ResourceChange struct {
+	Address      string `json:"address"`
+	Mode         string `json:"mode"`
+	Type         string `json:"type"`
+	Name         string `json:"name"`
+	ProviderName string `json:"provider_name"`
+	Change       Change `json:"change"`
+}
+
+type Change struct {
+	Actions []string               `json:"actions"`
+	Before  map[string]interface{} `json:"before"`
+	After   map[string]interface{} `json:"after"`
+}
+
+// ReadPlan decodes the JSON output of `terraform show -json <planfile>`.
+func ReadPlan(r io.Reader) (*Plan, error) {
+	var plan Plan
+	if err := json.NewDecoder(r).Decode(&plan); err != nil {
+		return nil, fmt.Errorf("error decoding plan JSON: %w", err)
+	}
+	if plan.FormatVersion == "" {
+		return nil, fmt.Errorf("input is not a Terraform JSON plan: format_version is missing")
+	}
+	return &plan, nil
+}
+
+// Converter predicts the Cloud Asset Inventory assets that applying a plan
+// would produce, based on the resource metadata files.
+type Converter struct {
+	// Metadata is keyed by resource type, as returned by LoadMetadata.
+	Metadata map[string]Metadata
+
+	// Project and ProjectNumber are used for resources that inherit their
+	// project from the provider configuration, and for services whose asset
+	// names use the project number.
+	Project       string
+	ProjectNumber string
+
+	// Ancestors overrides the ancestry of every asset, for example
+	// []string{"projects/123", "folders/456", "organizations/789"}.
+	Ancestors []string
+
+	// IsSingleNestedBlock reports whether a dotted Terraform field path of a
+	// resource type is a block limited to a single element. Such blocks are
+	// represented as objects rather than lists in API payloads. When nil,
+	// every Terraform list is converted to an API list.
+	IsSingleNestedBlock func(resourceType, field string) bool
+}
+
+// Convert returns the predicted assets for every google resource that exists
+// after the plan is applied. Resources whose asset name or type cannot be
+// determined are reported in the returned errors and, when they have a known
+// asset type, still emitted with an empty name.
+func (c *Converter) Convert(plan *Plan) ([]Asset, []error) {
+	var assets []Asset
+	var errs []error
+	iamAssets := make(map[string]*Asset)
+	var iamOrder []string
+
+	for _, rc := range plan.ResourceChanges {
+		if rc.Mode != "managed" || !strings.HasPrefix(rc.Type, "google_") || rc.Change.After == nil {
+			continue
+		}
+		if slices.Equal(rc.Change.Actions, []string{"delete"}) {
+			continue
+		}
+
+		if IsIamResource(rc.Type) {
+			asset, err := c.convertIam(rc)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", rc.Address, err))
+				continue
+			}
+			if existing, ok := iamAssets[asset.Name]; ok {
+				existing.IamPolicy.Bindings = mergeBindings(existing.IamPolicy.Bindings, asset.IamPolicy.Bindings)
+				existing.IamPolicy.AuditConfigs = mergeAuditConfigs(existing.IamPolicy.AuditConfigs, asset.IamPolicy.AuditConfigs)
+				continue
+			}
+			iamAssets[asset.Name] = asset
+			iamOrder = append(iamOrder, asset.Name)
+			continue
+		}
+
+		asset, err := c.convertResource(rc)
+		if asset != nil {
+			assets = append(assets, *asset)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", rc.Address, err))
+		}
+	}
+
+	for _, name := range iamOrder {
+		assets = append(assets, *iamAssets[name])
+	}
+
+	return assets, errs
+}
+
+func (c *Converter) convertResource(rc ResourceChange) (*Asset, error) {
+	metadata, ok := c.Metadata[rc.Type]
+	if !ok {
+		return nil, fmt.Errorf("unknown resource type %s", rc.Type)
+	}
+	if metadata.AssetType() == "" {
+		return nil, fmt.Errorf("no CAI asset type for resource type %s", rc.Type)
+	}
+
+	attributes := c.attributes(rc.Change.After)
+	asset := &Asset{
+		AssetType:    metadata.AssetType(),
+		ContentType:  ContentTypeResource,
+		Ancestors:    c.ancestors(attributes),
+		TerraformRef: rc.Address,
+		Resource: &Resource{
+			Version:       metadata.ApiVersion,
+			DiscoveryName: metadata.ApiResourceTypeKind,
+			Parent:        c.parent(attributes),
+			Data:          c.resourceData(rc.Type, metadata, rc.Change.After),
+		},
+	}
+
+	name, err := c.assetName(rc.Type, metadata, attributes)
+	asset.Name = name
+	return asset, err
+}
+
+func (c *Converter) convertIam(rc ResourceChange) (*Asset, error) {
+	attributes := c.attributes(rc.Change.After)
+
+	name, assetType, err := c.iamAssetName(rc.Type, attributes)
+	if err != nil {
+		return nil, err
+	}
+
+	var bindings []IamBinding
+	var auditConfigs []IamAuditConfig
+	switch {
+	case strings.HasSuffix(rc.Type, "_iam_member"):
+		bindings = []IamBinding{{
+			Role:      attributes["role"],
+			Members:   []string{attributes["member"]},
+			Condition: iamCondition(rc.Change.After["condition"]),
+		}}
+	case strings.HasSuffix(rc.Type, "_iam_binding"):
+		binding := IamBinding{
+			Role:      attributes["role"],
+			Condition: iamCondition(rc.Change.After["condition"]),
+		}
+		if members, ok := rc.Change.After["members"].([]interface{}); ok {
+			for _, m := range members {
+				binding.Members = append(binding.Members, fmt.Sprint(m))
+			}
+		}
+		sort.Strings(binding.Members)
+		bindings = []IamBinding{binding}
+	case strings.HasSuffix(rc.Type, "_iam_policy"):
+		policyData, ok := rc.Change.After["policy_data"].(string)
+		if !ok {
+			return nil, fmt.Errorf("policy_data is not known at plan time")
+		}
+		var policy IamPolicy
+		if err := json.Unmarshal([]byte(policyData), &policy); err != nil {
+			return nil, fmt.Errorf("error parsing policy_data: %w", err)
+		}
+		bindings = policy.Bindings
+		auditConfigs, err = policyDataAuditConfigs(policyData)
+		if err != nil {
+			return nil, err
+		}
+	case strings.HasSuffix(rc.Type, "_iam_audit_config"):
+		auditConfig := IamAuditConfig{Service: attributes["service"]}
+		logConfigs, _ := rc.Change.After["audit_log_config"].([]interface{})
+		for _, raw := range logConfigs {
+			logConfig, _ := raw.(map[string]interface{})
+			auditLogConfig := IamAuditLogConfig{LogType: fmt.Sprint(logConfig["log_type"])}
+			if members, ok := logConfig["exempted_members"].([]interface{}); ok {
+				for _, m := range members {
+					auditLogConfig.ExemptedMembers = append(auditLogConfig.ExemptedMembers, fmt.Sprint(m))
+				}
+			}
+			sort.Strings(auditLogConfig.ExemptedMembers)
+			auditConfig.AuditLogConfigs = append(auditConfig.AuditLogConfigs, auditLogConfig)
+		}
+		auditConfigs = []IamAuditConfig{auditConfig}
+	default:
+		return nil, fmt.Errorf("resource type %s does not manage IAM bindings", rc.Type)
+	}
+
+	return &Asset{
+		Name:         name,
+		AssetType:    assetType,
+		ContentType:  ContentTypeIamPolicy,
+		Ancestors:    c.ancestors(attributes),
+		TerraformRef: rc.Address,
+		IamPolicy: &IamPolicy{
+			Bindings:     mergeBindings(nil, bindings),
+			AuditConfigs: mergeAuditConfigs(nil, auditConfigs),
+		},
+	}, nil
+}
+
+// policyDataAuditConfigs returns the audit configs of an IAM policy in its API
+// representation, whose field names differ from those of a CAI export.
+func policyDataAuditConfigs(policyData string) ([]IamAuditConfig, error) {
+	var policy struct {
+		AuditConfigs []struct {
+			Service         string `json:"service"`
+			AuditLogConfigs []struct {
+				LogType         string   `json:"logType"`
+				ExemptedMembers []string `json:"exemptedMembers"`
+			} `json:"auditLogConfigs"`
+		} `json:"auditConfigs"`
+	}
+	if err := json.Unmarshal([]byte(policyData), &policy); err != nil {
+		return nil, fmt.Errorf("error parsing policy_data: %w", err)
+	}
+
+	var auditConfigs []IamAuditConfig
+	for _, ac := range policy.AuditConfigs {
+		auditConfig := IamAuditConfig{Service: ac.Service}
+		for _, lc := range ac.AuditLogConfigs {
+			auditConfig.AuditLogConfigs = append(auditConfig.AuditLogConfigs, IamAuditLogConfig{
+				LogType:         lc.LogType,
+				ExemptedMembers: lc.ExemptedMembers,
+			})
+		}
+		auditConfigs = append(auditConfigs, auditConfig)
+	}
+	return auditConfigs, nil
+}
+
+// attributes returns the top-level scalar attributes of a planned resource
+// as strings, falling back to the converter's project when none is set.
+func (c *Converter) attributes(values map[string]interface{}) map[string]string {
+	attributes := make(map[string]string)
+	for k, v := range values {
+		switch v.(type) {
+		case string, bool, float64, json.Number:
+			attributes[k] = fmt.Sprint(v)
+		}
+	}
+	if attributes["project"] == "" && c.Project != "" {
+		attributes["project"] = c.Project
+	}
+	return attributes
+}
+
+func (c *Converter) projectRef(attributes map[string]string) string {
+	if c.ProjectNumber != "" && (attributes["project"] == "" || attributes["project"] == c.Project) {
+		return c.ProjectNumber
+	}
+	return attributes["project"]
+}
+
+func (c *Converter) ancestors(attributes map[string]string) []string {
+	if len(c.Ancestors) > 0 {
+		return c.Ancestors
+	}
+	if project := c.projectRef(attributes); project != "" {
+		return []string{fmt.Sprintf("projects/%s", project)}
+	}
+	return nil
+}
+
+func (c *Converter) parent(attributes map[string]string) string {
+	if project := c.projectRef(attributes); project != "" {
+		return fmt.Sprintf("//cloudresourcemanager.googleapis.com/projects/%s", project)
+	}
+	return ""
+}
+
+// assetName predicts the CAI asset name of a resource. The name formats in
+// the metadata are preferred, followed by the resource id when it is already
+// known (updates and no-op changes) and finally the API variant patterns.
+func (c *Converter) assetName(resourceType string, metadata Metadata, attributes map[string]string) (string, error) {
+	switch len(metadata.CaiAssetNameFormats) {
+	case 0:
+	case 1:
+		if name, ok := c.formatName(metadata, metadata.CaiAssetNameFormats[0], attributes); ok {
+			return name, nil
+		}
+	default:
+		if strings.HasPrefix(resourceType, "google_container_") {
+			format := metadata.CaiAssetNameFormats[0]
+			if tpgresource.IsZone(attributes["location"]) {
+				format = strings.Replace(format, "/locations/", "/zones/", 1)
+			}
+			if name, ok := c.formatName(metadata, format, attributes); ok {
+				return name, nil
+			}
+		}
+	}
+
+	if id := attributes["id"]; id != "" {
+		if resourceType == "google_project" && attributes["number"] != "" {
+			id = fmt.Sprintf("projects/%s", attributes["number"])
+		}
+		return fmt.Sprintf("//%s/%s", metadata.ApiServiceName, c.withProjectNumber(metadata, id)), nil
+	}
+
+	for _, pattern := range metadata.ApiVariantPatterns {
+		if name, ok := c.formatName(metadata, "//"+metadata.ApiServiceName+"/"+variantPatternToFormat(pattern), variantAttributes(pattern, attributes)); ok {
+			return name, nil
+		}
+	}
+
+	return "", fmt.Errorf("cannot determine the CAI asset name of %s from planned values", resourceType)
+}
+
+func (c *Converter) formatName(metadata Metadata, format string, attributes map[string]string) (string, bool) {
+	for _, param := range ExtractIdentifiers(format) {
+		if attributes[param] == "" {
+			return "", false
+		}
+	}
+	return c.withProjectNumber(metadata, FormatAssetName(format, attributes)), true
+}
+
+func (c *Converter) withProjectNumber(metadata Metadata, name string) string {
+	if _, ok := ServicesWithProjectNumber[metadata.ServicePackage]; ok && c.Project != "" && c.ProjectNumber != "" {
+		return strings.Replace(name, c.Project, c.ProjectNumber, 1)
+	}
+	return name
+}
+
+// iamAttributes are the attributes of IAM resources that describe the
+// bindings rather than the resource the policy is attached to.
+var iamAttributes = map[string]struct{}{
+	"id":               {},
+	"role":             {},
+	"member":           {},
+	"members":          {},
+	"condition":        {},
+	"etag":             {},
+	"policy_data":      {},
+	"service":          {},
+	"audit_log_config": {},
+	"project":          {},
+	"location":         {},
+	"region":           {},
+	"zone":             {},
+}
+
+func (c *Converter) iamAssetName(resourceType string, attributes map[string]string) (string, string, error) {
+	switch iamParentResourceType(resourceType) {
+	case "google_project":
+		return fmt.Sprintf("//cloudresourcemanager.googleapis.com/projects/%s", attributes["project"]), "cloudresourcemanager.googleapis.com/Project", nil
+	case "google_folder":
+		folder := attributes["folder"]
+		if !strings.HasPrefix(folder, "folders/") {
+			folder = "folders/" + folder
+		}
+		return fmt.Sprintf("//cloudresourcemanager.googleapis.com/%s", folder), "cloudresourcemanager.googleapis.com/Folder", nil
+	case "google_organization":
+		return fmt.Sprintf("//cloudresourcemanager.googleapis.com/organizations/%s", attributes["org_id"]), "cloudresourcemanager.googleapis.com/Organization", nil
+	}
+
+	metadata, ok := LookupMetadata(c.Metadata, resourceType)
+	if !ok {
+		return "", "", fmt.Errorf("unknown resource type %s", resourceType)
+	}
+
+	// The remaining attribute identifies the resource the policy is attached
+	// to, either as a full resource path or as its short name.
+	var ref string
+	keys := make([]string, 0, len(attributes))
+	for k := range attributes {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		v := attributes[k]
+		if _, ok := iamAttributes[k]; ok || v == "" {
+			continue
+		}
+		if ref == "" || strings.Contains(v, "/") {
+			ref = v
+		}
+		if strings.Contains(ref, "/") {
+			break
+		}
+	}
+	if ref == "" {
+		return "", "", fmt.Errorf("cannot determine the resource the IAM policy of %s is attached to", resourceType)
+	}
+
+	if strings.Contains(ref, "/") {
+		ref = strings.TrimPrefix(ref, "//"+metadata.ApiServiceName+"/")
+		if i := strings.Index(ref, "/projects/"); i >= 0 {
+			// self links such as https://www.googleapis.com/compute/v1/projects/...
+			ref = ref[i+1:]
+		}
+		return fmt.Sprintf("//%s/%s", metadata.ApiServiceName, c.withProjectNumber(metadata, ref)), metadata.AssetType(), nil
+	}
+
+	parentAttributes := make(map[string]string, len(attributes)+1)
+	for k, v := range attributes {
+		parentAttributes[k] = v
+	}
+	if parentAttributes["name"] == "" {
+		parentAttributes["name"] = ref
+	}
+	name, err := c.assetName(iamParentResourceType(resourceType), metadata, parentAttributes)
+	return name, metadata.AssetType(), err
+}
+
+func iamCondition(v interface{}) map[string]interface{} {
+	conditions, ok := v.([]interface{})
+	if !ok || len(conditions) == 0 {
+		return nil
+	}
+	condition, _ := conditions[0].(map[string]interface{})
+	return condition
+}
+
+// mergeBindings adds bindings to existing, combining members of bindings
+// with the same role and condition.
+func mergeBindings(existing, bindings []IamBinding) []IamBinding {
+	for _, b := range bindings {
+		merged := false
+		for i := range existing {
+			if existing[i].Role != b.Role || !conditionsEqual(existing[i].Condition, b.Condition) {
+				continue
+			}
+			for _, m := range b.Members {
+				if !slices.Contains(existing[i].Members, m) {
+					existing[i].Members = append(existing[i].Members, m)
+				}
+			}
+			sort.Strings(existing[i].Members)
+			merged = true
+			break
+		}
+		if !merged {
+			existing = append(existing, b)
+		}
+	}
+	return existing
+}
+
+// mergeAuditConfigs adds auditConfigs to existing, combining the log configs
+// of audit configs for the same service.
+func mergeAuditConfigs(existing, auditConfigs []IamAuditConfig) []IamAuditConfig {
+	for _, ac := range auditConfigs {
+		i := slices.IndexFunc(existing, func(e IamAuditConfig) bool { return e.Service == ac.Service })
+		if i < 0 {
+			existing = append(existing, ac)
+			continue
+		}
+		existing[i].AuditLogConfigs = append(existing[i].AuditLogConfigs, ac.AuditLogConfigs...)
+	}
+	return existing
+}
+
+func conditionsEqual(a, b map[string]interface{}) bool {
+	return fmt.Sprint(a["expression"]) == fmt.Sprint(b["expression"]) && fmt.Sprint(a["title"]) == fmt.Sprint(b["title"])
+}
+
+// variantPatternToFormat converts an API variant pattern such as
+// projects/{project}/topics/{topic} to a name format.
+func variantPatternToFormat(pattern string) string {
+	return strings.NewReplacer("{", "{{", "}", "}}").Replace(pattern)
+}
+
+// variantAttributes maps the parameters of an API variant pattern to
+// planned attribute values. The last parameter names the resource itself and
+// falls back to the name attribute.
+func variantAttributes(pattern string, attributes map[string]string) map[string]string {
+	params := ExtractIdentifiers(variantPatternToFormat(pattern))
+	result := make(map[string]string, len(params))
+	for i, param := range params {
+		v := attributes[camelToSnake(param)]
+		if v == "" && i == len(params)-1 {
+			v = attributes["name"]
+		}
+		if i := strings.LastIndex(v, "/"); i >= 0 {
+			v = v[i+1:]
+		}
+		result[param] = v
+	}
+	return result
+}
+
+// resourceData maps the planned values of a resource to its API
+// representation using the field mappings in the metadata.
+func (c *Converter) resourceData(resourceType string, metadata Metadata, values map[string]interface{}) map[string]interface{} {
+	data := make(map[string]interface{})
+	for _, f := range metadata.Fields {
+		if f.ProviderOnly || f.ApiField == "" {
+			continue
+		}
+		tf := strings.Split(f.TerraformField(), ".")
+		api := strings.Split(f.ApiField, ".")
+
+		// Fields flattened by the provider nest under extra API objects.
+		dst := data
+		for len(api) > len(tf) {
+			dst = ensureMap(dst, api[0])
+			api = api[1:]
+		}
+		src := values
+		prefix := ""
+		for len(tf) > len(api) {
+			next, ok := singleElement(src[tf[0]])
+			if !ok {
+				src = nil
+				break
+			}
+			prefix += tf[0] + "."
+			src = next
+			tf = tf[1:]
+		}
+		if src == nil {
+			continue
+		}
+
+		c.copyField(resourceType, prefix, dst, src, tf, api, f.Json)
+	}
+	pruneEmpty(data)
+	return data
+}
+
+func (c *Converter) copyField(resourceType, prefix string, dst, src map[string]interface{}, tf, api []string, isJson bool) {
+	v, ok := src[tf[0]]
+	if !ok || v == nil {
+		return
+	}
+
+	if len(tf) == 1 {
+		if s, ok := v.(string); ok && isJson && s != "" {
+			var decoded interface{}
+			if err := json.Unmarshal([]byte(s), &decoded); err == nil {
+				v = decoded
+			}
+		}
+		dst[api[0]] = v
+		return
+	}
+
+	field := prefix + tf[0]
+	switch t := v.(type) {
+	case map[string]interface{}:
+		c.copyField(resourceType, field+".", ensureMap(dst, api[0]), t, tf[1:], api[1:], isJson)
+	case []interface{}:
+		if c.IsSingleNestedBlock != nil && c.IsSingleNestedBlock(resourceType, field) {
+			if elem, ok := singleElement(t); ok {
+				c.copyField(resourceType, field+".", ensureMap(dst, api[0]), elem, tf[1:], api[1:], isJson)
+			}
+			return
+		}
+		list := ensureList(dst, api[0], len(t))
+		for i, e := range t {
+			elem, ok := e.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			c.copyField(resourceType, field+".", list[i].(map[string]interface{}), elem, tf[1:], api[1:], isJson)
+		}
+	}
+}
+
+func singleElement(v interface{}) (map[string]interface{}, bool) {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		return t, true
+	case []interface{}:
+		if len(t) == 1 {
+			m, ok := t[0].(map[string]interface{})
+			return m, ok
+		}
+	}
+	return nil, false
+}
+
+func ensureMap(dst map[string]interface{}, key string) map[string]interface{} {
+	if m, ok := dst[key].(map[string]interface{}); ok {
+		return m
+	}
+	m := make(map[string]interface{})
+	dst[key] = m
+	return m
+}
+
+func ensureList(dst map[string]interface{}, key string, length int) []interface{} {
+	if l, ok := dst[key].([]interface{}); ok && len(l) == length {
+		return l
+	}
+	l := make([]interface{}, length)
+	for i := range l {
+		l[i] = make(map[string]interface{})
+	}
+	dst[key] = l
+	return l
+}
+
+// pruneEmpty removes the objects created while walking field paths that
+// ended up without any planned values.
+func pruneEmpty(v interface{}) bool {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, e := range t {
+			if pruneEmpty(e) {
+				delete(t, k)
+			}
+		}
+		return len(t) == 0
+	case []interface{}:
+		empty := true
+		for _, e := range t {
+			if !pruneEmpty(e) {
+				empty = false
+			}
+		}
+		return empty
+	}
+	return false
+}
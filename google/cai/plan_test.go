@@ -0,0 +1,298 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/cai/plan_test.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package cai
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testPlan = `{
+  "format_version": "1.2",
+  "resource_changes": [
+    {
+      "address": "google_cloud_asset_project_feed.feed",
+      "mode": "managed",
+      "type": "google_cloud_asset_project_feed",
+      "name": "feed",
+      "change": {
+        "actions": ["create"],
+        "after": {
+          "feed_id": "my-feed",
+          "content_type": "RESOURCE",
+          "feed_output_config": [{"pubsub_destination": [{"topic": "projects/p/topics/t"}]}]
+        }
+      }
+    },
+    {
+      "address": "google_pubsub_topic.topic",
+      "mode": "managed",
+      "type": "google_pubsub_topic",
+      "name": "topic",
+      "change": {
+        "actions": ["update"],
+        "after": {
+          "id": "projects/my-project/topics/t",
+          "name": "t",
+          "project": "my-project",
+          "labels": {"env": "dev"}
+        }
+      }
+    },
+    {
+      "address": "google_pubsub_topic.gone",
+      "mode": "managed",
+      "type": "google_pubsub_topic",
+      "name": "gone",
+      "change": {
+        "actions": ["delete"],
+        "before": {"id": "projects/my-project/topics/gone"},
+        "after": null
+      }
+    },
+    {
+      "address": "google_pubsub_topic_iam_member.a",
+      "mode": "managed",
+      "type": "google_pubsub_topic_iam_member",
+      "name": "a",
+      "change": {
+        "actions": ["create"],
+        "after": {"topic": "projects/my-project/topics/t", "role": "roles/pubsub.publisher", "member": "user:a@example.com", "condition": []}
+      }
+    },
+    {
+      "address": "google_pubsub_topic_iam_member.b",
+      "mode": "managed",
+      "type": "google_pubsub_topic_iam_member",
+      "name": "b",
+      "change": {
+        "actions": ["create"],
+        "after": {"topic": "projects/my-project/topics/t", "role": "roles/pubsub.publisher", "member": "user:b@example.com", "condition": []}
+      }
+    },
+    {
+      "address": "data.google_project.p",
+      "mode": "data",
+      "type": "google_project",
+      "name": "p",
+      "change": {"actions": ["read"], "after": {}}
+    }
+  ]
+}`
+
+var testMetadata = map[string]Metadata{
+	"google_cloud_asset_project_feed": {
+		Resource:            "google_cloud_asset_project_feed",
+		ApiServiceName:      "cloudasset.googleapis.com",
+		ApiVersion:          "v1",
+		ApiResourceTypeKind: "Feed",
+		CaiAssetNameFormats: []string{"//cloudasset.googleapis.com/projects/{{project}}/feeds/{{feed_id}}"},
+		Fields: []MetadataField{
+			{ApiField: "contentType"},
+			{ApiField: "feedOutputConfig.pubsubDestination.topic"},
+			{Field: "billing_project", ProviderOnly: true},
+		},
+	},
+	"google_pubsub_topic": {
+		Resource:            "google_pubsub_topic",
+		ApiServiceName:      "pubsub.googleapis.com",
+		ApiVersion:          "v1",
+		ApiResourceTypeKind: "Topic",
+		Fields: []MetadataField{
+			{ApiField: "labels"},
+			{ApiField: "name"},
+		},
+	},
+}
+
+func TestConverterConvert(t *testing.T) {
+	plan, err := ReadPlan(strings.NewReader(testPlan))
+	if err != nil {
+		t.Fatalf("unexpected error reading plan: %s", err)
+	}
+
+	c := &Converter{
+		Metadata:      testMetadata,
+		Project:       "my-project",
+		ProjectNumber: "123",
+		IsSingleNestedBlock: func(resourceType, field string) bool {
+			return field == "feed_output_config" || field == "feed_output_config.pubsub_destination"
+		},
+	}
+	assets, errs := c.Convert(plan)
+	if len(errs) != 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	if len(assets) != 3 {
+		t.Fatalf("expected 3 assets, got %d: %#v", len(assets), assets)
+	}
+
+	feed := assets[0]
+	if feed.Name != "//cloudasset.googleapis.com/projects/my-project/feeds/my-feed" {
+		t.Errorf("unexpected feed asset name %q", feed.Name)
+	}
+	if feed.AssetType != "cloudasset.googleapis.com/Feed" || feed.ContentType != ContentTypeResource {
+		t.Errorf("unexpected feed asset type %q / content type %q", feed.AssetType, feed.ContentType)
+	}
+	wantData := map[string]interface{}{
+		"contentType": "RESOURCE",
+		"feedOutputConfig": map[string]interface{}{
+			"pubsubDestination": map[string]interface{}{
+				"topic": "projects/p/topics/t",
+			},
+		},
+	}
+	if !reflect.DeepEqual(feed.Resource.Data, wantData) {
+		t.Errorf("unexpected feed data: got %#v, want %#v", feed.Resource.Data, wantData)
+	}
+	if !reflect.DeepEqual(feed.Ancestors, []string{"projects/123"}) {
+		t.Errorf("unexpected feed ancestors %v", feed.Ancestors)
+	}
+
+	topic := assets[1]
+	if topic.Name != "//pubsub.googleapis.com/projects/my-project/topics/t" {
+		t.Errorf("unexpected topic asset name %q", topic.Name)
+	}
+
+	policy := assets[2]
+	if policy.Name != topic.Name || policy.ContentType != ContentTypeIamPolicy || policy.AssetType != "pubsub.googleapis.com/Topic" {
+		t.Errorf("unexpected IAM asset %#v", policy)
+	}
+	wantBindings := []IamBinding{{Role: "roles/pubsub.publisher", Members: []string{"user:a@example.com", "user:b@example.com"}}}
+	if !reflect.DeepEqual(policy.IamPolicy.Bindings, wantBindings) {
+		t.Errorf("unexpected bindings: got %#v, want %#v", policy.IamPolicy.Bindings, wantBindings)
+	}
+}
+
+func TestConverterConvert_unresolvedName(t *testing.T) {
+	plan := &Plan{
+		FormatVersion: "1.2",
+		ResourceChanges: []ResourceChange{{
+			Address: "google_pubsub_topic.new",
+			Mode:    "managed",
+			Type:    "google_pubsub_topic",
+			Change:  Change{Actions: []string{"create"}, After: map[string]interface{}{"name": "new"}},
+		}},
+	}
+
+	assets, errs := (&Converter{Metadata: testMetadata}).Convert(plan)
+	if len(errs) != 1 {
+		t.Fatalf("expected 1 error, got %v", errs)
+	}
+	if len(assets) != 1 || assets[0].Name != "" || assets[0].AssetType != "pubsub.googleapis.com/Topic" {
+		t.Errorf("expected an unnamed topic asset, got %#v", assets)
+	}
+}
+
+func TestConverterConvert_auditConfig(t *testing.T) {
+	plan := &Plan{
+		FormatVersion: "1.2",
+		ResourceChanges: []ResourceChange{
+			{
+				Address: "google_project_iam_audit_config.storage",
+				Mode:    "managed",
+				Type:    "google_project_iam_audit_config",
+				Change: Change{Actions: []string{"create"}, After: map[string]interface{}{
+					"project": "my-project",
+					"service": "storage.googleapis.com",
+					"audit_log_config": []interface{}{
+						map[string]interface{}{"log_type": "DATA_READ", "exempted_members": []interface{}{"user:b@example.com", "user:a@example.com"}},
+					},
+				}},
+			},
+			{
+				Address: "google_project_iam_member.viewer",
+				Mode:    "managed",
+				Type:    "google_project_iam_member",
+				Change: Change{Actions: []string{"create"}, After: map[string]interface{}{
+					"project": "my-project",
+					"role":    "roles/viewer",
+					"member":  "user:a@example.com",
+				}},
+			},
+			{
+				Address: "google_project_iam_audit_config.storage_writes",
+				Mode:    "managed",
+				Type:    "google_project_iam_audit_config",
+				Change: Change{Actions: []string{"create"}, After: map[string]interface{}{
+					"project": "my-project",
+					"service": "storage.googleapis.com",
+					"audit_log_config": []interface{}{
+						map[string]interface{}{"log_type": "DATA_WRITE"},
+					},
+				}},
+			},
+		},
+	}
+
+	assets, errs := (&Converter{Metadata: testMetadata}).Convert(plan)
+	if len(errs) != 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	if len(assets) != 1 {
+		t.Fatalf("expected 1 asset, got %d: %#v", len(assets), assets)
+	}
+
+	policy := assets[0]
+	if policy.Name != "//cloudresourcemanager.googleapis.com/projects/my-project" || policy.AssetType != "cloudresourcemanager.googleapis.com/Project" {
+		t.Errorf("unexpected IAM asset %#v", policy)
+	}
+	wantBindings := []IamBinding{{Role: "roles/viewer", Members: []string{"user:a@example.com"}}}
+	if !reflect.DeepEqual(policy.IamPolicy.Bindings, wantBindings) {
+		t.Errorf("unexpected bindings: got %#v, want %#v", policy.IamPolicy.Bindings, wantBindings)
+	}
+	wantAuditConfigs := []IamAuditConfig{{
+		Service: "storage.googleapis.com",
+		AuditLogConfigs: []IamAuditLogConfig{
+			{LogType: "DATA_READ", ExemptedMembers: []string{"user:a@example.com", "user:b@example.com"}},
+			{LogType: "DATA_WRITE"},
+		},
+	}}
+	if !reflect.DeepEqual(policy.IamPolicy.AuditConfigs, wantAuditConfigs) {
+		t.Errorf("unexpected audit configs: got %#v, want %#v", policy.IamPolicy.AuditConfigs, wantAuditConfigs)
+	}
+}
+
+func TestReadPlan_notAPlan(t *testing.T) {
+	if _, err := ReadPlan(strings.NewReader(`{"resource_changes": []}`)); err == nil {
+		t.Error("expected an error for input without format_version")
+	}
+}
+
+func TestMetadataFieldTerraformField(t *testing.T) {
+	cases := map[string]struct {
+		field MetadataField
+		want  string
+	}{
+		"api field": {
+			field: MetadataField{ApiField: "feedOutputConfig.pubsubDestination.topic"},
+			want:  "feed_output_config.pubsub_destination.topic",
+		},
+		"explicit field": {
+			field: MetadataField{ApiField: "onPremisesConfiguration.host", Field: "host"},
+			want:  "host",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := tc.field.TerraformField(); got != tc.want {
+				t.Errorf("TerraformField() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
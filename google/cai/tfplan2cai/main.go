@@ -0,0 +1,122 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/cai/tfplan2cai/main.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+// tfplan2cai predicts the Cloud Asset Inventory assets that a Terraform plan
+// would produce, so that org policy and constraint rules can be evaluated
+// against a plan before it is applied.
+//
+// Example usage:
+//
+//	terraform plan -out=tfplan && terraform show -json tfplan > plan.json
+//	go run ./google/cai/tfplan2cai -plan plan.json -project my-project -project-number 123
+//
+// The assets are written to stdout as a JSON array using the field names of
+// a CAI export. Resources that cannot be fully converted are reported on
+// stderr; the command exits non-zero only if the plan cannot be read.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-google/google/cai"
+	"github.com/hashicorp/terraform-provider-google/google/provider"
+)
+
+func main() {
+	planPath := flag.String("plan", "-", "file containing the terraform show -json output of a plan, or - for stdin")
+	servicesDir := flag.String("services-dir", "google/services", "directory containing the resource metadata files")
+	project := flag.String("project", "", "project used by resources that inherit the provider project")
+	projectNumber := flag.String("project-number", "", "number of -project, used for ancestry and for services whose asset names use project numbers")
+	ancestors := flag.String("ancestors", "", "comma-separated ancestry for all assets, for example projects/123,folders/456,organizations/789")
+	flag.Parse()
+
+	var in io.Reader = os.Stdin
+	if *planPath != "-" {
+		f, err := os.Open(*planPath)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer f.Close()
+		in = f
+	}
+
+	plan, err := cai.ReadPlan(in)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	metadata, err := cai.LoadMetadata(*servicesDir)
+	if err != nil {
+		// Malformed files only affect the resources they describe.
+		fmt.Fprintf(os.Stderr, "warning: %s\n", err)
+	}
+
+	c := &cai.Converter{
+		Metadata:            metadata,
+		Project:             *project,
+		ProjectNumber:       *projectNumber,
+		IsSingleNestedBlock: singleNestedBlockLookup(provider.Provider().ResourcesMap),
+	}
+	if *ancestors != "" {
+		c.Ancestors = strings.Split(*ancestors, ",")
+	}
+
+	assets, errs := c.Convert(plan)
+	for _, err := range errs {
+		fmt.Fprintf(os.Stderr, "warning: %s\n", err)
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(assets); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// singleNestedBlockLookup reports fields backed by a MaxItems: 1 block in
+// the provider schema, which the API represents as a nested object.
+func singleNestedBlockLookup(resources map[string]*schema.Resource) func(string, string) bool {
+	return func(resourceType, field string) bool {
+		r, ok := resources[resourceType]
+		if !ok {
+			return false
+		}
+		parts := strings.Split(field, ".")
+		s := r.Schema
+		for i, part := range parts {
+			f, ok := s[part]
+			if !ok {
+				return false
+			}
+			elem, ok := f.Elem.(*schema.Resource)
+			if !ok {
+				return false
+			}
+			if i == len(parts)-1 {
+				return f.MaxItems == 1
+			}
+			s = elem.Schema
+		}
+		return false
+	}
+}
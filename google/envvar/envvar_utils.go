@@ -21,6 +21,7 @@ import (
 	"log"
 	"os"
 	"testing"
+	"time"
 )
 
 const TestEnvVar = "TF_ACC"
@@ -139,6 +140,28 @@ var vmwareengineProjectEnvVars = []string{
 	"GOOGLE_VMWAREENGINE_PROJECT",
 }
 
+// Setting this to true labels resources created by acceptance tests with the test
+// name and run, so that sweepers can select them by label. See acctest.TestRunLabels
+var TestRunLabelsEnvVars = []string{
+	"GOOGLE_TEST_RUN_LABELS",
+}
+
+// This value identifies the test run in resource ownership labels, for example a CI build id
+var TestRunIdEnvVars = []string{
+	"GOOGLE_TEST_RUN_ID",
+}
+
+// Labels stamped on test resources through the provider's default_labels when
+// TestRunLabelsEnvVars is set, which sweepers use to select resources by test run.
+const (
+	// TestNameLabel holds the sanitized name of the test that created the resource
+	TestNameLabel = "tf-test-name"
+	// TestRunIdLabel holds the identifier of the test run that created the resource
+	TestRunIdLabel = "tf-test-run-id"
+	// TestRunTimeLabel holds the start of the test run as seconds since the epoch
+	TestRunTimeLabel = "tf-test-run-time"
+)
+
 // AccTestPreCheck ensures at least one of the project env variables is set.
 func GetTestProjectNumberFromEnv() string {
 	return MultiEnvSearch(ProjectNumberEnvVars)
@@ -181,6 +204,16 @@ func GetTestImpersonateServiceAccountFromEnv() string {
 	return MultiEnvSearch(ImpersonateServiceAccountEnvVars)
 }
 
+// Returns the id of the test run, or an empty string if none is set.
+func GetTestRunIdFromEnv() string {
+	return MultiEnvSearch(TestRunIdEnvVars)
+}
+
+// TestRunTimeLabelValue formats a test run start time for TestRunTimeLabel
+func TestRunTimeLabelValue(t time.Time) string {
+	return fmt.Sprintf("%d", t.Unix())
+}
+
 func GetTestCustIdFromEnv(t *testing.T) string {
 	SkipIfEnvNotSet(t, CustIdEnvVars...)
 	return MultiEnvSearch(CustIdEnvVars)
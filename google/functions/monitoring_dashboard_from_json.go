@@ -0,0 +1,132 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/functions/monitoring_dashboard_from_json.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package functions
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+
+	"github.com/hashicorp/terraform-provider-google/google/services/monitoring"
+)
+
+var _ function.Function = MonitoringDashboardFromJsonFunction{}
+
+func NewMonitoringDashboardFromJsonFunction() function.Function {
+	return &MonitoringDashboardFromJsonFunction{}
+}
+
+type MonitoringDashboardFromJsonFunction struct{}
+
+func (f MonitoringDashboardFromJsonFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "monitoring_dashboard_from_json"
+}
+
+func (f MonitoringDashboardFromJsonFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Converts the JSON representation of a monitoring dashboard to its typed form",
+		Description: "Takes a single string argument, which should be the JSON representation of a dashboard as used by google_monitoring_dashboard.dashboard_json, and returns an object with the display_name and layout fields of the typed form. The name and etag fields are ignored, and other fields that the typed form doesn't cover are an error.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "dashboard_json",
+				Description: "The JSON representation of a dashboard.",
+			},
+		},
+		Return: function.DynamicReturn{},
+	}
+}
+
+func (f MonitoringDashboardFromJsonFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	// Load arguments from function call
+	var dashboardJson string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.GetArgument(ctx, 0, &dashboardJson))
+	if resp.Error != nil {
+		return
+	}
+
+	obj, err := structure.ExpandJsonFromString(dashboardJson)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(function.NewArgumentFuncError(0, fmt.Sprintf("The input string is not a valid JSON object: %s", err)))
+		return
+	}
+
+	typed, unsupported := monitoring.FlattenMonitoringDashboardTyped(obj)
+	if len(unsupported) > 0 {
+		resp.Error = function.ConcatFuncErrors(function.NewArgumentFuncError(0, fmt.Sprintf("The dashboard has fields that the typed form doesn't support: %s", strings.Join(unsupported, ", "))))
+		return
+	}
+
+	value, err := monitoringDashboardValue(ctx, typed)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(function.NewFuncError(err.Error()))
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, types.DynamicValue(value)))
+}
+
+// monitoringDashboardValue converts the typed form of a dashboard to a
+// Terraform value. Lists are returned as tuples, as their elements may have
+// different attributes.
+func monitoringDashboardValue(ctx context.Context, v interface{}) (attr.Value, error) {
+	switch v := v.(type) {
+	case string:
+		return types.StringValue(v), nil
+	case int:
+		return types.NumberValue(new(big.Float).SetInt64(int64(v))), nil
+	case float64:
+		return types.NumberValue(big.NewFloat(v)), nil
+	case []interface{}:
+		elemTypes := make([]attr.Type, 0, len(v))
+		elems := make([]attr.Value, 0, len(v))
+		for _, raw := range v {
+			elem, err := monitoringDashboardValue(ctx, raw)
+			if err != nil {
+				return nil, err
+			}
+			elemTypes = append(elemTypes, elem.Type(ctx))
+			elems = append(elems, elem)
+		}
+		value, diags := types.TupleValue(elemTypes, elems)
+		if diags.HasError() {
+			return nil, fmt.Errorf("error converting list: %v", diags)
+		}
+		return value, nil
+	case map[string]interface{}:
+		attrTypes := make(map[string]attr.Type, len(v))
+		attrs := make(map[string]attr.Value, len(v))
+		for k, raw := range v {
+			value, err := monitoringDashboardValue(ctx, raw)
+			if err != nil {
+				return nil, err
+			}
+			attrTypes[k] = value.Type(ctx)
+			attrs[k] = value
+		}
+		value, diags := types.ObjectValue(attrTypes, attrs)
+		if diags.HasError() {
+			return nil, fmt.Errorf("error converting object: %v", diags)
+		}
+		return value, nil
+	}
+	return nil, fmt.Errorf("unexpected value of type %T", v)
+}
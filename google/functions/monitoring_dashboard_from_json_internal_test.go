@@ -0,0 +1,135 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/functions/monitoring_dashboard_from_json_internal_test.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package functions
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestFunctionRun_monitoring_dashboard_from_json(t *testing.T) {
+	t.Parallel()
+
+	dashboardJson := `{
+  "name": "projects/123/dashboards/abc",
+  "etag": "0123",
+  "displayName": "Example",
+  "mosaicLayout": {
+    "columns": 12,
+    "tiles": [{
+      "width": 6,
+      "height": 4,
+      "widget": {
+        "text": {"content": "Hello", "format": "MARKDOWN"}
+      }
+    }]
+  }
+}`
+
+	unsupportedDashboardJson := `{
+  "displayName": "Example",
+  "labels": {"team": "example"},
+  "mosaicLayout": {
+    "columns": 12,
+    "tiles": [{
+      "width": 6,
+      "height": 4,
+      "widget": {"logsPanel": {}}
+    }]
+  }
+}`
+
+	expected := testMonitoringDashboardObject(t, map[string]attr.Value{
+		"display_name": types.StringValue("Example"),
+		"mosaic_layout": testMonitoringDashboardTuple(t, testMonitoringDashboardObject(t, map[string]attr.Value{
+			"columns": types.NumberValue(new(big.Float).SetInt64(12)),
+			"tiles": testMonitoringDashboardTuple(t, testMonitoringDashboardObject(t, map[string]attr.Value{
+				"width":  types.NumberValue(new(big.Float).SetInt64(6)),
+				"height": types.NumberValue(new(big.Float).SetInt64(4)),
+				"widget": testMonitoringDashboardTuple(t, testMonitoringDashboardObject(t, map[string]attr.Value{
+					"text": testMonitoringDashboardTuple(t, testMonitoringDashboardObject(t, map[string]attr.Value{
+						"content": types.StringValue("Hello"),
+						"format":  types.StringValue("MARKDOWN"),
+					})),
+				})),
+			})),
+		})),
+	})
+
+	testCases := map[string]struct {
+		request  function.RunRequest
+		expected function.RunResponse
+	}{
+		"it returns the typed form of a dashboard, ignoring output only fields": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(dashboardJson)}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.DynamicValue(expected)),
+			},
+		},
+		"it returns an error listing the fields that the typed form doesn't support": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(unsupportedDashboardJson)}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.DynamicUnknown()),
+				Error:  function.NewArgumentFuncError(0, "The dashboard has fields that the typed form doesn't support: labels, mosaicLayout.tiles[0].widget.logsPanel"),
+			},
+		},
+		"it returns an error when given input is not JSON": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("foobar")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.DynamicUnknown()),
+				Error:  function.NewArgumentFuncError(0, "The input string is not a valid JSON object: invalid character 'o' in literal false (expecting 'a')"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		tn, tc := name, testCase
+
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			got := function.RunResponse{
+				Result: function.NewResultData(basetypes.NewDynamicUnknown()),
+			}
+
+			// Act
+			NewMonitoringDashboardFromJsonFunction().Run(context.Background(), tc.request, &got)
+
+			// Assert
+			if diff := cmp.Diff(got.Result, tc.expected.Result); diff != "" {
+				t.Errorf("unexpected diff between expected and received result: %s", diff)
+			}
+			if diff := cmp.Diff(got.Error, tc.expected.Error); diff != "" {
+				t.Errorf("unexpected diff between expected and received errors: %s", diff)
+			}
+		})
+	}
+}
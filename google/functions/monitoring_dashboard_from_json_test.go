@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/functions/monitoring_dashboard_from_json_test.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package functions_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-google/google/acctest"
+)
+
+func TestAccProviderFunction_monitoring_dashboard_from_json(t *testing.T) {
+	t.Parallel()
+
+	acctest.VcrTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderFunction_monitoring_dashboard_from_json(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("display_name", "Example"),
+					resource.TestCheckOutput("prometheus_query", "up"),
+				),
+			},
+		},
+	})
+}
+
+func testProviderFunction_monitoring_dashboard_from_json() string {
+	return `
+# terraform block required for provider function to be found
+terraform {
+	required_providers {
+		google = {
+		  source = "hashicorp/google"
+		}
+	}
+}
+
+locals {
+  dashboard = provider::google::monitoring_dashboard_from_json(jsonencode({
+    displayName = "Example"
+    gridLayout = {
+      widgets = [{
+        scorecard = {
+          timeSeriesQuery = {
+            prometheusQuery = "up"
+          }
+        }
+      }]
+    }
+  }))
+}
+
+output "display_name" {
+  value = local.dashboard.display_name
+}
+
+output "prometheus_query" {
+  value = local.dashboard.grid_layout[0].widgets[0].scorecard[0].time_series_query[0].prometheus_query
+}
+`
+}
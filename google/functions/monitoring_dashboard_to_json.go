@@ -0,0 +1,159 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/functions/monitoring_dashboard_to_json.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package functions
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+
+	"github.com/hashicorp/terraform-provider-google/google/services/monitoring"
+)
+
+var _ function.Function = MonitoringDashboardToJsonFunction{}
+
+func NewMonitoringDashboardToJsonFunction() function.Function {
+	return &MonitoringDashboardToJsonFunction{}
+}
+
+type MonitoringDashboardToJsonFunction struct{}
+
+func (f MonitoringDashboardToJsonFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "monitoring_dashboard_to_json"
+}
+
+func (f MonitoringDashboardToJsonFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Converts the typed form of a monitoring dashboard to its JSON representation",
+		Description: "Takes a single object argument with the display_name and layout fields of the typed form of google_monitoring_dashboard, and returns the JSON representation of the dashboard. Nested blocks may be given as objects or as lists with a single element.",
+		Parameters: []function.Parameter{
+			function.DynamicParameter{
+				Name:        "dashboard",
+				Description: "The typed form of a dashboard.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f MonitoringDashboardToJsonFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	// Load arguments from function call
+	var dashboard types.Dynamic
+	resp.Error = function.ConcatFuncErrors(req.Arguments.GetArgument(ctx, 0, &dashboard))
+	if resp.Error != nil {
+		return
+	}
+
+	if dashboard.IsNull() || dashboard.IsUnderlyingValueNull() {
+		resp.Error = function.ConcatFuncErrors(function.NewArgumentFuncError(0, "The input value cannot be null."))
+		return
+	}
+
+	value, err := dashboard.UnderlyingValue().ToTerraformValue(ctx)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+	raw, err := monitoringDashboardFromValue(value)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+	typed, ok := raw.(map[string]interface{})
+	if !ok {
+		resp.Error = function.ConcatFuncErrors(function.NewArgumentFuncError(0, "The input value must be an object."))
+		return
+	}
+
+	obj, err := monitoring.ExpandMonitoringDashboardTyped(typed)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(function.NewArgumentFuncError(0, fmt.Sprintf("The input value is not a valid dashboard: %s", err)))
+		return
+	}
+	str, err := structure.FlattenJsonToString(obj)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(function.NewFuncError(err.Error()))
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, str))
+}
+
+// monitoringDashboardFromValue converts a Terraform value to the typed form
+// of a dashboard, in the shape used by the SDK for nested blocks.
+func monitoringDashboardFromValue(v tftypes.Value) (interface{}, error) {
+	if !v.IsKnown() {
+		return nil, fmt.Errorf("The input value must be known.")
+	}
+	if v.IsNull() {
+		return nil, nil
+	}
+	switch {
+	case v.Type().Is(tftypes.String):
+		var s string
+		err := v.As(&s)
+		return s, err
+	case v.Type().Is(tftypes.Number):
+		n := new(big.Float)
+		if err := v.As(&n); err != nil {
+			return nil, err
+		}
+		if n.IsInt() {
+			i, _ := n.Int64()
+			return int(i), nil
+		}
+		f, _ := n.Float64()
+		return f, nil
+	case v.Type().Is(tftypes.Bool):
+		var b bool
+		err := v.As(&b)
+		return b, err
+	case v.Type().Is(tftypes.List{}), v.Type().Is(tftypes.Set{}), v.Type().Is(tftypes.Tuple{}):
+		var elems []tftypes.Value
+		if err := v.As(&elems); err != nil {
+			return nil, err
+		}
+		l := make([]interface{}, 0, len(elems))
+		for _, elem := range elems {
+			raw, err := monitoringDashboardFromValue(elem)
+			if err != nil {
+				return nil, err
+			}
+			l = append(l, raw)
+		}
+		return l, nil
+	case v.Type().Is(tftypes.Map{}), v.Type().Is(tftypes.Object{}):
+		var attrs map[string]tftypes.Value
+		if err := v.As(&attrs); err != nil {
+			return nil, err
+		}
+		m := make(map[string]interface{}, len(attrs))
+		for k, attr := range attrs {
+			raw, err := monitoringDashboardFromValue(attr)
+			if err != nil {
+				return nil, err
+			}
+			m[k] = raw
+		}
+		return m, nil
+	}
+	return nil, fmt.Errorf("unexpected value of type %s", v.Type())
+}
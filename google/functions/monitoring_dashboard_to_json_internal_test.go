@@ -0,0 +1,142 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/functions/monitoring_dashboard_to_json_internal_test.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package functions
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func testMonitoringDashboardObject(t *testing.T, attrs map[string]attr.Value) attr.Value {
+	attrTypes := make(map[string]attr.Type, len(attrs))
+	for k, v := range attrs {
+		attrTypes[k] = v.Type(context.Background())
+	}
+	obj, diags := types.ObjectValue(attrTypes, attrs)
+	if diags.HasError() {
+		t.Fatalf("error building object: %v", diags)
+	}
+	return obj
+}
+
+func testMonitoringDashboardTuple(t *testing.T, elems ...attr.Value) attr.Value {
+	elemTypes := make([]attr.Type, 0, len(elems))
+	for _, v := range elems {
+		elemTypes = append(elemTypes, v.Type(context.Background()))
+	}
+	tuple, diags := types.TupleValue(elemTypes, elems)
+	if diags.HasError() {
+		t.Fatalf("error building tuple: %v", diags)
+	}
+	return tuple
+}
+
+func TestFunctionRun_monitoring_dashboard_to_json(t *testing.T) {
+	t.Parallel()
+
+	widget := func(query map[string]attr.Value) attr.Value {
+		return testMonitoringDashboardObject(t, map[string]attr.Value{
+			"title": types.StringValue("CPU"),
+			"scorecard": testMonitoringDashboardObject(t, map[string]attr.Value{
+				"time_series_query": testMonitoringDashboardObject(t, query),
+				"thresholds": testMonitoringDashboardTuple(t, testMonitoringDashboardObject(t, map[string]attr.Value{
+					"value": types.NumberValue(big.NewFloat(0.8)),
+					"color": types.StringValue("RED"),
+				})),
+			}),
+		})
+	}
+	dashboard := func(query map[string]attr.Value) attr.Value {
+		return types.DynamicValue(testMonitoringDashboardObject(t, map[string]attr.Value{
+			"display_name": types.StringValue("Example"),
+			// Blocks can be given as lists with a single element, as in
+			// the resource, or as objects.
+			"grid_layout": testMonitoringDashboardTuple(t, testMonitoringDashboardObject(t, map[string]attr.Value{
+				"columns": types.NumberValue(big.NewFloat(2)),
+				"widgets": testMonitoringDashboardTuple(t, widget(query)),
+			})),
+		}))
+	}
+
+	testCases := map[string]struct {
+		request  function.RunRequest
+		expected function.RunResponse
+	}{
+		"it returns the JSON representation of a valid dashboard": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{dashboard(map[string]attr.Value{
+					"prometheus_query": types.StringValue("up"),
+				})}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringValue(`{"displayName":"Example","gridLayout":{"columns":"2","widgets":[{"scorecard":{"thresholds":[{"color":"RED","value":0.8}],"timeSeriesQuery":{"prometheusQuery":"up"}},"title":"CPU"}]}}`)),
+			},
+		},
+		"it returns an error when a query sets several languages": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{dashboard(map[string]attr.Value{
+					"prometheus_query":           types.StringValue("up"),
+					"time_series_query_language": types.StringValue("fetch gce_instance"),
+				})}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringUnknown()),
+				Error:  function.NewArgumentFuncError(0, "The input value is not a valid dashboard: grid_layout.0.widgets.0.scorecard.0.time_series_query.0: exactly one of time_series_filter, time_series_query_language, prometheus_query must be set"),
+			},
+		},
+		"it returns an error when given input is not an object": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.DynamicValue(types.StringValue("foobar"))}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringUnknown()),
+				Error:  function.NewArgumentFuncError(0, "The input value must be an object."),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		tn, tc := name, testCase
+
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			got := function.RunResponse{
+				Result: function.NewResultData(basetypes.NewStringUnknown()),
+			}
+
+			// Act
+			NewMonitoringDashboardToJsonFunction().Run(context.Background(), tc.request, &got)
+
+			// Assert
+			if diff := cmp.Diff(got.Result, tc.expected.Result); diff != "" {
+				t.Errorf("unexpected diff between expected and received result: %s", diff)
+			}
+			if diff := cmp.Diff(got.Error, tc.expected.Error); diff != "" {
+				t.Errorf("unexpected diff between expected and received errors: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/functions/monitoring_dashboard_to_json_test.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package functions_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-google/google/acctest"
+)
+
+func TestAccProviderFunction_monitoring_dashboard_to_json(t *testing.T) {
+	t.Parallel()
+
+	acctest.VcrTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderFunction_monitoring_dashboard_to_json(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("dashboard_json", `{"displayName":"Example","gridLayout":{"columns":"2","widgets":[{"text":{"content":"Hello","format":"MARKDOWN"}}]}}`),
+				),
+			},
+		},
+	})
+}
+
+func testProviderFunction_monitoring_dashboard_to_json() string {
+	return `
+# terraform block required for provider function to be found
+terraform {
+	required_providers {
+		google = {
+		  source = "hashicorp/google"
+		}
+	}
+}
+
+output "dashboard_json" {
+  value = provider::google::monitoring_dashboard_to_json({
+    display_name = "Example"
+    grid_layout = {
+      columns = 2
+      widgets = [{
+        text = {
+          content = "Hello"
+          format  = "MARKDOWN"
+        }
+      }]
+    }
+  })
+}
+`
+}
@@ -241,13 +241,15 @@ type ProviderModel struct {
 }
 
 type ProviderBatching struct {
-	SendAfter      types.String `tfsdk:"send_after"`
-	EnableBatching types.Bool   `tfsdk:"enable_batching"`
+	SendAfter         types.String `tfsdk:"send_after"`
+	EnableBatching    types.Bool   `tfsdk:"enable_batching"`
+	EnableDnsBatching types.Bool   `tfsdk:"enable_dns_batching"`
 }
 
 var ProviderBatchingAttributes = map[string]attr.Type{
-	"send_after":      types.StringType,
-	"enable_batching": types.BoolType,
+	"send_after":          types.StringType,
+	"enable_batching":     types.BoolType,
+	"enable_dns_batching": types.BoolType,
 }
 
 // ProviderMetaModel describes the provider meta model
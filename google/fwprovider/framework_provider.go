@@ -22,6 +22,7 @@ import (
 	sdk_schema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
@@ -50,6 +51,7 @@ var (
 	_ provider.ProviderWithFunctions          = &FrameworkProvider{}
 	_ provider.ProviderWithEphemeralResources = &FrameworkProvider{}
 	_ provider.ProviderWithListResources      = &FrameworkProvider{}
+	_ provider.ProviderWithActions            = &FrameworkProvider{}
 )
 
 // New is a helper function to simplify provider server and testing implementation.
@@ -1291,6 +1293,9 @@ func (p *FrameworkProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 						"enable_batching": schema.BoolAttribute{
 							Optional: true,
 						},
+						"enable_dns_batching": schema.BoolAttribute{
+							Optional: true,
+						},
 					},
 				},
 			},
@@ -1344,13 +1349,14 @@ func (p *FrameworkProvider) Configure(ctx context.Context, req provider.Configur
 	//    See also, new approaches to handle this: https://github.com/GoogleCloudPlatform/magic-modules/pull/11925
 
 	// This is how we make provider configuration info (configured clients, default project, etc) available to resources, data sources,
-	// ephemeral resources, and list resources implemented using the plugin-framework. Their Configure functions receive this data via ConfigureRequest.ProviderData
+	// ephemeral resources, list resources and actions implemented using the plugin-framework. Their Configure functions receive this data via ConfigureRequest.ProviderData
 	// (list resources use ConfigureResponse.ListResourceData — see terraform-plugin-framework list.ConfigureRequest).
 	meta := p.Primary.Meta().(*transport_tpg.Config)
 	resp.DataSourceData = meta
 	resp.ResourceData = meta
 	resp.EphemeralResourceData = meta
 	resp.ListResourceData = meta
+	resp.ActionData = meta
 }
 
 // DataSources defines the data sources implemented in the provider.
@@ -1367,6 +1373,8 @@ func (p *FrameworkProvider) Resources(_ context.Context) []func() resource.Resou
 func (p *FrameworkProvider) Functions(_ context.Context) []func() function.Function {
 	return []func() function.Function{
 		functions.NewLocationFromIdFunction,
+		functions.NewMonitoringDashboardFromJsonFunction,
+		functions.NewMonitoringDashboardToJsonFunction,
 		functions.NewNameFromIdFunction,
 		functions.NewProjectFromIdFunction,
 		functions.NewRegionFromIdFunction,
@@ -1384,6 +1392,11 @@ func (p *FrameworkProvider) ListResources(_ context.Context) []func() list.ListR
 	return registry.FrameworkListResourceFuncs()
 }
 
+// Actions defines the actions implemented in the provider.
+func (p *FrameworkProvider) Actions(_ context.Context) []func() action.Action {
+	return registry.FrameworkActionFuncs()
+}
+
 func (p *FrameworkProvider) GenerateResourceConfig(context.Context, any) (any, error) {
 	return nil, nil
 }
@@ -141,6 +141,10 @@ func Provider() *schema.Provider {
 							Type:     schema.TypeBool,
 							Optional: true,
 						},
+						"enable_dns_batching": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
 					},
 				},
 			},
@@ -42,6 +42,14 @@ func TestProvider(t *testing.T) {
 	}
 }
 
+func TestProvider_writeOnlyArguments(t *testing.T) {
+	for name, r := range provider.Provider().ResourcesMap {
+		if err := tpgresource.ValidateWriteOnlyArguments(r.Schema); err != nil {
+			t.Errorf("%s: %s", name, err)
+		}
+	}
+}
+
 func TestProvider_impl(t *testing.T) {
 	var _ *schema.Provider = provider.Provider()
 }
@@ -23,6 +23,7 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/list"
@@ -192,6 +193,7 @@ type frameworkRegistry struct {
 	resource   map[string]FrameworkResource
 	ephemeral  map[string]FrameworkEphemeralResource
 	list       map[string]FrameworkListResource
+	action     map[string]FrameworkAction
 }
 
 var framework = &frameworkRegistry{
@@ -199,6 +201,7 @@ var framework = &frameworkRegistry{
 	resource:   map[string]FrameworkResource{},
 	ephemeral:  map[string]FrameworkEphemeralResource{},
 	list:       map[string]FrameworkListResource{},
+	action:     map[string]FrameworkAction{},
 }
 
 type FrameworkDataSource struct {
@@ -332,3 +335,36 @@ func FrameworkListResourceFuncs() []func() list.ListResource {
 	}
 	return ret
 }
+
+type FrameworkAction struct {
+	Name        string
+	ProductName string
+	Func        func() action.Action
+}
+
+func (a FrameworkAction) Register() {
+	framework.Lock()
+	defer framework.Unlock()
+	if _, ok := framework.action[a.Name]; ok {
+		log.Fatalf("Duplicate registration attempt for framework action %q", a.Name)
+	}
+	framework.action[a.Name] = a
+}
+
+func FrameworkActionFuncs() []func() action.Action {
+	framework.RLock()
+	defer framework.RUnlock()
+	var actions []FrameworkAction
+	for _, a := range framework.action {
+		actions = append(actions, a)
+	}
+	slices.SortFunc(actions, func(a, b FrameworkAction) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	var ret []func() action.Action
+	for _, a := range actions {
+		ret = append(ret, a.Func)
+	}
+	return ret
+}
@@ -80,6 +80,9 @@ func testSweepAccessContextManagerPolicies(region string) error {
 	}
 
 	policy := policies[0].(map[string]interface{})
+	if sweeper.SkipForDryRun(policy["name"].(string)) {
+		return nil
+	}
 	log.Printf("[DEBUG] Deleting test Access Policies %q", policy["name"])
 
 	policyUrl := transport_tpg.BaseUrl(Product, config) + policy["name"].(string)
@@ -170,12 +170,15 @@ of https://cloud.google.com/managed-microsoft-ad/reference/rest/v1/projects.loca
 				Description:  `The trust direction, which decides if the current domain is trusted, trusting, or both. Possible values: ["INBOUND", "OUTBOUND", "BIDIRECTIONAL"]`,
 			},
 			"trust_handshake_secret": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: `The trust secret used for the handshake with the target domain. This will not be stored.`,
-				Sensitive:   true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  `The trust secret used for the handshake with the target domain. This will not be stored.`,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"trust_handshake_secret", "trust_handshake_secret_wo"},
 			},
+			"trust_handshake_secret_wo":         tpgresource.WriteOnlySchema("trust_handshake_secret", `Write-only trust secret used for the handshake with the target domain.`, true),
+			"trust_handshake_secret_wo_version": tpgresource.WriteOnlyVersionSchema("trust_handshake_secret", true),
 			"trust_type": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -250,7 +253,7 @@ func resourceActiveDirectoryDomainTrustCreate(d *schema.ResourceData, meta inter
 	} else if v, ok := d.GetOkExists("target_dns_ip_addresses"); !tpgresource.IsEmptyValue(reflect.ValueOf(targetDnsIpAddressesProp)) && (ok || !reflect.DeepEqual(v, targetDnsIpAddressesProp)) {
 		obj["targetDnsIpAddresses"] = targetDnsIpAddressesProp
 	}
-	trustHandshakeSecretProp, err := expandNestedActiveDirectoryDomainTrustTrustHandshakeSecret(d.Get("trust_handshake_secret"), d, config)
+	trustHandshakeSecretProp, err := expandNestedActiveDirectoryDomainTrustTrustHandshakeSecret(tpgresource.GetWriteOnlyValue(d, "trust_handshake_secret", d.Get("trust_handshake_secret")), d, config)
 	if err != nil {
 		return err
 	} else if v, ok := d.GetOkExists("trust_handshake_secret"); !tpgresource.IsEmptyValue(reflect.ValueOf(trustHandshakeSecretProp)) && (ok || !reflect.DeepEqual(v, trustHandshakeSecretProp)) {
@@ -531,10 +534,10 @@ func resourceActiveDirectoryDomainTrustUpdate(d *schema.ResourceData, meta inter
 	} else if v, ok := d.GetOkExists("target_dns_ip_addresses"); !tpgresource.IsEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, targetDnsIpAddressesProp)) {
 		obj["targetDnsIpAddresses"] = targetDnsIpAddressesProp
 	}
-	trustHandshakeSecretProp, err := expandNestedActiveDirectoryDomainTrustTrustHandshakeSecret(d.Get("trust_handshake_secret"), d, config)
+	trustHandshakeSecretProp, err := expandNestedActiveDirectoryDomainTrustTrustHandshakeSecret(tpgresource.GetWriteOnlyValue(d, "trust_handshake_secret", d.Get("trust_handshake_secret")), d, config)
 	if err != nil {
 		return err
-	} else if v, ok := d.GetOkExists("trust_handshake_secret"); !tpgresource.IsEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, trustHandshakeSecretProp)) {
+	} else if v, ok := d.GetOkExists("trust_handshake_secret"); !tpgresource.IsEmptyValue(reflect.ValueOf(trustHandshakeSecretProp)) && (ok || !reflect.DeepEqual(v, trustHandshakeSecretProp)) {
 		obj["trustHandshakeSecret"] = trustHandshakeSecretProp
 	}
 
@@ -639,10 +642,10 @@ func resourceActiveDirectoryDomainTrustDelete(d *schema.ResourceData, meta inter
 	} else if v, ok := d.GetOkExists("target_dns_ip_addresses"); !tpgresource.IsEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, targetDnsIpAddressesProp)) {
 		obj["targetDnsIpAddresses"] = targetDnsIpAddressesProp
 	}
-	trustHandshakeSecretProp, err := expandNestedActiveDirectoryDomainTrustTrustHandshakeSecret(d.Get("trust_handshake_secret"), d, config)
+	trustHandshakeSecretProp, err := expandNestedActiveDirectoryDomainTrustTrustHandshakeSecret(tpgresource.GetWriteOnlyValue(d, "trust_handshake_secret", d.Get("trust_handshake_secret")), d, config)
 	if err != nil {
 		return err
-	} else if v, ok := d.GetOkExists("trust_handshake_secret"); !tpgresource.IsEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, trustHandshakeSecretProp)) {
+	} else if v, ok := d.GetOkExists("trust_handshake_secret"); !tpgresource.IsEmptyValue(reflect.ValueOf(trustHandshakeSecretProp)) && (ok || !reflect.DeepEqual(v, trustHandshakeSecretProp)) {
 		obj["trustHandshakeSecret"] = trustHandshakeSecretProp
 	}
 
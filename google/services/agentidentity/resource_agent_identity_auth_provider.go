@@ -194,11 +194,14 @@ Required when creating an auth_provider.`,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"api_key": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										Description: `Input only. The API key for this auth_provider.`,
-										Sensitive:   true,
+										Type:          schema.TypeString,
+										Optional:      true,
+										Description:   `Input only. The API key for this auth_provider.`,
+										Sensitive:     true,
+										ConflictsWith: []string{"auth_provider_type_params.0.api_key.0.api_key_wo"},
 									},
+									"api_key_wo":         tpgresource.WriteOnlySchema("auth_provider_type_params.0.api_key.0.api_key", `Write-only API key for this auth_provider.`, false),
+									"api_key_wo_version": tpgresource.WriteOnlyVersionSchema("auth_provider_type_params.0.api_key.0.api_key", false),
 								},
 							},
 							ExactlyOneOf: []string{"auth_provider_type_params.0.api_key", "auth_provider_type_params.0.three_legged_oauth", "auth_provider_type_params.0.two_legged_oauth"},
@@ -1127,7 +1130,7 @@ func expandAgentIdentityAuthProviderAuthProviderTypeParamsApiKey(v interface{},
 	original := raw.(map[string]interface{})
 	transformed := make(map[string]interface{})
 
-	transformedApiKey, err := expandAgentIdentityAuthProviderAuthProviderTypeParamsApiKeyApiKey(original["api_key"], d, config)
+	transformedApiKey, err := expandAgentIdentityAuthProviderAuthProviderTypeParamsApiKeyApiKey(tpgresource.GetWriteOnlyValue(d, "auth_provider_type_params.0.api_key.0.api_key", original["api_key"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedApiKey); val.IsValid() && !tpgresource.IsEmptyValue(val) {
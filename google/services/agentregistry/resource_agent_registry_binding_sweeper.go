@@ -194,7 +194,7 @@ func deleteResourceAgentRegistryBinding(config *transport_tpg.Config, d *tpgreso
 	}
 
 	// Skip resources that shouldn't be sweeped
-	if !sweeper.IsSweepableTestResource(name) {
+	if !sweeper.ShouldSweep(name, obj) {
 		return nil
 	}
 
@@ -103,7 +103,7 @@ func testSweepAlloydbCluster(region string) error {
 		name := obj["name"].(string)
 		shortname := tpgresource.GetResourceNameFromSelfLink(name)
 		// Skip resources that shouldn't be sweeped
-		if !sweeper.IsSweepableTestResource(shortname) {
+		if !sweeper.ShouldSweep(shortname, obj) {
 			nonPrefixCount++
 			continue
 		}
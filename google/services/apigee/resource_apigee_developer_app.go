@@ -347,8 +347,11 @@ recreated.
 
 This is a write-only input used at create time; the effective secret is
 exposed in the 'credentials' output.`,
-				Sensitive: true,
+				Sensitive:     true,
+				ConflictsWith: []string{"consumer_secret_wo"},
 			},
+			"consumer_secret_wo":         tpgresource.WriteOnlySchema("consumer_secret", `Write-only static consumer secret for the developer app's credential, used instead of 'consumer_secret'.`, false),
+			"consumer_secret_wo_version": tpgresource.WriteOnlyVersionSchema("consumer_secret", true),
 			"key_expires_in": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -599,7 +602,7 @@ func resourceApigeeDeveloperAppCreate(d *schema.ResourceData, meta interface{})
 	// app is first created with an auto-generated credential and then, here, we swap
 	// in the user-supplied credential via the keys API.
 	if consumerKey, ok := d.GetOk("consumer_key"); ok {
-		consumerSecret := d.Get("consumer_secret").(string)
+		consumerSecret := tpgresource.GetWriteOnlyValue(d, "consumer_secret", d.Get("consumer_secret")).(string)
 
 		// First, obtain the auto-generated consumer key(s) to delete them later.
 		readURL, err := tpgresource.ReplaceVars(d, config, "{{ApigeeBasePath}}{{org_id}}/developers/{{developer_email}}/apps/{{name}}")
@@ -190,7 +190,7 @@ func deleteResourceApigeeOrganization(config *transport_tpg.Config, d *tpgresour
 	name = obj["organization"].(string)
 
 	// Skip resources that shouldn't be sweeped
-	if !sweeper.IsSweepableTestResource(name) {
+	if !sweeper.ShouldSweep(name, obj) {
 		return nil
 	}
 
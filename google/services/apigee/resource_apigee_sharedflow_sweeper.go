@@ -106,7 +106,7 @@ func testSweepApigeeSharedFlow(region string) error {
 			return nil
 		}
 		// Skip resources that shouldn't be sweeped
-		if !sweeper.IsSweepableTestResource(name) {
+		if !sweeper.ShouldSweep(name, obj) {
 			nonPrefixCount++
 			continue
 		}
@@ -65,5 +65,5 @@ func testSweepApikeysKey(region string) error {
 }
 
 func isDeletableApikeysKey(r *Key) bool {
-	return sweeper.IsSweepableTestResource(*r.Name)
+	return sweeper.ShouldSweepTyped(*r.Name, r)
 }
@@ -79,7 +79,7 @@ func testSweepAppEngineAppVersion(region string) error {
 
 		id := obj["id"].(string)
 		// Increment count and skip if resource is not sweepable.
-		if !sweeper.IsSweepableTestResource(id) {
+		if !sweeper.ShouldSweep(id, obj) {
 			nonPrefixCount++
 			continue
 		}
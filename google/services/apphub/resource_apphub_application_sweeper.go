@@ -196,7 +196,7 @@ func deleteResourceApphubApplication(config *transport_tpg.Config, d *tpgresourc
 	}
 
 	// Skip resources that shouldn't be sweeped
-	if !sweeper.IsSweepableTestResource(name) {
+	if !sweeper.ShouldSweep(name, obj) {
 		return nil
 	}
 
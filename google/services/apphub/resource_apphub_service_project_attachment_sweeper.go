@@ -194,7 +194,7 @@ func deleteResourceApphubServiceProjectAttachment(config *transport_tpg.Config,
 	}
 
 	// Skip resources that shouldn't be sweeped
-	if !sweeper.IsSweepableTestResource(name) {
+	if !sweeper.ShouldSweep(name, obj) {
 		return nil
 	}
 
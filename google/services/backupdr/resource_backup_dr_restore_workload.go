@@ -500,19 +500,25 @@ func ResourceBackupDRRestoreWorkload() *schema.Resource {
 										Description: ``,
 									},
 									"raw_key": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										ForceNew:    true,
-										Description: ``,
-										Sensitive:   true,
+										Type:          schema.TypeString,
+										Optional:      true,
+										ForceNew:      true,
+										Description:   ``,
+										Sensitive:     true,
+										ConflictsWith: []string{"compute_instance_restore_properties.0.instance_encryption_key.0.raw_key_wo"},
 									},
+									"raw_key_wo":         tpgresource.WriteOnlySchema("compute_instance_restore_properties.0.instance_encryption_key.0.raw_key", `Write-only 256-bit customer-supplied encryption key, encoded in RFC 4648 base64.`, false),
+									"raw_key_wo_version": tpgresource.WriteOnlyVersionSchema("compute_instance_restore_properties.0.instance_encryption_key.0.raw_key", true),
 									"rsa_encrypted_key": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										ForceNew:    true,
-										Description: ``,
-										Sensitive:   true,
+										Type:          schema.TypeString,
+										Optional:      true,
+										ForceNew:      true,
+										Description:   ``,
+										Sensitive:     true,
+										ConflictsWith: []string{"compute_instance_restore_properties.0.instance_encryption_key.0.rsa_encrypted_key_wo"},
 									},
+									"rsa_encrypted_key_wo":         tpgresource.WriteOnlySchema("compute_instance_restore_properties.0.instance_encryption_key.0.rsa_encrypted_key", `Write-only RFC 4648 base64 encoded, RSA-wrapped 2048-bit customer-supplied encryption key.`, false),
+									"rsa_encrypted_key_wo_version": tpgresource.WriteOnlyVersionSchema("compute_instance_restore_properties.0.instance_encryption_key.0.rsa_encrypted_key", true),
 								},
 							},
 						},
@@ -1185,19 +1191,25 @@ If false, only the restore record is removed from the state, leaving the resourc
 										Description: ``,
 									},
 									"raw_key": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										ForceNew:    true,
-										Description: ``,
-										Sensitive:   true,
+										Type:          schema.TypeString,
+										Optional:      true,
+										ForceNew:      true,
+										Description:   ``,
+										Sensitive:     true,
+										ConflictsWith: []string{"disk_restore_properties.0.disk_encryption_key.0.raw_key_wo"},
 									},
+									"raw_key_wo":         tpgresource.WriteOnlySchema("disk_restore_properties.0.disk_encryption_key.0.raw_key", `Write-only 256-bit customer-supplied encryption key, encoded in RFC 4648 base64.`, false),
+									"raw_key_wo_version": tpgresource.WriteOnlyVersionSchema("disk_restore_properties.0.disk_encryption_key.0.raw_key", true),
 									"rsa_encrypted_key": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										ForceNew:    true,
-										Description: ``,
-										Sensitive:   true,
+										Type:          schema.TypeString,
+										Optional:      true,
+										ForceNew:      true,
+										Description:   ``,
+										Sensitive:     true,
+										ConflictsWith: []string{"disk_restore_properties.0.disk_encryption_key.0.rsa_encrypted_key_wo"},
 									},
+									"rsa_encrypted_key_wo":         tpgresource.WriteOnlySchema("disk_restore_properties.0.disk_encryption_key.0.rsa_encrypted_key", `Write-only RFC 4648 base64 encoded, RSA-wrapped 2048-bit customer-supplied encryption key.`, false),
+									"rsa_encrypted_key_wo_version": tpgresource.WriteOnlyVersionSchema("disk_restore_properties.0.disk_encryption_key.0.rsa_encrypted_key", true),
 								},
 							},
 						},
@@ -3207,8 +3219,12 @@ func flattenBackupDRRestoreWorkloadComputeInstanceRestorePropertiesInstanceEncry
 	transformed := make(map[string]interface{})
 	transformed["raw_key"] =
 		flattenBackupDRRestoreWorkloadComputeInstanceRestorePropertiesInstanceEncryptionKeyRawKey(original["rawKey"], d, config)
+	transformed["raw_key_wo_version"] =
+		flattenBackupDRRestoreWorkloadComputeInstanceRestorePropertiesInstanceEncryptionKeyRawKeyWoVersion(original["rawKeyWoVersion"], d, config)
 	transformed["rsa_encrypted_key"] =
 		flattenBackupDRRestoreWorkloadComputeInstanceRestorePropertiesInstanceEncryptionKeyRsaEncryptedKey(original["rsaEncryptedKey"], d, config)
+	transformed["rsa_encrypted_key_wo_version"] =
+		flattenBackupDRRestoreWorkloadComputeInstanceRestorePropertiesInstanceEncryptionKeyRsaEncryptedKeyWoVersion(original["rsaEncryptedKeyWoVersion"], d, config)
 	transformed["kms_key_name"] =
 		flattenBackupDRRestoreWorkloadComputeInstanceRestorePropertiesInstanceEncryptionKeyKmsKeyName(original["kmsKeyName"], d, config)
 	transformed["kms_key_service_account"] =
@@ -3219,10 +3235,18 @@ func flattenBackupDRRestoreWorkloadComputeInstanceRestorePropertiesInstanceEncry
 	return v
 }
 
+func flattenBackupDRRestoreWorkloadComputeInstanceRestorePropertiesInstanceEncryptionKeyRawKeyWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("compute_instance_restore_properties.0.instance_encryption_key.0.raw_key_wo_version")
+}
+
 func flattenBackupDRRestoreWorkloadComputeInstanceRestorePropertiesInstanceEncryptionKeyRsaEncryptedKey(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
 
+func flattenBackupDRRestoreWorkloadComputeInstanceRestorePropertiesInstanceEncryptionKeyRsaEncryptedKeyWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("compute_instance_restore_properties.0.instance_encryption_key.0.rsa_encrypted_key_wo_version")
+}
+
 func flattenBackupDRRestoreWorkloadComputeInstanceRestorePropertiesInstanceEncryptionKeyKmsKeyName(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -4041,8 +4065,12 @@ func flattenBackupDRRestoreWorkloadDiskRestorePropertiesDiskEncryptionKey(v inte
 	transformed := make(map[string]interface{})
 	transformed["raw_key"] =
 		flattenBackupDRRestoreWorkloadDiskRestorePropertiesDiskEncryptionKeyRawKey(original["rawKey"], d, config)
+	transformed["raw_key_wo_version"] =
+		flattenBackupDRRestoreWorkloadDiskRestorePropertiesDiskEncryptionKeyRawKeyWoVersion(original["rawKeyWoVersion"], d, config)
 	transformed["rsa_encrypted_key"] =
 		flattenBackupDRRestoreWorkloadDiskRestorePropertiesDiskEncryptionKeyRsaEncryptedKey(original["rsaEncryptedKey"], d, config)
+	transformed["rsa_encrypted_key_wo_version"] =
+		flattenBackupDRRestoreWorkloadDiskRestorePropertiesDiskEncryptionKeyRsaEncryptedKeyWoVersion(original["rsaEncryptedKeyWoVersion"], d, config)
 	transformed["kms_key_name"] =
 		flattenBackupDRRestoreWorkloadDiskRestorePropertiesDiskEncryptionKeyKmsKeyName(original["kmsKeyName"], d, config)
 	transformed["kms_key_service_account"] =
@@ -4053,10 +4081,18 @@ func flattenBackupDRRestoreWorkloadDiskRestorePropertiesDiskEncryptionKeyRawKey(
 	return v
 }
 
+func flattenBackupDRRestoreWorkloadDiskRestorePropertiesDiskEncryptionKeyRawKeyWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("disk_restore_properties.0.disk_encryption_key.0.raw_key_wo_version")
+}
+
 func flattenBackupDRRestoreWorkloadDiskRestorePropertiesDiskEncryptionKeyRsaEncryptedKey(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
 
+func flattenBackupDRRestoreWorkloadDiskRestorePropertiesDiskEncryptionKeyRsaEncryptedKeyWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("disk_restore_properties.0.disk_encryption_key.0.rsa_encrypted_key_wo_version")
+}
+
 func flattenBackupDRRestoreWorkloadDiskRestorePropertiesDiskEncryptionKeyKmsKeyName(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -5078,14 +5114,14 @@ func expandBackupDRRestoreWorkloadComputeInstanceRestorePropertiesInstanceEncryp
 	original := raw.(map[string]interface{})
 	transformed := make(map[string]interface{})
 
-	transformedRawKey, err := expandBackupDRRestoreWorkloadComputeInstanceRestorePropertiesInstanceEncryptionKeyRawKey(original["raw_key"], d, config)
+	transformedRawKey, err := expandBackupDRRestoreWorkloadComputeInstanceRestorePropertiesInstanceEncryptionKeyRawKey(tpgresource.GetWriteOnlyValue(d, "compute_instance_restore_properties.0.instance_encryption_key.0.raw_key", original["raw_key"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedRawKey); val.IsValid() && !tpgresource.IsEmptyValue(val) {
 		transformed["rawKey"] = transformedRawKey
 	}
 
-	transformedRsaEncryptedKey, err := expandBackupDRRestoreWorkloadComputeInstanceRestorePropertiesInstanceEncryptionKeyRsaEncryptedKey(original["rsa_encrypted_key"], d, config)
+	transformedRsaEncryptedKey, err := expandBackupDRRestoreWorkloadComputeInstanceRestorePropertiesInstanceEncryptionKeyRsaEncryptedKey(tpgresource.GetWriteOnlyValue(d, "compute_instance_restore_properties.0.instance_encryption_key.0.rsa_encrypted_key", original["rsa_encrypted_key"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedRsaEncryptedKey); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -6305,14 +6341,14 @@ func expandBackupDRRestoreWorkloadDiskRestorePropertiesDiskEncryptionKey(v inter
 	original := raw.(map[string]interface{})
 	transformed := make(map[string]interface{})
 
-	transformedRawKey, err := expandBackupDRRestoreWorkloadDiskRestorePropertiesDiskEncryptionKeyRawKey(original["raw_key"], d, config)
+	transformedRawKey, err := expandBackupDRRestoreWorkloadDiskRestorePropertiesDiskEncryptionKeyRawKey(tpgresource.GetWriteOnlyValue(d, "disk_restore_properties.0.disk_encryption_key.0.raw_key", original["raw_key"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedRawKey); val.IsValid() && !tpgresource.IsEmptyValue(val) {
 		transformed["rawKey"] = transformedRawKey
 	}
 
-	transformedRsaEncryptedKey, err := expandBackupDRRestoreWorkloadDiskRestorePropertiesDiskEncryptionKeyRsaEncryptedKey(original["rsa_encrypted_key"], d, config)
+	transformedRsaEncryptedKey, err := expandBackupDRRestoreWorkloadDiskRestorePropertiesDiskEncryptionKeyRsaEncryptedKey(tpgresource.GetWriteOnlyValue(d, "disk_restore_properties.0.disk_encryption_key.0.rsa_encrypted_key", original["rsa_encrypted_key"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedRsaEncryptedKey); val.IsValid() && !tpgresource.IsEmptyValue(val) {
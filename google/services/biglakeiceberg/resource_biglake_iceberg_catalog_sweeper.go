@@ -191,7 +191,7 @@ func deleteResourceBiglakeIcebergIcebergCatalog(config *transport_tpg.Config, d
 	name = tpgresource.GetResourceNameFromSelfLink(obj["name"].(string))
 
 	// Skip resources that shouldn't be sweeped
-	if !sweeper.IsSweepableTestResource(name) {
+	if !sweeper.ShouldSweep(name, obj) {
 		return nil
 	}
 
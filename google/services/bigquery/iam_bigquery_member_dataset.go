@@ -411,6 +411,8 @@ func NewBigqueryDatasetIamMemberListResource() list.ListResource {
 			ParentResourceField: "dataset_id",
 			EnableRoleFilter:    true,
 			EnableMemberFilter:  true,
+			AssetType:           "bigquery.googleapis.com/Dataset",
+			AssetNameFormat:     "//bigquery.googleapis.com/projects/{{project}}/datasets/{{dataset_id}}",
 		},
 	)
 }
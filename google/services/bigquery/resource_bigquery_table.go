@@ -606,16 +606,42 @@ func resourceBigQueryTableSchemaCustomizeDiffFunc(d tpgresource.TerraformResourc
 			return nil
 		}
 		_, isExternalTable := d.GetOk("external_data_configuration")
-		isChangeable, err := resourceBigQueryTableSchemaIsChangeable(old, new, isExternalTable, true, hasRowAccessPolicyFunc)
+
+		// Renames, type coercions and relaxations of top level columns are
+		// migrated in place with DDL before the table is updated.
+		var steps []string
+		migrated := old
+		oldColumns, okOld := old.([]interface{})
+		newColumns, okNew := new.([]interface{})
+		if okOld && okNew && !isExternalTable {
+			if err := bigQueryTablecheckNameExists(oldColumns); err != nil {
+				return err
+			}
+			if err := bigQueryTablecheckNameExists(newColumns); err != nil {
+				return err
+			}
+			renames, _ := d.Get("column_renames").(map[string]interface{})
+			steps, migrated = bigQueryTableSchemaMigration(oldColumns, newColumns, renames, bigQueryTableDDLName(d))
+		}
+
+		isChangeable, err := resourceBigQueryTableSchemaIsChangeable(migrated, new, isExternalTable, true, hasRowAccessPolicyFunc)
 		if err != nil {
 			return err
 		}
 		if !isChangeable {
+			// Replacing a table deletes its data, unless it's an external table.
+			// The schema is unknown until the table exists, or when it's only
+			// known after apply.
+			allowReplacement, _ := d.Get("allow_schema_replacement").(bool)
+			if !allowReplacement && !isExternalTable && old != nil && new != nil {
+				return fmt.Errorf("the schema change can't be applied in place, and the table would have to be replaced, deleting its data. Use column_renames to rename columns, or set allow_schema_replacement = true to replace the table")
+			}
 			if err := d.ForceNew("schema"); err != nil {
 				return err
 			}
+			steps = nil
 		}
-		return nil
+		return d.SetNew("schema_migration_steps", tpgresource.ConvertStringArrToInterface(steps))
 	}
 	return nil
 }
@@ -670,6 +696,7 @@ func ResourceBigQueryTable() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			tpgresource.DefaultProviderDeletionPolicy("DELETE"),
 			tpgresource.DefaultProviderProject,
+			resourceBigQueryTableSchemaFieldsCustomizeDiff,
 			resourceBigQueryTableSchemaCustomizeDiff,
 			tpgresource.SetLabelsDiff,
 		),
@@ -1253,8 +1280,16 @@ func ResourceBigQueryTable() *schema.Resource {
 					return json
 				},
 				DiffSuppressFunc: bigQueryTableSchemaDiffSuppress,
+				ConflictsWith:    []string{"schema_fields"},
 				Description:      `A JSON schema for the table.`,
 			},
+			"schema_fields": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ConflictsWith: []string{"schema"},
+				Description:   `The columns of the table, as an alternative to the JSON schema.`,
+				Elem:          bigQueryTableSchemaFieldsSchema(bigQueryTableSchemaFieldsMaxDepth),
+			},
 			// SchemaForeignTypeInfo: [Optional] Specifies metadata of the foreign data type definition in field schema.
 			"schema_foreign_type_info": {
 				Type:        schema.TypeList,
@@ -1518,6 +1553,24 @@ func ResourceBigQueryTable() *schema.Resource {
 				Description: `Mention which fields in schema are to be ignored`,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"column_renames": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: `A map of column names to the names the columns are renamed to in schema. Renamed top level columns are renamed in place instead of being dropped and added.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"allow_schema_replacement": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: `Whether schema changes that can't be applied in place replace the table, deleting its data. When false, such changes fail to plan.`,
+			},
+			"schema_migration_steps": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: `The DDL statements run to migrate the schema of the table in place during the last schema change.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 			// LastModifiedTime: [Output-only] The time when this table was last
 			// modified, in milliseconds since the epoch.
 			"last_modified_time": {
@@ -2437,6 +2490,11 @@ func resourceBigQueryTableRead(d *schema.ResourceData, meta interface{}) error {
 		if err := d.Set("schema", schema); err != nil {
 			return fmt.Errorf("Error setting schema: %s", err)
 		}
+		if _, ok := d.GetOk("schema_fields"); ok {
+			if err := d.Set("schema_fields", flattenBigQueryTableSchemaFields(schemaFields)); err != nil {
+				return fmt.Errorf("Error setting schema_fields: %s", err)
+			}
+		}
 		if foreignTypeInfoRaw, ok := schemaRaw["foreignTypeInfo"].(map[string]interface{}); ok {
 			foreignTypeInfo := flattenForeignTypeInfo(foreignTypeInfoRaw)
 			if err := d.Set("schema_foreign_type_info", foreignTypeInfo); err != nil {
@@ -2568,7 +2626,7 @@ func addAutoGenSchemaFields(d *schema.ResourceData, tableBody map[string]interfa
 
 func resourceBigQueryTableUpdate(d *schema.ResourceData, meta interface{}) error {
 	// If only client-side fields were modified, short-circuit the Update function to avoid sending an update API request.
-	clientSideFields := map[string]bool{"deletion_protection": true, "ignore_schema_changes": true, "ignore_auto_generated_schema": true, "table_metadata_view": true, "deletion_policy": true}
+	clientSideFields := map[string]bool{"deletion_protection": true, "ignore_schema_changes": true, "ignore_auto_generated_schema": true, "table_metadata_view": true, "deletion_policy": true, "column_renames": true, "allow_schema_replacement": true}
 	clientSideOnly := true
 	for field := range ResourceBigQueryTable().Schema {
 		if d.HasChange(field) && !clientSideFields[field] {
@@ -2622,6 +2680,18 @@ func resourceBigQueryTableUpdate(d *schema.ResourceData, meta interface{}) error
 		tableID:   tableID,
 	}
 
+	// Schema migrations run before the old table is fetched, so that renamed
+	// columns aren't dropped. The steps are planned for every schema change,
+	// so they may be the same as the ones run by the last schema change.
+	if d.HasChange("schema") {
+		for _, step := range d.Get("schema_migration_steps").([]interface{}) {
+			log.Printf("[INFO] Migrating the schema of BigQuery table %s: %s", d.Id(), step)
+			if err := bigQueryTableRunDDL(config, userAgent, project, step.(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return fmt.Errorf("Error migrating the schema of BigQuery table %s with %q: %s", d.Id(), step, err)
+			}
+		}
+	}
+
 	// Logic to fetch oldTable if needed for Dropping Columns OR Merging Data Policies
 	ignoreSchemaChanges := d.Get("ignore_schema_changes").([]interface{})
 	shouldIgnoreDataPolicies := slices.Contains(ignoreSchemaChanges, "dataPolicies")
@@ -19,6 +19,7 @@ package bigquery
 import (
 	"encoding/json"
 	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -605,6 +606,8 @@ func (testcase *testUnitBigQueryDataTableJSONChangeableTestCase) check(t *testin
 
 	d.Before["schema"] = testcase.jsonOld
 	d.After["schema"] = testcase.jsonNew
+	// Replacement is checked through d.IsForceNew.
+	d.After["allow_schema_replacement"] = true
 
 	// Set the ignore flag if provided in the test case
 	if testcase.ignoreSchemaChanges != nil {
@@ -837,6 +840,131 @@ func TestUnitBigQueryDataTable_schemaIsChangeableNested(t *testing.T) {
 	}
 }
 
+func TestUnitBigQueryDataTable_schemaMigration(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name       string
+		jsonOld    string
+		jsonNew    string
+		renames    map[string]interface{}
+		steps      []interface{}
+		changeable bool
+	}{
+		{
+			name:       "renameColumn",
+			jsonOld:    `[{"name": "a", "type": "STRING"}, {"name": "b", "type": "STRING"}]`,
+			jsonNew:    `[{"name": "c", "type": "STRING"}, {"name": "b", "type": "STRING"}]`,
+			renames:    map[string]interface{}{"a": "c"},
+			steps:      []interface{}{"ALTER TABLE `p.d.t` RENAME COLUMN `a` TO `c`"},
+			changeable: true,
+		},
+		{
+			name:       "renameWithoutRenames",
+			jsonOld:    `[{"name": "a", "type": "STRING"}]`,
+			jsonNew:    `[{"name": "c", "type": "STRING"}]`,
+			changeable: false,
+		},
+		{
+			name:       "renameToExistingColumn",
+			jsonOld:    `[{"name": "a", "type": "STRING"}, {"name": "c", "type": "STRING"}]`,
+			jsonNew:    `[{"name": "c", "type": "STRING"}]`,
+			renames:    map[string]interface{}{"a": "c"},
+			steps:      []interface{}{},
+			changeable: true,
+		},
+		{
+			name:       "appliedRename",
+			jsonOld:    `[{"name": "c", "type": "STRING"}]`,
+			jsonNew:    `[{"name": "c", "type": "STRING", "description": "renamed"}]`,
+			renames:    map[string]interface{}{"a": "c"},
+			steps:      []interface{}{},
+			changeable: true,
+		},
+		{
+			name:    "renameAndWidenType",
+			jsonOld: `[{"name": "a", "type": "INTEGER", "mode": "REQUIRED"}]`,
+			jsonNew: `[{"name": "c", "type": "NUMERIC"}]`,
+			renames: map[string]interface{}{"a": "c"},
+			steps: []interface{}{
+				"ALTER TABLE `p.d.t` RENAME COLUMN `a` TO `c`",
+				"ALTER TABLE `p.d.t` ALTER COLUMN `c` SET DATA TYPE NUMERIC",
+				"ALTER TABLE `p.d.t` ALTER COLUMN `c` DROP NOT NULL",
+			},
+			changeable: true,
+		},
+		{
+			name:       "widenType",
+			jsonOld:    `[{"name": "a", "type": "NUMERIC"}]`,
+			jsonNew:    `[{"name": "a", "type": "FLOAT"}]`,
+			steps:      []interface{}{"ALTER TABLE `p.d.t` ALTER COLUMN `a` SET DATA TYPE FLOAT64"},
+			changeable: true,
+		},
+		{
+			name:       "narrowType",
+			jsonOld:    `[{"name": "a", "type": "FLOAT"}]`,
+			jsonNew:    `[{"name": "a", "type": "INTEGER"}]`,
+			changeable: false,
+		},
+		{
+			name:       "widenNestedType",
+			jsonOld:    `[{"name": "a", "type": "RECORD", "fields": [{"name": "b", "type": "INTEGER"}]}]`,
+			jsonNew:    `[{"name": "a", "type": "RECORD", "fields": [{"name": "b", "type": "NUMERIC"}]}]`,
+			changeable: false,
+		},
+		{
+			name:       "tightenMode",
+			jsonOld:    `[{"name": "a", "type": "STRING"}]`,
+			jsonNew:    `[{"name": "a", "type": "STRING", "mode": "REQUIRED"}]`,
+			changeable: false,
+		},
+	}
+
+	for _, tc := range cases {
+		d := &tpgresource.ResourceDiffMock{
+			Before: map[string]interface{}{
+				"schema": tc.jsonOld,
+			},
+			After: map[string]interface{}{
+				"schema":         tc.jsonNew,
+				"project":        "p",
+				"dataset_id":     "d",
+				"table_id":       "t",
+				"column_renames": tc.renames,
+			},
+		}
+		hasRowAccessPolicyFunc := func() (bool, error) {
+			return false, nil
+		}
+
+		err := resourceBigQueryTableSchemaCustomizeDiffFunc(d, hasRowAccessPolicyFunc)
+		if tc.changeable {
+			if err != nil {
+				t.Errorf("%s: unexpected error: %s", tc.name, err)
+				continue
+			}
+			if d.IsForceNew {
+				t.Errorf("%s: expected the schema change to be in place", tc.name)
+			}
+			if steps := d.After["schema_migration_steps"]; !reflect.DeepEqual(steps, tc.steps) {
+				t.Errorf("%s: expected steps %v, got %v", tc.name, tc.steps, steps)
+			}
+			continue
+		}
+		if err == nil {
+			t.Errorf("%s: expected an error without allow_schema_replacement", tc.name)
+		}
+
+		d.After["allow_schema_replacement"] = true
+		if err := resourceBigQueryTableSchemaCustomizeDiffFunc(d, hasRowAccessPolicyFunc); err != nil {
+			t.Errorf("%s: unexpected error with allow_schema_replacement: %s", tc.name, err)
+		}
+		if !d.IsForceNew {
+			t.Errorf("%s: expected the table to be replaced with allow_schema_replacement", tc.name)
+		}
+	}
+}
+
 func TestMergeDataPoliciesIntoMap(t *testing.T) {
 	t.Parallel()
 
@@ -857,3 +985,75 @@ func TestMergeDataPoliciesIntoMap(t *testing.T) {
 		t.Errorf("dataPolicies were not merged into the map")
 	}
 }
+
+func TestUnitBigQueryDataTable_schemaFields(t *testing.T) {
+	t.Parallel()
+
+	schemaFields := []interface{}{
+		map[string]interface{}{
+			"name":                     "id",
+			"type":                     "INT64",
+			"mode":                     "REQUIRED",
+			"description":              "",
+			"max_length":               0,
+			"precision":                0,
+			"scale":                    0,
+			"collation":                "",
+			"default_value_expression": "",
+			"rounding_mode":            "",
+			"policy_tags":              []interface{}{},
+		},
+		map[string]interface{}{
+			"name":                     "price",
+			"type":                     "NUMERIC",
+			"mode":                     "",
+			"description":              "The price.",
+			"max_length":               0,
+			"precision":                10,
+			"scale":                    2,
+			"collation":                "",
+			"default_value_expression": "0",
+			"rounding_mode":            "ROUND_HALF_EVEN",
+			"policy_tags":              []interface{}{"projects/p/locations/us/taxonomies/1/policyTags/2"},
+		},
+		map[string]interface{}{
+			"name": "address",
+			"type": "RECORD",
+			"mode": "REPEATED",
+			"fields": []interface{}{
+				map[string]interface{}{
+					"name":      "city",
+					"type":      "STRING",
+					"collation": "und:ci",
+				},
+			},
+		},
+	}
+
+	want := `[{"mode":"REQUIRED","name":"id","type":"INT64"},` +
+		`{"defaultValueExpression":"0","description":"The price.","name":"price","policyTags":{"names":["projects/p/locations/us/taxonomies/1/policyTags/2"]},"precision":"10","roundingMode":"ROUND_HALF_EVEN","scale":"2","type":"NUMERIC"},` +
+		`{"fields":[{"collation":"und:ci","name":"city","type":"STRING"}],"mode":"REPEATED","name":"address","type":"RECORD"}]`
+
+	fields := expandBigQueryTableSchemaFields(schemaFields)
+	got, err := flattenSchema(fields)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got != want {
+		t.Fatalf("expanded schema_fields:\n got: %s\nwant: %s", got, want)
+	}
+
+	flattened := flattenBigQueryTableSchemaFields(fields)
+	price := flattened[1].(map[string]interface{})
+	if price["precision"] != 10 || price["scale"] != 2 || price["rounding_mode"] != "ROUND_HALF_EVEN" {
+		t.Errorf("unexpected flattened column: %v", price)
+	}
+	if !reflect.DeepEqual(price["policy_tags"], []interface{}{"projects/p/locations/us/taxonomies/1/policyTags/2"}) {
+		t.Errorf("unexpected flattened policy tags: %v", price["policy_tags"])
+	}
+	address := flattened[2].(map[string]interface{})
+	city := address["fields"].([]interface{})[0].(map[string]interface{})
+	if city["name"] != "city" || city["collation"] != "und:ci" {
+		t.Errorf("unexpected flattened nested column: %v", city)
+	}
+}
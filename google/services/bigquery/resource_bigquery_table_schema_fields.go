@@ -0,0 +1,225 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/services/bigquery/resource_bigquery_table_schema_fields.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package bigquery
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// bigQueryTableSchemaFieldsMaxDepth is the maximum nesting depth of RECORD
+// columns supported by BigQuery.
+const bigQueryTableSchemaFieldsMaxDepth = 15
+
+// bigQueryTableSchemaFieldsSchema returns the schema of a list of columns.
+// Terraform schemas can't be recursive, so RECORD columns are nested up to
+// depth levels.
+func bigQueryTableSchemaFieldsSchema(depth int) *schema.Resource {
+	fields := map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: `The name of the column.`,
+		},
+		"type": {
+			Type:             schema.TypeString,
+			Required:         true,
+			DiffSuppressFunc: bigQueryTableSchemaFieldTypeDiffSuppress,
+			Description:      `The type of the column, such as STRING, INT64 or RECORD.`,
+		},
+		"mode": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice([]string{"NULLABLE", "REQUIRED", "REPEATED"}, false),
+			Description:  `The mode of the column. One of NULLABLE, REQUIRED or REPEATED.`,
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: `The description of the column.`,
+		},
+		"max_length": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Description: `The maximum length of a STRING or BYTES column.`,
+		},
+		"precision": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Description: `The precision of a NUMERIC or BIGNUMERIC column.`,
+		},
+		"scale": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Description: `The scale of a NUMERIC or BIGNUMERIC column.`,
+		},
+		"collation": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: `The collation of a STRING column. Inherited from the table or dataset if unset.`,
+		},
+		"default_value_expression": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: `A SQL expression used as the default value of the column.`,
+		},
+		"rounding_mode": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice([]string{"ROUND_HALF_AWAY_FROM_ZERO", "ROUND_HALF_EVEN"}, false),
+			Description:  `The rounding mode used for values written to a NUMERIC or BIGNUMERIC column.`,
+		},
+		"policy_tags": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: `The names of the policy tags attached to the column.`,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+	}
+	if depth > 1 {
+		fields["fields"] = &schema.Schema{
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: `The columns of a RECORD column.`,
+			Elem:        bigQueryTableSchemaFieldsSchema(depth - 1),
+		}
+	}
+	return &schema.Resource{Schema: fields}
+}
+
+func bigQueryTableSchemaFieldTypeDiffSuppress(_, old, new string, _ *schema.ResourceData) bool {
+	return bigQueryTableTypeEq(old, new)
+}
+
+// expandBigQueryTableSchemaFields converts schema_fields to the API
+// representation of the table columns, as accepted by flattenSchema.
+func expandBigQueryTableSchemaFields(v interface{}) []interface{} {
+	l, _ := v.([]interface{})
+	fields := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		field := map[string]interface{}{
+			"name": original["name"],
+			"type": original["type"],
+		}
+		for tfKey, apiKey := range map[string]string{
+			"mode":                     "mode",
+			"description":              "description",
+			"collation":                "collation",
+			"default_value_expression": "defaultValueExpression",
+			"rounding_mode":            "roundingMode",
+		} {
+			if s, _ := original[tfKey].(string); s != "" {
+				field[apiKey] = s
+			}
+		}
+		// int64 values are represented as strings by the API.
+		for tfKey, apiKey := range map[string]string{
+			"max_length": "maxLength",
+			"precision":  "precision",
+			"scale":      "scale",
+		} {
+			if i, _ := original[tfKey].(int); i != 0 {
+				field[apiKey] = strconv.Itoa(i)
+			}
+		}
+		if tags, _ := original["policy_tags"].([]interface{}); len(tags) > 0 {
+			field["policyTags"] = map[string]interface{}{"names": tags}
+		}
+		if sub, _ := original["fields"].([]interface{}); len(sub) > 0 {
+			field["fields"] = expandBigQueryTableSchemaFields(sub)
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+// flattenBigQueryTableSchemaFields converts the API representation of the
+// table columns to schema_fields.
+func flattenBigQueryTableSchemaFields(fields []interface{}) []interface{} {
+	return flattenBigQueryTableSchemaFieldsWithDepth(fields, bigQueryTableSchemaFieldsMaxDepth)
+}
+
+func flattenBigQueryTableSchemaFieldsWithDepth(fields []interface{}, depth int) []interface{} {
+	transformed := make([]interface{}, 0, len(fields))
+	for _, raw := range fields {
+		original, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		field := map[string]interface{}{
+			"name":                     original["name"],
+			"type":                     original["type"],
+			"mode":                     original["mode"],
+			"description":              original["description"],
+			"collation":                original["collation"],
+			"default_value_expression": original["defaultValueExpression"],
+			"rounding_mode":            original["roundingMode"],
+		}
+		for apiKey, tfKey := range map[string]string{
+			"maxLength": "max_length",
+			"precision": "precision",
+			"scale":     "scale",
+		} {
+			if s, ok := original[apiKey].(string); ok {
+				if i, err := strconv.Atoi(s); err == nil {
+					field[tfKey] = i
+				}
+			}
+		}
+		if policyTags, ok := original["policyTags"].(map[string]interface{}); ok {
+			field["policy_tags"] = policyTags["names"]
+		}
+		if sub, ok := original["fields"].([]interface{}); ok && depth > 1 {
+			field["fields"] = flattenBigQueryTableSchemaFieldsWithDepth(sub, depth-1)
+		}
+		transformed = append(transformed, field)
+	}
+	return transformed
+}
+
+// resourceBigQueryTableSchemaFieldsCustomizeDiff plans the JSON schema from
+// schema_fields, so the rest of the resource only deals with the JSON form.
+func resourceBigQueryTableSchemaFieldsCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	v, ok := d.GetOk("schema_fields")
+	if !ok {
+		return nil
+	}
+	if !d.NewValueKnown("schema_fields") {
+		return d.SetNewComputed("schema")
+	}
+	schemaJSON, err := flattenSchema(expandBigQueryTableSchemaFields(v))
+	if err != nil {
+		return fmt.Errorf("error converting schema_fields to JSON: %w", err)
+	}
+	// DiffSuppressFunc isn't applied to values set in CustomizeDiff, so keep
+	// the old value when the schemas are equivalent.
+	if old, ok := d.Get("schema").(string); ok && old != "" && bigQueryTableSchemaDiffSuppress("schema", old, schemaJSON, nil) {
+		return nil
+	}
+	return d.SetNew("schema", schemaJSON)
+}
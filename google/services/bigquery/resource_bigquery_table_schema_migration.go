@@ -0,0 +1,175 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/services/bigquery/resource_bigquery_table_schema_migration.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package bigquery
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+)
+
+// bigQueryTableTypeAliases maps the legacy and alias names of column types to
+// their GoogleSQL names.
+var bigQueryTableTypeAliases = map[string]string{
+	"INTEGER":    "INT64",
+	"FLOAT":      "FLOAT64",
+	"BOOLEAN":    "BOOL",
+	"DECIMAL":    "NUMERIC",
+	"BIGDECIMAL": "BIGNUMERIC",
+}
+
+// bigQueryTableTypeCoercions lists the column type changes supported by
+// ALTER COLUMN SET DATA TYPE.
+var bigQueryTableTypeCoercions = map[string][]string{
+	"INT64":   {"NUMERIC", "BIGNUMERIC", "FLOAT64"},
+	"NUMERIC": {"BIGNUMERIC", "FLOAT64"},
+}
+
+func bigQueryTableNormalizeType(t string) string {
+	t = strings.ToUpper(t)
+	if alias, ok := bigQueryTableTypeAliases[t]; ok {
+		return alias
+	}
+	return t
+}
+
+func bigQueryTableTypeIsCoercible(old, new string) bool {
+	for _, t := range bigQueryTableTypeCoercions[bigQueryTableNormalizeType(old)] {
+		if t == bigQueryTableNormalizeType(new) {
+			return true
+		}
+	}
+	return false
+}
+
+// bigQueryTableSchemaMigration returns the DDL statements migrating the top
+// level columns of the old schema towards the new schema in place, and the old
+// schema as it is after running them. Columns are renamed according to
+// renames, which maps old column names to new ones. Changes that can't be made
+// with DDL are left for the caller to compare.
+func bigQueryTableSchemaMigration(old, new []interface{}, renames map[string]interface{}, table string) ([]string, []interface{}) {
+	oldColumns := bigQueryArrayToMapIndexedByName(old)
+	newColumns := bigQueryArrayToMapIndexedByName(new)
+
+	var renameSteps, typeSteps, modeSteps []string
+	migrated := make([]interface{}, 0, len(old))
+	for _, v := range old {
+		column := map[string]interface{}{}
+		for key, val := range v.(map[string]interface{}) {
+			column[key] = val
+		}
+		migrated = append(migrated, column)
+
+		name := column["name"].(string)
+		if target, ok := renames[name].(string); ok && target != name {
+			_, targetExists := oldColumns[target]
+			_, targetPlanned := newColumns[target]
+			_, namePlanned := newColumns[name]
+			if !targetExists && targetPlanned && !namePlanned {
+				renameSteps = append(renameSteps, fmt.Sprintf("ALTER TABLE %s RENAME COLUMN `%s` TO `%s`", table, name, target))
+				column["name"] = target
+				name = target
+			}
+		}
+
+		newColumn, ok := newColumns[name].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		oldType, _ := column["type"].(string)
+		newType, _ := newColumn["type"].(string)
+		_, hasFields := column["fields"]
+		if !hasFields && oldType != "" && newType != "" && !bigQueryTableTypeEq(oldType, newType) && bigQueryTableTypeIsCoercible(oldType, newType) {
+			typeSteps = append(typeSteps, fmt.Sprintf("ALTER TABLE %s ALTER COLUMN `%s` SET DATA TYPE %s", table, name, bigQueryTableNormalizeType(newType)))
+			column["type"] = newType
+		}
+
+		if bigQueryTableNormalizeMode(column["mode"]) == "REQUIRED" && bigQueryTableNormalizeMode(newColumn["mode"]) == "NULLABLE" {
+			modeSteps = append(modeSteps, fmt.Sprintf("ALTER TABLE %s ALTER COLUMN `%s` DROP NOT NULL", table, name))
+			column["mode"] = "NULLABLE"
+		}
+	}
+
+	// Columns are renamed first, so that the other statements use their new names.
+	steps := append(append(renameSteps, typeSteps...), modeSteps...)
+	return steps, migrated
+}
+
+// bigQueryTableRunDDL runs a DDL statement as a query job, and waits for the
+// job to complete.
+func bigQueryTableRunDDL(config *transport_tpg.Config, userAgent, project, ddl string, timeout time.Duration) error {
+	queriesURL := transport_tpg.BaseUrl(Product, config) + "projects/" + project + "/queries"
+	res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+		Config:    config,
+		Method:    "POST",
+		Project:   project,
+		RawURL:    queriesURL,
+		UserAgent: userAgent,
+		Body: map[string]any{
+			"query":        ddl,
+			"useLegacySql": false,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	start := time.Now()
+	for complete, _ := res["jobComplete"].(bool); !complete; complete, _ = res["jobComplete"].(bool) {
+		if time.Since(start) > timeout {
+			return fmt.Errorf("timed out waiting for the query job to complete")
+		}
+		jobReference, _ := res["jobReference"].(map[string]interface{})
+		jobId, _ := jobReference["jobId"].(string)
+		if jobId == "" {
+			return fmt.Errorf("the query job has no ID")
+		}
+		url := queriesURL + "/" + jobId
+		if location, ok := jobReference["location"].(string); ok {
+			url, err = transport_tpg.AddQueryParams(url, map[string]string{"location": location})
+			if err != nil {
+				return err
+			}
+		}
+		res, err = transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+			Config:    config,
+			Method:    "GET",
+			Project:   project,
+			RawURL:    url,
+			UserAgent: userAgent,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if errs, ok := res["errors"].([]interface{}); ok && len(errs) > 0 {
+		return fmt.Errorf("the query job failed: %v", errs)
+	}
+	return nil
+}
+
+// bigQueryTableDDLName returns the name of the table in DDL statements.
+func bigQueryTableDDLName(d tpgresource.TerraformResourceDiff) string {
+	project, _ := d.Get("project").(string)
+	datasetId, _ := d.Get("dataset_id").(string)
+	tableId, _ := d.Get("table_id").(string)
+	return fmt.Sprintf("`%s.%s.%s`", project, datasetId, tableId)
+}
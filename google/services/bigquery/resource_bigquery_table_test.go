@@ -2178,7 +2178,7 @@ func TestAccBigQueryTable_schemaColumnDropWithRowAccessPolicy(t *testing.T) {
 				ResourceName:            "google_bigquery_table.test",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"deletion_protection", "ignore_auto_generated_schema", "generated_schema_columns", "etag", "last_modified_time"},
+				ImportStateVerifyIgnore: []string{"deletion_protection", "allow_schema_replacement", "ignore_auto_generated_schema", "generated_schema_columns", "etag", "last_modified_time"},
 			},
 		},
 	})
@@ -5396,6 +5396,129 @@ func testAccCheckBigQueryTableCollation(t *testing.T, datasetID, tableID, colNam
 	}
 }
 
+func TestAccBigQueryTable_schemaMigration(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"dataset_id": fmt.Sprintf("tf_test_dataset_%s", acctest.RandString(t, 10)),
+		"table_id":   fmt.Sprintf("tf_test_table_%s", acctest.RandString(t, 10)),
+	}
+
+	var tableCreationTime string
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckBigQueryTableDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBigQueryTable_schemaMigrationBasic(context),
+				Check: resource.ComposeTestCheckFunc(
+					func(s *terraform.State) error {
+						rs, ok := s.RootModule().Resources["google_bigquery_table.test"]
+						if !ok {
+							return fmt.Errorf("Not found: google_bigquery_table.test")
+						}
+						tableCreationTime = rs.Primary.Attributes["creation_time"]
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccBigQueryTable_schemaMigrationUpdated(context),
+				Check: resource.ComposeTestCheckFunc(
+					// Verify that creationTime is unchanged, implying that the table was migrated in place.
+					func(s *terraform.State) error {
+						rs, ok := s.RootModule().Resources["google_bigquery_table.test"]
+						if !ok {
+							return fmt.Errorf("Not found: google_bigquery_table.test")
+						}
+						newTimeCreated := rs.Primary.Attributes["creation_time"]
+						if newTimeCreated != tableCreationTime {
+							return fmt.Errorf("Table was recreated! Creation time changed from %s to %s", tableCreationTime, newTimeCreated)
+						}
+						return nil
+					},
+					resource.TestCheckResourceAttr("google_bigquery_table.test", "schema_migration_steps.#", "3"),
+				),
+			},
+			{
+				ResourceName:            "google_bigquery_table.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection", "column_renames", "schema_migration_steps", "etag", "last_modified_time"},
+			},
+		},
+	})
+}
+
+func TestAccBigQueryTable_schemaFields(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"dataset_id": fmt.Sprintf("tf_test_dataset_%s", acctest.RandString(t, 10)),
+		"table_id":   fmt.Sprintf("tf_test_table_%s", acctest.RandString(t, 10)),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckBigQueryTableDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBigQueryTable_schemaFields(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_bigquery_table.test", "schema_fields.#", "3"),
+					resource.TestCheckResourceAttr("google_bigquery_table.test", "schema_fields.2.fields.#", "2"),
+				),
+			},
+			{
+				ResourceName:            "google_bigquery_table.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection", "schema_fields"},
+			},
+			{
+				Config: testAccBigQueryTable_schemaFieldsUpdated(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_bigquery_table.test", "schema_fields.#", "4"),
+					resource.TestCheckResourceAttr("google_bigquery_table.test", "schema_fields.1.description", "The price, in USD."),
+				),
+			},
+			{
+				ResourceName:            "google_bigquery_table.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection", "schema_fields"},
+			},
+		},
+	})
+}
+
+func TestAccBigQueryTable_schemaMigrationNotInPlace(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"dataset_id": fmt.Sprintf("tf_test_dataset_%s", acctest.RandString(t, 10)),
+		"table_id":   fmt.Sprintf("tf_test_table_%s", acctest.RandString(t, 10)),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckBigQueryTableDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBigQueryTable_schemaMigrationBasic(context),
+			},
+			{
+				Config:      testAccBigQueryTable_schemaMigrationNarrowed(context),
+				ExpectError: regexp.MustCompile("can't be applied in place"),
+			},
+		},
+	})
+}
+
 func testAccBigQueryTableWithSchemaAndRowAccessPolicy(context map[string]interface{}) string {
 	return acctest.Nprintf(`
 resource "google_bigquery_dataset" "test" {
@@ -5453,7 +5576,8 @@ resource "google_bigquery_dataset" "test" {
 }
 
 resource "google_bigquery_table" "test" {
-  deletion_protection = false
+  deletion_protection      = false
+  allow_schema_replacement = true
   dataset_id = google_bigquery_dataset.test.dataset_id
   table_id   = "%{table_id}"
 
@@ -6168,3 +6292,210 @@ var TEST_INVALID_SCHEMA_JSON_LIST_WITH_NULL_ELEMENT = `[
       "type": "INT64"
     }
   ]`
+
+func testAccBigQueryTable_schemaMigrationBasic(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_bigquery_dataset" "test" {
+  dataset_id = "%{dataset_id}"
+}
+
+resource "google_bigquery_table" "test" {
+  deletion_protection = false
+  dataset_id          = google_bigquery_dataset.test.dataset_id
+  table_id            = "%{table_id}"
+
+  schema = <<EOF
+[
+  {
+    "name": "id",
+    "type": "INTEGER",
+    "mode": "REQUIRED"
+  },
+  {
+    "name": "amount",
+    "type": "INTEGER",
+    "mode": "NULLABLE"
+  },
+  {
+    "name": "city",
+    "type": "STRING",
+    "mode": "NULLABLE"
+  }
+]
+EOF
+}
+`, context)
+}
+
+func testAccBigQueryTable_schemaMigrationUpdated(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_bigquery_dataset" "test" {
+  dataset_id = "%{dataset_id}"
+}
+
+resource "google_bigquery_table" "test" {
+  deletion_protection = false
+  dataset_id          = google_bigquery_dataset.test.dataset_id
+  table_id            = "%{table_id}"
+
+  column_renames = {
+    city = "location"
+  }
+
+  schema = <<EOF
+[
+  {
+    "name": "id",
+    "type": "INTEGER",
+    "mode": "NULLABLE"
+  },
+  {
+    "name": "amount",
+    "type": "NUMERIC",
+    "mode": "NULLABLE"
+  },
+  {
+    "name": "location",
+    "type": "STRING",
+    "mode": "NULLABLE"
+  }
+]
+EOF
+}
+`, context)
+}
+
+func testAccBigQueryTable_schemaMigrationNarrowed(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_bigquery_dataset" "test" {
+  dataset_id = "%{dataset_id}"
+}
+
+resource "google_bigquery_table" "test" {
+  deletion_protection = false
+  dataset_id          = google_bigquery_dataset.test.dataset_id
+  table_id            = "%{table_id}"
+
+  schema = <<EOF
+[
+  {
+    "name": "id",
+    "type": "STRING",
+    "mode": "REQUIRED"
+  },
+  {
+    "name": "amount",
+    "type": "INTEGER",
+    "mode": "NULLABLE"
+  },
+  {
+    "name": "city",
+    "type": "STRING",
+    "mode": "NULLABLE"
+  }
+]
+EOF
+}
+`, context)
+}
+
+func testAccBigQueryTable_schemaFields(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_bigquery_dataset" "test" {
+  dataset_id = "%{dataset_id}"
+}
+
+resource "google_bigquery_table" "test" {
+  deletion_protection = false
+  dataset_id          = google_bigquery_dataset.test.dataset_id
+  table_id            = "%{table_id}"
+
+  schema_fields {
+    name = "id"
+    type = "INT64"
+    mode = "REQUIRED"
+  }
+
+  schema_fields {
+    name                     = "price"
+    type                     = "NUMERIC"
+    precision                = 10
+    scale                    = 2
+    rounding_mode            = "ROUND_HALF_EVEN"
+    default_value_expression = "0"
+    description              = "The price."
+  }
+
+  schema_fields {
+    name = "address"
+    type = "RECORD"
+    mode = "REPEATED"
+
+    fields {
+      name       = "city"
+      type       = "STRING"
+      max_length = 64
+      collation  = "und:ci"
+    }
+
+    fields {
+      name = "zip"
+      type = "STRING"
+    }
+  }
+}
+`, context)
+}
+
+func testAccBigQueryTable_schemaFieldsUpdated(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_bigquery_dataset" "test" {
+  dataset_id = "%{dataset_id}"
+}
+
+resource "google_bigquery_table" "test" {
+  deletion_protection = false
+  dataset_id          = google_bigquery_dataset.test.dataset_id
+  table_id            = "%{table_id}"
+
+  schema_fields {
+    name = "id"
+    type = "INT64"
+    mode = "REQUIRED"
+  }
+
+  schema_fields {
+    name                     = "price"
+    type                     = "NUMERIC"
+    precision                = 10
+    scale                    = 2
+    rounding_mode            = "ROUND_HALF_EVEN"
+    default_value_expression = "0"
+    description              = "The price, in USD."
+  }
+
+  schema_fields {
+    name = "address"
+    type = "RECORD"
+    mode = "REPEATED"
+
+    fields {
+      name       = "city"
+      type       = "STRING"
+      max_length = 64
+      collation  = "und:ci"
+    }
+
+    fields {
+      name = "zip"
+      type = "STRING"
+    }
+  }
+
+  schema_fields {
+    name = "created_at"
+    type = "TIMESTAMP"
+  }
+}
+`, context)
+}
@@ -294,11 +294,14 @@ func ResourceBigqueryConnectionConnection() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"password": {
-										Type:        schema.TypeString,
-										Required:    true,
-										Description: `Password for database.`,
-										Sensitive:   true,
+										Type:         schema.TypeString,
+										Optional:     true,
+										ExactlyOneOf: []string{"cloud_sql.0.credential.0.password", "cloud_sql.0.credential.0.password_wo"},
+										Description:  `Password for database.`,
+										Sensitive:    true,
 									},
+									"password_wo":         tpgresource.WriteOnlySchema("cloud_sql.0.credential.0.password", `Write-only password for database.`, true),
+									"password_wo_version": tpgresource.WriteOnlyVersionSchema("cloud_sql.0.credential.0.password", false),
 									"username": {
 										Type:        schema.TypeString,
 										Required:    true,
@@ -392,11 +395,14 @@ For AlloyDB, this is in the format of
 													Elem: &schema.Resource{
 														Schema: map[string]*schema.Schema{
 															"plaintext": {
-																Type:        schema.TypeString,
-																Required:    true,
-																Description: `The plaintext password.`,
-																Sensitive:   true,
+																Type:         schema.TypeString,
+																Optional:     true,
+																ExactlyOneOf: []string{"configuration.0.authentication.0.username_password.0.password.0.plaintext", "configuration.0.authentication.0.username_password.0.password.0.plaintext_wo"},
+																Description:  `The plaintext password.`,
+																Sensitive:    true,
 															},
+															"plaintext_wo":         tpgresource.WriteOnlySchema("configuration.0.authentication.0.username_password.0.password.0.plaintext", `Write-only plaintext password.`, true),
+															"plaintext_wo_version": tpgresource.WriteOnlyVersionSchema("configuration.0.authentication.0.username_password.0.password.0.plaintext", false),
 															"secret_type": {
 																Type:        schema.TypeString,
 																Computed:    true,
@@ -1155,8 +1161,9 @@ func flattenBigqueryConnectionConnectionCloudSqlDatabase(v interface{}, d *schem
 func flattenBigqueryConnectionConnectionCloudSqlCredential(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return []interface{}{
 		map[string]interface{}{
-			"username": d.Get("cloud_sql.0.credential.0.username"),
-			"password": d.Get("cloud_sql.0.credential.0.password"),
+			"username":            d.Get("cloud_sql.0.credential.0.username"),
+			"password":            d.Get("cloud_sql.0.credential.0.password"),
+			"password_wo_version": d.Get("cloud_sql.0.credential.0.password_wo_version"),
 		},
 	}
 }
@@ -1459,7 +1466,8 @@ func flattenBigqueryConnectionConnectionConfigurationAuthenticationUsernamePassw
 	password := map[string]interface{}{
 		// The API redacts the plaintext on read, so we keep the value from
 		// state to avoid a permadiff.
-		"plaintext": d.Get("configuration.0.authentication.0.username_password.0.password.0.plaintext"),
+		"plaintext":            d.Get("configuration.0.authentication.0.username_password.0.password.0.plaintext"),
+		"plaintext_wo_version": d.Get("configuration.0.authentication.0.username_password.0.password.0.plaintext_wo_version"),
 	}
 	if originalPassword, ok := original["password"].(map[string]interface{}); ok {
 		if secretType, ok := originalPassword["secretType"]; ok {
@@ -1625,7 +1633,7 @@ func expandBigqueryConnectionConnectionCloudSqlCredential(v interface{}, d tpgre
 		transformed["username"] = transformedUsername
 	}
 
-	transformedPassword, err := expandBigqueryConnectionConnectionCloudSqlCredentialPassword(original["password"], d, config)
+	transformedPassword, err := expandBigqueryConnectionConnectionCloudSqlCredentialPassword(tpgresource.GetWriteOnlyValue(d, "cloud_sql.0.credential.0.password", original["password"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedPassword); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -2155,7 +2163,7 @@ func expandBigqueryConnectionConnectionConfigurationAuthenticationUsernamePasswo
 	original := raw.(map[string]interface{})
 	transformed := make(map[string]interface{})
 
-	transformedPlaintext, err := expandBigqueryConnectionConnectionConfigurationAuthenticationUsernamePasswordPasswordPlaintext(original["plaintext"], d, config)
+	transformedPlaintext, err := expandBigqueryConnectionConnectionConfigurationAuthenticationUsernamePasswordPasswordPlaintext(tpgresource.GetWriteOnlyValue(d, "configuration.0.authentication.0.username_password.0.password.0.plaintext", original["plaintext"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedPlaintext); val.IsValid() && !tpgresource.IsEmptyValue(val) {
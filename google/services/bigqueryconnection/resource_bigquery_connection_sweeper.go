@@ -198,7 +198,7 @@ func deleteResourceBigqueryConnectionConnection(config *transport_tpg.Config, d
 	}
 
 	// Skip resources that shouldn't be sweeped
-	if !sweeper.IsSweepableTestResource(name) {
+	if !sweeper.ShouldSweep(name, obj) {
 		return nil
 	}
 
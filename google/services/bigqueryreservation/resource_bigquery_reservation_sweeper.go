@@ -81,7 +81,7 @@ func testSweepBigqueryReservation(region string) error {
 		reservationNameParts := strings.Split(reservationName, "/")
 		reservationShortName := reservationNameParts[len(reservationNameParts)-1]
 		// Increment count and skip if resource is not sweepable.
-		if !sweeper.IsSweepableTestResource(reservationShortName) {
+		if !sweeper.ShouldSweep(reservationShortName, obj) {
 			nonPrefixCount++
 			continue
 		}
@@ -80,7 +80,7 @@ func testSweepBigtableInstance(region string) error {
 
 		id := obj["displayName"].(string)
 		// Increment count and skip if resource is not sweepable.
-		if !sweeper.IsSweepableTestResource(id) {
+		if !sweeper.ShouldSweep(id, obj) {
 			nonPrefixCount++
 			continue
 		}
@@ -271,11 +271,14 @@ ME_CENTRAL_1`,
 													Description: `Client secret. Used when using OAuth auth.`,
 												},
 												"refresh_uri": {
-													Type:        schema.TypeString,
-													Optional:    true,
-													Description: `Refresh URI. Used when using OAuth auth.`,
-													Sensitive:   true,
+													Type:          schema.TypeString,
+													Optional:      true,
+													Description:   `Refresh URI. Used when using OAuth auth.`,
+													Sensitive:     true,
+													ConflictsWith: []string{"details.0.amazon_s3_settings.0.authentication.0.refresh_uri_wo"},
 												},
+												"refresh_uri_wo":         tpgresource.WriteOnlySchema("details.0.amazon_s3_settings.0.authentication.0.refresh_uri", `Write-only refresh URI. Used when using OAuth auth.`, false),
+												"refresh_uri_wo_version": tpgresource.WriteOnlyVersionSchema("details.0.amazon_s3_settings.0.authentication.0.refresh_uri", false),
 												"secret_access_key": {
 													Type:        schema.TypeString,
 													Optional:    true,
@@ -315,11 +318,14 @@ ME_CENTRAL_1`,
 																Description: `Access Key ID for an AWS account (a 20-character, alphanumeric string).`,
 															},
 															"secret_access_key": {
-																Type:        schema.TypeString,
-																Required:    true,
-																Description: `Secret Access Key for an AWS account (a 40-character string).`,
-																Sensitive:   true,
+																Type:         schema.TypeString,
+																Optional:     true,
+																ExactlyOneOf: []string{"details.0.amazon_s3_v2_settings.0.authentication.0.access_key_secret_auth.0.secret_access_key", "details.0.amazon_s3_v2_settings.0.authentication.0.access_key_secret_auth.0.secret_access_key_wo"},
+																Description:  `Secret Access Key for an AWS account (a 40-character string).`,
+																Sensitive:    true,
 															},
+															"secret_access_key_wo":         tpgresource.WriteOnlySchema("details.0.amazon_s3_v2_settings.0.authentication.0.access_key_secret_auth.0.secret_access_key", `Write-only secret access key for an AWS account (a 40-character string).`, true),
+															"secret_access_key_wo_version": tpgresource.WriteOnlyVersionSchema("details.0.amazon_s3_v2_settings.0.authentication.0.access_key_secret_auth.0.secret_access_key", false),
 														},
 													},
 												},
@@ -405,11 +411,14 @@ Tenancy Project.`,
 																Description: `Access key ID.`,
 															},
 															"secret_access_key": {
-																Type:        schema.TypeString,
-																Optional:    true,
-																Description: `Secret access key.`,
-																Sensitive:   true,
+																Type:          schema.TypeString,
+																Optional:      true,
+																Description:   `Secret access key.`,
+																Sensitive:     true,
+																ConflictsWith: []string{"details.0.amazon_sqs_settings.0.authentication.0.additional_s3_access_key_secret_auth.0.secret_access_key_wo"},
 															},
+															"secret_access_key_wo":         tpgresource.WriteOnlySchema("details.0.amazon_sqs_settings.0.authentication.0.additional_s3_access_key_secret_auth.0.secret_access_key", `Write-only secret access key.`, false),
+															"secret_access_key_wo_version": tpgresource.WriteOnlyVersionSchema("details.0.amazon_sqs_settings.0.authentication.0.additional_s3_access_key_secret_auth.0.secret_access_key", false),
 														},
 													},
 												},
@@ -426,11 +435,14 @@ Tenancy Project.`,
 																Description: `Access key ID.`,
 															},
 															"secret_access_key": {
-																Type:        schema.TypeString,
-																Optional:    true,
-																Description: `Secret access key.`,
-																Sensitive:   true,
+																Type:          schema.TypeString,
+																Optional:      true,
+																Description:   `Secret access key.`,
+																Sensitive:     true,
+																ConflictsWith: []string{"details.0.amazon_sqs_settings.0.authentication.0.sqs_access_key_secret_auth.0.secret_access_key_wo"},
 															},
+															"secret_access_key_wo":         tpgresource.WriteOnlySchema("details.0.amazon_sqs_settings.0.authentication.0.sqs_access_key_secret_auth.0.secret_access_key", `Write-only secret access key.`, false),
+															"secret_access_key_wo_version": tpgresource.WriteOnlyVersionSchema("details.0.amazon_sqs_settings.0.authentication.0.sqs_access_key_secret_auth.0.secret_access_key", false),
 														},
 													},
 												},
@@ -539,11 +551,14 @@ SOURCE_DELETION_ON_SUCCESS_FILES_ONLY`,
 																Description: `Access key ID of the S3 bucket.  Ex: AKIABCDEFGHIJKL.`,
 															},
 															"secret_access_key": {
-																Type:        schema.TypeString,
-																Optional:    true,
-																Description: `Secret access key to access the S3 bucket.`,
-																Sensitive:   true,
+																Type:          schema.TypeString,
+																Optional:      true,
+																Description:   `Secret access key to access the S3 bucket.`,
+																Sensitive:     true,
+																ConflictsWith: []string{"details.0.amazon_sqs_v2_settings.0.authentication.0.sqs_v2_access_key_secret_auth.0.secret_access_key_wo"},
 															},
+															"secret_access_key_wo":         tpgresource.WriteOnlySchema("details.0.amazon_sqs_v2_settings.0.authentication.0.sqs_v2_access_key_secret_auth.0.secret_access_key", `Write-only secret access key to access the S3 bucket.`, false),
+															"secret_access_key_wo_version": tpgresource.WriteOnlyVersionSchema("details.0.amazon_sqs_v2_settings.0.authentication.0.sqs_v2_access_key_secret_auth.0.secret_access_key", false),
 														},
 													},
 												},
@@ -597,11 +612,14 @@ Tenancy Project.`,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												"secret": {
-													Type:        schema.TypeString,
-													Optional:    true,
-													Description: `Secret of the account identified by user_name.`,
-													Sensitive:   true,
+													Type:          schema.TypeString,
+													Optional:      true,
+													Description:   `Secret of the account identified by user_name.`,
+													Sensitive:     true,
+													ConflictsWith: []string{"details.0.anomali_settings.0.authentication.0.secret_wo"},
 												},
+												"secret_wo":         tpgresource.WriteOnlySchema("details.0.anomali_settings.0.authentication.0.secret", `Write-only secret of the account identified by user_name.`, false),
+												"secret_wo_version": tpgresource.WriteOnlyVersionSchema("details.0.anomali_settings.0.authentication.0.secret", false),
 												"user": {
 													Type:        schema.TypeString,
 													Optional:    true,
@@ -634,11 +652,14 @@ Tenancy Project.`,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												"secret": {
-													Type:        schema.TypeString,
-													Optional:    true,
-													Description: `Secret of the account identified by user_name.`,
-													Sensitive:   true,
+													Type:          schema.TypeString,
+													Optional:      true,
+													Description:   `Secret of the account identified by user_name.`,
+													Sensitive:     true,
+													ConflictsWith: []string{"details.0.aws_ec2_hosts_settings.0.authentication.0.secret_wo"},
 												},
+												"secret_wo":         tpgresource.WriteOnlySchema("details.0.aws_ec2_hosts_settings.0.authentication.0.secret", `Write-only secret of the account identified by user_name.`, false),
+												"secret_wo_version": tpgresource.WriteOnlyVersionSchema("details.0.aws_ec2_hosts_settings.0.authentication.0.secret", false),
 												"user": {
 													Type:        schema.TypeString,
 													Optional:    true,
@@ -666,11 +687,14 @@ Tenancy Project.`,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												"secret": {
-													Type:        schema.TypeString,
-													Optional:    true,
-													Description: `Secret of the account identified by user_name.`,
-													Sensitive:   true,
+													Type:          schema.TypeString,
+													Optional:      true,
+													Description:   `Secret of the account identified by user_name.`,
+													Sensitive:     true,
+													ConflictsWith: []string{"details.0.aws_ec2_instances_settings.0.authentication.0.secret_wo"},
 												},
+												"secret_wo":         tpgresource.WriteOnlySchema("details.0.aws_ec2_instances_settings.0.authentication.0.secret", `Write-only secret of the account identified by user_name.`, false),
+												"secret_wo_version": tpgresource.WriteOnlyVersionSchema("details.0.aws_ec2_instances_settings.0.authentication.0.secret", false),
 												"user": {
 													Type:        schema.TypeString,
 													Optional:    true,
@@ -698,11 +722,14 @@ Tenancy Project.`,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												"secret": {
-													Type:        schema.TypeString,
-													Optional:    true,
-													Description: `Secret of the account identified by user_name.`,
-													Sensitive:   true,
+													Type:          schema.TypeString,
+													Optional:      true,
+													Description:   `Secret of the account identified by user_name.`,
+													Sensitive:     true,
+													ConflictsWith: []string{"details.0.aws_ec2_vpcs_settings.0.authentication.0.secret_wo"},
 												},
+												"secret_wo":         tpgresource.WriteOnlySchema("details.0.aws_ec2_vpcs_settings.0.authentication.0.secret", `Write-only secret of the account identified by user_name.`, false),
+												"secret_wo_version": tpgresource.WriteOnlyVersionSchema("details.0.aws_ec2_vpcs_settings.0.authentication.0.secret", false),
 												"user": {
 													Type:        schema.TypeString,
 													Optional:    true,
@@ -739,11 +766,14 @@ GROUPS`,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												"secret": {
-													Type:        schema.TypeString,
-													Optional:    true,
-													Description: `Secret of the account identified by user_name.`,
-													Sensitive:   true,
+													Type:          schema.TypeString,
+													Optional:      true,
+													Description:   `Secret of the account identified by user_name.`,
+													Sensitive:     true,
+													ConflictsWith: []string{"details.0.aws_iam_settings.0.authentication.0.secret_wo"},
 												},
+												"secret_wo":         tpgresource.WriteOnlySchema("details.0.aws_iam_settings.0.authentication.0.secret", `Write-only secret of the account identified by user_name.`, false),
+												"secret_wo_version": tpgresource.WriteOnlyVersionSchema("details.0.aws_iam_settings.0.authentication.0.secret", false),
 												"user": {
 													Type:        schema.TypeString,
 													Optional:    true,
@@ -781,11 +811,14 @@ GROUPS`,
 													Description: `Client ID.`,
 												},
 												"client_secret": {
-													Type:        schema.TypeString,
-													Optional:    true,
-													Description: `Client secret.`,
-													Sensitive:   true,
+													Type:          schema.TypeString,
+													Optional:      true,
+													Description:   `Client secret.`,
+													Sensitive:     true,
+													ConflictsWith: []string{"details.0.azure_ad_audit_settings.0.authentication.0.client_secret_wo"},
 												},
+												"client_secret_wo":         tpgresource.WriteOnlySchema("details.0.azure_ad_audit_settings.0.authentication.0.client_secret", `Write-only client secret.`, false),
+												"client_secret_wo_version": tpgresource.WriteOnlyVersionSchema("details.0.azure_ad_audit_settings.0.authentication.0.client_secret", false),
 											},
 										},
 									},
@@ -828,11 +861,14 @@ GROUPS`,
 													Description: `Client ID.`,
 												},
 												"client_secret": {
-													Type:        schema.TypeString,
-													Optional:    true,
-													Description: `Client secret.`,
-													Sensitive:   true,
+													Type:          schema.TypeString,
+													Optional:      true,
+													Description:   `Client secret.`,
+													Sensitive:     true,
+													ConflictsWith: []string{"details.0.azure_ad_context_settings.0.authentication.0.client_secret_wo"},
 												},
+												"client_secret_wo":         tpgresource.WriteOnlySchema("details.0.azure_ad_context_settings.0.authentication.0.client_secret", `Write-only client secret.`, false),
+												"client_secret_wo_version": tpgresource.WriteOnlyVersionSchema("details.0.azure_ad_context_settings.0.authentication.0.client_secret", false),
 											},
 										},
 									},
@@ -885,11 +921,14 @@ GROUPS`,
 													Description: `Client ID.`,
 												},
 												"client_secret": {
-													Type:        schema.TypeString,
-													Optional:    true,
-													Description: `Client secret.`,
-													Sensitive:   true,
+													Type:          schema.TypeString,
+													Optional:      true,
+													Description:   `Client secret.`,
+													Sensitive:     true,
+													ConflictsWith: []string{"details.0.azure_ad_settings.0.authentication.0.client_secret_wo"},
 												},
+												"client_secret_wo":         tpgresource.WriteOnlySchema("details.0.azure_ad_settings.0.authentication.0.client_secret", `Write-only client secret.`, false),
+												"client_secret_wo_version": tpgresource.WriteOnlyVersionSchema("details.0.azure_ad_settings.0.authentication.0.client_secret", false),
 											},
 										},
 									},
@@ -922,17 +961,23 @@ GROUPS`,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												"sas_token": {
-													Type:        schema.TypeString,
-													Optional:    true,
-													Description: `SAS Token.`,
-													Sensitive:   true,
+													Type:          schema.TypeString,
+													Optional:      true,
+													Description:   `SAS Token.`,
+													Sensitive:     true,
+													ConflictsWith: []string{"details.0.azure_blob_store_settings.0.authentication.0.sas_token_wo"},
 												},
+												"sas_token_wo":         tpgresource.WriteOnlySchema("details.0.azure_blob_store_settings.0.authentication.0.sas_token", `Write-only SAS token.`, false),
+												"sas_token_wo_version": tpgresource.WriteOnlyVersionSchema("details.0.azure_blob_store_settings.0.authentication.0.sas_token", false),
 												"shared_key": {
-													Type:        schema.TypeString,
-													Optional:    true,
-													Description: `Shared Key.`,
-													Sensitive:   true,
+													Type:          schema.TypeString,
+													Optional:      true,
+													Description:   `Shared Key.`,
+													Sensitive:     true,
+													ConflictsWith: []string{"details.0.azure_blob_store_settings.0.authentication.0.shared_key_wo"},
 												},
+												"shared_key_wo":         tpgresource.WriteOnlySchema("details.0.azure_blob_store_settings.0.authentication.0.shared_key", `Write-only shared key.`, false),
+												"shared_key_wo_version": tpgresource.WriteOnlyVersionSchema("details.0.azure_blob_store_settings.0.authentication.0.shared_key", false),
 											},
 										},
 									},
@@ -976,11 +1021,14 @@ FOLDERS_RECURSIVE`,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												"access_key": {
-													Type:        schema.TypeString,
-													Required:    true,
-													Description: `Access Key also known as shared key.`,
-													Sensitive:   true,
+													Type:         schema.TypeString,
+													Optional:     true,
+													ExactlyOneOf: []string{"details.0.azure_blob_store_v2_settings.0.authentication.0.access_key", "details.0.azure_blob_store_v2_settings.0.authentication.0.access_key_wo"},
+													Description:  `Access Key also known as shared key.`,
+													Sensitive:    true,
 												},
+												"access_key_wo":         tpgresource.WriteOnlySchema("details.0.azure_blob_store_v2_settings.0.authentication.0.access_key", `Write-only access key, also known as shared key.`, true),
+												"access_key_wo_version": tpgresource.WriteOnlyVersionSchema("details.0.azure_blob_store_v2_settings.0.authentication.0.access_key", false),
 												"azure_v2_workload_identity_federation": {
 													Type:        schema.TypeList,
 													Required:    true,
@@ -1007,11 +1055,14 @@ FOLDERS_RECURSIVE`,
 													},
 												},
 												"sas_token": {
-													Type:        schema.TypeString,
-													Required:    true,
-													Description: `SAS Token.`,
-													Sensitive:   true,
+													Type:         schema.TypeString,
+													Optional:     true,
+													ExactlyOneOf: []string{"details.0.azure_blob_store_v2_settings.0.authentication.0.sas_token", "details.0.azure_blob_store_v2_settings.0.authentication.0.sas_token_wo"},
+													Description:  `SAS Token.`,
+													Sensitive:    true,
 												},
+												"sas_token_wo":         tpgresource.WriteOnlySchema("details.0.azure_blob_store_v2_settings.0.authentication.0.sas_token", `Write-only SAS token.`, true),
+												"sas_token_wo_version": tpgresource.WriteOnlyVersionSchema("details.0.azure_blob_store_v2_settings.0.authentication.0.sas_token", false),
 											},
 										},
 									},
@@ -1065,11 +1116,14 @@ Tenancy Project.`,
 										Description: `Event hub to read from.`,
 									},
 									"azure_sas_token": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										Description: `SAS token`,
-										Sensitive:   true,
+										Type:          schema.TypeString,
+										Optional:      true,
+										Description:   `SAS token`,
+										Sensitive:     true,
+										ConflictsWith: []string{"details.0.azure_event_hub_settings.0.azure_sas_token_wo"},
 									},
+									"azure_sas_token_wo":         tpgresource.WriteOnlySchema("details.0.azure_event_hub_settings.0.azure_sas_token", `Write-only SAS token.`, false),
+									"azure_sas_token_wo_version": tpgresource.WriteOnlyVersionSchema("details.0.azure_event_hub_settings.0.azure_sas_token", false),
 									"azure_storage_connection_string": {
 										Type:        schema.TypeString,
 										Optional:    true,
@@ -1114,11 +1168,14 @@ Tenancy Project.`,
 													Description: `Client ID.`,
 												},
 												"client_secret": {
-													Type:        schema.TypeString,
-													Optional:    true,
-													Description: `Client secret.`,
-													Sensitive:   true,
+													Type:          schema.TypeString,
+													Optional:      true,
+													Description:   `Client secret.`,
+													Sensitive:     true,
+													ConflictsWith: []string{"details.0.azure_mdm_intune_settings.0.authentication.0.client_secret_wo"},
 												},
+												"client_secret_wo":         tpgresource.WriteOnlySchema("details.0.azure_mdm_intune_settings.0.authentication.0.client_secret", `Write-only client secret.`, false),
+												"client_secret_wo_version": tpgresource.WriteOnlyVersionSchema("details.0.azure_mdm_intune_settings.0.authentication.0.client_secret", false),
 											},
 										},
 									},
@@ -1151,11 +1208,14 @@ Tenancy Project.`,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												"secret": {
-													Type:        schema.TypeString,
-													Optional:    true,
-													Description: `Secret of the account identified by user_name.`,
-													Sensitive:   true,
+													Type:          schema.TypeString,
+													Optional:      true,
+													Description:   `Secret of the account identified by user_name.`,
+													Sensitive:     true,
+													ConflictsWith: []string{"details.0.cloud_passage_settings.0.authentication.0.secret_wo"},
 												},
+												"secret_wo":         tpgresource.WriteOnlySchema("details.0.cloud_passage_settings.0.authentication.0.secret", `Write-only secret of the account identified by user_name.`, false),
+												"secret_wo_version": tpgresource.WriteOnlyVersionSchema("details.0.cloud_passage_settings.0.authentication.0.secret", false),
 												"user": {
 													Type:        schema.TypeString,
 													Optional:    true,
@@ -1207,6 +1267,17 @@ Tenancy Project.`,
 																Description: `Value.`,
 																Sensitive:   true,
 															},
+															"value_wo": {
+																Type:        schema.TypeString,
+																Optional:    true,
+																WriteOnly:   true,
+																Description: `Write-only value. Only one of value and value_wo may be set.`,
+															},
+															"value_wo_version": {
+																Type:        schema.TypeString,
+																Optional:    true,
+																Description: `Triggers update of 'value_wo' write-only. Increment this value when an update to 'value_wo' is needed. For more info see [updating write-only arguments](/docs/providers/google/guides/using_write_only_arguments.html#updating-write-only-arguments)`,
+															},
 														},
 													},
 												},
@@ -1247,11 +1318,14 @@ Tenancy Project.`,
 													Description: `Client ID.`,
 												},
 												"client_secret": {
-													Type:        schema.TypeString,
-													Optional:    true,
-													Description: `Client secret.`,
-													Sensitive:   true,
+													Type:          schema.TypeString,
+													Optional:      true,
+													Description:   `Client secret.`,
+													Sensitive:     true,
+													ConflictsWith: []string{"details.0.crowdstrike_alerts_settings.0.authentication.0.client_secret_wo"},
 												},
+												"client_secret_wo":         tpgresource.WriteOnlySchema("details.0.crowdstrike_alerts_settings.0.authentication.0.client_secret", `Write-only client secret.`, false),
+												"client_secret_wo_version": tpgresource.WriteOnlyVersionSchema("details.0.crowdstrike_alerts_settings.0.authentication.0.client_secret", false),
 												"token_endpoint": {
 													Type:        schema.TypeString,
 													Optional:    true,
@@ -1297,11 +1371,14 @@ BRING_ONLY_NEW_ALERTS`,
 													Description: `Client ID.`,
 												},
 												"client_secret": {
-													Type:        schema.TypeString,
-													Optional:    true,
-													Description: `Client secret.`,
-													Sensitive:   true,
+													Type:          schema.TypeString,
+													Optional:      true,
+													Description:   `Client secret.`,
+													Sensitive:     true,
+													ConflictsWith: []string{"details.0.crowdstrike_detects_settings.0.authentication.0.client_secret_wo"},
 												},
+												"client_secret_wo":         tpgresource.WriteOnlySchema("details.0.crowdstrike_detects_settings.0.authentication.0.client_secret", `Write-only client secret.`, false),
+												"client_secret_wo_version": tpgresource.WriteOnlyVersionSchema("details.0.crowdstrike_detects_settings.0.authentication.0.client_secret", false),
 												"token_endpoint": {
 													Type:        schema.TypeString,
 													Optional:    true,
@@ -1363,6 +1440,17 @@ BRING_ALL_DETECTIONS`,
 																Description: `Value.`,
 																Sensitive:   true,
 															},
+															"value_wo": {
+																Type:        schema.TypeString,
+																Optional:    true,
+																WriteOnly:   true,
+																Description: `Write-only value. Only one of value and value_wo may be set.`,
+															},
+															"value_wo_version": {
+																Type:        schema.TypeString,
+																Optional:    true,
+																Description: `Triggers update of 'value_wo' write-only. Increment this value when an update to 'value_wo' is needed. For more info see [updating write-only arguments](/docs/providers/google/guides/using_write_only_arguments.html#updating-write-only-arguments)`,
+															},
 														},
 													},
 												},
@@ -1388,11 +1476,14 @@ BRING_ALL_DETECTIONS`,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												"secret": {
-													Type:        schema.TypeString,
-													Optional:    true,
-													Description: `Secret of the account identified by user_name.`,
-													Sensitive:   true,
+													Type:          schema.TypeString,
+													Optional:      true,
+													Description:   `Secret of the account identified by user_name.`,
+													Sensitive:     true,
+													ConflictsWith: []string{"details.0.duo_auth_settings.0.authentication.0.secret_wo"},
 												},
+												"secret_wo":         tpgresource.WriteOnlySchema("details.0.duo_auth_settings.0.authentication.0.secret", `Write-only secret of the account identified by user_name.`, false),
+												"secret_wo_version": tpgresource.WriteOnlyVersionSchema("details.0.duo_auth_settings.0.authentication.0.secret", false),
 												"user": {
 													Type:        schema.TypeString,
 													Optional:    true,
@@ -1425,11 +1516,14 @@ BRING_ALL_DETECTIONS`,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												"secret": {
-													Type:        schema.TypeString,
-													Optional:    true,
-													Description: `Secret of the account identified by user_name.`,
-													Sensitive:   true,
+													Type:          schema.TypeString,
+													Optional:      true,
+													Description:   `Secret of the account identified by user_name.`,
+													Sensitive:     true,
+													ConflictsWith: []string{"details.0.duo_user_context_settings.0.authentication.0.secret_wo"},
 												},
+												"secret_wo":         tpgresource.WriteOnlySchema("details.0.duo_user_context_settings.0.authentication.0.secret", `Write-only secret of the account identified by user_name.`, false),
+												"secret_wo_version": tpgresource.WriteOnlyVersionSchema("details.0.duo_user_context_settings.0.authentication.0.secret", false),
 												"user": {
 													Type:        schema.TypeString,
 													Optional:    true,
@@ -1489,11 +1583,14 @@ GOOGLE_CLOUD_STORAGE_EVENT_DRIVEN Possible values: ["GOOGLE_CLOUD_STORAGE", "HTT
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												"secret": {
-													Type:        schema.TypeString,
-													Optional:    true,
-													Description: `Secret of the account identified by user_name.`,
-													Sensitive:   true,
+													Type:          schema.TypeString,
+													Optional:      true,
+													Description:   `Secret of the account identified by user_name.`,
+													Sensitive:     true,
+													ConflictsWith: []string{"details.0.fox_it_stix_settings.0.authentication.0.secret_wo"},
 												},
+												"secret_wo":         tpgresource.WriteOnlySchema("details.0.fox_it_stix_settings.0.authentication.0.secret", `Write-only secret of the account identified by user_name.`, false),
+												"secret_wo_version": tpgresource.WriteOnlyVersionSchema("details.0.fox_it_stix_settings.0.authentication.0.secret", false),
 												"user": {
 													Type:        schema.TypeString,
 													Optional:    true,
@@ -1532,8 +1629,11 @@ Proc-Type: 4,ENCRYPTED
 DEK-Info: DES-EDE3-CBC,F23074E02CF47304
 
 -----END RSA PRIVATE KEY-----`,
-													Sensitive: true,
+													Sensitive:     true,
+													ConflictsWith: []string{"details.0.fox_it_stix_settings.0.ssl.0.encoded_private_key_wo"},
 												},
+												"encoded_private_key_wo":         tpgresource.WriteOnlySchema("details.0.fox_it_stix_settings.0.ssl.0.encoded_private_key", `Write-only encoded private key, in PEM format.`, false),
+												"encoded_private_key_wo_version": tpgresource.WriteOnlyVersionSchema("details.0.fox_it_stix_settings.0.ssl.0.encoded_private_key", false),
 												"ssl_certificate": {
 													Type:     schema.TypeString,
 													Optional: true,
@@ -1545,8 +1645,11 @@ Example:
 -----BEGIN CERTIFICATE-----
 
 -----END CERTIFICATE-----`,
-													Sensitive: true,
+													Sensitive:     true,
+													ConflictsWith: []string{"details.0.fox_it_stix_settings.0.ssl.0.ssl_certificate_wo"},
 												},
+												"ssl_certificate_wo":         tpgresource.WriteOnlySchema("details.0.fox_it_stix_settings.0.ssl.0.ssl_certificate", `Write-only encoded SSL certificate, in PEM format.`, false),
+												"ssl_certificate_wo_version": tpgresource.WriteOnlyVersionSchema("details.0.fox_it_stix_settings.0.ssl.0.ssl_certificate", false),
 											},
 										},
 									},
@@ -1673,11 +1776,14 @@ Tenancy Project.`,
 													Elem: &schema.Resource{
 														Schema: map[string]*schema.Schema{
 															"private_key": {
-																Type:        schema.TypeString,
-																Optional:    true,
-																Description: `Private key in PEM format.`,
-																Sensitive:   true,
+																Type:          schema.TypeString,
+																Optional:      true,
+																Description:   `Private key in PEM format.`,
+																Sensitive:     true,
+																ConflictsWith: []string{"details.0.google_cloud_identity_device_users_settings.0.authentication.0.rs_credentials.0.private_key_wo"},
 															},
+															"private_key_wo":         tpgresource.WriteOnlySchema("details.0.google_cloud_identity_device_users_settings.0.authentication.0.rs_credentials.0.private_key", `Write-only private key in PEM format.`, false),
+															"private_key_wo_version": tpgresource.WriteOnlyVersionSchema("details.0.google_cloud_identity_device_users_settings.0.authentication.0.rs_credentials.0.private_key", false),
 														},
 													},
 												},
@@ -1745,11 +1851,14 @@ Tenancy Project.`,
 													Elem: &schema.Resource{
 														Schema: map[string]*schema.Schema{
 															"private_key": {
-																Type:        schema.TypeString,
-																Optional:    true,
-																Description: `Private key in PEM format.`,
-																Sensitive:   true,
+																Type:          schema.TypeString,
+																Optional:      true,
+																Description:   `Private key in PEM format.`,
+																Sensitive:     true,
+																ConflictsWith: []string{"details.0.google_cloud_identity_devices_settings.0.authentication.0.rs_credentials.0.private_key_wo"},
 															},
+															"private_key_wo":         tpgresource.WriteOnlySchema("details.0.google_cloud_identity_devices_settings.0.authentication.0.rs_credentials.0.private_key", `Write-only private key in PEM format.`, false),
+															"private_key_wo_version": tpgresource.WriteOnlyVersionSchema("details.0.google_cloud_identity_devices_settings.0.authentication.0.rs_credentials.0.private_key", false),
 														},
 													},
 												},
@@ -1916,6 +2025,17 @@ FOLDERS_RECURSIVE`,
 																Description: `Value.`,
 																Sensitive:   true,
 															},
+															"value_wo": {
+																Type:        schema.TypeString,
+																Optional:    true,
+																WriteOnly:   true,
+																Description: `Write-only value. Only one of value and value_wo may be set.`,
+															},
+															"value_wo_version": {
+																Type:        schema.TypeString,
+																Optional:    true,
+																Description: `Triggers update of 'value_wo' write-only. Increment this value when an update to 'value_wo' is needed. For more info see [updating write-only arguments](/docs/providers/google/guides/using_write_only_arguments.html#updating-write-only-arguments)`,
+															},
 														},
 													},
 												},
@@ -1964,6 +2084,17 @@ feed, and the resulting normalized data.`,
 																Description: `Value.`,
 																Sensitive:   true,
 															},
+															"value_wo": {
+																Type:        schema.TypeString,
+																Optional:    true,
+																WriteOnly:   true,
+																Description: `Write-only value. Only one of value and value_wo may be set.`,
+															},
+															"value_wo_version": {
+																Type:        schema.TypeString,
+																Optional:    true,
+																Description: `Triggers update of 'value_wo' write-only. Increment this value when an update to 'value_wo' is needed. For more info see [updating write-only arguments](/docs/providers/google/guides/using_write_only_arguments.html#updating-write-only-arguments)`,
+															},
 														},
 													},
 												},
@@ -2004,11 +2135,14 @@ feed, and the resulting normalized data.`,
 													Description: `Client ID.`,
 												},
 												"client_secret": {
-													Type:        schema.TypeString,
-													Optional:    true,
-													Description: `Client secret.`,
-													Sensitive:   true,
+													Type:          schema.TypeString,
+													Optional:      true,
+													Description:   `Client secret.`,
+													Sensitive:     true,
+													ConflictsWith: []string{"details.0.microsoft_graph_alert_settings.0.authentication.0.client_secret_wo"},
 												},
+												"client_secret_wo":         tpgresource.WriteOnlySchema("details.0.microsoft_graph_alert_settings.0.authentication.0.client_secret", `Write-only client secret.`, false),
+												"client_secret_wo_version": tpgresource.WriteOnlyVersionSchema("details.0.microsoft_graph_alert_settings.0.authentication.0.client_secret", false),
 											},
 										},
 									},
@@ -2051,11 +2185,14 @@ feed, and the resulting normalized data.`,
 													Description: `Client ID.`,
 												},
 												"client_secret": {
-													Type:        schema.TypeString,
-													Optional:    true,
-													Description: `Client secret.`,
-													Sensitive:   true,
+													Type:          schema.TypeString,
+													Optional:      true,
+													Description:   `Client secret.`,
+													Sensitive:     true,
+													ConflictsWith: []string{"details.0.microsoft_security_center_alert_settings.0.authentication.0.client_secret_wo"},
 												},
+												"client_secret_wo":         tpgresource.WriteOnlySchema("details.0.microsoft_security_center_alert_settings.0.authentication.0.client_secret", `Write-only client secret.`, false),
+												"client_secret_wo_version": tpgresource.WriteOnlyVersionSchema("details.0.microsoft_security_center_alert_settings.0.authentication.0.client_secret", false),
 											},
 										},
 									},
@@ -2109,6 +2246,17 @@ feed, and the resulting normalized data.`,
 																Description: `Value.`,
 																Sensitive:   true,
 															},
+															"value_wo": {
+																Type:        schema.TypeString,
+																Optional:    true,
+																WriteOnly:   true,
+																Description: `Write-only value. Only one of value and value_wo may be set.`,
+															},
+															"value_wo_version": {
+																Type:        schema.TypeString,
+																Optional:    true,
+																Description: `Triggers update of 'value_wo' write-only. Increment this value when an update to 'value_wo' is needed. For more info see [updating write-only arguments](/docs/providers/google/guides/using_write_only_arguments.html#updating-write-only-arguments)`,
+															},
 														},
 													},
 												},
@@ -2145,11 +2293,14 @@ NEXT TAG: 3`,
 													Description: `Client ID.`,
 												},
 												"client_secret": {
-													Type:        schema.TypeString,
-													Optional:    true,
-													Description: `Client Secret.`,
-													Sensitive:   true,
+													Type:          schema.TypeString,
+													Optional:      true,
+													Description:   `Client Secret.`,
+													Sensitive:     true,
+													ConflictsWith: []string{"details.0.mimecast_mail_v2_settings.0.auth_credentials.0.client_secret_wo"},
 												},
+												"client_secret_wo":         tpgresource.WriteOnlySchema("details.0.mimecast_mail_v2_settings.0.auth_credentials.0.client_secret", `Write-only client secret.`, false),
+												"client_secret_wo_version": tpgresource.WriteOnlyVersionSchema("details.0.mimecast_mail_v2_settings.0.auth_credentials.0.client_secret", false),
 											},
 										},
 									},
@@ -2188,6 +2339,17 @@ NEXT TAG: 3`,
 																Description: `Value.`,
 																Sensitive:   true,
 															},
+															"value_wo": {
+																Type:        schema.TypeString,
+																Optional:    true,
+																WriteOnly:   true,
+																Description: `Write-only value. Only one of value and value_wo may be set.`,
+															},
+															"value_wo_version": {
+																Type:        schema.TypeString,
+																Optional:    true,
+																Description: `Triggers update of 'value_wo' write-only. Increment this value when an update to 'value_wo' is needed. For more info see [updating write-only arguments](/docs/providers/google/guides/using_write_only_arguments.html#updating-write-only-arguments)`,
+															},
 														},
 													},
 												},
@@ -2244,6 +2406,17 @@ NEXT TAG: 3`,
 																Description: `Value.`,
 																Sensitive:   true,
 															},
+															"value_wo": {
+																Type:        schema.TypeString,
+																Optional:    true,
+																WriteOnly:   true,
+																Description: `Write-only value. Only one of value and value_wo may be set.`,
+															},
+															"value_wo_version": {
+																Type:        schema.TypeString,
+																Optional:    true,
+																Description: `Triggers update of 'value_wo' write-only. Increment this value when an update to 'value_wo' is needed. For more info see [updating write-only arguments](/docs/providers/google/guides/using_write_only_arguments.html#updating-write-only-arguments)`,
+															},
 														},
 													},
 												},
@@ -2297,11 +2470,14 @@ NEXT TAG: 3`,
 													Description: `Client ID.`,
 												},
 												"client_secret": {
-													Type:        schema.TypeString,
-													Optional:    true,
-													Description: `Client secret.`,
-													Sensitive:   true,
+													Type:          schema.TypeString,
+													Optional:      true,
+													Description:   `Client secret.`,
+													Sensitive:     true,
+													ConflictsWith: []string{"details.0.office365_settings.0.authentication.0.client_secret_wo"},
 												},
+												"client_secret_wo":         tpgresource.WriteOnlySchema("details.0.office365_settings.0.authentication.0.client_secret", `Write-only client secret.`, false),
+												"client_secret_wo_version": tpgresource.WriteOnlyVersionSchema("details.0.office365_settings.0.authentication.0.client_secret", false),
 											},
 										},
 									},
@@ -2361,6 +2537,17 @@ DLP_ALL`,
 																Description: `Value.`,
 																Sensitive:   true,
 															},
+															"value_wo": {
+																Type:        schema.TypeString,
+																Optional:    true,
+																WriteOnly:   true,
+																Description: `Write-only value. Only one of value and value_wo may be set.`,
+															},
+															"value_wo_version": {
+																Type:        schema.TypeString,
+																Optional:    true,
+																Description: `Triggers update of 'value_wo' write-only. Increment this value when an update to 'value_wo' is needed. For more info see [updating write-only arguments](/docs/providers/google/guides/using_write_only_arguments.html#updating-write-only-arguments)`,
+															},
 														},
 													},
 												},
@@ -2407,6 +2594,17 @@ DLP_ALL`,
 																Description: `Value.`,
 																Sensitive:   true,
 															},
+															"value_wo": {
+																Type:        schema.TypeString,
+																Optional:    true,
+																WriteOnly:   true,
+																Description: `Write-only value. Only one of value and value_wo may be set.`,
+															},
+															"value_wo_version": {
+																Type:        schema.TypeString,
+																Optional:    true,
+																Description: `Triggers update of 'value_wo' write-only. Increment this value when an update to 'value_wo' is needed. For more info see [updating write-only arguments](/docs/providers/google/guides/using_write_only_arguments.html#updating-write-only-arguments)`,
+															},
 														},
 													},
 												},
@@ -2458,6 +2656,17 @@ DLP_ALL`,
 																Description: `Value.`,
 																Sensitive:   true,
 															},
+															"value_wo": {
+																Type:        schema.TypeString,
+																Optional:    true,
+																WriteOnly:   true,
+																Description: `Write-only value. Only one of value and value_wo may be set.`,
+															},
+															"value_wo_version": {
+																Type:        schema.TypeString,
+																Optional:    true,
+																Description: `Triggers update of 'value_wo' write-only. Increment this value when an update to 'value_wo' is needed. For more info see [updating write-only arguments](/docs/providers/google/guides/using_write_only_arguments.html#updating-write-only-arguments)`,
+															},
 														},
 													},
 												},
@@ -2493,11 +2702,14 @@ DLP_ALL`,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												"password": {
-													Type:        schema.TypeString,
-													Optional:    true,
-													Description: `Password.`,
-													Sensitive:   true,
+													Type:          schema.TypeString,
+													Optional:      true,
+													Description:   `Password.`,
+													Sensitive:     true,
+													ConflictsWith: []string{"details.0.pan_prisma_cloud_settings.0.authentication.0.password_wo"},
 												},
+												"password_wo":         tpgresource.WriteOnlySchema("details.0.pan_prisma_cloud_settings.0.authentication.0.password", `Write-only password.`, false),
+												"password_wo_version": tpgresource.WriteOnlyVersionSchema("details.0.pan_prisma_cloud_settings.0.authentication.0.password", false),
 												"user": {
 													Type:        schema.TypeString,
 													Optional:    true,
@@ -2530,11 +2742,14 @@ DLP_ALL`,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												"secret": {
-													Type:        schema.TypeString,
-													Optional:    true,
-													Description: `Secret of the account identified by user_name.`,
-													Sensitive:   true,
+													Type:          schema.TypeString,
+													Optional:      true,
+													Description:   `Secret of the account identified by user_name.`,
+													Sensitive:     true,
+													ConflictsWith: []string{"details.0.proofpoint_mail_settings.0.authentication.0.secret_wo"},
 												},
+												"secret_wo":         tpgresource.WriteOnlySchema("details.0.proofpoint_mail_settings.0.authentication.0.secret", `Write-only secret of the account identified by user_name.`, false),
+												"secret_wo_version": tpgresource.WriteOnlyVersionSchema("details.0.proofpoint_mail_settings.0.authentication.0.secret", false),
 												"user": {
 													Type:        schema.TypeString,
 													Optional:    true,
@@ -2578,6 +2793,17 @@ DLP_ALL`,
 																Description: `Value.`,
 																Sensitive:   true,
 															},
+															"value_wo": {
+																Type:        schema.TypeString,
+																Optional:    true,
+																WriteOnly:   true,
+																Description: `Write-only value. Only one of value and value_wo may be set.`,
+															},
+															"value_wo_version": {
+																Type:        schema.TypeString,
+																Optional:    true,
+																Description: `Triggers update of 'value_wo' write-only. Increment this value when an update to 'value_wo' is needed. For more info see [updating write-only arguments](/docs/providers/google/guides/using_write_only_arguments.html#updating-write-only-arguments)`,
+															},
 														},
 													},
 												},
@@ -2633,11 +2859,14 @@ SCAN_COMPLIANCE_CONTROL_OUTPUT`,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												"secret": {
-													Type:        schema.TypeString,
-													Optional:    true,
-													Description: `Secret of the account identified by user_name.`,
-													Sensitive:   true,
+													Type:          schema.TypeString,
+													Optional:      true,
+													Description:   `Secret of the account identified by user_name.`,
+													Sensitive:     true,
+													ConflictsWith: []string{"details.0.qualys_scan_settings.0.authentication.0.secret_wo"},
 												},
+												"secret_wo":         tpgresource.WriteOnlySchema("details.0.qualys_scan_settings.0.authentication.0.secret", `Write-only secret of the account identified by user_name.`, false),
+												"secret_wo_version": tpgresource.WriteOnlyVersionSchema("details.0.qualys_scan_settings.0.authentication.0.secret", false),
 												"user": {
 													Type:        schema.TypeString,
 													Optional:    true,
@@ -2670,11 +2899,14 @@ SCAN_COMPLIANCE_CONTROL_OUTPUT`,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												"secret": {
-													Type:        schema.TypeString,
-													Optional:    true,
-													Description: `Secret of the account identified by user_name.`,
-													Sensitive:   true,
+													Type:          schema.TypeString,
+													Optional:      true,
+													Description:   `Secret of the account identified by user_name.`,
+													Sensitive:     true,
+													ConflictsWith: []string{"details.0.qualys_vm_settings.0.authentication.0.secret_wo"},
 												},
+												"secret_wo":         tpgresource.WriteOnlySchema("details.0.qualys_vm_settings.0.authentication.0.secret", `Write-only secret of the account identified by user_name.`, false),
+												"secret_wo_version": tpgresource.WriteOnlyVersionSchema("details.0.qualys_vm_settings.0.authentication.0.secret", false),
 												"user": {
 													Type:        schema.TypeString,
 													Optional:    true,
@@ -2723,6 +2955,17 @@ SCAN_COMPLIANCE_CONTROL_OUTPUT`,
 																Description: `Value.`,
 																Sensitive:   true,
 															},
+															"value_wo": {
+																Type:        schema.TypeString,
+																Optional:    true,
+																WriteOnly:   true,
+																Description: `Write-only value. Only one of value and value_wo may be set.`,
+															},
+															"value_wo_version": {
+																Type:        schema.TypeString,
+																Optional:    true,
+																Description: `Triggers update of 'value_wo' write-only. Increment this value when an update to 'value_wo' is needed. For more info see [updating write-only arguments](/docs/providers/google/guides/using_write_only_arguments.html#updating-write-only-arguments)`,
+															},
 														},
 													},
 												},
@@ -2774,6 +3017,17 @@ SCAN_COMPLIANCE_CONTROL_OUTPUT`,
 																Description: `Value.`,
 																Sensitive:   true,
 															},
+															"value_wo": {
+																Type:        schema.TypeString,
+																Optional:    true,
+																WriteOnly:   true,
+																Description: `Write-only value. Only one of value and value_wo may be set.`,
+															},
+															"value_wo_version": {
+																Type:        schema.TypeString,
+																Optional:    true,
+																Description: `Triggers update of 'value_wo' write-only. Increment this value when an update to 'value_wo' is needed. For more info see [updating write-only arguments](/docs/providers/google/guides/using_write_only_arguments.html#updating-write-only-arguments)`,
+															},
 														},
 													},
 												},
@@ -2804,11 +3058,14 @@ SCAN_COMPLIANCE_CONTROL_OUTPUT`,
 													Description: `Client ID.`,
 												},
 												"client_secret": {
-													Type:        schema.TypeString,
-													Optional:    true,
-													Description: `Client secret.`,
-													Sensitive:   true,
+													Type:          schema.TypeString,
+													Optional:      true,
+													Description:   `Client secret.`,
+													Sensitive:     true,
+													ConflictsWith: []string{"details.0.rh_isac_ioc_settings.0.authentication.0.client_secret_wo"},
 												},
+												"client_secret_wo":         tpgresource.WriteOnlySchema("details.0.rh_isac_ioc_settings.0.authentication.0.client_secret", `Write-only client secret.`, false),
+												"client_secret_wo_version": tpgresource.WriteOnlyVersionSchema("details.0.rh_isac_ioc_settings.0.authentication.0.client_secret", false),
 												"token_endpoint": {
 													Type:        schema.TypeString,
 													Optional:    true,
@@ -2873,11 +3130,14 @@ SCAN_COMPLIANCE_CONTROL_OUTPUT`,
 													Elem: &schema.Resource{
 														Schema: map[string]*schema.Schema{
 															"private_key": {
-																Type:        schema.TypeString,
-																Optional:    true,
-																Description: `Private key in PEM format.`,
-																Sensitive:   true,
+																Type:          schema.TypeString,
+																Optional:      true,
+																Description:   `Private key in PEM format.`,
+																Sensitive:     true,
+																ConflictsWith: []string{"details.0.salesforce_settings.0.oauth_jwt_credentials.0.rs_credentials.0.private_key_wo"},
 															},
+															"private_key_wo":         tpgresource.WriteOnlySchema("details.0.salesforce_settings.0.oauth_jwt_credentials.0.rs_credentials.0.private_key", `Write-only private key in PEM format.`, false),
+															"private_key_wo_version": tpgresource.WriteOnlyVersionSchema("details.0.salesforce_settings.0.oauth_jwt_credentials.0.rs_credentials.0.private_key", false),
 														},
 													},
 												},
@@ -2959,6 +3219,17 @@ SCAN_COMPLIANCE_CONTROL_OUTPUT`,
 																Description: `Value.`,
 																Sensitive:   true,
 															},
+															"value_wo": {
+																Type:        schema.TypeString,
+																Optional:    true,
+																WriteOnly:   true,
+																Description: `Write-only value. Only one of value and value_wo may be set.`,
+															},
+															"value_wo_version": {
+																Type:        schema.TypeString,
+																Optional:    true,
+																Description: `Triggers update of 'value_wo' write-only. Increment this value when an update to 'value_wo' is needed. For more info see [updating write-only arguments](/docs/providers/google/guides/using_write_only_arguments.html#updating-write-only-arguments)`,
+															},
 														},
 													},
 												},
@@ -2999,11 +3270,14 @@ SCAN_COMPLIANCE_CONTROL_OUTPUT`,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												"secret": {
-													Type:        schema.TypeString,
-													Optional:    true,
-													Description: `Secret of the account identified by user_name.`,
-													Sensitive:   true,
+													Type:          schema.TypeString,
+													Optional:      true,
+													Description:   `Secret of the account identified by user_name.`,
+													Sensitive:     true,
+													ConflictsWith: []string{"details.0.service_now_cmdb_settings.0.authentication.0.secret_wo"},
 												},
+												"secret_wo":         tpgresource.WriteOnlySchema("details.0.service_now_cmdb_settings.0.authentication.0.secret", `Write-only secret of the account identified by user_name.`, false),
+												"secret_wo_version": tpgresource.WriteOnlyVersionSchema("details.0.service_now_cmdb_settings.0.authentication.0.secret", false),
 												"user": {
 													Type:        schema.TypeString,
 													Optional:    true,
@@ -3041,17 +3315,23 @@ SCAN_COMPLIANCE_CONTROL_OUTPUT`,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												"password": {
-													Type:        schema.TypeString,
-													Optional:    true,
-													Description: `Password. Used for username and password authentication.`,
-													Sensitive:   true,
+													Type:          schema.TypeString,
+													Optional:      true,
+													Description:   `Password. Used for username and password authentication.`,
+													Sensitive:     true,
+													ConflictsWith: []string{"details.0.sftp_settings.0.authentication.0.password_wo"},
 												},
+												"password_wo":         tpgresource.WriteOnlySchema("details.0.sftp_settings.0.authentication.0.password", `Write-only password. Used for username and password authentication.`, false),
+												"password_wo_version": tpgresource.WriteOnlyVersionSchema("details.0.sftp_settings.0.authentication.0.password", false),
 												"private_key": {
-													Type:        schema.TypeString,
-													Optional:    true,
-													Description: `Private key. Used for private key authentication.`,
-													Sensitive:   true,
+													Type:          schema.TypeString,
+													Optional:      true,
+													Description:   `Private key. Used for private key authentication.`,
+													Sensitive:     true,
+													ConflictsWith: []string{"details.0.sftp_settings.0.authentication.0.private_key_wo"},
 												},
+												"private_key_wo":         tpgresource.WriteOnlySchema("details.0.sftp_settings.0.authentication.0.private_key", `Write-only private key. Used for private key authentication.`, false),
+												"private_key_wo_version": tpgresource.WriteOnlyVersionSchema("details.0.sftp_settings.0.authentication.0.private_key", false),
 												"private_key_passphrase": {
 													Type:        schema.TypeString,
 													Optional:    true,
@@ -3110,11 +3390,14 @@ FOLDERS_RECURSIVE`,
 													Description: `Client ID.`,
 												},
 												"client_secret": {
-													Type:        schema.TypeString,
-													Optional:    true,
-													Description: `Client secret.`,
-													Sensitive:   true,
+													Type:          schema.TypeString,
+													Optional:      true,
+													Description:   `Client secret.`,
+													Sensitive:     true,
+													ConflictsWith: []string{"details.0.symantec_event_export_settings.0.authentication.0.client_secret_wo"},
 												},
+												"client_secret_wo":         tpgresource.WriteOnlySchema("details.0.symantec_event_export_settings.0.authentication.0.client_secret", `Write-only client secret.`, false),
+												"client_secret_wo_version": tpgresource.WriteOnlyVersionSchema("details.0.symantec_event_export_settings.0.authentication.0.client_secret", false),
 												"refresh_token": {
 													Type:        schema.TypeString,
 													Optional:    true,
@@ -3163,6 +3446,17 @@ FOLDERS_RECURSIVE`,
 																Description: `Value.`,
 																Sensitive:   true,
 															},
+															"value_wo": {
+																Type:        schema.TypeString,
+																Optional:    true,
+																WriteOnly:   true,
+																Description: `Write-only value. Only one of value and value_wo may be set.`,
+															},
+															"value_wo_version": {
+																Type:        schema.TypeString,
+																Optional:    true,
+																Description: `Triggers update of 'value_wo' write-only. Increment this value when an update to 'value_wo' is needed. For more info see [updating write-only arguments](/docs/providers/google/guides/using_write_only_arguments.html#updating-write-only-arguments)`,
+															},
 														},
 													},
 												},
@@ -3193,11 +3487,14 @@ FOLDERS_RECURSIVE`,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												"secret": {
-													Type:        schema.TypeString,
-													Optional:    true,
-													Description: `Secret of the account identified by user_name.`,
-													Sensitive:   true,
+													Type:          schema.TypeString,
+													Optional:      true,
+													Description:   `Secret of the account identified by user_name.`,
+													Sensitive:     true,
+													ConflictsWith: []string{"details.0.threat_connect_ioc_settings.0.authentication.0.secret_wo"},
 												},
+												"secret_wo":         tpgresource.WriteOnlySchema("details.0.threat_connect_ioc_settings.0.authentication.0.secret", `Write-only secret of the account identified by user_name.`, false),
+												"secret_wo_version": tpgresource.WriteOnlyVersionSchema("details.0.threat_connect_ioc_settings.0.authentication.0.secret", false),
 												"user": {
 													Type:        schema.TypeString,
 													Optional:    true,
@@ -3238,11 +3535,14 @@ FOLDERS_RECURSIVE`,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												"secret": {
-													Type:        schema.TypeString,
-													Optional:    true,
-													Description: `Secret of the account identified by user_name.`,
-													Sensitive:   true,
+													Type:          schema.TypeString,
+													Optional:      true,
+													Description:   `Secret of the account identified by user_name.`,
+													Sensitive:     true,
+													ConflictsWith: []string{"details.0.threat_connect_ioc_v3_settings.0.authentication.0.secret_wo"},
 												},
+												"secret_wo":         tpgresource.WriteOnlySchema("details.0.threat_connect_ioc_v3_settings.0.authentication.0.secret", `Write-only secret of the account identified by user_name.`, false),
+												"secret_wo_version": tpgresource.WriteOnlyVersionSchema("details.0.threat_connect_ioc_v3_settings.0.authentication.0.secret", false),
 												"user": {
 													Type:        schema.TypeString,
 													Optional:    true,
@@ -3324,8 +3624,11 @@ specifically enforced min / max length or character set. The password
 will have been provided by an MSSO administrator and it is assumed that
 they have provided a password that is internally consistent with MSSO
 authentication requirements / validation.`,
-																Sensitive: true,
+																Sensitive:     true,
+																ConflictsWith: []string{"details.0.trellix_hx_alerts_settings.0.authentication.0.msso.0.password_wo"},
 															},
+															"password_wo":         tpgresource.WriteOnlySchema("details.0.trellix_hx_alerts_settings.0.authentication.0.msso.0.password", `Write-only password of the account identified by username.`, false),
+															"password_wo_version": tpgresource.WriteOnlyVersionSchema("details.0.trellix_hx_alerts_settings.0.authentication.0.msso.0.password", false),
 															"username": {
 																Type:     schema.TypeString,
 																Optional: true,
@@ -3363,8 +3666,11 @@ This is the secret generated in Trellix IAM for the Client ID. It has no
 default, specifically enforced min / max length or character set. It is
 assumed that the secret generated in Trellix IAM is internally
 consistent with Trellix IAM authentication requirements / validation.`,
-																Sensitive: true,
+																Sensitive:     true,
+																ConflictsWith: []string{"details.0.trellix_hx_alerts_settings.0.authentication.0.trellix_iam.0.client_secret_wo"},
 															},
+															"client_secret_wo":         tpgresource.WriteOnlySchema("details.0.trellix_hx_alerts_settings.0.authentication.0.trellix_iam.0.client_secret", `Write-only secret associated with the Client ID.`, false),
+															"client_secret_wo_version": tpgresource.WriteOnlyVersionSchema("details.0.trellix_hx_alerts_settings.0.authentication.0.trellix_iam.0.client_secret", false),
 															"scope": {
 																Type:     schema.TypeString,
 																Optional: true,
@@ -3434,8 +3740,9 @@ endpoint.`,
 This must be a valid URL with an http or https scheme. It has no default.`,
 															},
 															"password": {
-																Type:     schema.TypeString,
-																Required: true,
+																Type:         schema.TypeString,
+																Optional:     true,
+																ExactlyOneOf: []string{"details.0.trellix_hx_bulk_acqs_settings.0.authentication.0.msso.0.password", "details.0.trellix_hx_bulk_acqs_settings.0.authentication.0.msso.0.password_wo"},
 																Description: `Password of the account identified by username.
 There are no restrictions on the format of the password. It has no default,
 specifically enforced min / max length or character set. The password
@@ -3444,6 +3751,8 @@ they have provided a password that is internally consistent with MSSO
 authentication requirements / validation.`,
 																Sensitive: true,
 															},
+															"password_wo":         tpgresource.WriteOnlySchema("details.0.trellix_hx_bulk_acqs_settings.0.authentication.0.msso.0.password", `Write-only password of the account identified by username.`, true),
+															"password_wo_version": tpgresource.WriteOnlyVersionSchema("details.0.trellix_hx_bulk_acqs_settings.0.authentication.0.msso.0.password", false),
 															"username": {
 																Type:     schema.TypeString,
 																Required: true,
@@ -3474,8 +3783,9 @@ It is assumed that the Client ID generated in Trellix IAM is internally
 consistent with Trellix IAM authentication requirements / validation.`,
 															},
 															"client_secret": {
-																Type:     schema.TypeString,
-																Required: true,
+																Type:         schema.TypeString,
+																Optional:     true,
+																ExactlyOneOf: []string{"details.0.trellix_hx_bulk_acqs_settings.0.authentication.0.trellix_iam.0.client_secret", "details.0.trellix_hx_bulk_acqs_settings.0.authentication.0.trellix_iam.0.client_secret_wo"},
 																Description: `Secret associated with the Client ID.
 This is the secret generated in Trellix IAM for the Client ID. It has no
 default, specifically enforced min / max length or character set. It is
@@ -3483,6 +3793,8 @@ assumed that the secret generated in Trellix IAM is internally
 consistent with Trellix IAM authentication requirements / validation.`,
 																Sensitive: true,
 															},
+															"client_secret_wo":         tpgresource.WriteOnlySchema("details.0.trellix_hx_bulk_acqs_settings.0.authentication.0.trellix_iam.0.client_secret", `Write-only secret associated with the Client ID.`, true),
+															"client_secret_wo_version": tpgresource.WriteOnlyVersionSchema("details.0.trellix_hx_bulk_acqs_settings.0.authentication.0.trellix_iam.0.client_secret", false),
 															"scope": {
 																Type:     schema.TypeString,
 																Required: true,
@@ -3542,8 +3854,9 @@ endpoint.`,
 This must be a valid URL with an http or https scheme. It has no default.`,
 															},
 															"password": {
-																Type:     schema.TypeString,
-																Required: true,
+																Type:         schema.TypeString,
+																Optional:     true,
+																ExactlyOneOf: []string{"details.0.trellix_hx_hosts_settings.0.authentication.0.msso.0.password", "details.0.trellix_hx_hosts_settings.0.authentication.0.msso.0.password_wo"},
 																Description: `Password of the account identified by username.
 There are no restrictions on the format of the password. It has no default,
 specifically enforced min / max length or character set. The password
@@ -3552,6 +3865,8 @@ they have provided a password that is internally consistent with MSSO
 authentication requirements / validation.`,
 																Sensitive: true,
 															},
+															"password_wo":         tpgresource.WriteOnlySchema("details.0.trellix_hx_hosts_settings.0.authentication.0.msso.0.password", `Write-only password of the account identified by username.`, true),
+															"password_wo_version": tpgresource.WriteOnlyVersionSchema("details.0.trellix_hx_hosts_settings.0.authentication.0.msso.0.password", false),
 															"username": {
 																Type:     schema.TypeString,
 																Required: true,
@@ -3582,8 +3897,9 @@ It is assumed that the Client ID generated in Trellix IAM is internally
 consistent with Trellix IAM authentication requirements / validation.`,
 															},
 															"client_secret": {
-																Type:     schema.TypeString,
-																Required: true,
+																Type:         schema.TypeString,
+																Optional:     true,
+																ExactlyOneOf: []string{"details.0.trellix_hx_hosts_settings.0.authentication.0.trellix_iam.0.client_secret", "details.0.trellix_hx_hosts_settings.0.authentication.0.trellix_iam.0.client_secret_wo"},
 																Description: `Secret associated with the Client ID.
 This is the secret generated in Trellix IAM for the Client ID. It has no
 default, specifically enforced min / max length or character set. It is
@@ -3591,6 +3907,8 @@ assumed that the secret generated in Trellix IAM is internally
 consistent with Trellix IAM authentication requirements / validation.`,
 																Sensitive: true,
 															},
+															"client_secret_wo":         tpgresource.WriteOnlySchema("details.0.trellix_hx_hosts_settings.0.authentication.0.trellix_iam.0.client_secret", `Write-only secret associated with the Client ID.`, true),
+															"client_secret_wo_version": tpgresource.WriteOnlyVersionSchema("details.0.trellix_hx_hosts_settings.0.authentication.0.trellix_iam.0.client_secret", false),
 															"scope": {
 																Type:     schema.TypeString,
 																Required: true,
@@ -3640,11 +3958,14 @@ authentication requirements / validation.`,
 													Description: `Client ID.`,
 												},
 												"client_secret": {
-													Type:        schema.TypeString,
-													Optional:    true,
-													Description: `Client Secret.`,
-													Sensitive:   true,
+													Type:          schema.TypeString,
+													Optional:      true,
+													Description:   `Client Secret.`,
+													Sensitive:     true,
+													ConflictsWith: []string{"details.0.workday_settings.0.authentication.0.client_secret_wo"},
 												},
+												"client_secret_wo":         tpgresource.WriteOnlySchema("details.0.workday_settings.0.authentication.0.client_secret", `Write-only client secret.`, false),
+												"client_secret_wo_version": tpgresource.WriteOnlyVersionSchema("details.0.workday_settings.0.authentication.0.client_secret", false),
 												"refresh_token": {
 													Type:        schema.TypeString,
 													Optional:    true,
@@ -3658,8 +3979,11 @@ authentication requirements / validation.`,
 using username (which was unused) and secret (which is used as the access
 token). Either this field or all of the other OAuth fields below must be
 specified.`,
-													Sensitive: true,
+													Sensitive:     true,
+													ConflictsWith: []string{"details.0.workday_settings.0.authentication.0.secret_wo"},
 												},
+												"secret_wo":         tpgresource.WriteOnlySchema("details.0.workday_settings.0.authentication.0.secret", `Write-only access token used to authenticate against Workday.`, false),
+												"secret_wo_version": tpgresource.WriteOnlyVersionSchema("details.0.workday_settings.0.authentication.0.secret", false),
 												"token_endpoint": {
 													Type:        schema.TypeString,
 													Optional:    true,
@@ -3744,11 +4068,14 @@ used, and it was used to supply the OAuth access token.`,
 													Elem: &schema.Resource{
 														Schema: map[string]*schema.Schema{
 															"private_key": {
-																Type:        schema.TypeString,
-																Optional:    true,
-																Description: `Private key in PEM format.`,
-																Sensitive:   true,
+																Type:          schema.TypeString,
+																Optional:      true,
+																Description:   `Private key in PEM format.`,
+																Sensitive:     true,
+																ConflictsWith: []string{"details.0.workspace_activity_settings.0.authentication.0.rs_credentials.0.private_key_wo"},
 															},
+															"private_key_wo":         tpgresource.WriteOnlySchema("details.0.workspace_activity_settings.0.authentication.0.rs_credentials.0.private_key", `Write-only private key in PEM format.`, false),
+															"private_key_wo_version": tpgresource.WriteOnlyVersionSchema("details.0.workspace_activity_settings.0.authentication.0.rs_credentials.0.private_key", false),
 														},
 													},
 												},
@@ -3816,11 +4143,14 @@ used, and it was used to supply the OAuth access token.`,
 													Elem: &schema.Resource{
 														Schema: map[string]*schema.Schema{
 															"private_key": {
-																Type:        schema.TypeString,
-																Optional:    true,
-																Description: `Private key in PEM format.`,
-																Sensitive:   true,
+																Type:          schema.TypeString,
+																Optional:      true,
+																Description:   `Private key in PEM format.`,
+																Sensitive:     true,
+																ConflictsWith: []string{"details.0.workspace_alerts_settings.0.authentication.0.rs_credentials.0.private_key_wo"},
 															},
+															"private_key_wo":         tpgresource.WriteOnlySchema("details.0.workspace_alerts_settings.0.authentication.0.rs_credentials.0.private_key", `Write-only private key in PEM format.`, false),
+															"private_key_wo_version": tpgresource.WriteOnlyVersionSchema("details.0.workspace_alerts_settings.0.authentication.0.rs_credentials.0.private_key", false),
 														},
 													},
 												},
@@ -3888,11 +4218,14 @@ used, and it was used to supply the OAuth access token.`,
 													Elem: &schema.Resource{
 														Schema: map[string]*schema.Schema{
 															"private_key": {
-																Type:        schema.TypeString,
-																Optional:    true,
-																Description: `Private key in PEM format.`,
-																Sensitive:   true,
+																Type:          schema.TypeString,
+																Optional:      true,
+																Description:   `Private key in PEM format.`,
+																Sensitive:     true,
+																ConflictsWith: []string{"details.0.workspace_chrome_os_settings.0.authentication.0.rs_credentials.0.private_key_wo"},
 															},
+															"private_key_wo":         tpgresource.WriteOnlySchema("details.0.workspace_chrome_os_settings.0.authentication.0.rs_credentials.0.private_key", `Write-only private key in PEM format.`, false),
+															"private_key_wo_version": tpgresource.WriteOnlyVersionSchema("details.0.workspace_chrome_os_settings.0.authentication.0.rs_credentials.0.private_key", false),
 														},
 													},
 												},
@@ -3960,11 +4293,14 @@ used, and it was used to supply the OAuth access token.`,
 													Elem: &schema.Resource{
 														Schema: map[string]*schema.Schema{
 															"private_key": {
-																Type:        schema.TypeString,
-																Optional:    true,
-																Description: `Private key in PEM format.`,
-																Sensitive:   true,
+																Type:          schema.TypeString,
+																Optional:      true,
+																Description:   `Private key in PEM format.`,
+																Sensitive:     true,
+																ConflictsWith: []string{"details.0.workspace_groups_settings.0.authentication.0.rs_credentials.0.private_key_wo"},
 															},
+															"private_key_wo":         tpgresource.WriteOnlySchema("details.0.workspace_groups_settings.0.authentication.0.rs_credentials.0.private_key", `Write-only private key in PEM format.`, false),
+															"private_key_wo_version": tpgresource.WriteOnlyVersionSchema("details.0.workspace_groups_settings.0.authentication.0.rs_credentials.0.private_key", false),
 														},
 													},
 												},
@@ -4032,11 +4368,14 @@ used, and it was used to supply the OAuth access token.`,
 													Elem: &schema.Resource{
 														Schema: map[string]*schema.Schema{
 															"private_key": {
-																Type:        schema.TypeString,
-																Optional:    true,
-																Description: `Private key in PEM format.`,
-																Sensitive:   true,
+																Type:          schema.TypeString,
+																Optional:      true,
+																Description:   `Private key in PEM format.`,
+																Sensitive:     true,
+																ConflictsWith: []string{"details.0.workspace_mobile_settings.0.authentication.0.rs_credentials.0.private_key_wo"},
 															},
+															"private_key_wo":         tpgresource.WriteOnlySchema("details.0.workspace_mobile_settings.0.authentication.0.rs_credentials.0.private_key", `Write-only private key in PEM format.`, false),
+															"private_key_wo_version": tpgresource.WriteOnlyVersionSchema("details.0.workspace_mobile_settings.0.authentication.0.rs_credentials.0.private_key", false),
 														},
 													},
 												},
@@ -4104,11 +4443,14 @@ used, and it was used to supply the OAuth access token.`,
 													Elem: &schema.Resource{
 														Schema: map[string]*schema.Schema{
 															"private_key": {
-																Type:        schema.TypeString,
-																Optional:    true,
-																Description: `Private key in PEM format.`,
-																Sensitive:   true,
+																Type:          schema.TypeString,
+																Optional:      true,
+																Description:   `Private key in PEM format.`,
+																Sensitive:     true,
+																ConflictsWith: []string{"details.0.workspace_privileges_settings.0.authentication.0.rs_credentials.0.private_key_wo"},
 															},
+															"private_key_wo":         tpgresource.WriteOnlySchema("details.0.workspace_privileges_settings.0.authentication.0.rs_credentials.0.private_key", `Write-only private key in PEM format.`, false),
+															"private_key_wo_version": tpgresource.WriteOnlyVersionSchema("details.0.workspace_privileges_settings.0.authentication.0.rs_credentials.0.private_key", false),
 														},
 													},
 												},
@@ -4176,11 +4518,14 @@ used, and it was used to supply the OAuth access token.`,
 													Elem: &schema.Resource{
 														Schema: map[string]*schema.Schema{
 															"private_key": {
-																Type:        schema.TypeString,
-																Optional:    true,
-																Description: `Private key in PEM format.`,
-																Sensitive:   true,
+																Type:          schema.TypeString,
+																Optional:      true,
+																Description:   `Private key in PEM format.`,
+																Sensitive:     true,
+																ConflictsWith: []string{"details.0.workspace_users_settings.0.authentication.0.rs_credentials.0.private_key_wo"},
 															},
+															"private_key_wo":         tpgresource.WriteOnlySchema("details.0.workspace_users_settings.0.authentication.0.rs_credentials.0.private_key", `Write-only private key in PEM format.`, false),
+															"private_key_wo_version": tpgresource.WriteOnlyVersionSchema("details.0.workspace_users_settings.0.authentication.0.rs_credentials.0.private_key", false),
 														},
 													},
 												},
@@ -5102,6 +5447,8 @@ func flattenChronicleFeedDetailsAmazonS3SettingsAuthentication(v interface{}, d
 		flattenChronicleFeedDetailsAmazonS3SettingsAuthenticationClientSecret(original["clientSecret"], d, config)
 	transformed["refresh_uri"] =
 		flattenChronicleFeedDetailsAmazonS3SettingsAuthenticationRefreshUri(original["refreshUri"], d, config)
+	transformed["refresh_uri_wo_version"] =
+		flattenChronicleFeedDetailsAmazonS3SettingsAuthenticationRefreshUriWoVersion(original["refreshUriWoVersion"], d, config)
 	transformed["region"] =
 		flattenChronicleFeedDetailsAmazonS3SettingsAuthenticationRegion(original["region"], d, config)
 	transformed["secret_access_key"] =
@@ -5124,6 +5471,10 @@ func flattenChronicleFeedDetailsAmazonS3SettingsAuthenticationRefreshUri(v inter
 	return v
 }
 
+func flattenChronicleFeedDetailsAmazonS3SettingsAuthenticationRefreshUriWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("details.0.amazon_s3_settings.0.authentication.0.refresh_uri_wo_version")
+}
+
 func flattenChronicleFeedDetailsAmazonS3SettingsAuthenticationRegion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -5193,6 +5544,8 @@ func flattenChronicleFeedDetailsAmazonS3V2SettingsAuthenticationAccessKeySecretA
 		flattenChronicleFeedDetailsAmazonS3V2SettingsAuthenticationAccessKeySecretAuthAccessKeyId(original["accessKeyId"], d, config)
 	transformed["secret_access_key"] =
 		flattenChronicleFeedDetailsAmazonS3V2SettingsAuthenticationAccessKeySecretAuthSecretAccessKey(original["secretAccessKey"], d, config)
+	transformed["secret_access_key_wo_version"] =
+		flattenChronicleFeedDetailsAmazonS3V2SettingsAuthenticationAccessKeySecretAuthSecretAccessKeyWoVersion(original["secretAccessKeyWoVersion"], d, config)
 	return []interface{}{transformed}
 }
 func flattenChronicleFeedDetailsAmazonS3V2SettingsAuthenticationAccessKeySecretAuthAccessKeyId(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -5203,6 +5556,10 @@ func flattenChronicleFeedDetailsAmazonS3V2SettingsAuthenticationAccessKeySecretA
 	return v
 }
 
+func flattenChronicleFeedDetailsAmazonS3V2SettingsAuthenticationAccessKeySecretAuthSecretAccessKeyWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("details.0.amazon_s3_v2_settings.0.authentication.0.access_key_secret_auth.0.secret_access_key_wo_version")
+}
+
 func flattenChronicleFeedDetailsAmazonS3V2SettingsAuthenticationAwsIamRoleAuth(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	if v == nil {
 		return nil
@@ -5308,6 +5665,8 @@ func flattenChronicleFeedDetailsAmazonSqsSettingsAuthenticationAdditionalS3Acces
 		flattenChronicleFeedDetailsAmazonSqsSettingsAuthenticationAdditionalS3AccessKeySecretAuthAccessKeyId(original["accessKeyId"], d, config)
 	transformed["secret_access_key"] =
 		flattenChronicleFeedDetailsAmazonSqsSettingsAuthenticationAdditionalS3AccessKeySecretAuthSecretAccessKey(original["secretAccessKey"], d, config)
+	transformed["secret_access_key_wo_version"] =
+		flattenChronicleFeedDetailsAmazonSqsSettingsAuthenticationAdditionalS3AccessKeySecretAuthSecretAccessKeyWoVersion(original["secretAccessKeyWoVersion"], d, config)
 	return []interface{}{transformed}
 }
 func flattenChronicleFeedDetailsAmazonSqsSettingsAuthenticationAdditionalS3AccessKeySecretAuthAccessKeyId(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -5318,6 +5677,10 @@ func flattenChronicleFeedDetailsAmazonSqsSettingsAuthenticationAdditionalS3Acces
 	return v
 }
 
+func flattenChronicleFeedDetailsAmazonSqsSettingsAuthenticationAdditionalS3AccessKeySecretAuthSecretAccessKeyWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("details.0.amazon_sqs_settings.0.authentication.0.additional_s3_access_key_secret_auth.0.secret_access_key_wo_version")
+}
+
 func flattenChronicleFeedDetailsAmazonSqsSettingsAuthenticationSqsAccessKeySecretAuth(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	if v == nil {
 		return nil
@@ -5331,6 +5694,8 @@ func flattenChronicleFeedDetailsAmazonSqsSettingsAuthenticationSqsAccessKeySecre
 		flattenChronicleFeedDetailsAmazonSqsSettingsAuthenticationSqsAccessKeySecretAuthAccessKeyId(original["accessKeyId"], d, config)
 	transformed["secret_access_key"] =
 		flattenChronicleFeedDetailsAmazonSqsSettingsAuthenticationSqsAccessKeySecretAuthSecretAccessKey(original["secretAccessKey"], d, config)
+	transformed["secret_access_key_wo_version"] =
+		flattenChronicleFeedDetailsAmazonSqsSettingsAuthenticationSqsAccessKeySecretAuthSecretAccessKeyWoVersion(original["secretAccessKeyWoVersion"], d, config)
 	return []interface{}{transformed}
 }
 func flattenChronicleFeedDetailsAmazonSqsSettingsAuthenticationSqsAccessKeySecretAuthAccessKeyId(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -5341,6 +5706,10 @@ func flattenChronicleFeedDetailsAmazonSqsSettingsAuthenticationSqsAccessKeySecre
 	return v
 }
 
+func flattenChronicleFeedDetailsAmazonSqsSettingsAuthenticationSqsAccessKeySecretAuthSecretAccessKeyWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("details.0.amazon_sqs_settings.0.authentication.0.sqs_access_key_secret_auth.0.secret_access_key_wo_version")
+}
+
 func flattenChronicleFeedDetailsAmazonSqsSettingsQueue(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -5427,6 +5796,8 @@ func flattenChronicleFeedDetailsAmazonSqsV2SettingsAuthenticationSqsV2AccessKeyS
 		flattenChronicleFeedDetailsAmazonSqsV2SettingsAuthenticationSqsV2AccessKeySecretAuthAccessKeyId(original["accessKeyId"], d, config)
 	transformed["secret_access_key"] =
 		flattenChronicleFeedDetailsAmazonSqsV2SettingsAuthenticationSqsV2AccessKeySecretAuthSecretAccessKey(original["secretAccessKey"], d, config)
+	transformed["secret_access_key_wo_version"] =
+		flattenChronicleFeedDetailsAmazonSqsV2SettingsAuthenticationSqsV2AccessKeySecretAuthSecretAccessKeyWoVersion(original["secretAccessKeyWoVersion"], d, config)
 	return []interface{}{transformed}
 }
 func flattenChronicleFeedDetailsAmazonSqsV2SettingsAuthenticationSqsV2AccessKeySecretAuthAccessKeyId(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -5437,6 +5808,10 @@ func flattenChronicleFeedDetailsAmazonSqsV2SettingsAuthenticationSqsV2AccessKeyS
 	return v
 }
 
+func flattenChronicleFeedDetailsAmazonSqsV2SettingsAuthenticationSqsV2AccessKeySecretAuthSecretAccessKeyWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("details.0.amazon_sqs_v2_settings.0.authentication.0.sqs_v2_access_key_secret_auth.0.secret_access_key_wo_version")
+}
+
 func flattenChronicleFeedDetailsAmazonSqsV2SettingsChronicleServiceAccount(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -5494,6 +5869,8 @@ func flattenChronicleFeedDetailsAnomaliSettingsAuthentication(v interface{}, d *
 	transformed := make(map[string]interface{})
 	transformed["secret"] =
 		flattenChronicleFeedDetailsAnomaliSettingsAuthenticationSecret(original["secret"], d, config)
+	transformed["secret_wo_version"] =
+		flattenChronicleFeedDetailsAnomaliSettingsAuthenticationSecretWoVersion(original["secretWoVersion"], d, config)
 	transformed["user"] =
 		flattenChronicleFeedDetailsAnomaliSettingsAuthenticationUser(original["user"], d, config)
 	return []interface{}{transformed}
@@ -5502,6 +5879,10 @@ func flattenChronicleFeedDetailsAnomaliSettingsAuthenticationSecret(v interface{
 	return v
 }
 
+func flattenChronicleFeedDetailsAnomaliSettingsAuthenticationSecretWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("details.0.anomali_settings.0.authentication.0.secret_wo_version")
+}
+
 func flattenChronicleFeedDetailsAnomaliSettingsAuthenticationUser(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -5534,6 +5915,8 @@ func flattenChronicleFeedDetailsAwsEc2HostsSettingsAuthentication(v interface{},
 	transformed := make(map[string]interface{})
 	transformed["secret"] =
 		flattenChronicleFeedDetailsAwsEc2HostsSettingsAuthenticationSecret(original["secret"], d, config)
+	transformed["secret_wo_version"] =
+		flattenChronicleFeedDetailsAwsEc2HostsSettingsAuthenticationSecretWoVersion(original["secretWoVersion"], d, config)
 	transformed["user"] =
 		flattenChronicleFeedDetailsAwsEc2HostsSettingsAuthenticationUser(original["user"], d, config)
 	return []interface{}{transformed}
@@ -5542,6 +5925,10 @@ func flattenChronicleFeedDetailsAwsEc2HostsSettingsAuthenticationSecret(v interf
 	return v
 }
 
+func flattenChronicleFeedDetailsAwsEc2HostsSettingsAuthenticationSecretWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("details.0.aws_ec2_hosts_settings.0.authentication.0.secret_wo_version")
+}
+
 func flattenChronicleFeedDetailsAwsEc2HostsSettingsAuthenticationUser(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -5570,6 +5957,8 @@ func flattenChronicleFeedDetailsAwsEc2InstancesSettingsAuthentication(v interfac
 	transformed := make(map[string]interface{})
 	transformed["secret"] =
 		flattenChronicleFeedDetailsAwsEc2InstancesSettingsAuthenticationSecret(original["secret"], d, config)
+	transformed["secret_wo_version"] =
+		flattenChronicleFeedDetailsAwsEc2InstancesSettingsAuthenticationSecretWoVersion(original["secretWoVersion"], d, config)
 	transformed["user"] =
 		flattenChronicleFeedDetailsAwsEc2InstancesSettingsAuthenticationUser(original["user"], d, config)
 	return []interface{}{transformed}
@@ -5578,6 +5967,10 @@ func flattenChronicleFeedDetailsAwsEc2InstancesSettingsAuthenticationSecret(v in
 	return v
 }
 
+func flattenChronicleFeedDetailsAwsEc2InstancesSettingsAuthenticationSecretWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("details.0.aws_ec2_instances_settings.0.authentication.0.secret_wo_version")
+}
+
 func flattenChronicleFeedDetailsAwsEc2InstancesSettingsAuthenticationUser(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -5606,6 +5999,8 @@ func flattenChronicleFeedDetailsAwsEc2VpcsSettingsAuthentication(v interface{},
 	transformed := make(map[string]interface{})
 	transformed["secret"] =
 		flattenChronicleFeedDetailsAwsEc2VpcsSettingsAuthenticationSecret(original["secret"], d, config)
+	transformed["secret_wo_version"] =
+		flattenChronicleFeedDetailsAwsEc2VpcsSettingsAuthenticationSecretWoVersion(original["secretWoVersion"], d, config)
 	transformed["user"] =
 		flattenChronicleFeedDetailsAwsEc2VpcsSettingsAuthenticationUser(original["user"], d, config)
 	return []interface{}{transformed}
@@ -5614,6 +6009,10 @@ func flattenChronicleFeedDetailsAwsEc2VpcsSettingsAuthenticationSecret(v interfa
 	return v
 }
 
+func flattenChronicleFeedDetailsAwsEc2VpcsSettingsAuthenticationSecretWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("details.0.aws_ec2_vpcs_settings.0.authentication.0.secret_wo_version")
+}
+
 func flattenChronicleFeedDetailsAwsEc2VpcsSettingsAuthenticationUser(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -5648,6 +6047,8 @@ func flattenChronicleFeedDetailsAwsIamSettingsAuthentication(v interface{}, d *s
 	transformed := make(map[string]interface{})
 	transformed["secret"] =
 		flattenChronicleFeedDetailsAwsIamSettingsAuthenticationSecret(original["secret"], d, config)
+	transformed["secret_wo_version"] =
+		flattenChronicleFeedDetailsAwsIamSettingsAuthenticationSecretWoVersion(original["secretWoVersion"], d, config)
 	transformed["user"] =
 		flattenChronicleFeedDetailsAwsIamSettingsAuthenticationUser(original["user"], d, config)
 	return []interface{}{transformed}
@@ -5656,6 +6057,10 @@ func flattenChronicleFeedDetailsAwsIamSettingsAuthenticationSecret(v interface{}
 	return v
 }
 
+func flattenChronicleFeedDetailsAwsIamSettingsAuthenticationSecretWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("details.0.aws_iam_settings.0.authentication.0.secret_wo_version")
+}
+
 func flattenChronicleFeedDetailsAwsIamSettingsAuthenticationUser(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -5696,6 +6101,8 @@ func flattenChronicleFeedDetailsAzureAdAuditSettingsAuthentication(v interface{}
 		flattenChronicleFeedDetailsAzureAdAuditSettingsAuthenticationClientId(original["clientId"], d, config)
 	transformed["client_secret"] =
 		flattenChronicleFeedDetailsAzureAdAuditSettingsAuthenticationClientSecret(original["clientSecret"], d, config)
+	transformed["client_secret_wo_version"] =
+		flattenChronicleFeedDetailsAzureAdAuditSettingsAuthenticationClientSecretWoVersion(original["clientSecretWoVersion"], d, config)
 	return []interface{}{transformed}
 }
 func flattenChronicleFeedDetailsAzureAdAuditSettingsAuthenticationClientId(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -5706,6 +6113,10 @@ func flattenChronicleFeedDetailsAzureAdAuditSettingsAuthenticationClientSecret(v
 	return v
 }
 
+func flattenChronicleFeedDetailsAzureAdAuditSettingsAuthenticationClientSecretWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("details.0.azure_ad_audit_settings.0.authentication.0.client_secret_wo_version")
+}
+
 func flattenChronicleFeedDetailsAzureAdAuditSettingsHostname(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -5754,6 +6165,8 @@ func flattenChronicleFeedDetailsAzureAdContextSettingsAuthentication(v interface
 		flattenChronicleFeedDetailsAzureAdContextSettingsAuthenticationClientId(original["clientId"], d, config)
 	transformed["client_secret"] =
 		flattenChronicleFeedDetailsAzureAdContextSettingsAuthenticationClientSecret(original["clientSecret"], d, config)
+	transformed["client_secret_wo_version"] =
+		flattenChronicleFeedDetailsAzureAdContextSettingsAuthenticationClientSecretWoVersion(original["clientSecretWoVersion"], d, config)
 	return []interface{}{transformed}
 }
 func flattenChronicleFeedDetailsAzureAdContextSettingsAuthenticationClientId(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -5764,6 +6177,10 @@ func flattenChronicleFeedDetailsAzureAdContextSettingsAuthenticationClientSecret
 	return v
 }
 
+func flattenChronicleFeedDetailsAzureAdContextSettingsAuthenticationClientSecretWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("details.0.azure_ad_context_settings.0.authentication.0.client_secret_wo_version")
+}
+
 func flattenChronicleFeedDetailsAzureAdContextSettingsHostname(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -5816,6 +6233,8 @@ func flattenChronicleFeedDetailsAzureAdSettingsAuthentication(v interface{}, d *
 		flattenChronicleFeedDetailsAzureAdSettingsAuthenticationClientId(original["clientId"], d, config)
 	transformed["client_secret"] =
 		flattenChronicleFeedDetailsAzureAdSettingsAuthenticationClientSecret(original["clientSecret"], d, config)
+	transformed["client_secret_wo_version"] =
+		flattenChronicleFeedDetailsAzureAdSettingsAuthenticationClientSecretWoVersion(original["clientSecretWoVersion"], d, config)
 	return []interface{}{transformed}
 }
 func flattenChronicleFeedDetailsAzureAdSettingsAuthenticationClientId(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -5826,6 +6245,10 @@ func flattenChronicleFeedDetailsAzureAdSettingsAuthenticationClientSecret(v inte
 	return v
 }
 
+func flattenChronicleFeedDetailsAzureAdSettingsAuthenticationClientSecretWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("details.0.azure_ad_settings.0.authentication.0.client_secret_wo_version")
+}
+
 func flattenChronicleFeedDetailsAzureAdSettingsHostname(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -5864,18 +6287,30 @@ func flattenChronicleFeedDetailsAzureBlobStoreSettingsAuthentication(v interface
 	transformed := make(map[string]interface{})
 	transformed["sas_token"] =
 		flattenChronicleFeedDetailsAzureBlobStoreSettingsAuthenticationSasToken(original["sasToken"], d, config)
+	transformed["sas_token_wo_version"] =
+		flattenChronicleFeedDetailsAzureBlobStoreSettingsAuthenticationSasTokenWoVersion(original["sasTokenWoVersion"], d, config)
 	transformed["shared_key"] =
 		flattenChronicleFeedDetailsAzureBlobStoreSettingsAuthenticationSharedKey(original["sharedKey"], d, config)
+	transformed["shared_key_wo_version"] =
+		flattenChronicleFeedDetailsAzureBlobStoreSettingsAuthenticationSharedKeyWoVersion(original["sharedKeyWoVersion"], d, config)
 	return []interface{}{transformed}
 }
 func flattenChronicleFeedDetailsAzureBlobStoreSettingsAuthenticationSasToken(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
 
+func flattenChronicleFeedDetailsAzureBlobStoreSettingsAuthenticationSasTokenWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("details.0.azure_blob_store_settings.0.authentication.0.sas_token_wo_version")
+}
+
 func flattenChronicleFeedDetailsAzureBlobStoreSettingsAuthenticationSharedKey(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
 
+func flattenChronicleFeedDetailsAzureBlobStoreSettingsAuthenticationSharedKeyWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("details.0.azure_blob_store_settings.0.authentication.0.shared_key_wo_version")
+}
+
 func flattenChronicleFeedDetailsAzureBlobStoreSettingsAzureUri(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -5920,16 +6355,24 @@ func flattenChronicleFeedDetailsAzureBlobStoreV2SettingsAuthentication(v interfa
 	transformed := make(map[string]interface{})
 	transformed["access_key"] =
 		flattenChronicleFeedDetailsAzureBlobStoreV2SettingsAuthenticationAccessKey(original["accessKey"], d, config)
+	transformed["access_key_wo_version"] =
+		flattenChronicleFeedDetailsAzureBlobStoreV2SettingsAuthenticationAccessKeyWoVersion(original["accessKeyWoVersion"], d, config)
 	transformed["azure_v2_workload_identity_federation"] =
 		flattenChronicleFeedDetailsAzureBlobStoreV2SettingsAuthenticationAzureV2WorkloadIdentityFederation(original["azureV2WorkloadIdentityFederation"], d, config)
 	transformed["sas_token"] =
 		flattenChronicleFeedDetailsAzureBlobStoreV2SettingsAuthenticationSasToken(original["sasToken"], d, config)
+	transformed["sas_token_wo_version"] =
+		flattenChronicleFeedDetailsAzureBlobStoreV2SettingsAuthenticationSasTokenWoVersion(original["sasTokenWoVersion"], d, config)
 	return []interface{}{transformed}
 }
 func flattenChronicleFeedDetailsAzureBlobStoreV2SettingsAuthenticationAccessKey(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
 
+func flattenChronicleFeedDetailsAzureBlobStoreV2SettingsAuthenticationAccessKeyWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("details.0.azure_blob_store_v2_settings.0.authentication.0.access_key_wo_version")
+}
+
 func flattenChronicleFeedDetailsAzureBlobStoreV2SettingsAuthenticationAzureV2WorkloadIdentityFederation(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	if v == nil {
 		return nil
@@ -5963,6 +6406,10 @@ func flattenChronicleFeedDetailsAzureBlobStoreV2SettingsAuthenticationSasToken(v
 	return v
 }
 
+func flattenChronicleFeedDetailsAzureBlobStoreV2SettingsAuthenticationSasTokenWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("details.0.azure_blob_store_v2_settings.0.authentication.0.sas_token_wo_version")
+}
+
 func flattenChronicleFeedDetailsAzureBlobStoreV2SettingsAzureUri(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -6003,6 +6450,8 @@ func flattenChronicleFeedDetailsAzureEventHubSettings(v interface{}, d *schema.R
 	transformed := make(map[string]interface{})
 	transformed["azure_sas_token"] =
 		flattenChronicleFeedDetailsAzureEventHubSettingsAzureSasToken(original["azureSasToken"], d, config)
+	transformed["azure_sas_token_wo_version"] =
+		flattenChronicleFeedDetailsAzureEventHubSettingsAzureSasTokenWoVersion(original["azureSasTokenWoVersion"], d, config)
 	transformed["azure_storage_connection_string"] =
 		flattenChronicleFeedDetailsAzureEventHubSettingsAzureStorageConnectionString(original["azureStorageConnectionString"], d, config)
 	transformed["azure_storage_container"] =
@@ -6021,6 +6470,10 @@ func flattenChronicleFeedDetailsAzureEventHubSettingsAzureSasToken(v interface{}
 	return v
 }
 
+func flattenChronicleFeedDetailsAzureEventHubSettingsAzureSasTokenWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("details.0.azure_event_hub_settings.0.azure_sas_token_wo_version")
+}
+
 func flattenChronicleFeedDetailsAzureEventHubSettingsAzureStorageConnectionString(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -6081,6 +6534,8 @@ func flattenChronicleFeedDetailsAzureMdmIntuneSettingsAuthentication(v interface
 		flattenChronicleFeedDetailsAzureMdmIntuneSettingsAuthenticationClientId(original["clientId"], d, config)
 	transformed["client_secret"] =
 		flattenChronicleFeedDetailsAzureMdmIntuneSettingsAuthenticationClientSecret(original["clientSecret"], d, config)
+	transformed["client_secret_wo_version"] =
+		flattenChronicleFeedDetailsAzureMdmIntuneSettingsAuthenticationClientSecretWoVersion(original["clientSecretWoVersion"], d, config)
 	return []interface{}{transformed}
 }
 func flattenChronicleFeedDetailsAzureMdmIntuneSettingsAuthenticationClientId(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -6091,6 +6546,10 @@ func flattenChronicleFeedDetailsAzureMdmIntuneSettingsAuthenticationClientSecret
 	return v
 }
 
+func flattenChronicleFeedDetailsAzureMdmIntuneSettingsAuthenticationClientSecretWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("details.0.azure_mdm_intune_settings.0.authentication.0.client_secret_wo_version")
+}
+
 func flattenChronicleFeedDetailsAzureMdmIntuneSettingsHostname(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -6125,6 +6584,8 @@ func flattenChronicleFeedDetailsCloudPassageSettingsAuthentication(v interface{}
 	transformed := make(map[string]interface{})
 	transformed["secret"] =
 		flattenChronicleFeedDetailsCloudPassageSettingsAuthenticationSecret(original["secret"], d, config)
+	transformed["secret_wo_version"] =
+		flattenChronicleFeedDetailsCloudPassageSettingsAuthenticationSecretWoVersion(original["secretWoVersion"], d, config)
 	transformed["user"] =
 		flattenChronicleFeedDetailsCloudPassageSettingsAuthenticationUser(original["user"], d, config)
 	return []interface{}{transformed}
@@ -6133,6 +6594,10 @@ func flattenChronicleFeedDetailsCloudPassageSettingsAuthenticationSecret(v inter
 	return v
 }
 
+func flattenChronicleFeedDetailsCloudPassageSettingsAuthenticationSecretWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("details.0.cloud_passage_settings.0.authentication.0.secret_wo_version")
+}
+
 func flattenChronicleFeedDetailsCloudPassageSettingsAuthenticationUser(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -6185,8 +6650,9 @@ func flattenChronicleFeedDetailsCortexXdrSettingsAuthenticationHeaderKeyValues(v
 			continue
 		}
 		transformed = append(transformed, map[string]interface{}{
-			"key":   flattenChronicleFeedDetailsCortexXdrSettingsAuthenticationHeaderKeyValuesKey(original["key"], d, config),
-			"value": flattenChronicleFeedDetailsCortexXdrSettingsAuthenticationHeaderKeyValuesValue(original["value"], d, config),
+			"key":              flattenChronicleFeedDetailsCortexXdrSettingsAuthenticationHeaderKeyValuesKey(original["key"], d, config),
+			"value":            flattenChronicleFeedDetailsCortexXdrSettingsAuthenticationHeaderKeyValuesValue(original["value"], d, config),
+			"value_wo_version": d.Get(fmt.Sprintf("details.0.cortex_xdr_settings.0.authentication.0.header_key_values.%d.value_wo_version", i)),
 		})
 	}
 	return transformed
@@ -6237,6 +6703,8 @@ func flattenChronicleFeedDetailsCrowdstrikeAlertsSettingsAuthentication(v interf
 		flattenChronicleFeedDetailsCrowdstrikeAlertsSettingsAuthenticationClientId(original["clientId"], d, config)
 	transformed["client_secret"] =
 		flattenChronicleFeedDetailsCrowdstrikeAlertsSettingsAuthenticationClientSecret(original["clientSecret"], d, config)
+	transformed["client_secret_wo_version"] =
+		flattenChronicleFeedDetailsCrowdstrikeAlertsSettingsAuthenticationClientSecretWoVersion(original["clientSecretWoVersion"], d, config)
 	transformed["token_endpoint"] =
 		flattenChronicleFeedDetailsCrowdstrikeAlertsSettingsAuthenticationTokenEndpoint(original["tokenEndpoint"], d, config)
 	return []interface{}{transformed}
@@ -6249,6 +6717,10 @@ func flattenChronicleFeedDetailsCrowdstrikeAlertsSettingsAuthenticationClientSec
 	return v
 }
 
+func flattenChronicleFeedDetailsCrowdstrikeAlertsSettingsAuthenticationClientSecretWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("details.0.crowdstrike_alerts_settings.0.authentication.0.client_secret_wo_version")
+}
+
 func flattenChronicleFeedDetailsCrowdstrikeAlertsSettingsAuthenticationTokenEndpoint(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -6291,6 +6763,8 @@ func flattenChronicleFeedDetailsCrowdstrikeDetectsSettingsAuthentication(v inter
 		flattenChronicleFeedDetailsCrowdstrikeDetectsSettingsAuthenticationClientId(original["clientId"], d, config)
 	transformed["client_secret"] =
 		flattenChronicleFeedDetailsCrowdstrikeDetectsSettingsAuthenticationClientSecret(original["clientSecret"], d, config)
+	transformed["client_secret_wo_version"] =
+		flattenChronicleFeedDetailsCrowdstrikeDetectsSettingsAuthenticationClientSecretWoVersion(original["clientSecretWoVersion"], d, config)
 	transformed["token_endpoint"] =
 		flattenChronicleFeedDetailsCrowdstrikeDetectsSettingsAuthenticationTokenEndpoint(original["tokenEndpoint"], d, config)
 	return []interface{}{transformed}
@@ -6303,6 +6777,10 @@ func flattenChronicleFeedDetailsCrowdstrikeDetectsSettingsAuthenticationClientSe
 	return v
 }
 
+func flattenChronicleFeedDetailsCrowdstrikeDetectsSettingsAuthenticationClientSecretWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("details.0.crowdstrike_detects_settings.0.authentication.0.client_secret_wo_version")
+}
+
 func flattenChronicleFeedDetailsCrowdstrikeDetectsSettingsAuthenticationTokenEndpoint(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -6361,8 +6839,9 @@ func flattenChronicleFeedDetailsDummyLogTypeSettingsAuthenticationHeaderKeyValue
 			continue
 		}
 		transformed = append(transformed, map[string]interface{}{
-			"key":   flattenChronicleFeedDetailsDummyLogTypeSettingsAuthenticationHeaderKeyValuesKey(original["key"], d, config),
-			"value": flattenChronicleFeedDetailsDummyLogTypeSettingsAuthenticationHeaderKeyValuesValue(original["value"], d, config),
+			"key":              flattenChronicleFeedDetailsDummyLogTypeSettingsAuthenticationHeaderKeyValuesKey(original["key"], d, config),
+			"value":            flattenChronicleFeedDetailsDummyLogTypeSettingsAuthenticationHeaderKeyValuesValue(original["value"], d, config),
+			"value_wo_version": d.Get(fmt.Sprintf("details.0.dummy_log_type_settings.0.authentication.0.header_key_values.%d.value_wo_version", i)),
 		})
 	}
 	return transformed
@@ -6401,6 +6880,8 @@ func flattenChronicleFeedDetailsDuoAuthSettingsAuthentication(v interface{}, d *
 	transformed := make(map[string]interface{})
 	transformed["secret"] =
 		flattenChronicleFeedDetailsDuoAuthSettingsAuthenticationSecret(original["secret"], d, config)
+	transformed["secret_wo_version"] =
+		flattenChronicleFeedDetailsDuoAuthSettingsAuthenticationSecretWoVersion(original["secretWoVersion"], d, config)
 	transformed["user"] =
 		flattenChronicleFeedDetailsDuoAuthSettingsAuthenticationUser(original["user"], d, config)
 	return []interface{}{transformed}
@@ -6409,6 +6890,10 @@ func flattenChronicleFeedDetailsDuoAuthSettingsAuthenticationSecret(v interface{
 	return v
 }
 
+func flattenChronicleFeedDetailsDuoAuthSettingsAuthenticationSecretWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("details.0.duo_auth_settings.0.authentication.0.secret_wo_version")
+}
+
 func flattenChronicleFeedDetailsDuoAuthSettingsAuthenticationUser(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -6443,6 +6928,8 @@ func flattenChronicleFeedDetailsDuoUserContextSettingsAuthentication(v interface
 	transformed := make(map[string]interface{})
 	transformed["secret"] =
 		flattenChronicleFeedDetailsDuoUserContextSettingsAuthenticationSecret(original["secret"], d, config)
+	transformed["secret_wo_version"] =
+		flattenChronicleFeedDetailsDuoUserContextSettingsAuthenticationSecretWoVersion(original["secretWoVersion"], d, config)
 	transformed["user"] =
 		flattenChronicleFeedDetailsDuoUserContextSettingsAuthenticationUser(original["user"], d, config)
 	return []interface{}{transformed}
@@ -6451,6 +6938,10 @@ func flattenChronicleFeedDetailsDuoUserContextSettingsAuthenticationSecret(v int
 	return v
 }
 
+func flattenChronicleFeedDetailsDuoUserContextSettingsAuthenticationSecretWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("details.0.duo_user_context_settings.0.authentication.0.secret_wo_version")
+}
+
 func flattenChronicleFeedDetailsDuoUserContextSettingsAuthenticationUser(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -6493,6 +6984,8 @@ func flattenChronicleFeedDetailsFoxItStixSettingsAuthentication(v interface{}, d
 	transformed := make(map[string]interface{})
 	transformed["secret"] =
 		flattenChronicleFeedDetailsFoxItStixSettingsAuthenticationSecret(original["secret"], d, config)
+	transformed["secret_wo_version"] =
+		flattenChronicleFeedDetailsFoxItStixSettingsAuthenticationSecretWoVersion(original["secretWoVersion"], d, config)
 	transformed["user"] =
 		flattenChronicleFeedDetailsFoxItStixSettingsAuthenticationUser(original["user"], d, config)
 	return []interface{}{transformed}
@@ -6501,6 +6994,10 @@ func flattenChronicleFeedDetailsFoxItStixSettingsAuthenticationSecret(v interfac
 	return v
 }
 
+func flattenChronicleFeedDetailsFoxItStixSettingsAuthenticationSecretWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("details.0.fox_it_stix_settings.0.authentication.0.secret_wo_version")
+}
+
 func flattenChronicleFeedDetailsFoxItStixSettingsAuthenticationUser(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -6524,18 +7021,30 @@ func flattenChronicleFeedDetailsFoxItStixSettingsSsl(v interface{}, d *schema.Re
 	transformed := make(map[string]interface{})
 	transformed["encoded_private_key"] =
 		flattenChronicleFeedDetailsFoxItStixSettingsSslEncodedPrivateKey(original["encodedPrivateKey"], d, config)
+	transformed["encoded_private_key_wo_version"] =
+		flattenChronicleFeedDetailsFoxItStixSettingsSslEncodedPrivateKeyWoVersion(original["encodedPrivateKeyWoVersion"], d, config)
 	transformed["ssl_certificate"] =
 		flattenChronicleFeedDetailsFoxItStixSettingsSslSslCertificate(original["sslCertificate"], d, config)
+	transformed["ssl_certificate_wo_version"] =
+		flattenChronicleFeedDetailsFoxItStixSettingsSslSslCertificateWoVersion(original["sslCertificateWoVersion"], d, config)
 	return []interface{}{transformed}
 }
 func flattenChronicleFeedDetailsFoxItStixSettingsSslEncodedPrivateKey(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
 
+func flattenChronicleFeedDetailsFoxItStixSettingsSslEncodedPrivateKeyWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("details.0.fox_it_stix_settings.0.ssl.0.encoded_private_key_wo_version")
+}
+
 func flattenChronicleFeedDetailsFoxItStixSettingsSslSslCertificate(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
 
+func flattenChronicleFeedDetailsFoxItStixSettingsSslSslCertificateWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("details.0.fox_it_stix_settings.0.ssl.0.ssl_certificate_wo_version")
+}
+
 func flattenChronicleFeedDetailsGcsSettings(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	if v == nil {
 		return nil
@@ -6689,12 +7198,18 @@ func flattenChronicleFeedDetailsGoogleCloudIdentityDeviceUsersSettingsAuthentica
 	transformed := make(map[string]interface{})
 	transformed["private_key"] =
 		flattenChronicleFeedDetailsGoogleCloudIdentityDeviceUsersSettingsAuthenticationRsCredentialsPrivateKey(original["privateKey"], d, config)
+	transformed["private_key_wo_version"] =
+		flattenChronicleFeedDetailsGoogleCloudIdentityDeviceUsersSettingsAuthenticationRsCredentialsPrivateKeyWoVersion(original["privateKeyWoVersion"], d, config)
 	return []interface{}{transformed}
 }
 func flattenChronicleFeedDetailsGoogleCloudIdentityDeviceUsersSettingsAuthenticationRsCredentialsPrivateKey(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
 
+func flattenChronicleFeedDetailsGoogleCloudIdentityDeviceUsersSettingsAuthenticationRsCredentialsPrivateKeyWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("details.0.google_cloud_identity_device_users_settings.0.authentication.0.rs_credentials.0.private_key_wo_version")
+}
+
 func flattenChronicleFeedDetailsGoogleCloudIdentityDeviceUsersSettingsAuthenticationTokenEndpoint(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -6775,12 +7290,18 @@ func flattenChronicleFeedDetailsGoogleCloudIdentityDevicesSettingsAuthentication
 	transformed := make(map[string]interface{})
 	transformed["private_key"] =
 		flattenChronicleFeedDetailsGoogleCloudIdentityDevicesSettingsAuthenticationRsCredentialsPrivateKey(original["privateKey"], d, config)
+	transformed["private_key_wo_version"] =
+		flattenChronicleFeedDetailsGoogleCloudIdentityDevicesSettingsAuthenticationRsCredentialsPrivateKeyWoVersion(original["privateKeyWoVersion"], d, config)
 	return []interface{}{transformed}
 }
 func flattenChronicleFeedDetailsGoogleCloudIdentityDevicesSettingsAuthenticationRsCredentialsPrivateKey(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
 
+func flattenChronicleFeedDetailsGoogleCloudIdentityDevicesSettingsAuthenticationRsCredentialsPrivateKeyWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("details.0.google_cloud_identity_devices_settings.0.authentication.0.rs_credentials.0.private_key_wo_version")
+}
+
 func flattenChronicleFeedDetailsGoogleCloudIdentityDevicesSettingsAuthenticationTokenEndpoint(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -6959,8 +7480,9 @@ func flattenChronicleFeedDetailsImpervaWafSettingsAuthenticationHeaderKeyValues(
 			continue
 		}
 		transformed = append(transformed, map[string]interface{}{
-			"key":   flattenChronicleFeedDetailsImpervaWafSettingsAuthenticationHeaderKeyValuesKey(original["key"], d, config),
-			"value": flattenChronicleFeedDetailsImpervaWafSettingsAuthenticationHeaderKeyValuesValue(original["value"], d, config),
+			"key":              flattenChronicleFeedDetailsImpervaWafSettingsAuthenticationHeaderKeyValuesKey(original["key"], d, config),
+			"value":            flattenChronicleFeedDetailsImpervaWafSettingsAuthenticationHeaderKeyValuesValue(original["value"], d, config),
+			"value_wo_version": d.Get(fmt.Sprintf("details.0.imperva_waf_settings.0.authentication.0.header_key_values.%d.value_wo_version", i)),
 		})
 	}
 	return transformed
@@ -7023,8 +7545,9 @@ func flattenChronicleFeedDetailsMandiantIocSettingsAuthenticationHeaderKeyValues
 			continue
 		}
 		transformed = append(transformed, map[string]interface{}{
-			"key":   flattenChronicleFeedDetailsMandiantIocSettingsAuthenticationHeaderKeyValuesKey(original["key"], d, config),
-			"value": flattenChronicleFeedDetailsMandiantIocSettingsAuthenticationHeaderKeyValuesValue(original["value"], d, config),
+			"key":              flattenChronicleFeedDetailsMandiantIocSettingsAuthenticationHeaderKeyValuesKey(original["key"], d, config),
+			"value":            flattenChronicleFeedDetailsMandiantIocSettingsAuthenticationHeaderKeyValuesValue(original["value"], d, config),
+			"value_wo_version": d.Get(fmt.Sprintf("details.0.mandiant_ioc_settings.0.authentication.0.header_key_values.%d.value_wo_version", i)),
 		})
 	}
 	return transformed
@@ -7077,6 +7600,8 @@ func flattenChronicleFeedDetailsMicrosoftGraphAlertSettingsAuthentication(v inte
 		flattenChronicleFeedDetailsMicrosoftGraphAlertSettingsAuthenticationClientId(original["clientId"], d, config)
 	transformed["client_secret"] =
 		flattenChronicleFeedDetailsMicrosoftGraphAlertSettingsAuthenticationClientSecret(original["clientSecret"], d, config)
+	transformed["client_secret_wo_version"] =
+		flattenChronicleFeedDetailsMicrosoftGraphAlertSettingsAuthenticationClientSecretWoVersion(original["clientSecretWoVersion"], d, config)
 	return []interface{}{transformed}
 }
 func flattenChronicleFeedDetailsMicrosoftGraphAlertSettingsAuthenticationClientId(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -7087,6 +7612,10 @@ func flattenChronicleFeedDetailsMicrosoftGraphAlertSettingsAuthenticationClientS
 	return v
 }
 
+func flattenChronicleFeedDetailsMicrosoftGraphAlertSettingsAuthenticationClientSecretWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("details.0.microsoft_graph_alert_settings.0.authentication.0.client_secret_wo_version")
+}
+
 func flattenChronicleFeedDetailsMicrosoftGraphAlertSettingsHostname(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -7133,6 +7662,8 @@ func flattenChronicleFeedDetailsMicrosoftSecurityCenterAlertSettingsAuthenticati
 		flattenChronicleFeedDetailsMicrosoftSecurityCenterAlertSettingsAuthenticationClientId(original["clientId"], d, config)
 	transformed["client_secret"] =
 		flattenChronicleFeedDetailsMicrosoftSecurityCenterAlertSettingsAuthenticationClientSecret(original["clientSecret"], d, config)
+	transformed["client_secret_wo_version"] =
+		flattenChronicleFeedDetailsMicrosoftSecurityCenterAlertSettingsAuthenticationClientSecretWoVersion(original["clientSecretWoVersion"], d, config)
 	return []interface{}{transformed}
 }
 func flattenChronicleFeedDetailsMicrosoftSecurityCenterAlertSettingsAuthenticationClientId(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -7143,6 +7674,10 @@ func flattenChronicleFeedDetailsMicrosoftSecurityCenterAlertSettingsAuthenticati
 	return v
 }
 
+func flattenChronicleFeedDetailsMicrosoftSecurityCenterAlertSettingsAuthenticationClientSecretWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("details.0.microsoft_security_center_alert_settings.0.authentication.0.client_secret_wo_version")
+}
+
 func flattenChronicleFeedDetailsMicrosoftSecurityCenterAlertSettingsHostname(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -7197,8 +7732,9 @@ func flattenChronicleFeedDetailsMimecastMailSettingsAuthenticationHeaderKeyValue
 			continue
 		}
 		transformed = append(transformed, map[string]interface{}{
-			"key":   flattenChronicleFeedDetailsMimecastMailSettingsAuthenticationHeaderKeyValuesKey(original["key"], d, config),
-			"value": flattenChronicleFeedDetailsMimecastMailSettingsAuthenticationHeaderKeyValuesValue(original["value"], d, config),
+			"key":              flattenChronicleFeedDetailsMimecastMailSettingsAuthenticationHeaderKeyValuesKey(original["key"], d, config),
+			"value":            flattenChronicleFeedDetailsMimecastMailSettingsAuthenticationHeaderKeyValuesValue(original["value"], d, config),
+			"value_wo_version": d.Get(fmt.Sprintf("details.0.mimecast_mail_settings.0.authentication.0.header_key_values.%d.value_wo_version", i)),
 		})
 	}
 	return transformed
@@ -7241,6 +7777,8 @@ func flattenChronicleFeedDetailsMimecastMailV2SettingsAuthCredentials(v interfac
 		flattenChronicleFeedDetailsMimecastMailV2SettingsAuthCredentialsClientId(original["clientId"], d, config)
 	transformed["client_secret"] =
 		flattenChronicleFeedDetailsMimecastMailV2SettingsAuthCredentialsClientSecret(original["clientSecret"], d, config)
+	transformed["client_secret_wo_version"] =
+		flattenChronicleFeedDetailsMimecastMailV2SettingsAuthCredentialsClientSecretWoVersion(original["clientSecretWoVersion"], d, config)
 	return []interface{}{transformed}
 }
 func flattenChronicleFeedDetailsMimecastMailV2SettingsAuthCredentialsClientId(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -7251,6 +7789,10 @@ func flattenChronicleFeedDetailsMimecastMailV2SettingsAuthCredentialsClientSecre
 	return v
 }
 
+func flattenChronicleFeedDetailsMimecastMailV2SettingsAuthCredentialsClientSecretWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("details.0.mimecast_mail_v2_settings.0.auth_credentials.0.client_secret_wo_version")
+}
+
 func flattenChronicleFeedDetailsNetskopeAlertSettings(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	if v == nil {
 		return nil
@@ -7297,8 +7839,9 @@ func flattenChronicleFeedDetailsNetskopeAlertSettingsAuthenticationHeaderKeyValu
 			continue
 		}
 		transformed = append(transformed, map[string]interface{}{
-			"key":   flattenChronicleFeedDetailsNetskopeAlertSettingsAuthenticationHeaderKeyValuesKey(original["key"], d, config),
-			"value": flattenChronicleFeedDetailsNetskopeAlertSettingsAuthenticationHeaderKeyValuesValue(original["value"], d, config),
+			"key":              flattenChronicleFeedDetailsNetskopeAlertSettingsAuthenticationHeaderKeyValuesKey(original["key"], d, config),
+			"value":            flattenChronicleFeedDetailsNetskopeAlertSettingsAuthenticationHeaderKeyValuesValue(original["value"], d, config),
+			"value_wo_version": d.Get(fmt.Sprintf("details.0.netskope_alert_settings.0.authentication.0.header_key_values.%d.value_wo_version", i)),
 		})
 	}
 	return transformed
@@ -7369,8 +7912,9 @@ func flattenChronicleFeedDetailsNetskopeAlertV2SettingsAuthenticationHeaderKeyVa
 			continue
 		}
 		transformed = append(transformed, map[string]interface{}{
-			"key":   flattenChronicleFeedDetailsNetskopeAlertV2SettingsAuthenticationHeaderKeyValuesKey(original["key"], d, config),
-			"value": flattenChronicleFeedDetailsNetskopeAlertV2SettingsAuthenticationHeaderKeyValuesValue(original["value"], d, config),
+			"key":              flattenChronicleFeedDetailsNetskopeAlertV2SettingsAuthenticationHeaderKeyValuesKey(original["key"], d, config),
+			"value":            flattenChronicleFeedDetailsNetskopeAlertV2SettingsAuthenticationHeaderKeyValuesValue(original["value"], d, config),
+			"value_wo_version": d.Get(fmt.Sprintf("details.0.netskope_alert_v2_settings.0.authentication.0.header_key_values.%d.value_wo_version", i)),
 		})
 	}
 	return transformed
@@ -7433,6 +7977,8 @@ func flattenChronicleFeedDetailsOffice365SettingsAuthentication(v interface{}, d
 		flattenChronicleFeedDetailsOffice365SettingsAuthenticationClientId(original["clientId"], d, config)
 	transformed["client_secret"] =
 		flattenChronicleFeedDetailsOffice365SettingsAuthenticationClientSecret(original["clientSecret"], d, config)
+	transformed["client_secret_wo_version"] =
+		flattenChronicleFeedDetailsOffice365SettingsAuthenticationClientSecretWoVersion(original["clientSecretWoVersion"], d, config)
 	return []interface{}{transformed}
 }
 func flattenChronicleFeedDetailsOffice365SettingsAuthenticationClientId(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -7443,6 +7989,10 @@ func flattenChronicleFeedDetailsOffice365SettingsAuthenticationClientSecret(v in
 	return v
 }
 
+func flattenChronicleFeedDetailsOffice365SettingsAuthenticationClientSecretWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("details.0.office365_settings.0.authentication.0.client_secret_wo_version")
+}
+
 func flattenChronicleFeedDetailsOffice365SettingsContentType(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -7497,8 +8047,9 @@ func flattenChronicleFeedDetailsOktaSettingsAuthenticationHeaderKeyValues(v inte
 			continue
 		}
 		transformed = append(transformed, map[string]interface{}{
-			"key":   flattenChronicleFeedDetailsOktaSettingsAuthenticationHeaderKeyValuesKey(original["key"], d, config),
-			"value": flattenChronicleFeedDetailsOktaSettingsAuthenticationHeaderKeyValuesValue(original["value"], d, config),
+			"key":              flattenChronicleFeedDetailsOktaSettingsAuthenticationHeaderKeyValuesKey(original["key"], d, config),
+			"value":            flattenChronicleFeedDetailsOktaSettingsAuthenticationHeaderKeyValuesValue(original["value"], d, config),
+			"value_wo_version": d.Get(fmt.Sprintf("details.0.okta_settings.0.authentication.0.header_key_values.%d.value_wo_version", i)),
 		})
 	}
 	return transformed
@@ -7559,8 +8110,9 @@ func flattenChronicleFeedDetailsOktaUserContextSettingsAuthenticationHeaderKeyVa
 			continue
 		}
 		transformed = append(transformed, map[string]interface{}{
-			"key":   flattenChronicleFeedDetailsOktaUserContextSettingsAuthenticationHeaderKeyValuesKey(original["key"], d, config),
-			"value": flattenChronicleFeedDetailsOktaUserContextSettingsAuthenticationHeaderKeyValuesValue(original["value"], d, config),
+			"key":              flattenChronicleFeedDetailsOktaUserContextSettingsAuthenticationHeaderKeyValuesKey(original["key"], d, config),
+			"value":            flattenChronicleFeedDetailsOktaUserContextSettingsAuthenticationHeaderKeyValuesValue(original["value"], d, config),
+			"value_wo_version": d.Get(fmt.Sprintf("details.0.okta_user_context_settings.0.authentication.0.header_key_values.%d.value_wo_version", i)),
 		})
 	}
 	return transformed
@@ -7625,8 +8177,9 @@ func flattenChronicleFeedDetailsPanIocSettingsAuthenticationHeaderKeyValues(v in
 			continue
 		}
 		transformed = append(transformed, map[string]interface{}{
-			"key":   flattenChronicleFeedDetailsPanIocSettingsAuthenticationHeaderKeyValuesKey(original["key"], d, config),
-			"value": flattenChronicleFeedDetailsPanIocSettingsAuthenticationHeaderKeyValuesValue(original["value"], d, config),
+			"key":              flattenChronicleFeedDetailsPanIocSettingsAuthenticationHeaderKeyValuesKey(original["key"], d, config),
+			"value":            flattenChronicleFeedDetailsPanIocSettingsAuthenticationHeaderKeyValuesValue(original["value"], d, config),
+			"value_wo_version": d.Get(fmt.Sprintf("details.0.pan_ioc_settings.0.authentication.0.header_key_values.%d.value_wo_version", i)),
 		})
 	}
 	return transformed
@@ -7673,6 +8226,8 @@ func flattenChronicleFeedDetailsPanPrismaCloudSettingsAuthentication(v interface
 	transformed := make(map[string]interface{})
 	transformed["password"] =
 		flattenChronicleFeedDetailsPanPrismaCloudSettingsAuthenticationPassword(original["password"], d, config)
+	transformed["password_wo_version"] =
+		flattenChronicleFeedDetailsPanPrismaCloudSettingsAuthenticationPasswordWoVersion(original["passwordWoVersion"], d, config)
 	transformed["user"] =
 		flattenChronicleFeedDetailsPanPrismaCloudSettingsAuthenticationUser(original["user"], d, config)
 	return []interface{}{transformed}
@@ -7681,6 +8236,10 @@ func flattenChronicleFeedDetailsPanPrismaCloudSettingsAuthenticationPassword(v i
 	return v
 }
 
+func flattenChronicleFeedDetailsPanPrismaCloudSettingsAuthenticationPasswordWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("details.0.pan_prisma_cloud_settings.0.authentication.0.password_wo_version")
+}
+
 func flattenChronicleFeedDetailsPanPrismaCloudSettingsAuthenticationUser(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -7713,6 +8272,8 @@ func flattenChronicleFeedDetailsProofpointMailSettingsAuthentication(v interface
 	transformed := make(map[string]interface{})
 	transformed["secret"] =
 		flattenChronicleFeedDetailsProofpointMailSettingsAuthenticationSecret(original["secret"], d, config)
+	transformed["secret_wo_version"] =
+		flattenChronicleFeedDetailsProofpointMailSettingsAuthenticationSecretWoVersion(original["secretWoVersion"], d, config)
 	transformed["user"] =
 		flattenChronicleFeedDetailsProofpointMailSettingsAuthenticationUser(original["user"], d, config)
 	return []interface{}{transformed}
@@ -7721,6 +8282,10 @@ func flattenChronicleFeedDetailsProofpointMailSettingsAuthenticationSecret(v int
 	return v
 }
 
+func flattenChronicleFeedDetailsProofpointMailSettingsAuthenticationSecretWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("details.0.proofpoint_mail_settings.0.authentication.0.secret_wo_version")
+}
+
 func flattenChronicleFeedDetailsProofpointMailSettingsAuthenticationUser(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -7767,8 +8332,9 @@ func flattenChronicleFeedDetailsProofpointOnDemandSettingsAuthenticationHeaderKe
 			continue
 		}
 		transformed = append(transformed, map[string]interface{}{
-			"key":   flattenChronicleFeedDetailsProofpointOnDemandSettingsAuthenticationHeaderKeyValuesKey(original["key"], d, config),
-			"value": flattenChronicleFeedDetailsProofpointOnDemandSettingsAuthenticationHeaderKeyValuesValue(original["value"], d, config),
+			"key":              flattenChronicleFeedDetailsProofpointOnDemandSettingsAuthenticationHeaderKeyValuesKey(original["key"], d, config),
+			"value":            flattenChronicleFeedDetailsProofpointOnDemandSettingsAuthenticationHeaderKeyValuesValue(original["value"], d, config),
+			"value_wo_version": d.Get(fmt.Sprintf("details.0.proofpoint_on_demand_settings.0.authentication.0.header_key_values.%d.value_wo_version", i)),
 		})
 	}
 	return transformed
@@ -7834,6 +8400,8 @@ func flattenChronicleFeedDetailsQualysScanSettingsAuthentication(v interface{},
 	transformed := make(map[string]interface{})
 	transformed["secret"] =
 		flattenChronicleFeedDetailsQualysScanSettingsAuthenticationSecret(original["secret"], d, config)
+	transformed["secret_wo_version"] =
+		flattenChronicleFeedDetailsQualysScanSettingsAuthenticationSecretWoVersion(original["secretWoVersion"], d, config)
 	transformed["user"] =
 		flattenChronicleFeedDetailsQualysScanSettingsAuthenticationUser(original["user"], d, config)
 	return []interface{}{transformed}
@@ -7842,6 +8410,10 @@ func flattenChronicleFeedDetailsQualysScanSettingsAuthenticationSecret(v interfa
 	return v
 }
 
+func flattenChronicleFeedDetailsQualysScanSettingsAuthenticationSecretWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("details.0.qualys_scan_settings.0.authentication.0.secret_wo_version")
+}
+
 func flattenChronicleFeedDetailsQualysScanSettingsAuthenticationUser(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -7876,6 +8448,8 @@ func flattenChronicleFeedDetailsQualysVmSettingsAuthentication(v interface{}, d
 	transformed := make(map[string]interface{})
 	transformed["secret"] =
 		flattenChronicleFeedDetailsQualysVmSettingsAuthenticationSecret(original["secret"], d, config)
+	transformed["secret_wo_version"] =
+		flattenChronicleFeedDetailsQualysVmSettingsAuthenticationSecretWoVersion(original["secretWoVersion"], d, config)
 	transformed["user"] =
 		flattenChronicleFeedDetailsQualysVmSettingsAuthenticationUser(original["user"], d, config)
 	return []interface{}{transformed}
@@ -7884,6 +8458,10 @@ func flattenChronicleFeedDetailsQualysVmSettingsAuthenticationSecret(v interface
 	return v
 }
 
+func flattenChronicleFeedDetailsQualysVmSettingsAuthenticationSecretWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("details.0.qualys_vm_settings.0.authentication.0.secret_wo_version")
+}
+
 func flattenChronicleFeedDetailsQualysVmSettingsAuthenticationUser(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -7936,8 +8514,9 @@ func flattenChronicleFeedDetailsRapid7InsightSettingsAuthenticationHeaderKeyValu
 			continue
 		}
 		transformed = append(transformed, map[string]interface{}{
-			"key":   flattenChronicleFeedDetailsRapid7InsightSettingsAuthenticationHeaderKeyValuesKey(original["key"], d, config),
-			"value": flattenChronicleFeedDetailsRapid7InsightSettingsAuthenticationHeaderKeyValuesValue(original["value"], d, config),
+			"key":              flattenChronicleFeedDetailsRapid7InsightSettingsAuthenticationHeaderKeyValuesKey(original["key"], d, config),
+			"value":            flattenChronicleFeedDetailsRapid7InsightSettingsAuthenticationHeaderKeyValuesValue(original["value"], d, config),
+			"value_wo_version": d.Get(fmt.Sprintf("details.0.rapid7_insight_settings.0.authentication.0.header_key_values.%d.value_wo_version", i)),
 		})
 	}
 	return transformed
@@ -7998,8 +8577,9 @@ func flattenChronicleFeedDetailsRecordedFutureIocSettingsAuthenticationHeaderKey
 			continue
 		}
 		transformed = append(transformed, map[string]interface{}{
-			"key":   flattenChronicleFeedDetailsRecordedFutureIocSettingsAuthenticationHeaderKeyValuesKey(original["key"], d, config),
-			"value": flattenChronicleFeedDetailsRecordedFutureIocSettingsAuthenticationHeaderKeyValuesValue(original["value"], d, config),
+			"key":              flattenChronicleFeedDetailsRecordedFutureIocSettingsAuthenticationHeaderKeyValuesKey(original["key"], d, config),
+			"value":            flattenChronicleFeedDetailsRecordedFutureIocSettingsAuthenticationHeaderKeyValuesValue(original["value"], d, config),
+			"value_wo_version": d.Get(fmt.Sprintf("details.0.recorded_future_ioc_settings.0.authentication.0.header_key_values.%d.value_wo_version", i)),
 		})
 	}
 	return transformed
@@ -8038,6 +8618,8 @@ func flattenChronicleFeedDetailsRhIsacIocSettingsAuthentication(v interface{}, d
 		flattenChronicleFeedDetailsRhIsacIocSettingsAuthenticationClientId(original["clientId"], d, config)
 	transformed["client_secret"] =
 		flattenChronicleFeedDetailsRhIsacIocSettingsAuthenticationClientSecret(original["clientSecret"], d, config)
+	transformed["client_secret_wo_version"] =
+		flattenChronicleFeedDetailsRhIsacIocSettingsAuthenticationClientSecretWoVersion(original["clientSecretWoVersion"], d, config)
 	transformed["token_endpoint"] =
 		flattenChronicleFeedDetailsRhIsacIocSettingsAuthenticationTokenEndpoint(original["tokenEndpoint"], d, config)
 	return []interface{}{transformed}
@@ -8050,6 +8632,10 @@ func flattenChronicleFeedDetailsRhIsacIocSettingsAuthenticationClientSecret(v in
 	return v
 }
 
+func flattenChronicleFeedDetailsRhIsacIocSettingsAuthenticationClientSecretWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("details.0.rh_isac_ioc_settings.0.authentication.0.client_secret_wo_version")
+}
+
 func flattenChronicleFeedDetailsRhIsacIocSettingsAuthenticationTokenEndpoint(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -8132,12 +8718,18 @@ func flattenChronicleFeedDetailsSalesforceSettingsOauthJwtCredentialsRsCredentia
 	transformed := make(map[string]interface{})
 	transformed["private_key"] =
 		flattenChronicleFeedDetailsSalesforceSettingsOauthJwtCredentialsRsCredentialsPrivateKey(original["privateKey"], d, config)
+	transformed["private_key_wo_version"] =
+		flattenChronicleFeedDetailsSalesforceSettingsOauthJwtCredentialsRsCredentialsPrivateKeyWoVersion(original["privateKeyWoVersion"], d, config)
 	return []interface{}{transformed}
 }
 func flattenChronicleFeedDetailsSalesforceSettingsOauthJwtCredentialsRsCredentialsPrivateKey(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
 
+func flattenChronicleFeedDetailsSalesforceSettingsOauthJwtCredentialsRsCredentialsPrivateKeyWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("details.0.salesforce_settings.0.oauth_jwt_credentials.0.rs_credentials.0.private_key_wo_version")
+}
+
 func flattenChronicleFeedDetailsSalesforceSettingsOauthJwtCredentialsTokenEndpoint(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -8229,8 +8821,9 @@ func flattenChronicleFeedDetailsSentineloneAlertSettingsAuthenticationHeaderKeyV
 			continue
 		}
 		transformed = append(transformed, map[string]interface{}{
-			"key":   flattenChronicleFeedDetailsSentineloneAlertSettingsAuthenticationHeaderKeyValuesKey(original["key"], d, config),
-			"value": flattenChronicleFeedDetailsSentineloneAlertSettingsAuthenticationHeaderKeyValuesValue(original["value"], d, config),
+			"key":              flattenChronicleFeedDetailsSentineloneAlertSettingsAuthenticationHeaderKeyValuesKey(original["key"], d, config),
+			"value":            flattenChronicleFeedDetailsSentineloneAlertSettingsAuthenticationHeaderKeyValuesValue(original["value"], d, config),
+			"value_wo_version": d.Get(fmt.Sprintf("details.0.sentinelone_alert_settings.0.authentication.0.header_key_values.%d.value_wo_version", i)),
 		})
 	}
 	return transformed
@@ -8283,6 +8876,8 @@ func flattenChronicleFeedDetailsServiceNowCmdbSettingsAuthentication(v interface
 	transformed := make(map[string]interface{})
 	transformed["secret"] =
 		flattenChronicleFeedDetailsServiceNowCmdbSettingsAuthenticationSecret(original["secret"], d, config)
+	transformed["secret_wo_version"] =
+		flattenChronicleFeedDetailsServiceNowCmdbSettingsAuthenticationSecretWoVersion(original["secretWoVersion"], d, config)
 	transformed["user"] =
 		flattenChronicleFeedDetailsServiceNowCmdbSettingsAuthenticationUser(original["user"], d, config)
 	return []interface{}{transformed}
@@ -8291,6 +8886,10 @@ func flattenChronicleFeedDetailsServiceNowCmdbSettingsAuthenticationSecret(v int
 	return v
 }
 
+func flattenChronicleFeedDetailsServiceNowCmdbSettingsAuthenticationSecretWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("details.0.service_now_cmdb_settings.0.authentication.0.secret_wo_version")
+}
+
 func flattenChronicleFeedDetailsServiceNowCmdbSettingsAuthenticationUser(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -8333,8 +8932,12 @@ func flattenChronicleFeedDetailsSftpSettingsAuthentication(v interface{}, d *sch
 	transformed := make(map[string]interface{})
 	transformed["password"] =
 		flattenChronicleFeedDetailsSftpSettingsAuthenticationPassword(original["password"], d, config)
+	transformed["password_wo_version"] =
+		flattenChronicleFeedDetailsSftpSettingsAuthenticationPasswordWoVersion(original["passwordWoVersion"], d, config)
 	transformed["private_key"] =
 		flattenChronicleFeedDetailsSftpSettingsAuthenticationPrivateKey(original["privateKey"], d, config)
+	transformed["private_key_wo_version"] =
+		flattenChronicleFeedDetailsSftpSettingsAuthenticationPrivateKeyWoVersion(original["privateKeyWoVersion"], d, config)
 	transformed["private_key_passphrase"] =
 		flattenChronicleFeedDetailsSftpSettingsAuthenticationPrivateKeyPassphrase(original["privateKeyPassphrase"], d, config)
 	transformed["username"] =
@@ -8345,10 +8948,18 @@ func flattenChronicleFeedDetailsSftpSettingsAuthenticationPassword(v interface{}
 	return v
 }
 
+func flattenChronicleFeedDetailsSftpSettingsAuthenticationPasswordWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("details.0.sftp_settings.0.authentication.0.password_wo_version")
+}
+
 func flattenChronicleFeedDetailsSftpSettingsAuthenticationPrivateKey(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
 
+func flattenChronicleFeedDetailsSftpSettingsAuthenticationPrivateKeyWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("details.0.sftp_settings.0.authentication.0.private_key_wo_version")
+}
+
 func flattenChronicleFeedDetailsSftpSettingsAuthenticationPrivateKeyPassphrase(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -8399,6 +9010,8 @@ func flattenChronicleFeedDetailsSymantecEventExportSettingsAuthentication(v inte
 		flattenChronicleFeedDetailsSymantecEventExportSettingsAuthenticationClientId(original["clientId"], d, config)
 	transformed["client_secret"] =
 		flattenChronicleFeedDetailsSymantecEventExportSettingsAuthenticationClientSecret(original["clientSecret"], d, config)
+	transformed["client_secret_wo_version"] =
+		flattenChronicleFeedDetailsSymantecEventExportSettingsAuthenticationClientSecretWoVersion(original["clientSecretWoVersion"], d, config)
 	transformed["refresh_token"] =
 		flattenChronicleFeedDetailsSymantecEventExportSettingsAuthenticationRefreshToken(original["refreshToken"], d, config)
 	transformed["token_endpoint"] =
@@ -8413,6 +9026,10 @@ func flattenChronicleFeedDetailsSymantecEventExportSettingsAuthenticationClientS
 	return v
 }
 
+func flattenChronicleFeedDetailsSymantecEventExportSettingsAuthenticationClientSecretWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("details.0.symantec_event_export_settings.0.authentication.0.client_secret_wo_version")
+}
+
 func flattenChronicleFeedDetailsSymantecEventExportSettingsAuthenticationRefreshToken(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -8463,8 +9080,9 @@ func flattenChronicleFeedDetailsThinkstCanarySettingsAuthenticationHeaderKeyValu
 			continue
 		}
 		transformed = append(transformed, map[string]interface{}{
-			"key":   flattenChronicleFeedDetailsThinkstCanarySettingsAuthenticationHeaderKeyValuesKey(original["key"], d, config),
-			"value": flattenChronicleFeedDetailsThinkstCanarySettingsAuthenticationHeaderKeyValuesValue(original["value"], d, config),
+			"key":              flattenChronicleFeedDetailsThinkstCanarySettingsAuthenticationHeaderKeyValuesKey(original["key"], d, config),
+			"value":            flattenChronicleFeedDetailsThinkstCanarySettingsAuthenticationHeaderKeyValuesValue(original["value"], d, config),
+			"value_wo_version": d.Get(fmt.Sprintf("details.0.thinkst_canary_settings.0.authentication.0.header_key_values.%d.value_wo_version", i)),
 		})
 	}
 	return transformed
@@ -8509,6 +9127,8 @@ func flattenChronicleFeedDetailsThreatConnectIocSettingsAuthentication(v interfa
 	transformed := make(map[string]interface{})
 	transformed["secret"] =
 		flattenChronicleFeedDetailsThreatConnectIocSettingsAuthenticationSecret(original["secret"], d, config)
+	transformed["secret_wo_version"] =
+		flattenChronicleFeedDetailsThreatConnectIocSettingsAuthenticationSecretWoVersion(original["secretWoVersion"], d, config)
 	transformed["user"] =
 		flattenChronicleFeedDetailsThreatConnectIocSettingsAuthenticationUser(original["user"], d, config)
 	return []interface{}{transformed}
@@ -8517,6 +9137,10 @@ func flattenChronicleFeedDetailsThreatConnectIocSettingsAuthenticationSecret(v i
 	return v
 }
 
+func flattenChronicleFeedDetailsThreatConnectIocSettingsAuthenticationSecretWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("details.0.threat_connect_ioc_settings.0.authentication.0.secret_wo_version")
+}
+
 func flattenChronicleFeedDetailsThreatConnectIocSettingsAuthenticationUser(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -8563,6 +9187,8 @@ func flattenChronicleFeedDetailsThreatConnectIocV3SettingsAuthentication(v inter
 	transformed := make(map[string]interface{})
 	transformed["secret"] =
 		flattenChronicleFeedDetailsThreatConnectIocV3SettingsAuthenticationSecret(original["secret"], d, config)
+	transformed["secret_wo_version"] =
+		flattenChronicleFeedDetailsThreatConnectIocV3SettingsAuthenticationSecretWoVersion(original["secretWoVersion"], d, config)
 	transformed["user"] =
 		flattenChronicleFeedDetailsThreatConnectIocV3SettingsAuthenticationUser(original["user"], d, config)
 	return []interface{}{transformed}
@@ -8571,6 +9197,10 @@ func flattenChronicleFeedDetailsThreatConnectIocV3SettingsAuthenticationSecret(v
 	return v
 }
 
+func flattenChronicleFeedDetailsThreatConnectIocV3SettingsAuthenticationSecretWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("details.0.threat_connect_ioc_v3_settings.0.authentication.0.secret_wo_version")
+}
+
 func flattenChronicleFeedDetailsThreatConnectIocV3SettingsAuthenticationUser(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -8651,6 +9281,8 @@ func flattenChronicleFeedDetailsTrellixHxAlertsSettingsAuthenticationMsso(v inte
 		flattenChronicleFeedDetailsTrellixHxAlertsSettingsAuthenticationMssoApiEndpoint(original["apiEndpoint"], d, config)
 	transformed["password"] =
 		flattenChronicleFeedDetailsTrellixHxAlertsSettingsAuthenticationMssoPassword(original["password"], d, config)
+	transformed["password_wo_version"] =
+		flattenChronicleFeedDetailsTrellixHxAlertsSettingsAuthenticationMssoPasswordWoVersion(original["passwordWoVersion"], d, config)
 	transformed["username"] =
 		flattenChronicleFeedDetailsTrellixHxAlertsSettingsAuthenticationMssoUsername(original["username"], d, config)
 	return []interface{}{transformed}
@@ -8663,6 +9295,10 @@ func flattenChronicleFeedDetailsTrellixHxAlertsSettingsAuthenticationMssoPasswor
 	return v
 }
 
+func flattenChronicleFeedDetailsTrellixHxAlertsSettingsAuthenticationMssoPasswordWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("details.0.trellix_hx_alerts_settings.0.authentication.0.msso.0.password_wo_version")
+}
+
 func flattenChronicleFeedDetailsTrellixHxAlertsSettingsAuthenticationMssoUsername(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -8680,6 +9316,8 @@ func flattenChronicleFeedDetailsTrellixHxAlertsSettingsAuthenticationTrellixIam(
 		flattenChronicleFeedDetailsTrellixHxAlertsSettingsAuthenticationTrellixIamClientId(original["clientId"], d, config)
 	transformed["client_secret"] =
 		flattenChronicleFeedDetailsTrellixHxAlertsSettingsAuthenticationTrellixIamClientSecret(original["clientSecret"], d, config)
+	transformed["client_secret_wo_version"] =
+		flattenChronicleFeedDetailsTrellixHxAlertsSettingsAuthenticationTrellixIamClientSecretWoVersion(original["clientSecretWoVersion"], d, config)
 	transformed["scope"] =
 		flattenChronicleFeedDetailsTrellixHxAlertsSettingsAuthenticationTrellixIamScope(original["scope"], d, config)
 	return []interface{}{transformed}
@@ -8692,6 +9330,10 @@ func flattenChronicleFeedDetailsTrellixHxAlertsSettingsAuthenticationTrellixIamC
 	return v
 }
 
+func flattenChronicleFeedDetailsTrellixHxAlertsSettingsAuthenticationTrellixIamClientSecretWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("details.0.trellix_hx_alerts_settings.0.authentication.0.trellix_iam.0.client_secret_wo_version")
+}
+
 func flattenChronicleFeedDetailsTrellixHxAlertsSettingsAuthenticationTrellixIamScope(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -8743,6 +9385,8 @@ func flattenChronicleFeedDetailsTrellixHxBulkAcqsSettingsAuthenticationMsso(v in
 		flattenChronicleFeedDetailsTrellixHxBulkAcqsSettingsAuthenticationMssoApiEndpoint(original["apiEndpoint"], d, config)
 	transformed["password"] =
 		flattenChronicleFeedDetailsTrellixHxBulkAcqsSettingsAuthenticationMssoPassword(original["password"], d, config)
+	transformed["password_wo_version"] =
+		flattenChronicleFeedDetailsTrellixHxBulkAcqsSettingsAuthenticationMssoPasswordWoVersion(original["passwordWoVersion"], d, config)
 	transformed["username"] =
 		flattenChronicleFeedDetailsTrellixHxBulkAcqsSettingsAuthenticationMssoUsername(original["username"], d, config)
 	return []interface{}{transformed}
@@ -8755,6 +9399,10 @@ func flattenChronicleFeedDetailsTrellixHxBulkAcqsSettingsAuthenticationMssoPassw
 	return v
 }
 
+func flattenChronicleFeedDetailsTrellixHxBulkAcqsSettingsAuthenticationMssoPasswordWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("details.0.trellix_hx_bulk_acqs_settings.0.authentication.0.msso.0.password_wo_version")
+}
+
 func flattenChronicleFeedDetailsTrellixHxBulkAcqsSettingsAuthenticationMssoUsername(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -8772,6 +9420,8 @@ func flattenChronicleFeedDetailsTrellixHxBulkAcqsSettingsAuthenticationTrellixIa
 		flattenChronicleFeedDetailsTrellixHxBulkAcqsSettingsAuthenticationTrellixIamClientId(original["clientId"], d, config)
 	transformed["client_secret"] =
 		flattenChronicleFeedDetailsTrellixHxBulkAcqsSettingsAuthenticationTrellixIamClientSecret(original["clientSecret"], d, config)
+	transformed["client_secret_wo_version"] =
+		flattenChronicleFeedDetailsTrellixHxBulkAcqsSettingsAuthenticationTrellixIamClientSecretWoVersion(original["clientSecretWoVersion"], d, config)
 	transformed["scope"] =
 		flattenChronicleFeedDetailsTrellixHxBulkAcqsSettingsAuthenticationTrellixIamScope(original["scope"], d, config)
 	return []interface{}{transformed}
@@ -8784,6 +9434,10 @@ func flattenChronicleFeedDetailsTrellixHxBulkAcqsSettingsAuthenticationTrellixIa
 	return v
 }
 
+func flattenChronicleFeedDetailsTrellixHxBulkAcqsSettingsAuthenticationTrellixIamClientSecretWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("details.0.trellix_hx_bulk_acqs_settings.0.authentication.0.trellix_iam.0.client_secret_wo_version")
+}
+
 func flattenChronicleFeedDetailsTrellixHxBulkAcqsSettingsAuthenticationTrellixIamScope(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -8835,6 +9489,8 @@ func flattenChronicleFeedDetailsTrellixHxHostsSettingsAuthenticationMsso(v inter
 		flattenChronicleFeedDetailsTrellixHxHostsSettingsAuthenticationMssoApiEndpoint(original["apiEndpoint"], d, config)
 	transformed["password"] =
 		flattenChronicleFeedDetailsTrellixHxHostsSettingsAuthenticationMssoPassword(original["password"], d, config)
+	transformed["password_wo_version"] =
+		flattenChronicleFeedDetailsTrellixHxHostsSettingsAuthenticationMssoPasswordWoVersion(original["passwordWoVersion"], d, config)
 	transformed["username"] =
 		flattenChronicleFeedDetailsTrellixHxHostsSettingsAuthenticationMssoUsername(original["username"], d, config)
 	return []interface{}{transformed}
@@ -8847,6 +9503,10 @@ func flattenChronicleFeedDetailsTrellixHxHostsSettingsAuthenticationMssoPassword
 	return v
 }
 
+func flattenChronicleFeedDetailsTrellixHxHostsSettingsAuthenticationMssoPasswordWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("details.0.trellix_hx_hosts_settings.0.authentication.0.msso.0.password_wo_version")
+}
+
 func flattenChronicleFeedDetailsTrellixHxHostsSettingsAuthenticationMssoUsername(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -8864,6 +9524,8 @@ func flattenChronicleFeedDetailsTrellixHxHostsSettingsAuthenticationTrellixIam(v
 		flattenChronicleFeedDetailsTrellixHxHostsSettingsAuthenticationTrellixIamClientId(original["clientId"], d, config)
 	transformed["client_secret"] =
 		flattenChronicleFeedDetailsTrellixHxHostsSettingsAuthenticationTrellixIamClientSecret(original["clientSecret"], d, config)
+	transformed["client_secret_wo_version"] =
+		flattenChronicleFeedDetailsTrellixHxHostsSettingsAuthenticationTrellixIamClientSecretWoVersion(original["clientSecretWoVersion"], d, config)
 	transformed["scope"] =
 		flattenChronicleFeedDetailsTrellixHxHostsSettingsAuthenticationTrellixIamScope(original["scope"], d, config)
 	return []interface{}{transformed}
@@ -8876,6 +9538,10 @@ func flattenChronicleFeedDetailsTrellixHxHostsSettingsAuthenticationTrellixIamCl
 	return v
 }
 
+func flattenChronicleFeedDetailsTrellixHxHostsSettingsAuthenticationTrellixIamClientSecretWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("details.0.trellix_hx_hosts_settings.0.authentication.0.trellix_iam.0.client_secret_wo_version")
+}
+
 func flattenChronicleFeedDetailsTrellixHxHostsSettingsAuthenticationTrellixIamScope(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -8926,10 +9592,14 @@ func flattenChronicleFeedDetailsWorkdaySettingsAuthentication(v interface{}, d *
 		flattenChronicleFeedDetailsWorkdaySettingsAuthenticationClientId(original["clientId"], d, config)
 	transformed["client_secret"] =
 		flattenChronicleFeedDetailsWorkdaySettingsAuthenticationClientSecret(original["clientSecret"], d, config)
+	transformed["client_secret_wo_version"] =
+		flattenChronicleFeedDetailsWorkdaySettingsAuthenticationClientSecretWoVersion(original["clientSecretWoVersion"], d, config)
 	transformed["refresh_token"] =
 		flattenChronicleFeedDetailsWorkdaySettingsAuthenticationRefreshToken(original["refreshToken"], d, config)
 	transformed["secret"] =
 		flattenChronicleFeedDetailsWorkdaySettingsAuthenticationSecret(original["secret"], d, config)
+	transformed["secret_wo_version"] =
+		flattenChronicleFeedDetailsWorkdaySettingsAuthenticationSecretWoVersion(original["secretWoVersion"], d, config)
 	transformed["token_endpoint"] =
 		flattenChronicleFeedDetailsWorkdaySettingsAuthenticationTokenEndpoint(original["tokenEndpoint"], d, config)
 	transformed["user"] =
@@ -8944,6 +9614,10 @@ func flattenChronicleFeedDetailsWorkdaySettingsAuthenticationClientSecret(v inte
 	return v
 }
 
+func flattenChronicleFeedDetailsWorkdaySettingsAuthenticationClientSecretWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("details.0.workday_settings.0.authentication.0.client_secret_wo_version")
+}
+
 func flattenChronicleFeedDetailsWorkdaySettingsAuthenticationRefreshToken(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -8952,6 +9626,10 @@ func flattenChronicleFeedDetailsWorkdaySettingsAuthenticationSecret(v interface{
 	return v
 }
 
+func flattenChronicleFeedDetailsWorkdaySettingsAuthenticationSecretWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("details.0.workday_settings.0.authentication.0.secret_wo_version")
+}
+
 func flattenChronicleFeedDetailsWorkdaySettingsAuthenticationTokenEndpoint(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -9046,12 +9724,18 @@ func flattenChronicleFeedDetailsWorkspaceActivitySettingsAuthenticationRsCredent
 	transformed := make(map[string]interface{})
 	transformed["private_key"] =
 		flattenChronicleFeedDetailsWorkspaceActivitySettingsAuthenticationRsCredentialsPrivateKey(original["privateKey"], d, config)
+	transformed["private_key_wo_version"] =
+		flattenChronicleFeedDetailsWorkspaceActivitySettingsAuthenticationRsCredentialsPrivateKeyWoVersion(original["privateKeyWoVersion"], d, config)
 	return []interface{}{transformed}
 }
 func flattenChronicleFeedDetailsWorkspaceActivitySettingsAuthenticationRsCredentialsPrivateKey(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
 
+func flattenChronicleFeedDetailsWorkspaceActivitySettingsAuthenticationRsCredentialsPrivateKeyWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("details.0.workspace_activity_settings.0.authentication.0.rs_credentials.0.private_key_wo_version")
+}
+
 func flattenChronicleFeedDetailsWorkspaceActivitySettingsAuthenticationTokenEndpoint(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -9132,12 +9816,18 @@ func flattenChronicleFeedDetailsWorkspaceAlertsSettingsAuthenticationRsCredentia
 	transformed := make(map[string]interface{})
 	transformed["private_key"] =
 		flattenChronicleFeedDetailsWorkspaceAlertsSettingsAuthenticationRsCredentialsPrivateKey(original["privateKey"], d, config)
+	transformed["private_key_wo_version"] =
+		flattenChronicleFeedDetailsWorkspaceAlertsSettingsAuthenticationRsCredentialsPrivateKeyWoVersion(original["privateKeyWoVersion"], d, config)
 	return []interface{}{transformed}
 }
 func flattenChronicleFeedDetailsWorkspaceAlertsSettingsAuthenticationRsCredentialsPrivateKey(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
 
+func flattenChronicleFeedDetailsWorkspaceAlertsSettingsAuthenticationRsCredentialsPrivateKeyWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("details.0.workspace_alerts_settings.0.authentication.0.rs_credentials.0.private_key_wo_version")
+}
+
 func flattenChronicleFeedDetailsWorkspaceAlertsSettingsAuthenticationTokenEndpoint(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -9218,12 +9908,18 @@ func flattenChronicleFeedDetailsWorkspaceChromeOsSettingsAuthenticationRsCredent
 	transformed := make(map[string]interface{})
 	transformed["private_key"] =
 		flattenChronicleFeedDetailsWorkspaceChromeOsSettingsAuthenticationRsCredentialsPrivateKey(original["privateKey"], d, config)
+	transformed["private_key_wo_version"] =
+		flattenChronicleFeedDetailsWorkspaceChromeOsSettingsAuthenticationRsCredentialsPrivateKeyWoVersion(original["privateKeyWoVersion"], d, config)
 	return []interface{}{transformed}
 }
 func flattenChronicleFeedDetailsWorkspaceChromeOsSettingsAuthenticationRsCredentialsPrivateKey(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
 
+func flattenChronicleFeedDetailsWorkspaceChromeOsSettingsAuthenticationRsCredentialsPrivateKeyWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("details.0.workspace_chrome_os_settings.0.authentication.0.rs_credentials.0.private_key_wo_version")
+}
+
 func flattenChronicleFeedDetailsWorkspaceChromeOsSettingsAuthenticationTokenEndpoint(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -9304,12 +10000,18 @@ func flattenChronicleFeedDetailsWorkspaceGroupsSettingsAuthenticationRsCredentia
 	transformed := make(map[string]interface{})
 	transformed["private_key"] =
 		flattenChronicleFeedDetailsWorkspaceGroupsSettingsAuthenticationRsCredentialsPrivateKey(original["privateKey"], d, config)
+	transformed["private_key_wo_version"] =
+		flattenChronicleFeedDetailsWorkspaceGroupsSettingsAuthenticationRsCredentialsPrivateKeyWoVersion(original["privateKeyWoVersion"], d, config)
 	return []interface{}{transformed}
 }
 func flattenChronicleFeedDetailsWorkspaceGroupsSettingsAuthenticationRsCredentialsPrivateKey(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
 
+func flattenChronicleFeedDetailsWorkspaceGroupsSettingsAuthenticationRsCredentialsPrivateKeyWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("details.0.workspace_groups_settings.0.authentication.0.rs_credentials.0.private_key_wo_version")
+}
+
 func flattenChronicleFeedDetailsWorkspaceGroupsSettingsAuthenticationTokenEndpoint(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -9390,12 +10092,18 @@ func flattenChronicleFeedDetailsWorkspaceMobileSettingsAuthenticationRsCredentia
 	transformed := make(map[string]interface{})
 	transformed["private_key"] =
 		flattenChronicleFeedDetailsWorkspaceMobileSettingsAuthenticationRsCredentialsPrivateKey(original["privateKey"], d, config)
+	transformed["private_key_wo_version"] =
+		flattenChronicleFeedDetailsWorkspaceMobileSettingsAuthenticationRsCredentialsPrivateKeyWoVersion(original["privateKeyWoVersion"], d, config)
 	return []interface{}{transformed}
 }
 func flattenChronicleFeedDetailsWorkspaceMobileSettingsAuthenticationRsCredentialsPrivateKey(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
 
+func flattenChronicleFeedDetailsWorkspaceMobileSettingsAuthenticationRsCredentialsPrivateKeyWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("details.0.workspace_mobile_settings.0.authentication.0.rs_credentials.0.private_key_wo_version")
+}
+
 func flattenChronicleFeedDetailsWorkspaceMobileSettingsAuthenticationTokenEndpoint(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -9476,12 +10184,18 @@ func flattenChronicleFeedDetailsWorkspacePrivilegesSettingsAuthenticationRsCrede
 	transformed := make(map[string]interface{})
 	transformed["private_key"] =
 		flattenChronicleFeedDetailsWorkspacePrivilegesSettingsAuthenticationRsCredentialsPrivateKey(original["privateKey"], d, config)
+	transformed["private_key_wo_version"] =
+		flattenChronicleFeedDetailsWorkspacePrivilegesSettingsAuthenticationRsCredentialsPrivateKeyWoVersion(original["privateKeyWoVersion"], d, config)
 	return []interface{}{transformed}
 }
 func flattenChronicleFeedDetailsWorkspacePrivilegesSettingsAuthenticationRsCredentialsPrivateKey(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
 
+func flattenChronicleFeedDetailsWorkspacePrivilegesSettingsAuthenticationRsCredentialsPrivateKeyWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("details.0.workspace_privileges_settings.0.authentication.0.rs_credentials.0.private_key_wo_version")
+}
+
 func flattenChronicleFeedDetailsWorkspacePrivilegesSettingsAuthenticationTokenEndpoint(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -9564,12 +10278,18 @@ func flattenChronicleFeedDetailsWorkspaceUsersSettingsAuthenticationRsCredential
 	transformed := make(map[string]interface{})
 	transformed["private_key"] =
 		flattenChronicleFeedDetailsWorkspaceUsersSettingsAuthenticationRsCredentialsPrivateKey(original["privateKey"], d, config)
+	transformed["private_key_wo_version"] =
+		flattenChronicleFeedDetailsWorkspaceUsersSettingsAuthenticationRsCredentialsPrivateKeyWoVersion(original["privateKeyWoVersion"], d, config)
 	return []interface{}{transformed}
 }
 func flattenChronicleFeedDetailsWorkspaceUsersSettingsAuthenticationRsCredentialsPrivateKey(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
 
+func flattenChronicleFeedDetailsWorkspaceUsersSettingsAuthenticationRsCredentialsPrivateKeyWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("details.0.workspace_users_settings.0.authentication.0.rs_credentials.0.private_key_wo_version")
+}
+
 func flattenChronicleFeedDetailsWorkspaceUsersSettingsAuthenticationTokenEndpoint(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -10322,7 +11042,7 @@ func expandChronicleFeedDetailsAmazonS3SettingsAuthentication(v interface{}, d t
 		transformed["clientSecret"] = transformedClientSecret
 	}
 
-	transformedRefreshUri, err := expandChronicleFeedDetailsAmazonS3SettingsAuthenticationRefreshUri(original["refresh_uri"], d, config)
+	transformedRefreshUri, err := expandChronicleFeedDetailsAmazonS3SettingsAuthenticationRefreshUri(tpgresource.GetWriteOnlyValue(d, "details.0.amazon_s3_settings.0.authentication.0.refresh_uri", original["refresh_uri"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedRefreshUri); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -10480,7 +11200,7 @@ func expandChronicleFeedDetailsAmazonS3V2SettingsAuthenticationAccessKeySecretAu
 		transformed["accessKeyId"] = transformedAccessKeyId
 	}
 
-	transformedSecretAccessKey, err := expandChronicleFeedDetailsAmazonS3V2SettingsAuthenticationAccessKeySecretAuthSecretAccessKey(original["secret_access_key"], d, config)
+	transformedSecretAccessKey, err := expandChronicleFeedDetailsAmazonS3V2SettingsAuthenticationAccessKeySecretAuthSecretAccessKey(tpgresource.GetWriteOnlyValue(d, "details.0.amazon_s3_v2_settings.0.authentication.0.access_key_secret_auth.0.secret_access_key", original["secret_access_key"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedSecretAccessKey); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -10653,7 +11373,7 @@ func expandChronicleFeedDetailsAmazonSqsSettingsAuthenticationAdditionalS3Access
 		transformed["accessKeyId"] = transformedAccessKeyId
 	}
 
-	transformedSecretAccessKey, err := expandChronicleFeedDetailsAmazonSqsSettingsAuthenticationAdditionalS3AccessKeySecretAuthSecretAccessKey(original["secret_access_key"], d, config)
+	transformedSecretAccessKey, err := expandChronicleFeedDetailsAmazonSqsSettingsAuthenticationAdditionalS3AccessKeySecretAuthSecretAccessKey(tpgresource.GetWriteOnlyValue(d, "details.0.amazon_sqs_settings.0.authentication.0.additional_s3_access_key_secret_auth.0.secret_access_key", original["secret_access_key"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedSecretAccessKey); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -10690,7 +11410,7 @@ func expandChronicleFeedDetailsAmazonSqsSettingsAuthenticationSqsAccessKeySecret
 		transformed["accessKeyId"] = transformedAccessKeyId
 	}
 
-	transformedSecretAccessKey, err := expandChronicleFeedDetailsAmazonSqsSettingsAuthenticationSqsAccessKeySecretAuthSecretAccessKey(original["secret_access_key"], d, config)
+	transformedSecretAccessKey, err := expandChronicleFeedDetailsAmazonSqsSettingsAuthenticationSqsAccessKeySecretAuthSecretAccessKey(tpgresource.GetWriteOnlyValue(d, "details.0.amazon_sqs_settings.0.authentication.0.sqs_access_key_secret_auth.0.secret_access_key", original["secret_access_key"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedSecretAccessKey); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -10862,7 +11582,7 @@ func expandChronicleFeedDetailsAmazonSqsV2SettingsAuthenticationSqsV2AccessKeySe
 		transformed["accessKeyId"] = transformedAccessKeyId
 	}
 
-	transformedSecretAccessKey, err := expandChronicleFeedDetailsAmazonSqsV2SettingsAuthenticationSqsV2AccessKeySecretAuthSecretAccessKey(original["secret_access_key"], d, config)
+	transformedSecretAccessKey, err := expandChronicleFeedDetailsAmazonSqsV2SettingsAuthenticationSqsV2AccessKeySecretAuthSecretAccessKey(tpgresource.GetWriteOnlyValue(d, "details.0.amazon_sqs_v2_settings.0.authentication.0.sqs_v2_access_key_secret_auth.0.secret_access_key", original["secret_access_key"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedSecretAccessKey); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -10934,7 +11654,7 @@ func expandChronicleFeedDetailsAnomaliSettingsAuthentication(v interface{}, d tp
 	original := raw.(map[string]interface{})
 	transformed := make(map[string]interface{})
 
-	transformedSecret, err := expandChronicleFeedDetailsAnomaliSettingsAuthenticationSecret(original["secret"], d, config)
+	transformedSecret, err := expandChronicleFeedDetailsAnomaliSettingsAuthenticationSecret(tpgresource.GetWriteOnlyValue(d, "details.0.anomali_settings.0.authentication.0.secret", original["secret"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedSecret); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -10997,7 +11717,7 @@ func expandChronicleFeedDetailsAwsEc2HostsSettingsAuthentication(v interface{},
 	original := raw.(map[string]interface{})
 	transformed := make(map[string]interface{})
 
-	transformedSecret, err := expandChronicleFeedDetailsAwsEc2HostsSettingsAuthenticationSecret(original["secret"], d, config)
+	transformedSecret, err := expandChronicleFeedDetailsAwsEc2HostsSettingsAuthenticationSecret(tpgresource.GetWriteOnlyValue(d, "details.0.aws_ec2_hosts_settings.0.authentication.0.secret", original["secret"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedSecret); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -11056,7 +11776,7 @@ func expandChronicleFeedDetailsAwsEc2InstancesSettingsAuthentication(v interface
 	original := raw.(map[string]interface{})
 	transformed := make(map[string]interface{})
 
-	transformedSecret, err := expandChronicleFeedDetailsAwsEc2InstancesSettingsAuthenticationSecret(original["secret"], d, config)
+	transformedSecret, err := expandChronicleFeedDetailsAwsEc2InstancesSettingsAuthenticationSecret(tpgresource.GetWriteOnlyValue(d, "details.0.aws_ec2_instances_settings.0.authentication.0.secret", original["secret"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedSecret); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -11115,7 +11835,7 @@ func expandChronicleFeedDetailsAwsEc2VpcsSettingsAuthentication(v interface{}, d
 	original := raw.(map[string]interface{})
 	transformed := make(map[string]interface{})
 
-	transformedSecret, err := expandChronicleFeedDetailsAwsEc2VpcsSettingsAuthenticationSecret(original["secret"], d, config)
+	transformedSecret, err := expandChronicleFeedDetailsAwsEc2VpcsSettingsAuthenticationSecret(tpgresource.GetWriteOnlyValue(d, "details.0.aws_ec2_vpcs_settings.0.authentication.0.secret", original["secret"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedSecret); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -11185,7 +11905,7 @@ func expandChronicleFeedDetailsAwsIamSettingsAuthentication(v interface{}, d tpg
 	original := raw.(map[string]interface{})
 	transformed := make(map[string]interface{})
 
-	transformedSecret, err := expandChronicleFeedDetailsAwsIamSettingsAuthenticationSecret(original["secret"], d, config)
+	transformedSecret, err := expandChronicleFeedDetailsAwsIamSettingsAuthenticationSecret(tpgresource.GetWriteOnlyValue(d, "details.0.aws_iam_settings.0.authentication.0.secret", original["secret"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedSecret); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -11276,7 +11996,7 @@ func expandChronicleFeedDetailsAzureAdAuditSettingsAuthentication(v interface{},
 		transformed["clientId"] = transformedClientId
 	}
 
-	transformedClientSecret, err := expandChronicleFeedDetailsAzureAdAuditSettingsAuthenticationClientSecret(original["client_secret"], d, config)
+	transformedClientSecret, err := expandChronicleFeedDetailsAzureAdAuditSettingsAuthenticationClientSecret(tpgresource.GetWriteOnlyValue(d, "details.0.azure_ad_audit_settings.0.authentication.0.client_secret", original["client_secret"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedClientSecret); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -11382,7 +12102,7 @@ func expandChronicleFeedDetailsAzureAdContextSettingsAuthentication(v interface{
 		transformed["clientId"] = transformedClientId
 	}
 
-	transformedClientSecret, err := expandChronicleFeedDetailsAzureAdContextSettingsAuthenticationClientSecret(original["client_secret"], d, config)
+	transformedClientSecret, err := expandChronicleFeedDetailsAzureAdContextSettingsAuthenticationClientSecret(tpgresource.GetWriteOnlyValue(d, "details.0.azure_ad_context_settings.0.authentication.0.client_secret", original["client_secret"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedClientSecret); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -11482,7 +12202,7 @@ func expandChronicleFeedDetailsAzureAdSettingsAuthentication(v interface{}, d tp
 		transformed["clientId"] = transformedClientId
 	}
 
-	transformedClientSecret, err := expandChronicleFeedDetailsAzureAdSettingsAuthenticationClientSecret(original["client_secret"], d, config)
+	transformedClientSecret, err := expandChronicleFeedDetailsAzureAdSettingsAuthenticationClientSecret(tpgresource.GetWriteOnlyValue(d, "details.0.azure_ad_settings.0.authentication.0.client_secret", original["client_secret"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedClientSecret); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -11563,14 +12283,14 @@ func expandChronicleFeedDetailsAzureBlobStoreSettingsAuthentication(v interface{
 	original := raw.(map[string]interface{})
 	transformed := make(map[string]interface{})
 
-	transformedSasToken, err := expandChronicleFeedDetailsAzureBlobStoreSettingsAuthenticationSasToken(original["sas_token"], d, config)
+	transformedSasToken, err := expandChronicleFeedDetailsAzureBlobStoreSettingsAuthenticationSasToken(tpgresource.GetWriteOnlyValue(d, "details.0.azure_blob_store_settings.0.authentication.0.sas_token", original["sas_token"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedSasToken); val.IsValid() && !tpgresource.IsEmptyValue(val) {
 		transformed["sasToken"] = transformedSasToken
 	}
 
-	transformedSharedKey, err := expandChronicleFeedDetailsAzureBlobStoreSettingsAuthenticationSharedKey(original["shared_key"], d, config)
+	transformedSharedKey, err := expandChronicleFeedDetailsAzureBlobStoreSettingsAuthenticationSharedKey(tpgresource.GetWriteOnlyValue(d, "details.0.azure_blob_store_settings.0.authentication.0.shared_key", original["shared_key"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedSharedKey); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -11662,7 +12382,7 @@ func expandChronicleFeedDetailsAzureBlobStoreV2SettingsAuthentication(v interfac
 	original := raw.(map[string]interface{})
 	transformed := make(map[string]interface{})
 
-	transformedAccessKey, err := expandChronicleFeedDetailsAzureBlobStoreV2SettingsAuthenticationAccessKey(original["access_key"], d, config)
+	transformedAccessKey, err := expandChronicleFeedDetailsAzureBlobStoreV2SettingsAuthenticationAccessKey(tpgresource.GetWriteOnlyValue(d, "details.0.azure_blob_store_v2_settings.0.authentication.0.access_key", original["access_key"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedAccessKey); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -11676,7 +12396,7 @@ func expandChronicleFeedDetailsAzureBlobStoreV2SettingsAuthentication(v interfac
 		transformed["azureV2WorkloadIdentityFederation"] = transformedAzureV2WorkloadIdentityFederation
 	}
 
-	transformedSasToken, err := expandChronicleFeedDetailsAzureBlobStoreV2SettingsAuthenticationSasToken(original["sas_token"], d, config)
+	transformedSasToken, err := expandChronicleFeedDetailsAzureBlobStoreV2SettingsAuthenticationSasToken(tpgresource.GetWriteOnlyValue(d, "details.0.azure_blob_store_v2_settings.0.authentication.0.sas_token", original["sas_token"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedSasToken); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -11770,7 +12490,7 @@ func expandChronicleFeedDetailsAzureEventHubSettings(v interface{}, d tpgresourc
 	original := raw.(map[string]interface{})
 	transformed := make(map[string]interface{})
 
-	transformedAzureSasToken, err := expandChronicleFeedDetailsAzureEventHubSettingsAzureSasToken(original["azure_sas_token"], d, config)
+	transformedAzureSasToken, err := expandChronicleFeedDetailsAzureEventHubSettingsAzureSasToken(tpgresource.GetWriteOnlyValue(d, "details.0.azure_event_hub_settings.0.azure_sas_token", original["azure_sas_token"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedAzureSasToken); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -11916,7 +12636,7 @@ func expandChronicleFeedDetailsAzureMdmIntuneSettingsAuthentication(v interface{
 		transformed["clientId"] = transformedClientId
 	}
 
-	transformedClientSecret, err := expandChronicleFeedDetailsAzureMdmIntuneSettingsAuthenticationClientSecret(original["client_secret"], d, config)
+	transformedClientSecret, err := expandChronicleFeedDetailsAzureMdmIntuneSettingsAuthenticationClientSecret(tpgresource.GetWriteOnlyValue(d, "details.0.azure_mdm_intune_settings.0.authentication.0.client_secret", original["client_secret"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedClientSecret); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -11983,7 +12703,7 @@ func expandChronicleFeedDetailsCloudPassageSettingsAuthentication(v interface{},
 	original := raw.(map[string]interface{})
 	transformed := make(map[string]interface{})
 
-	transformedSecret, err := expandChronicleFeedDetailsCloudPassageSettingsAuthenticationSecret(original["secret"], d, config)
+	transformedSecret, err := expandChronicleFeedDetailsCloudPassageSettingsAuthenticationSecret(tpgresource.GetWriteOnlyValue(d, "details.0.cloud_passage_settings.0.authentication.0.secret", original["secret"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedSecret); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -12076,7 +12796,7 @@ func expandChronicleFeedDetailsCortexXdrSettingsAuthenticationHeaderKeyValues(v
 	}
 	l := v.([]interface{})
 	req := make([]interface{}, 0, len(l))
-	for _, raw := range l {
+	for i, raw := range l {
 		if raw == nil {
 			continue
 		}
@@ -12090,7 +12810,7 @@ func expandChronicleFeedDetailsCortexXdrSettingsAuthenticationHeaderKeyValues(v
 			transformed["key"] = transformedKey
 		}
 
-		transformedValue, err := expandChronicleFeedDetailsCortexXdrSettingsAuthenticationHeaderKeyValuesValue(original["value"], d, config)
+		transformedValue, err := expandChronicleFeedDetailsCortexXdrSettingsAuthenticationHeaderKeyValuesValue(tpgresource.GetWriteOnlyValue(d, fmt.Sprintf("details.0.cortex_xdr_settings.0.authentication.0.header_key_values.%d.value", i), original["value"]), d, config)
 		if err != nil {
 			return nil, err
 		} else if val := reflect.ValueOf(transformedValue); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -12173,7 +12893,7 @@ func expandChronicleFeedDetailsCrowdstrikeAlertsSettingsAuthentication(v interfa
 		transformed["clientId"] = transformedClientId
 	}
 
-	transformedClientSecret, err := expandChronicleFeedDetailsCrowdstrikeAlertsSettingsAuthenticationClientSecret(original["client_secret"], d, config)
+	transformedClientSecret, err := expandChronicleFeedDetailsCrowdstrikeAlertsSettingsAuthenticationClientSecret(tpgresource.GetWriteOnlyValue(d, "details.0.crowdstrike_alerts_settings.0.authentication.0.client_secret", original["client_secret"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedClientSecret); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -12265,7 +12985,7 @@ func expandChronicleFeedDetailsCrowdstrikeDetectsSettingsAuthentication(v interf
 		transformed["clientId"] = transformedClientId
 	}
 
-	transformedClientSecret, err := expandChronicleFeedDetailsCrowdstrikeDetectsSettingsAuthenticationClientSecret(original["client_secret"], d, config)
+	transformedClientSecret, err := expandChronicleFeedDetailsCrowdstrikeDetectsSettingsAuthenticationClientSecret(tpgresource.GetWriteOnlyValue(d, "details.0.crowdstrike_detects_settings.0.authentication.0.client_secret", original["client_secret"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedClientSecret); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -12363,7 +13083,7 @@ func expandChronicleFeedDetailsDummyLogTypeSettingsAuthenticationHeaderKeyValues
 	}
 	l := v.([]interface{})
 	req := make([]interface{}, 0, len(l))
-	for _, raw := range l {
+	for i, raw := range l {
 		if raw == nil {
 			continue
 		}
@@ -12377,7 +13097,7 @@ func expandChronicleFeedDetailsDummyLogTypeSettingsAuthenticationHeaderKeyValues
 			transformed["key"] = transformedKey
 		}
 
-		transformedValue, err := expandChronicleFeedDetailsDummyLogTypeSettingsAuthenticationHeaderKeyValuesValue(original["value"], d, config)
+		transformedValue, err := expandChronicleFeedDetailsDummyLogTypeSettingsAuthenticationHeaderKeyValuesValue(tpgresource.GetWriteOnlyValue(d, fmt.Sprintf("details.0.dummy_log_type_settings.0.authentication.0.header_key_values.%d.value", i), original["value"]), d, config)
 		if err != nil {
 			return nil, err
 		} else if val := reflect.ValueOf(transformedValue); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -12438,7 +13158,7 @@ func expandChronicleFeedDetailsDuoAuthSettingsAuthentication(v interface{}, d tp
 	original := raw.(map[string]interface{})
 	transformed := make(map[string]interface{})
 
-	transformedSecret, err := expandChronicleFeedDetailsDuoAuthSettingsAuthenticationSecret(original["secret"], d, config)
+	transformedSecret, err := expandChronicleFeedDetailsDuoAuthSettingsAuthenticationSecret(tpgresource.GetWriteOnlyValue(d, "details.0.duo_auth_settings.0.authentication.0.secret", original["secret"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedSecret); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -12508,7 +13228,7 @@ func expandChronicleFeedDetailsDuoUserContextSettingsAuthentication(v interface{
 	original := raw.(map[string]interface{})
 	transformed := make(map[string]interface{})
 
-	transformedSecret, err := expandChronicleFeedDetailsDuoUserContextSettingsAuthenticationSecret(original["secret"], d, config)
+	transformedSecret, err := expandChronicleFeedDetailsDuoUserContextSettingsAuthenticationSecret(tpgresource.GetWriteOnlyValue(d, "details.0.duo_user_context_settings.0.authentication.0.secret", original["secret"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedSecret); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -12596,7 +13316,7 @@ func expandChronicleFeedDetailsFoxItStixSettingsAuthentication(v interface{}, d
 	original := raw.(map[string]interface{})
 	transformed := make(map[string]interface{})
 
-	transformedSecret, err := expandChronicleFeedDetailsFoxItStixSettingsAuthenticationSecret(original["secret"], d, config)
+	transformedSecret, err := expandChronicleFeedDetailsFoxItStixSettingsAuthenticationSecret(tpgresource.GetWriteOnlyValue(d, "details.0.fox_it_stix_settings.0.authentication.0.secret", original["secret"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedSecret); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -12641,14 +13361,14 @@ func expandChronicleFeedDetailsFoxItStixSettingsSsl(v interface{}, d tpgresource
 	original := raw.(map[string]interface{})
 	transformed := make(map[string]interface{})
 
-	transformedEncodedPrivateKey, err := expandChronicleFeedDetailsFoxItStixSettingsSslEncodedPrivateKey(original["encoded_private_key"], d, config)
+	transformedEncodedPrivateKey, err := expandChronicleFeedDetailsFoxItStixSettingsSslEncodedPrivateKey(tpgresource.GetWriteOnlyValue(d, "details.0.fox_it_stix_settings.0.ssl.0.encoded_private_key", original["encoded_private_key"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedEncodedPrivateKey); val.IsValid() && !tpgresource.IsEmptyValue(val) {
 		transformed["encodedPrivateKey"] = transformedEncodedPrivateKey
 	}
 
-	transformedSslCertificate, err := expandChronicleFeedDetailsFoxItStixSettingsSslSslCertificate(original["ssl_certificate"], d, config)
+	transformedSslCertificate, err := expandChronicleFeedDetailsFoxItStixSettingsSslSslCertificate(tpgresource.GetWriteOnlyValue(d, "details.0.fox_it_stix_settings.0.ssl.0.ssl_certificate", original["ssl_certificate"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedSslCertificate); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -12902,7 +13622,7 @@ func expandChronicleFeedDetailsGoogleCloudIdentityDeviceUsersSettingsAuthenticat
 	original := raw.(map[string]interface{})
 	transformed := make(map[string]interface{})
 
-	transformedPrivateKey, err := expandChronicleFeedDetailsGoogleCloudIdentityDeviceUsersSettingsAuthenticationRsCredentialsPrivateKey(original["private_key"], d, config)
+	transformedPrivateKey, err := expandChronicleFeedDetailsGoogleCloudIdentityDeviceUsersSettingsAuthenticationRsCredentialsPrivateKey(tpgresource.GetWriteOnlyValue(d, "details.0.google_cloud_identity_device_users_settings.0.authentication.0.rs_credentials.0.private_key", original["private_key"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedPrivateKey); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -13049,7 +13769,7 @@ func expandChronicleFeedDetailsGoogleCloudIdentityDevicesSettingsAuthenticationR
 	original := raw.(map[string]interface{})
 	transformed := make(map[string]interface{})
 
-	transformedPrivateKey, err := expandChronicleFeedDetailsGoogleCloudIdentityDevicesSettingsAuthenticationRsCredentialsPrivateKey(original["private_key"], d, config)
+	transformedPrivateKey, err := expandChronicleFeedDetailsGoogleCloudIdentityDevicesSettingsAuthenticationRsCredentialsPrivateKey(tpgresource.GetWriteOnlyValue(d, "details.0.google_cloud_identity_devices_settings.0.authentication.0.rs_credentials.0.private_key", original["private_key"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedPrivateKey); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -13313,7 +14033,7 @@ func expandChronicleFeedDetailsImpervaWafSettingsAuthenticationHeaderKeyValues(v
 	}
 	l := v.([]interface{})
 	req := make([]interface{}, 0, len(l))
-	for _, raw := range l {
+	for i, raw := range l {
 		if raw == nil {
 			continue
 		}
@@ -13327,7 +14047,7 @@ func expandChronicleFeedDetailsImpervaWafSettingsAuthenticationHeaderKeyValues(v
 			transformed["key"] = transformedKey
 		}
 
-		transformedValue, err := expandChronicleFeedDetailsImpervaWafSettingsAuthenticationHeaderKeyValuesValue(original["value"], d, config)
+		transformedValue, err := expandChronicleFeedDetailsImpervaWafSettingsAuthenticationHeaderKeyValuesValue(tpgresource.GetWriteOnlyValue(d, fmt.Sprintf("details.0.imperva_waf_settings.0.authentication.0.header_key_values.%d.value", i), original["value"]), d, config)
 		if err != nil {
 			return nil, err
 		} else if val := reflect.ValueOf(transformedValue); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -13419,7 +14139,7 @@ func expandChronicleFeedDetailsMandiantIocSettingsAuthenticationHeaderKeyValues(
 	}
 	l := v.([]interface{})
 	req := make([]interface{}, 0, len(l))
-	for _, raw := range l {
+	for i, raw := range l {
 		if raw == nil {
 			continue
 		}
@@ -13433,7 +14153,7 @@ func expandChronicleFeedDetailsMandiantIocSettingsAuthenticationHeaderKeyValues(
 			transformed["key"] = transformedKey
 		}
 
-		transformedValue, err := expandChronicleFeedDetailsMandiantIocSettingsAuthenticationHeaderKeyValuesValue(original["value"], d, config)
+		transformedValue, err := expandChronicleFeedDetailsMandiantIocSettingsAuthenticationHeaderKeyValuesValue(tpgresource.GetWriteOnlyValue(d, fmt.Sprintf("details.0.mandiant_ioc_settings.0.authentication.0.header_key_values.%d.value", i), original["value"]), d, config)
 		if err != nil {
 			return nil, err
 		} else if val := reflect.ValueOf(transformedValue); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -13523,7 +14243,7 @@ func expandChronicleFeedDetailsMicrosoftGraphAlertSettingsAuthentication(v inter
 		transformed["clientId"] = transformedClientId
 	}
 
-	transformedClientSecret, err := expandChronicleFeedDetailsMicrosoftGraphAlertSettingsAuthenticationClientSecret(original["client_secret"], d, config)
+	transformedClientSecret, err := expandChronicleFeedDetailsMicrosoftGraphAlertSettingsAuthenticationClientSecret(tpgresource.GetWriteOnlyValue(d, "details.0.microsoft_graph_alert_settings.0.authentication.0.client_secret", original["client_secret"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedClientSecret); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -13622,7 +14342,7 @@ func expandChronicleFeedDetailsMicrosoftSecurityCenterAlertSettingsAuthenticatio
 		transformed["clientId"] = transformedClientId
 	}
 
-	transformedClientSecret, err := expandChronicleFeedDetailsMicrosoftSecurityCenterAlertSettingsAuthenticationClientSecret(original["client_secret"], d, config)
+	transformedClientSecret, err := expandChronicleFeedDetailsMicrosoftSecurityCenterAlertSettingsAuthenticationClientSecret(tpgresource.GetWriteOnlyValue(d, "details.0.microsoft_security_center_alert_settings.0.authentication.0.client_secret", original["client_secret"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedClientSecret); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -13709,7 +14429,7 @@ func expandChronicleFeedDetailsMimecastMailSettingsAuthenticationHeaderKeyValues
 	}
 	l := v.([]interface{})
 	req := make([]interface{}, 0, len(l))
-	for _, raw := range l {
+	for i, raw := range l {
 		if raw == nil {
 			continue
 		}
@@ -13723,7 +14443,7 @@ func expandChronicleFeedDetailsMimecastMailSettingsAuthenticationHeaderKeyValues
 			transformed["key"] = transformedKey
 		}
 
-		transformedValue, err := expandChronicleFeedDetailsMimecastMailSettingsAuthenticationHeaderKeyValuesValue(original["value"], d, config)
+		transformedValue, err := expandChronicleFeedDetailsMimecastMailSettingsAuthenticationHeaderKeyValuesValue(tpgresource.GetWriteOnlyValue(d, fmt.Sprintf("details.0.mimecast_mail_settings.0.authentication.0.header_key_values.%d.value", i), original["value"]), d, config)
 		if err != nil {
 			return nil, err
 		} else if val := reflect.ValueOf(transformedValue); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -13788,7 +14508,7 @@ func expandChronicleFeedDetailsMimecastMailV2SettingsAuthCredentials(v interface
 		transformed["clientId"] = transformedClientId
 	}
 
-	transformedClientSecret, err := expandChronicleFeedDetailsMimecastMailV2SettingsAuthCredentialsClientSecret(original["client_secret"], d, config)
+	transformedClientSecret, err := expandChronicleFeedDetailsMimecastMailV2SettingsAuthCredentialsClientSecret(tpgresource.GetWriteOnlyValue(d, "details.0.mimecast_mail_v2_settings.0.auth_credentials.0.client_secret", original["client_secret"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedClientSecret); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -13877,7 +14597,7 @@ func expandChronicleFeedDetailsNetskopeAlertSettingsAuthenticationHeaderKeyValue
 	}
 	l := v.([]interface{})
 	req := make([]interface{}, 0, len(l))
-	for _, raw := range l {
+	for i, raw := range l {
 		if raw == nil {
 			continue
 		}
@@ -13891,7 +14611,7 @@ func expandChronicleFeedDetailsNetskopeAlertSettingsAuthenticationHeaderKeyValue
 			transformed["key"] = transformedKey
 		}
 
-		transformedValue, err := expandChronicleFeedDetailsNetskopeAlertSettingsAuthenticationHeaderKeyValuesValue(original["value"], d, config)
+		transformedValue, err := expandChronicleFeedDetailsNetskopeAlertSettingsAuthenticationHeaderKeyValuesValue(tpgresource.GetWriteOnlyValue(d, fmt.Sprintf("details.0.netskope_alert_settings.0.authentication.0.header_key_values.%d.value", i), original["value"]), d, config)
 		if err != nil {
 			return nil, err
 		} else if val := reflect.ValueOf(transformedValue); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -13994,7 +14714,7 @@ func expandChronicleFeedDetailsNetskopeAlertV2SettingsAuthenticationHeaderKeyVal
 	}
 	l := v.([]interface{})
 	req := make([]interface{}, 0, len(l))
-	for _, raw := range l {
+	for i, raw := range l {
 		if raw == nil {
 			continue
 		}
@@ -14008,7 +14728,7 @@ func expandChronicleFeedDetailsNetskopeAlertV2SettingsAuthenticationHeaderKeyVal
 			transformed["key"] = transformedKey
 		}
 
-		transformedValue, err := expandChronicleFeedDetailsNetskopeAlertV2SettingsAuthenticationHeaderKeyValuesValue(original["value"], d, config)
+		transformedValue, err := expandChronicleFeedDetailsNetskopeAlertV2SettingsAuthenticationHeaderKeyValuesValue(tpgresource.GetWriteOnlyValue(d, fmt.Sprintf("details.0.netskope_alert_v2_settings.0.authentication.0.header_key_values.%d.value", i), original["value"]), d, config)
 		if err != nil {
 			return nil, err
 		} else if val := reflect.ValueOf(transformedValue); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -14113,7 +14833,7 @@ func expandChronicleFeedDetailsOffice365SettingsAuthentication(v interface{}, d
 		transformed["clientId"] = transformedClientId
 	}
 
-	transformedClientSecret, err := expandChronicleFeedDetailsOffice365SettingsAuthenticationClientSecret(original["client_secret"], d, config)
+	transformedClientSecret, err := expandChronicleFeedDetailsOffice365SettingsAuthenticationClientSecret(tpgresource.GetWriteOnlyValue(d, "details.0.office365_settings.0.authentication.0.client_secret", original["client_secret"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedClientSecret); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -14200,7 +14920,7 @@ func expandChronicleFeedDetailsOktaSettingsAuthenticationHeaderKeyValues(v inter
 	}
 	l := v.([]interface{})
 	req := make([]interface{}, 0, len(l))
-	for _, raw := range l {
+	for i, raw := range l {
 		if raw == nil {
 			continue
 		}
@@ -14214,7 +14934,7 @@ func expandChronicleFeedDetailsOktaSettingsAuthenticationHeaderKeyValues(v inter
 			transformed["key"] = transformedKey
 		}
 
-		transformedValue, err := expandChronicleFeedDetailsOktaSettingsAuthenticationHeaderKeyValuesValue(original["value"], d, config)
+		transformedValue, err := expandChronicleFeedDetailsOktaSettingsAuthenticationHeaderKeyValuesValue(tpgresource.GetWriteOnlyValue(d, fmt.Sprintf("details.0.okta_settings.0.authentication.0.header_key_values.%d.value", i), original["value"]), d, config)
 		if err != nil {
 			return nil, err
 		} else if val := reflect.ValueOf(transformedValue); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -14302,7 +15022,7 @@ func expandChronicleFeedDetailsOktaUserContextSettingsAuthenticationHeaderKeyVal
 	}
 	l := v.([]interface{})
 	req := make([]interface{}, 0, len(l))
-	for _, raw := range l {
+	for i, raw := range l {
 		if raw == nil {
 			continue
 		}
@@ -14316,7 +15036,7 @@ func expandChronicleFeedDetailsOktaUserContextSettingsAuthenticationHeaderKeyVal
 			transformed["key"] = transformedKey
 		}
 
-		transformedValue, err := expandChronicleFeedDetailsOktaUserContextSettingsAuthenticationHeaderKeyValuesValue(original["value"], d, config)
+		transformedValue, err := expandChronicleFeedDetailsOktaUserContextSettingsAuthenticationHeaderKeyValuesValue(tpgresource.GetWriteOnlyValue(d, fmt.Sprintf("details.0.okta_user_context_settings.0.authentication.0.header_key_values.%d.value", i), original["value"]), d, config)
 		if err != nil {
 			return nil, err
 		} else if val := reflect.ValueOf(transformedValue); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -14408,7 +15128,7 @@ func expandChronicleFeedDetailsPanIocSettingsAuthenticationHeaderKeyValues(v int
 	}
 	l := v.([]interface{})
 	req := make([]interface{}, 0, len(l))
-	for _, raw := range l {
+	for i, raw := range l {
 		if raw == nil {
 			continue
 		}
@@ -14422,7 +15142,7 @@ func expandChronicleFeedDetailsPanIocSettingsAuthenticationHeaderKeyValues(v int
 			transformed["key"] = transformedKey
 		}
 
-		transformedValue, err := expandChronicleFeedDetailsPanIocSettingsAuthenticationHeaderKeyValuesValue(original["value"], d, config)
+		transformedValue, err := expandChronicleFeedDetailsPanIocSettingsAuthenticationHeaderKeyValuesValue(tpgresource.GetWriteOnlyValue(d, fmt.Sprintf("details.0.pan_ioc_settings.0.authentication.0.header_key_values.%d.value", i), original["value"]), d, config)
 		if err != nil {
 			return nil, err
 		} else if val := reflect.ValueOf(transformedValue); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -14491,7 +15211,7 @@ func expandChronicleFeedDetailsPanPrismaCloudSettingsAuthentication(v interface{
 	original := raw.(map[string]interface{})
 	transformed := make(map[string]interface{})
 
-	transformedPassword, err := expandChronicleFeedDetailsPanPrismaCloudSettingsAuthenticationPassword(original["password"], d, config)
+	transformedPassword, err := expandChronicleFeedDetailsPanPrismaCloudSettingsAuthenticationPassword(tpgresource.GetWriteOnlyValue(d, "details.0.pan_prisma_cloud_settings.0.authentication.0.password", original["password"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedPassword); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -14554,7 +15274,7 @@ func expandChronicleFeedDetailsProofpointMailSettingsAuthentication(v interface{
 	original := raw.(map[string]interface{})
 	transformed := make(map[string]interface{})
 
-	transformedSecret, err := expandChronicleFeedDetailsProofpointMailSettingsAuthenticationSecret(original["secret"], d, config)
+	transformedSecret, err := expandChronicleFeedDetailsProofpointMailSettingsAuthenticationSecret(tpgresource.GetWriteOnlyValue(d, "details.0.proofpoint_mail_settings.0.authentication.0.secret", original["secret"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedSecret); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -14636,7 +15356,7 @@ func expandChronicleFeedDetailsProofpointOnDemandSettingsAuthenticationHeaderKey
 	}
 	l := v.([]interface{})
 	req := make([]interface{}, 0, len(l))
-	for _, raw := range l {
+	for i, raw := range l {
 		if raw == nil {
 			continue
 		}
@@ -14650,7 +15370,7 @@ func expandChronicleFeedDetailsProofpointOnDemandSettingsAuthenticationHeaderKey
 			transformed["key"] = transformedKey
 		}
 
-		transformedValue, err := expandChronicleFeedDetailsProofpointOnDemandSettingsAuthenticationHeaderKeyValuesValue(original["value"], d, config)
+		transformedValue, err := expandChronicleFeedDetailsProofpointOnDemandSettingsAuthenticationHeaderKeyValuesValue(tpgresource.GetWriteOnlyValue(d, fmt.Sprintf("details.0.proofpoint_on_demand_settings.0.authentication.0.header_key_values.%d.value", i), original["value"]), d, config)
 		if err != nil {
 			return nil, err
 		} else if val := reflect.ValueOf(transformedValue); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -14752,7 +15472,7 @@ func expandChronicleFeedDetailsQualysScanSettingsAuthentication(v interface{}, d
 	original := raw.(map[string]interface{})
 	transformed := make(map[string]interface{})
 
-	transformedSecret, err := expandChronicleFeedDetailsQualysScanSettingsAuthenticationSecret(original["secret"], d, config)
+	transformedSecret, err := expandChronicleFeedDetailsQualysScanSettingsAuthenticationSecret(tpgresource.GetWriteOnlyValue(d, "details.0.qualys_scan_settings.0.authentication.0.secret", original["secret"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedSecret); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -14822,7 +15542,7 @@ func expandChronicleFeedDetailsQualysVmSettingsAuthentication(v interface{}, d t
 	original := raw.(map[string]interface{})
 	transformed := make(map[string]interface{})
 
-	transformedSecret, err := expandChronicleFeedDetailsQualysVmSettingsAuthenticationSecret(original["secret"], d, config)
+	transformedSecret, err := expandChronicleFeedDetailsQualysVmSettingsAuthenticationSecret(tpgresource.GetWriteOnlyValue(d, "details.0.qualys_vm_settings.0.authentication.0.secret", original["secret"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedSecret); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -14915,7 +15635,7 @@ func expandChronicleFeedDetailsRapid7InsightSettingsAuthenticationHeaderKeyValue
 	}
 	l := v.([]interface{})
 	req := make([]interface{}, 0, len(l))
-	for _, raw := range l {
+	for i, raw := range l {
 		if raw == nil {
 			continue
 		}
@@ -14929,7 +15649,7 @@ func expandChronicleFeedDetailsRapid7InsightSettingsAuthenticationHeaderKeyValue
 			transformed["key"] = transformedKey
 		}
 
-		transformedValue, err := expandChronicleFeedDetailsRapid7InsightSettingsAuthenticationHeaderKeyValuesValue(original["value"], d, config)
+		transformedValue, err := expandChronicleFeedDetailsRapid7InsightSettingsAuthenticationHeaderKeyValuesValue(tpgresource.GetWriteOnlyValue(d, fmt.Sprintf("details.0.rapid7_insight_settings.0.authentication.0.header_key_values.%d.value", i), original["value"]), d, config)
 		if err != nil {
 			return nil, err
 		} else if val := reflect.ValueOf(transformedValue); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -15007,7 +15727,7 @@ func expandChronicleFeedDetailsRecordedFutureIocSettingsAuthenticationHeaderKeyV
 	}
 	l := v.([]interface{})
 	req := make([]interface{}, 0, len(l))
-	for _, raw := range l {
+	for i, raw := range l {
 		if raw == nil {
 			continue
 		}
@@ -15021,7 +15741,7 @@ func expandChronicleFeedDetailsRecordedFutureIocSettingsAuthenticationHeaderKeyV
 			transformed["key"] = transformedKey
 		}
 
-		transformedValue, err := expandChronicleFeedDetailsRecordedFutureIocSettingsAuthenticationHeaderKeyValuesValue(original["value"], d, config)
+		transformedValue, err := expandChronicleFeedDetailsRecordedFutureIocSettingsAuthenticationHeaderKeyValuesValue(tpgresource.GetWriteOnlyValue(d, fmt.Sprintf("details.0.recorded_future_ioc_settings.0.authentication.0.header_key_values.%d.value", i), original["value"]), d, config)
 		if err != nil {
 			return nil, err
 		} else if val := reflect.ValueOf(transformedValue); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -15082,7 +15802,7 @@ func expandChronicleFeedDetailsRhIsacIocSettingsAuthentication(v interface{}, d
 		transformed["clientId"] = transformedClientId
 	}
 
-	transformedClientSecret, err := expandChronicleFeedDetailsRhIsacIocSettingsAuthenticationClientSecret(original["client_secret"], d, config)
+	transformedClientSecret, err := expandChronicleFeedDetailsRhIsacIocSettingsAuthenticationClientSecret(tpgresource.GetWriteOnlyValue(d, "details.0.rh_isac_ioc_settings.0.authentication.0.client_secret", original["client_secret"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedClientSecret); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -15247,7 +15967,7 @@ func expandChronicleFeedDetailsSalesforceSettingsOauthJwtCredentialsRsCredential
 	original := raw.(map[string]interface{})
 	transformed := make(map[string]interface{})
 
-	transformedPrivateKey, err := expandChronicleFeedDetailsSalesforceSettingsOauthJwtCredentialsRsCredentialsPrivateKey(original["private_key"], d, config)
+	transformedPrivateKey, err := expandChronicleFeedDetailsSalesforceSettingsOauthJwtCredentialsRsCredentialsPrivateKey(tpgresource.GetWriteOnlyValue(d, "details.0.salesforce_settings.0.oauth_jwt_credentials.0.rs_credentials.0.private_key", original["private_key"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedPrivateKey); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -15406,7 +16126,7 @@ func expandChronicleFeedDetailsSentineloneAlertSettingsAuthenticationHeaderKeyVa
 	}
 	l := v.([]interface{})
 	req := make([]interface{}, 0, len(l))
-	for _, raw := range l {
+	for i, raw := range l {
 		if raw == nil {
 			continue
 		}
@@ -15420,7 +16140,7 @@ func expandChronicleFeedDetailsSentineloneAlertSettingsAuthenticationHeaderKeyVa
 			transformed["key"] = transformedKey
 		}
 
-		transformedValue, err := expandChronicleFeedDetailsSentineloneAlertSettingsAuthenticationHeaderKeyValuesValue(original["value"], d, config)
+		transformedValue, err := expandChronicleFeedDetailsSentineloneAlertSettingsAuthenticationHeaderKeyValuesValue(tpgresource.GetWriteOnlyValue(d, fmt.Sprintf("details.0.sentinelone_alert_settings.0.authentication.0.header_key_values.%d.value", i), original["value"]), d, config)
 		if err != nil {
 			return nil, err
 		} else if val := reflect.ValueOf(transformedValue); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -15500,7 +16220,7 @@ func expandChronicleFeedDetailsServiceNowCmdbSettingsAuthentication(v interface{
 	original := raw.(map[string]interface{})
 	transformed := make(map[string]interface{})
 
-	transformedSecret, err := expandChronicleFeedDetailsServiceNowCmdbSettingsAuthenticationSecret(original["secret"], d, config)
+	transformedSecret, err := expandChronicleFeedDetailsServiceNowCmdbSettingsAuthenticationSecret(tpgresource.GetWriteOnlyValue(d, "details.0.service_now_cmdb_settings.0.authentication.0.secret", original["secret"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedSecret); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -15588,14 +16308,14 @@ func expandChronicleFeedDetailsSftpSettingsAuthentication(v interface{}, d tpgre
 	original := raw.(map[string]interface{})
 	transformed := make(map[string]interface{})
 
-	transformedPassword, err := expandChronicleFeedDetailsSftpSettingsAuthenticationPassword(original["password"], d, config)
+	transformedPassword, err := expandChronicleFeedDetailsSftpSettingsAuthenticationPassword(tpgresource.GetWriteOnlyValue(d, "details.0.sftp_settings.0.authentication.0.password", original["password"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedPassword); val.IsValid() && !tpgresource.IsEmptyValue(val) {
 		transformed["password"] = transformedPassword
 	}
 
-	transformedPrivateKey, err := expandChronicleFeedDetailsSftpSettingsAuthenticationPrivateKey(original["private_key"], d, config)
+	transformedPrivateKey, err := expandChronicleFeedDetailsSftpSettingsAuthenticationPrivateKey(tpgresource.GetWriteOnlyValue(d, "details.0.sftp_settings.0.authentication.0.private_key", original["private_key"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedPrivateKey); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -15692,7 +16412,7 @@ func expandChronicleFeedDetailsSymantecEventExportSettingsAuthentication(v inter
 		transformed["clientId"] = transformedClientId
 	}
 
-	transformedClientSecret, err := expandChronicleFeedDetailsSymantecEventExportSettingsAuthenticationClientSecret(original["client_secret"], d, config)
+	transformedClientSecret, err := expandChronicleFeedDetailsSymantecEventExportSettingsAuthenticationClientSecret(tpgresource.GetWriteOnlyValue(d, "details.0.symantec_event_export_settings.0.authentication.0.client_secret", original["client_secret"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedClientSecret); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -15789,7 +16509,7 @@ func expandChronicleFeedDetailsThinkstCanarySettingsAuthenticationHeaderKeyValue
 	}
 	l := v.([]interface{})
 	req := make([]interface{}, 0, len(l))
-	for _, raw := range l {
+	for i, raw := range l {
 		if raw == nil {
 			continue
 		}
@@ -15803,7 +16523,7 @@ func expandChronicleFeedDetailsThinkstCanarySettingsAuthenticationHeaderKeyValue
 			transformed["key"] = transformedKey
 		}
 
-		transformedValue, err := expandChronicleFeedDetailsThinkstCanarySettingsAuthenticationHeaderKeyValuesValue(original["value"], d, config)
+		transformedValue, err := expandChronicleFeedDetailsThinkstCanarySettingsAuthenticationHeaderKeyValuesValue(tpgresource.GetWriteOnlyValue(d, fmt.Sprintf("details.0.thinkst_canary_settings.0.authentication.0.header_key_values.%d.value", i), original["value"]), d, config)
 		if err != nil {
 			return nil, err
 		} else if val := reflect.ValueOf(transformedValue); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -15875,7 +16595,7 @@ func expandChronicleFeedDetailsThreatConnectIocSettingsAuthentication(v interfac
 	original := raw.(map[string]interface{})
 	transformed := make(map[string]interface{})
 
-	transformedSecret, err := expandChronicleFeedDetailsThreatConnectIocSettingsAuthenticationSecret(original["secret"], d, config)
+	transformedSecret, err := expandChronicleFeedDetailsThreatConnectIocSettingsAuthenticationSecret(tpgresource.GetWriteOnlyValue(d, "details.0.threat_connect_ioc_settings.0.authentication.0.secret", original["secret"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedSecret); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -15977,7 +16697,7 @@ func expandChronicleFeedDetailsThreatConnectIocV3SettingsAuthentication(v interf
 	original := raw.(map[string]interface{})
 	transformed := make(map[string]interface{})
 
-	transformedSecret, err := expandChronicleFeedDetailsThreatConnectIocV3SettingsAuthenticationSecret(original["secret"], d, config)
+	transformedSecret, err := expandChronicleFeedDetailsThreatConnectIocV3SettingsAuthenticationSecret(tpgresource.GetWriteOnlyValue(d, "details.0.threat_connect_ioc_v3_settings.0.authentication.0.secret", original["secret"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedSecret); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -16099,7 +16819,7 @@ func expandChronicleFeedDetailsTrellixHxAlertsSettingsAuthenticationMsso(v inter
 		transformed["apiEndpoint"] = transformedApiEndpoint
 	}
 
-	transformedPassword, err := expandChronicleFeedDetailsTrellixHxAlertsSettingsAuthenticationMssoPassword(original["password"], d, config)
+	transformedPassword, err := expandChronicleFeedDetailsTrellixHxAlertsSettingsAuthenticationMssoPassword(tpgresource.GetWriteOnlyValue(d, "details.0.trellix_hx_alerts_settings.0.authentication.0.msso.0.password", original["password"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedPassword); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -16147,7 +16867,7 @@ func expandChronicleFeedDetailsTrellixHxAlertsSettingsAuthenticationTrellixIam(v
 		transformed["clientId"] = transformedClientId
 	}
 
-	transformedClientSecret, err := expandChronicleFeedDetailsTrellixHxAlertsSettingsAuthenticationTrellixIamClientSecret(original["client_secret"], d, config)
+	transformedClientSecret, err := expandChronicleFeedDetailsTrellixHxAlertsSettingsAuthenticationTrellixIamClientSecret(tpgresource.GetWriteOnlyValue(d, "details.0.trellix_hx_alerts_settings.0.authentication.0.trellix_iam.0.client_secret", original["client_secret"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedClientSecret); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -16257,7 +16977,7 @@ func expandChronicleFeedDetailsTrellixHxBulkAcqsSettingsAuthenticationMsso(v int
 		transformed["apiEndpoint"] = transformedApiEndpoint
 	}
 
-	transformedPassword, err := expandChronicleFeedDetailsTrellixHxBulkAcqsSettingsAuthenticationMssoPassword(original["password"], d, config)
+	transformedPassword, err := expandChronicleFeedDetailsTrellixHxBulkAcqsSettingsAuthenticationMssoPassword(tpgresource.GetWriteOnlyValue(d, "details.0.trellix_hx_bulk_acqs_settings.0.authentication.0.msso.0.password", original["password"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedPassword); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -16305,7 +17025,7 @@ func expandChronicleFeedDetailsTrellixHxBulkAcqsSettingsAuthenticationTrellixIam
 		transformed["clientId"] = transformedClientId
 	}
 
-	transformedClientSecret, err := expandChronicleFeedDetailsTrellixHxBulkAcqsSettingsAuthenticationTrellixIamClientSecret(original["client_secret"], d, config)
+	transformedClientSecret, err := expandChronicleFeedDetailsTrellixHxBulkAcqsSettingsAuthenticationTrellixIamClientSecret(tpgresource.GetWriteOnlyValue(d, "details.0.trellix_hx_bulk_acqs_settings.0.authentication.0.trellix_iam.0.client_secret", original["client_secret"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedClientSecret); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -16415,7 +17135,7 @@ func expandChronicleFeedDetailsTrellixHxHostsSettingsAuthenticationMsso(v interf
 		transformed["apiEndpoint"] = transformedApiEndpoint
 	}
 
-	transformedPassword, err := expandChronicleFeedDetailsTrellixHxHostsSettingsAuthenticationMssoPassword(original["password"], d, config)
+	transformedPassword, err := expandChronicleFeedDetailsTrellixHxHostsSettingsAuthenticationMssoPassword(tpgresource.GetWriteOnlyValue(d, "details.0.trellix_hx_hosts_settings.0.authentication.0.msso.0.password", original["password"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedPassword); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -16463,7 +17183,7 @@ func expandChronicleFeedDetailsTrellixHxHostsSettingsAuthenticationTrellixIam(v
 		transformed["clientId"] = transformedClientId
 	}
 
-	transformedClientSecret, err := expandChronicleFeedDetailsTrellixHxHostsSettingsAuthenticationTrellixIamClientSecret(original["client_secret"], d, config)
+	transformedClientSecret, err := expandChronicleFeedDetailsTrellixHxHostsSettingsAuthenticationTrellixIamClientSecret(tpgresource.GetWriteOnlyValue(d, "details.0.trellix_hx_hosts_settings.0.authentication.0.trellix_iam.0.client_secret", original["client_secret"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedClientSecret); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -16555,7 +17275,7 @@ func expandChronicleFeedDetailsWorkdaySettingsAuthentication(v interface{}, d tp
 		transformed["clientId"] = transformedClientId
 	}
 
-	transformedClientSecret, err := expandChronicleFeedDetailsWorkdaySettingsAuthenticationClientSecret(original["client_secret"], d, config)
+	transformedClientSecret, err := expandChronicleFeedDetailsWorkdaySettingsAuthenticationClientSecret(tpgresource.GetWriteOnlyValue(d, "details.0.workday_settings.0.authentication.0.client_secret", original["client_secret"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedClientSecret); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -16569,7 +17289,7 @@ func expandChronicleFeedDetailsWorkdaySettingsAuthentication(v interface{}, d tp
 		transformed["refreshToken"] = transformedRefreshToken
 	}
 
-	transformedSecret, err := expandChronicleFeedDetailsWorkdaySettingsAuthenticationSecret(original["secret"], d, config)
+	transformedSecret, err := expandChronicleFeedDetailsWorkdaySettingsAuthenticationSecret(tpgresource.GetWriteOnlyValue(d, "details.0.workday_settings.0.authentication.0.secret", original["secret"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedSecret); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -16761,7 +17481,7 @@ func expandChronicleFeedDetailsWorkspaceActivitySettingsAuthenticationRsCredenti
 	original := raw.(map[string]interface{})
 	transformed := make(map[string]interface{})
 
-	transformedPrivateKey, err := expandChronicleFeedDetailsWorkspaceActivitySettingsAuthenticationRsCredentialsPrivateKey(original["private_key"], d, config)
+	transformedPrivateKey, err := expandChronicleFeedDetailsWorkspaceActivitySettingsAuthenticationRsCredentialsPrivateKey(tpgresource.GetWriteOnlyValue(d, "details.0.workspace_activity_settings.0.authentication.0.rs_credentials.0.private_key", original["private_key"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedPrivateKey); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -16908,7 +17628,7 @@ func expandChronicleFeedDetailsWorkspaceAlertsSettingsAuthenticationRsCredential
 	original := raw.(map[string]interface{})
 	transformed := make(map[string]interface{})
 
-	transformedPrivateKey, err := expandChronicleFeedDetailsWorkspaceAlertsSettingsAuthenticationRsCredentialsPrivateKey(original["private_key"], d, config)
+	transformedPrivateKey, err := expandChronicleFeedDetailsWorkspaceAlertsSettingsAuthenticationRsCredentialsPrivateKey(tpgresource.GetWriteOnlyValue(d, "details.0.workspace_alerts_settings.0.authentication.0.rs_credentials.0.private_key", original["private_key"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedPrivateKey); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -17055,7 +17775,7 @@ func expandChronicleFeedDetailsWorkspaceChromeOsSettingsAuthenticationRsCredenti
 	original := raw.(map[string]interface{})
 	transformed := make(map[string]interface{})
 
-	transformedPrivateKey, err := expandChronicleFeedDetailsWorkspaceChromeOsSettingsAuthenticationRsCredentialsPrivateKey(original["private_key"], d, config)
+	transformedPrivateKey, err := expandChronicleFeedDetailsWorkspaceChromeOsSettingsAuthenticationRsCredentialsPrivateKey(tpgresource.GetWriteOnlyValue(d, "details.0.workspace_chrome_os_settings.0.authentication.0.rs_credentials.0.private_key", original["private_key"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedPrivateKey); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -17202,7 +17922,7 @@ func expandChronicleFeedDetailsWorkspaceGroupsSettingsAuthenticationRsCredential
 	original := raw.(map[string]interface{})
 	transformed := make(map[string]interface{})
 
-	transformedPrivateKey, err := expandChronicleFeedDetailsWorkspaceGroupsSettingsAuthenticationRsCredentialsPrivateKey(original["private_key"], d, config)
+	transformedPrivateKey, err := expandChronicleFeedDetailsWorkspaceGroupsSettingsAuthenticationRsCredentialsPrivateKey(tpgresource.GetWriteOnlyValue(d, "details.0.workspace_groups_settings.0.authentication.0.rs_credentials.0.private_key", original["private_key"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedPrivateKey); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -17349,7 +18069,7 @@ func expandChronicleFeedDetailsWorkspaceMobileSettingsAuthenticationRsCredential
 	original := raw.(map[string]interface{})
 	transformed := make(map[string]interface{})
 
-	transformedPrivateKey, err := expandChronicleFeedDetailsWorkspaceMobileSettingsAuthenticationRsCredentialsPrivateKey(original["private_key"], d, config)
+	transformedPrivateKey, err := expandChronicleFeedDetailsWorkspaceMobileSettingsAuthenticationRsCredentialsPrivateKey(tpgresource.GetWriteOnlyValue(d, "details.0.workspace_mobile_settings.0.authentication.0.rs_credentials.0.private_key", original["private_key"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedPrivateKey); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -17496,7 +18216,7 @@ func expandChronicleFeedDetailsWorkspacePrivilegesSettingsAuthenticationRsCreden
 	original := raw.(map[string]interface{})
 	transformed := make(map[string]interface{})
 
-	transformedPrivateKey, err := expandChronicleFeedDetailsWorkspacePrivilegesSettingsAuthenticationRsCredentialsPrivateKey(original["private_key"], d, config)
+	transformedPrivateKey, err := expandChronicleFeedDetailsWorkspacePrivilegesSettingsAuthenticationRsCredentialsPrivateKey(tpgresource.GetWriteOnlyValue(d, "details.0.workspace_privileges_settings.0.authentication.0.rs_credentials.0.private_key", original["private_key"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedPrivateKey); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -17650,7 +18370,7 @@ func expandChronicleFeedDetailsWorkspaceUsersSettingsAuthenticationRsCredentials
 	original := raw.(map[string]interface{})
 	transformed := make(map[string]interface{})
 
-	transformedPrivateKey, err := expandChronicleFeedDetailsWorkspaceUsersSettingsAuthenticationRsCredentialsPrivateKey(original["private_key"], d, config)
+	transformedPrivateKey, err := expandChronicleFeedDetailsWorkspaceUsersSettingsAuthenticationRsCredentialsPrivateKey(tpgresource.GetWriteOnlyValue(d, "details.0.workspace_users_settings.0.authentication.0.rs_credentials.0.private_key", original["private_key"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedPrivateKey); val.IsValid() && !tpgresource.IsEmptyValue(val) {
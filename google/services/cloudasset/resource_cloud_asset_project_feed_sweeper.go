@@ -191,7 +191,7 @@ func deleteResourceCloudAssetProjectFeed(config *transport_tpg.Config, d *tpgres
 	name = tpgresource.GetResourceNameFromSelfLink(obj["name"].(string))
 
 	// Skip resources that shouldn't be sweeped
-	if !sweeper.IsSweepableTestResource(name) {
+	if !sweeper.ShouldSweep(name, obj) {
 		return nil
 	}
 
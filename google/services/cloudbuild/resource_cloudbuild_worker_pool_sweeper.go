@@ -65,5 +65,5 @@ func testSweepCloudbuildWorkerPool(region string) error {
 }
 
 func isDeletableCloudbuildWorkerPool(r *WorkerPool) bool {
-	return sweeper.IsSweepableTestResource(*r.Name)
+	return sweeper.ShouldSweepTyped(*r.Name, r)
 }
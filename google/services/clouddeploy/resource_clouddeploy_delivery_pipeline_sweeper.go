@@ -65,5 +65,5 @@ func testSweepClouddeployDeliveryPipeline(region string) error {
 }
 
 func isDeletableClouddeployDeliveryPipeline(r *DeliveryPipeline) bool {
-	return sweeper.IsSweepableTestResource(*r.Name)
+	return sweeper.ShouldSweepTyped(*r.Name, r)
 }
@@ -65,5 +65,5 @@ func testSweepClouddeployTarget(region string) error {
 }
 
 func isDeletableClouddeployTarget(r *Target) bool {
-	return sweeper.IsSweepableTestResource(*r.Name)
+	return sweeper.ShouldSweepTyped(*r.Name, r)
 }
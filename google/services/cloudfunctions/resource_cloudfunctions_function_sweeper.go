@@ -45,6 +45,9 @@ func sweepCloudFunctionSourceZipArchives(_ string) error {
 		}
 		if strings.HasPrefix(f.Name(), testFunctionsSourceArchivePrefix) {
 			filepath := fmt.Sprintf("%s/%s", os.TempDir(), f.Name())
+			if sweeper.SkipForDryRun(filepath) {
+				continue
+			}
 			if err := os.Remove(filepath); err != nil {
 				log.Printf("Error removing files: %s", err)
 				return nil
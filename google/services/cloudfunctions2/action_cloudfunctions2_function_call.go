@@ -0,0 +1,237 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/services/cloudfunctions2/action_cloudfunctions2_function_call.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package cloudfunctions2
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/iamcredentials/v1"
+	"google.golang.org/api/idtoken"
+	"google.golang.org/api/option"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-google/google/fwresource"
+	"github.com/hashicorp/terraform-provider-google/google/fwvalidators"
+	"github.com/hashicorp/terraform-provider-google/google/registry"
+	iamcredentials_tpg "github.com/hashicorp/terraform-provider-google/google/services/iamcredentials"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+)
+
+const (
+	cloudfunctions2FunctionCallTimeout = 60 * time.Minute
+	// cloudfunctions2FunctionCallMaxOutput is the maximum number of bytes of
+	// the response of the function that are reported.
+	cloudfunctions2FunctionCallMaxOutput = 4096
+	userInfoScope                        = "https://www.googleapis.com/auth/userinfo.email"
+)
+
+var _ action.ActionWithConfigure = &googleActionCloudfunctions2FunctionCall{}
+
+func init() {
+	registry.FrameworkAction{
+		Name:        "google_cloudfunctions2_function_call",
+		ProductName: "cloudfunctions2",
+		Func:        GoogleActionCloudfunctions2FunctionCall,
+	}.Register()
+}
+
+func GoogleActionCloudfunctions2FunctionCall() action.Action {
+	return &googleActionCloudfunctions2FunctionCall{}
+}
+
+type googleActionCloudfunctions2FunctionCall struct {
+	providerConfig *transport_tpg.Config
+}
+
+func (a *googleActionCloudfunctions2FunctionCall) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloudfunctions2_function_call"
+}
+
+type actionCloudfunctions2FunctionCallModel struct {
+	Name                 types.String `tfsdk:"name"`
+	Location             types.String `tfsdk:"location"`
+	Project              types.String `tfsdk:"project"`
+	Data                 types.String `tfsdk:"data"`
+	ContentType          types.String `tfsdk:"content_type"`
+	TargetServiceAccount types.String `tfsdk:"target_service_account"`
+}
+
+func (a *googleActionCloudfunctions2FunctionCall) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Calls an HTTP-triggered Cloud Function (2nd gen) with an authenticated POST request.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "The name of the function to call.",
+				Required:    true,
+			},
+			"location": schema.StringAttribute{
+				Description: "The location of the function. If it is not provided, the provider region is used.",
+				Optional:    true,
+			},
+			"project": schema.StringAttribute{
+				Description: "The ID of the project in which the function belongs. If it is not provided, the provider project is used.",
+				Optional:    true,
+			},
+			"data": schema.StringAttribute{
+				Description: "The body of the request sent to the function.",
+				Optional:    true,
+			},
+			"content_type": schema.StringAttribute{
+				Description: "The content type of the body of the request. Defaults to `application/json`.",
+				Optional:    true,
+			},
+			"target_service_account": schema.StringAttribute{
+				Description: "The email of a service account to impersonate to call the function. If it is not provided, the function is called with an ID token of the provider credentials, which must be service account credentials.",
+				Optional:    true,
+				Validators: []validator.String{
+					fwvalidators.ServiceAccountEmailValidator{},
+				},
+			},
+		},
+	}
+}
+
+func (a *googleActionCloudfunctions2FunctionCall) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	pd, ok := req.ProviderData.(*transport_tpg.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *transport_tpg.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.providerConfig = pd
+}
+
+func (a *googleActionCloudfunctions2FunctionCall) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data actionCloudfunctions2FunctionCallModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config := a.providerConfig
+
+	project := fwresource.GetProjectFramework(data.Project, types.StringValue(config.Project), &resp.Diagnostics).ValueString()
+	location := fwresource.GetRegionFramework(data.Location, types.StringValue(config.Region), &resp.Diagnostics).ValueString()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	name := fmt.Sprintf("projects/%s/locations/%s/functions/%s", project, location, data.Name.ValueString())
+
+	res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+		Config:    config,
+		Method:    "GET",
+		Project:   project,
+		RawURL:    transport_tpg.BaseUrl(Product, config) + name,
+		UserAgent: config.UserAgent,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error reading function %q", name), err.Error())
+		return
+	}
+	uri, _ := res["url"].(string)
+	if uri == "" {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling function %q", name), "the function has no URL, only HTTP-triggered functions can be called")
+		return
+	}
+
+	tokenSource, err := cloudfunctions2IdTokenSource(ctx, config, uri, data.TargetServiceAccount.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating an ID token", err.Error())
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, cloudfunctions2FunctionCallTimeout)
+	defer cancel()
+
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", uri, strings.NewReader(data.Data.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling function %q", name), err.Error())
+		return
+	}
+	contentType := "application/json"
+	if v := data.ContentType.ValueString(); v != "" {
+		contentType = v
+	}
+	httpReq.Header.Set("Content-Type", contentType)
+	httpReq.Header.Set("User-Agent", config.UserAgent)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Calling function %s", name),
+	})
+	httpResp, err := oauth2.NewClient(ctx, tokenSource).Do(httpReq)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling function %q", name), err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+
+	output, err := io.ReadAll(io.LimitReader(httpResp.Body, cloudfunctions2FunctionCallMaxOutput))
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error reading the response of function %q", name), err.Error())
+		return
+	}
+	if httpResp.StatusCode >= 300 {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling function %q", name), fmt.Sprintf("the function responded with %s: %s", httpResp.Status, output))
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Function %s responded with %s: %s", name, httpResp.Status, output),
+	})
+}
+
+// cloudfunctions2IdTokenSource returns a source of ID tokens for the given
+// audience, for the target service account if it's set, or the provider
+// credentials otherwise.
+func cloudfunctions2IdTokenSource(ctx context.Context, config *transport_tpg.Config, audience, targetServiceAccount string) (oauth2.TokenSource, error) {
+	if targetServiceAccount != "" {
+		at, err := iamcredentials_tpg.NewClient(config, config.UserAgent).Projects.ServiceAccounts.GenerateIdToken(
+			fmt.Sprintf("projects/-/serviceAccounts/%s", targetServiceAccount),
+			&iamcredentials.GenerateIdTokenRequest{Audience: audience},
+		).Context(ctx).Do()
+		if err != nil {
+			return nil, err
+		}
+		return oauth2.StaticTokenSource(&oauth2.Token{AccessToken: at.Token}), nil
+	}
+
+	creds, err := config.GetCredentials([]string{userInfoScope}, false)
+	if err != nil {
+		return nil, err
+	}
+	co := []option.ClientOption{}
+	if creds.JSON != nil {
+		co = append(co, idtoken.WithCredentialsJSON(creds.JSON))
+	}
+	return idtoken.NewTokenSource(ctx, audience, co...)
+}
@@ -0,0 +1,112 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/services/cloudfunctions2/action_cloudfunctions2_function_call_test.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package cloudfunctions2_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-google/google/acctest"
+	"github.com/hashicorp/terraform-provider-google/google/envvar"
+)
+
+func TestAccActionCloudfunctions2FunctionCall_basic(t *testing.T) {
+	// The function is called with an ID token outside of the recorded HTTP client.
+	acctest.SkipIfVcr(t)
+	t.Parallel()
+
+	randomSuffix := acctest.RandString(t, 10)
+
+	context := map[string]interface{}{
+		"project":     envvar.GetTestProjectFromEnv(),
+		"bucket_name": "tf-test-gcf-source" + randomSuffix,
+		"function":    "tf-test-function-v2" + randomSuffix,
+		"zip_path":    "./test-fixtures/function-source.zip",
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckCloudfunctions2functionDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccActionCloudfunctions2FunctionCall_basic(context),
+			},
+		},
+	})
+}
+
+func testAccActionCloudfunctions2FunctionCall_basic(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_storage_bucket" "bucket" {
+  name                        = "%{project}-%{bucket_name}"
+  location                    = "US"
+  uniform_bucket_level_access = true
+}
+
+resource "google_storage_bucket_object" "object" {
+  name   = "function-source.zip"
+  bucket = google_storage_bucket.bucket.name
+  source = "%{zip_path}"
+}
+
+resource "google_cloudfunctions2_function" "function" {
+  name     = "%{function}"
+  location = "us-central1"
+
+  build_config {
+    runtime     = "nodejs20"
+    entry_point = "helloHttp"
+    source {
+      storage_source {
+        bucket = google_storage_bucket.bucket.name
+        object = google_storage_bucket_object.object.name
+      }
+    }
+  }
+
+  service_config {
+    max_instance_count = 1
+    available_memory   = "256M"
+    timeout_seconds    = 60
+  }
+}
+
+action "google_cloudfunctions2_function_call" "call" {
+  config {
+    name     = google_cloudfunctions2_function.function.name
+    location = google_cloudfunctions2_function.function.location
+    data     = jsonencode({ name = "Terraform" })
+  }
+}
+
+resource "terraform_data" "call" {
+  input = google_cloudfunctions2_function.function.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.google_cloudfunctions2_function_call.call]
+    }
+  }
+}
+`, context)
+}
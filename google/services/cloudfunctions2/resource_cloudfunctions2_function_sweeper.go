@@ -193,7 +193,7 @@ func deleteResourceCloudfunctions2function(config *transport_tpg.Config, d *tpgr
 	name = tpgresource.GetResourceNameFromSelfLink(obj["name"].(string))
 
 	// Skip resources that shouldn't be sweeped
-	if !sweeper.IsSweepableTestResource(name) {
+	if !sweeper.ShouldSweep(name, obj) {
 		return nil
 	}
 
@@ -103,7 +103,7 @@ func testSweepCloudIdentityGroup(region string) error {
 
 		name := obj["name"].(string)
 		// Skip resources that shouldn't be sweeped
-		if !sweeper.IsSweepableTestResource(obj["displayName"].(string)) {
+		if !sweeper.ShouldSweep(obj["displayName"].(string), obj) {
 			nonPrefixCount++
 			continue
 		}
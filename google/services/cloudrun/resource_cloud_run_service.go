@@ -47,6 +47,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-google/google/registry"
+	"github.com/hashicorp/terraform-provider-google/google/services/tags"
 	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
 	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
 	"github.com/hashicorp/terraform-provider-google/google/verify"
@@ -156,10 +157,10 @@ func init() {
 
 func ResourceCloudRunService() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceCloudRunServiceCreate,
-		Read:   resourceCloudRunServiceRead,
-		Update: resourceCloudRunServiceUpdate,
-		Delete: resourceCloudRunServiceDelete,
+		CreateContext: tags.CreateWithTagBindings(resourceCloudRunServiceCreate, "google_cloud_run_service"),
+		Read:          resourceCloudRunServiceRead,
+		Update:        resourceCloudRunServiceUpdate,
+		Delete:        resourceCloudRunServiceDelete,
 
 		Importer: &schema.ResourceImporter{
 			State: resourceCloudRunServiceImport,
@@ -1400,6 +1401,7 @@ this field is set to false, the revision name will still autogenerate.)`,
 				Computed: true,
 				ForceNew: true,
 			},
+			"tags": tags.TagBindingsSchema(),
 			"deletion_policy": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -1724,11 +1726,20 @@ func resourceCloudRunServiceRead(d *schema.ResourceData, meta interface{}) error
 		log.Printf("[DEBUG] (Read) identity not set: %s", err)
 	}
 
+	if err := tags.ReadTagBindings(d, config, "google_cloud_run_service"); err != nil {
+		return err
+	}
+
 	return nil
 }
 
 func resourceCloudRunServiceUpdate(d *schema.ResourceData, meta interface{}) error {
-	clientSideFields := map[string]bool{"deletion_policy": true}
+	if d.HasChange("tags") {
+		if err := tags.UpdateTagBindings(d, meta.(*transport_tpg.Config), "google_cloud_run_service"); err != nil {
+			return err
+		}
+	}
+	clientSideFields := map[string]bool{"deletion_policy": true, "tags": true}
 	clientSideOnly := true
 	for field := range ResourceCloudRunService().Schema {
 		if d.HasChange(field) && !clientSideFields[field] {
@@ -193,7 +193,7 @@ func deleteResourceCloudRunService(config *transport_tpg.Config, d *tpgresource.
 	name = tpgresource.GetResourceNameFromSelfLink(obj["name"].(string))
 
 	// Skip resources that shouldn't be sweeped
-	if !sweeper.IsSweepableTestResource(name) {
+	if !sweeper.ShouldSweep(name, obj) {
 		return nil
 	}
 
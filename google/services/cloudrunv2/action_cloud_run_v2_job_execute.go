@@ -0,0 +1,232 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/services/cloudrunv2/action_cloud_run_v2_job_execute.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package cloudrunv2
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-google/google/fwresource"
+	"github.com/hashicorp/terraform-provider-google/google/registry"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+	runadminv2 "google.golang.org/api/run/v2"
+)
+
+const cloudRunV2JobExecuteTimeout = 24 * time.Hour
+
+var _ action.ActionWithConfigure = &googleActionCloudRunV2JobExecute{}
+
+func init() {
+	registry.FrameworkAction{
+		Name:        "google_cloud_run_v2_job_execute",
+		ProductName: "cloudrunv2",
+		Func:        GoogleActionCloudRunV2JobExecute,
+	}.Register()
+}
+
+func GoogleActionCloudRunV2JobExecute() action.Action {
+	return &googleActionCloudRunV2JobExecute{}
+}
+
+type googleActionCloudRunV2JobExecute struct {
+	providerConfig *transport_tpg.Config
+}
+
+func (a *googleActionCloudRunV2JobExecute) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloud_run_v2_job_execute"
+}
+
+type actionCloudRunV2JobExecuteModel struct {
+	Name               types.String                                `tfsdk:"name"`
+	Location           types.String                                `tfsdk:"location"`
+	Project            types.String                                `tfsdk:"project"`
+	TaskCount          types.Int64                                 `tfsdk:"task_count"`
+	Timeout            types.String                                `tfsdk:"timeout"`
+	WaitForCompletion  types.Bool                                  `tfsdk:"wait_for_completion"`
+	ContainerOverrides []actionCloudRunV2JobContainerOverrideModel `tfsdk:"container_overrides"`
+}
+
+type actionCloudRunV2JobContainerOverrideModel struct {
+	Name types.String `tfsdk:"name"`
+	Args types.List   `tfsdk:"args"`
+	Env  types.Map    `tfsdk:"env"`
+}
+
+func (a *googleActionCloudRunV2JobExecute) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Runs a Cloud Run job, optionally overriding the configuration of the execution.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "The name of the Cloud Run job to run.",
+				Required:    true,
+			},
+			"location": schema.StringAttribute{
+				Description: "The location of the Cloud Run job. If it is not provided, the provider region is used.",
+				Optional:    true,
+			},
+			"project": schema.StringAttribute{
+				Description: "The ID of the project in which the job belongs. If it is not provided, the provider project is used.",
+				Optional:    true,
+			},
+			"task_count": schema.Int64Attribute{
+				Description: "The number of tasks of the execution, overriding the task count of the job.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"timeout": schema.StringAttribute{
+				Description: "The maximum duration of each task of the execution, overriding the task timeout of the job. A duration in seconds with up to nine fractional digits, ending with 's'. Example: \"3.5s\".",
+				Optional:    true,
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				Description: "Whether to wait for the execution to complete, and fail if it fails. Defaults to true.",
+				Optional:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"container_overrides": schema.ListNestedBlock{
+				Description: "Overrides of the containers of the job for the execution.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the container to override. It can be omitted when the job has a single container.",
+							Optional:    true,
+						},
+						"args": schema.ListAttribute{
+							Description: "The arguments of the container, replacing the arguments of the job.",
+							Optional:    true,
+							ElementType: types.StringType,
+						},
+						"env": schema.MapAttribute{
+							Description: "Environment variables of the container, appended to the environment variables of the job.",
+							Optional:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (a *googleActionCloudRunV2JobExecute) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	pd, ok := req.ProviderData.(*transport_tpg.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *transport_tpg.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.providerConfig = pd
+}
+
+func (a *googleActionCloudRunV2JobExecute) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data actionCloudRunV2JobExecuteModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config := a.providerConfig
+
+	project := fwresource.GetProjectFramework(data.Project, types.StringValue(config.Project), &resp.Diagnostics).ValueString()
+	location := fwresource.GetRegionFramework(data.Location, types.StringValue(config.Region), &resp.Diagnostics).ValueString()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	name := fmt.Sprintf("projects/%s/locations/%s/jobs/%s", project, location, data.Name.ValueString())
+
+	overrides := &runadminv2.GoogleCloudRunV2Overrides{
+		TaskCount: data.TaskCount.ValueInt64(),
+		Timeout:   data.Timeout.ValueString(),
+	}
+	if !data.TaskCount.IsNull() {
+		overrides.ForceSendFields = append(overrides.ForceSendFields, "TaskCount")
+	}
+	for _, o := range data.ContainerOverrides {
+		override := &runadminv2.GoogleCloudRunV2ContainerOverride{
+			Name: o.Name.ValueString(),
+		}
+		if !o.Args.IsNull() {
+			resp.Diagnostics.Append(o.Args.ElementsAs(ctx, &override.Args, false)...)
+			// An empty list of arguments clears the arguments of the job.
+			override.ClearArgs = len(override.Args) == 0
+		}
+		env := map[string]string{}
+		if !o.Env.IsNull() {
+			resp.Diagnostics.Append(o.Env.ElementsAs(ctx, &env, false)...)
+		}
+		keys := make([]string, 0, len(env))
+		for k := range env {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			override.Env = append(override.Env, &runadminv2.GoogleCloudRunV2EnvVar{Name: k, Value: env[k]})
+		}
+		overrides.ContainerOverrides = append(overrides.ContainerOverrides, override)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := NewClient(config, config.UserAgent)
+	if client == nil {
+		resp.Diagnostics.AddError("Error creating Cloud Run client", "failed to get a Cloud Run Admin v2 client")
+		return
+	}
+
+	op, err := client.Projects.Locations.Jobs.Run(name, &runadminv2.GoogleCloudRunV2RunJobRequest{
+		Overrides: overrides,
+	}).Context(ctx).Do()
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error running Job %q", name), err.Error())
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Started an execution of Job %s", name),
+	})
+
+	if !data.WaitForCompletion.IsNull() && !data.WaitForCompletion.ValueBool() {
+		return
+	}
+
+	// The operation completes when the execution completes.
+	err = RunAdminV2OperationWaitTime(config, op, project, "Job execution to complete", config.UserAgent, cloudRunV2JobExecuteTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error waiting for the execution of Job %q", name), err.Error())
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("The execution of Job %s has completed", name),
+	})
+}
@@ -0,0 +1,90 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/services/cloudrunv2/action_cloud_run_v2_job_execute_test.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package cloudrunv2_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-google/google/acctest"
+)
+
+func TestAccActionCloudRunV2JobExecute_basic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"cloud_run_job_name": "tf-test-cloudrun-job" + acctest.RandString(t, 10),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckCloudRunV2JobDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccActionCloudRunV2JobExecute_basic(context),
+			},
+		},
+	})
+}
+
+func testAccActionCloudRunV2JobExecute_basic(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_cloud_run_v2_job" "default" {
+  name                = "%{cloud_run_job_name}"
+  location            = "us-central1"
+  deletion_protection = false
+
+  template {
+    template {
+      containers {
+        image = "us-docker.pkg.dev/cloudrun/container/job"
+      }
+    }
+  }
+}
+
+action "google_cloud_run_v2_job_execute" "execute" {
+  config {
+    name       = google_cloud_run_v2_job.default.name
+    location   = google_cloud_run_v2_job.default.location
+    task_count = 2
+
+    container_overrides {
+      env = {
+        FOO = "bar"
+      }
+    }
+  }
+}
+
+resource "terraform_data" "execute" {
+  input = google_cloud_run_v2_job.default.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.google_cloud_run_v2_job_execute.execute]
+    }
+  }
+}
+`, context)
+}
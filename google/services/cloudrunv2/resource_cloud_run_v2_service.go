@@ -117,6 +117,7 @@ func ResourceCloudRunV2Service() *schema.Resource {
 			tpgresource.SetAnnotationsDiff,
 			tpgresource.DefaultProviderProject,
 			tpgresource.DefaultProviderDeletionPolicy("DELETE"),
+			resourceCloudRunV2ServiceRolloutCustomizeDiff,
 		),
 
 		Identity: &schema.ResourceIdentity{
@@ -1567,6 +1568,7 @@ or 'terraform destroy' that would delete the service will fail.
 When the field is set to false, deleting the service is allowed.`,
 				Default: true,
 			},
+			"rollout": cloudRunV2ServiceRolloutSchema(),
 			"project": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -1944,7 +1946,7 @@ func resourceCloudRunV2ServiceRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceCloudRunV2ServiceUpdate(d *schema.ResourceData, meta interface{}) error {
-	clientSideFields := map[string]bool{"deletion_policy": true}
+	clientSideFields := map[string]bool{"deletion_policy": true, "rollout": true}
 	clientSideOnly := true
 	for field := range ResourceCloudRunV2Service().Schema {
 		if d.HasChange(field) && !clientSideFields[field] {
@@ -2095,6 +2097,14 @@ func resourceCloudRunV2ServiceUpdate(d *schema.ResourceData, meta interface{}) e
 		obj["annotations"] = effectiveAnnotationsProp
 	}
 
+	// Keep traffic on the current revisions while the new one is created, and
+	// move it progressively once the update completes.
+	rolloutBaseline := cloudRunV2ServiceRolloutBaseline(d)
+	rolloutDeadline := time.Now().Add(d.Timeout(schema.TimeoutUpdate))
+	if rolloutBaseline != nil {
+		obj["traffic"] = cloudRunV2ServiceRolloutTraffic(rolloutBaseline, "", 0)
+	}
+
 	url, err := tpgresource.ReplaceVars(d, config, transport_tpg.BaseUrl(Product, config)+"projects/{{project}}/locations/{{location}}/services/{{name}}")
 	if err != nil {
 		return err
@@ -2133,6 +2143,14 @@ func resourceCloudRunV2ServiceUpdate(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 
+	if rolloutBaseline != nil {
+		if err := cloudRunV2ServiceRollout(d, config, url, project, billingProject, userAgent, rolloutBaseline, trafficProp, rolloutDeadline); err != nil {
+			// Keep the prior state so that the next apply retries the rollout.
+			d.Partial(true)
+			return err
+		}
+	}
+
 	return resourceCloudRunV2ServiceRead(d, meta)
 }
 
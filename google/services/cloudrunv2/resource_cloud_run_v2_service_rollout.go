@@ -0,0 +1,440 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/services/cloudrunv2/resource_cloud_run_v2_service_rollout.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package cloudrunv2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-google/google/services/monitoring"
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+	"github.com/hashicorp/terraform-provider-google/google/verify"
+)
+
+// cloudRunV2ServiceRequestCountDelay is how long request_count data points take
+// to be readable from Cloud Monitoring after the requests they count.
+// See https://cloud.google.com/monitoring/api/metrics_gcp_p_z#gcp-run
+const cloudRunV2ServiceRequestCountDelay = 3 * time.Minute
+
+func cloudRunV2ServiceRolloutSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Description: `Progressively shifts traffic to the new revision when the template changes, instead of
+moving it all at once. Traffic moves through the steps in order, taken from the revisions that served
+it before the update in proportion to their percents, and moves back to them if a health check fails.
+Rollouts only run on updates that create a new revision.`,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"steps": {
+					Type:        schema.TypeList,
+					Required:    true,
+					MinItems:    1,
+					Description: `The steps of the rollout, with increasing percents. After the last step, traffic follows the traffic field.`,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"percent": {
+								Type:         schema.TypeInt,
+								Required:     true,
+								ValidateFunc: validation.IntBetween(1, 99),
+								Description:  `The percent of the traffic to send to the new revision during the step.`,
+							},
+							"duration": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: verify.ValidateDuration(),
+								Description: `How long the step lasts before its health check, as a duration in seconds
+with up to nine fractional digits, ending with 's'. Example: "300s".`,
+							},
+						},
+					},
+				},
+				"health_check": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: `The health criteria checked at the end of each step. Without them, every step passes.`,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"max_error_rate": {
+								Type:         schema.TypeFloat,
+								Required:     true,
+								ValidateFunc: validation.FloatBetween(0, 1),
+								Description: `The maximum ratio of requests to the new revision with a 5xx response code
+during a step, between 0 and 1, read from the run.googleapis.com/request_count metric of Cloud Monitoring.`,
+							},
+							"min_request_count": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IntAtLeast(0),
+								Description: `The minimum number of requests the new revision must serve during a step
+for its error rate to be checked. With fewer requests, the step passes.`,
+							},
+						},
+					},
+				},
+				"rollback_on_failure": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  true,
+					Description: `Whether to move all traffic back to the revisions that served it before the update when a health check fails.
+When false, traffic stays split as in the failed step.`,
+				},
+			},
+		},
+	}
+}
+
+// resourceCloudRunV2ServiceRolloutCustomizeDiff checks that the percents of
+// the rollout steps increase.
+func resourceCloudRunV2ServiceRolloutCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	previous := 0
+	for i, raw := range d.Get("rollout.0.steps").([]interface{}) {
+		step, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		percent := step["percent"].(int)
+		if percent <= previous {
+			return fmt.Errorf("rollout.0.steps.%d.percent must be greater than the percent of the previous step (%d), got %d", i, previous, percent)
+		}
+		previous = percent
+	}
+	return nil
+}
+
+// cloudRunV2ServiceRolloutBaseline returns the traffic targets that serve
+// traffic before an update, if the update should roll out progressively.
+// Targets on the latest revision are pinned to the revision that was latest
+// before the update.
+func cloudRunV2ServiceRolloutBaseline(d *schema.ResourceData) []interface{} {
+	if _, ok := d.GetOk("rollout.0.steps"); !ok || !d.HasChange("template") {
+		return nil
+	}
+	oldLatest, _ := d.GetChange("latest_ready_revision")
+	oldStatuses, _ := d.GetChange("traffic_statuses")
+	return cloudRunV2ServiceRolloutBaselineTraffic(oldStatuses.([]interface{}), tpgresource.GetResourceNameFromSelfLink(oldLatest.(string)))
+}
+
+// cloudRunV2ServiceRolloutBaselineTraffic converts traffic statuses to
+// traffic targets on fixed revisions. Without statuses, all traffic goes to
+// latest.
+func cloudRunV2ServiceRolloutBaselineTraffic(statuses []interface{}, latest string) []interface{} {
+	var baseline []interface{}
+	for _, raw := range statuses {
+		status, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		revision, _ := status["revision"].(string)
+		if status["type"] == "TRAFFIC_TARGET_ALLOCATION_TYPE_LATEST" || revision == "" {
+			revision = latest
+		}
+		target := map[string]interface{}{
+			"type":     "TRAFFIC_TARGET_ALLOCATION_TYPE_REVISION",
+			"revision": revision,
+			"percent":  status["percent"],
+		}
+		if tag, _ := status["tag"].(string); tag != "" {
+			target["tag"] = tag
+		}
+		baseline = append(baseline, target)
+	}
+	if len(baseline) == 0 {
+		baseline = []interface{}{
+			map[string]interface{}{
+				"type":     "TRAFFIC_TARGET_ALLOCATION_TYPE_REVISION",
+				"revision": latest,
+				"percent":  100,
+			},
+		}
+	}
+	return baseline
+}
+
+// cloudRunV2ServiceRolloutTraffic returns traffic targets that send percent of
+// the traffic to the new revision, and split the rest between the baseline
+// targets in proportion to their percents. Rounding gives the leftover
+// percents to the targets with the largest remainders.
+func cloudRunV2ServiceRolloutTraffic(baseline []interface{}, revision string, percent int) []interface{} {
+	traffic := make([]interface{}, 0, len(baseline)+1)
+	remainders := make([]int, len(baseline))
+	left := 100 - percent
+	for i, raw := range baseline {
+		target := make(map[string]interface{})
+		for k, v := range raw.(map[string]interface{}) {
+			target[k] = v
+		}
+		scaled := cloudRunV2ServiceRolloutPercent(target["percent"]) * (100 - percent)
+		target["percent"] = scaled / 100
+		remainders[i] = scaled % 100
+		left -= scaled / 100
+		traffic = append(traffic, target)
+	}
+	for ; left > 0; left-- {
+		largest := 0
+		for i := range remainders {
+			if remainders[i] > remainders[largest] {
+				largest = i
+			}
+		}
+		remainders[largest] = -1
+		target := traffic[largest].(map[string]interface{})
+		target["percent"] = target["percent"].(int) + 1
+	}
+	if percent > 0 {
+		traffic = append(traffic, map[string]interface{}{
+			"type":     "TRAFFIC_TARGET_ALLOCATION_TYPE_REVISION",
+			"revision": revision,
+			"percent":  percent,
+		})
+	}
+	return traffic
+}
+
+func cloudRunV2ServiceRolloutPercent(v interface{}) int {
+	switch p := v.(type) {
+	case int:
+		return p
+	case float64:
+		return int(p)
+	}
+	return 0
+}
+
+// cloudRunV2ServiceRolloutHasRevision returns whether a baseline target sends
+// traffic to revision.
+func cloudRunV2ServiceRolloutHasRevision(baseline []interface{}, revision string) bool {
+	for _, raw := range baseline {
+		if raw.(map[string]interface{})["revision"] == revision {
+			return true
+		}
+	}
+	return false
+}
+
+// cloudRunV2ServiceRollout moves traffic from the baseline targets to the
+// latest revision of the service through the rollout steps, checking the
+// health of the latest revision after each step. Once all steps pass, traffic
+// is set to finalTraffic. A step that can't complete before deadline fails like
+// a failed health check.
+func cloudRunV2ServiceRollout(d *schema.ResourceData, config *transport_tpg.Config, url, project, billingProject, userAgent string, baseline []interface{}, finalTraffic interface{}, deadline time.Time) error {
+	service, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+		Config:    config,
+		Method:    "GET",
+		Project:   billingProject,
+		RawURL:    url,
+		UserAgent: userAgent,
+	})
+	if err != nil {
+		return fmt.Errorf("Error reading Service %q: %s", d.Id(), err)
+	}
+	created, _ := service["latestCreatedRevision"].(string)
+	ready, _ := service["latestReadyRevision"].(string)
+	revision := tpgresource.GetResourceNameFromSelfLink(created)
+	if revision == "" || cloudRunV2ServiceRolloutHasRevision(baseline, revision) {
+		log.Printf("[DEBUG] Service %q has no new revision to roll out", d.Id())
+		return cloudRunV2ServiceSetTraffic(d, config, url, project, billingProject, userAgent, finalTraffic)
+	}
+	if created != ready {
+		return fmt.Errorf("Error rolling out revision %s of Service %q: the revision isn't ready, traffic stays on its previous revisions", revision, d.Id())
+	}
+
+	_, healthChecked := d.GetOk("rollout.0.health_check")
+	for i, raw := range d.Get("rollout.0.steps").([]interface{}) {
+		step := raw.(map[string]interface{})
+		percent := step["percent"].(int)
+		duration, err := time.ParseDuration(step["duration"].(string))
+		if err != nil {
+			return err
+		}
+
+		if err := cloudRunV2ServiceRolloutStep(d, config, url, project, billingProject, userAgent, baseline, revision, i, percent, duration, healthChecked, deadline); err != nil {
+			if d.Get("rollout.0.rollback_on_failure").(bool) {
+				log.Printf("[INFO] Rollout of revision %s failed at step %d, rolling back to the previous traffic", revision, i)
+				if rerr := cloudRunV2ServiceSetTraffic(d, config, url, project, billingProject, userAgent, cloudRunV2ServiceRolloutTraffic(baseline, revision, 0)); rerr != nil {
+					return fmt.Errorf("Error rolling back Service %q to its previous traffic after a failed step (%s): %s", d.Id(), err, rerr)
+				}
+				return fmt.Errorf("rollout of revision %s failed at step %d: %s. Traffic was rolled back to its previous revisions", revision, i, err)
+			}
+			return fmt.Errorf("rollout of revision %s failed at step %d: %s. Traffic stays split as in the last step", revision, i, err)
+		}
+	}
+
+	log.Printf("[INFO] Rollout of revision %s succeeded", revision)
+	return cloudRunV2ServiceSetTraffic(d, config, url, project, billingProject, userAgent, finalTraffic)
+}
+
+// cloudRunV2ServiceRolloutStep sends percent of the traffic to revision for
+// duration, and then checks the health of revision during the step once its
+// request counts are readable.
+func cloudRunV2ServiceRolloutStep(d *schema.ResourceData, config *transport_tpg.Config, url, project, billingProject, userAgent string, baseline []interface{}, revision string, i, percent int, duration time.Duration, healthChecked bool, deadline time.Time) error {
+	wait := cloudRunV2ServiceRolloutStepWait(duration, healthChecked)
+	if remaining := time.Until(deadline); wait > remaining {
+		return fmt.Errorf("the step takes %s, but only %s of the update timeout remain", wait, remaining.Round(time.Second))
+	}
+
+	log.Printf("[INFO] Rollout of revision %s, step %d: sending %d%% of the traffic for %s", revision, i, percent, duration)
+	if err := cloudRunV2ServiceSetTraffic(d, config, url, project, billingProject, userAgent, cloudRunV2ServiceRolloutTraffic(baseline, revision, percent)); err != nil {
+		return err
+	}
+	start := time.Now()
+	end := start.Add(duration)
+	log.Printf("[DEBUG] Rollout of revision %s, step %d: waiting %s for the step and its request counts", revision, i, wait)
+	time.Sleep(wait)
+
+	return cloudRunV2ServiceRolloutCheckHealth(d, config, project, revision, start, end)
+}
+
+// cloudRunV2ServiceRolloutStepWait returns how long a step of the given
+// duration waits before it completes. Health checked steps also wait for the
+// request counts of the step to be readable.
+func cloudRunV2ServiceRolloutStepWait(duration time.Duration, healthChecked bool) time.Duration {
+	if !healthChecked {
+		return duration
+	}
+	return duration + cloudRunV2ServiceRequestCountDelay
+}
+
+// cloudRunV2ServiceSetTraffic updates the traffic of the service, and waits
+// for the update to complete. Empty traffic sends all traffic to the latest
+// revision.
+func cloudRunV2ServiceSetTraffic(d *schema.ResourceData, config *transport_tpg.Config, url, project, billingProject, userAgent string, traffic interface{}) error {
+	if l, ok := traffic.([]interface{}); !ok || len(l) == 0 {
+		traffic = []interface{}{
+			map[string]interface{}{
+				"type":    "TRAFFIC_TARGET_ALLOCATION_TYPE_LATEST",
+				"percent": 100,
+			},
+		}
+	}
+	patchUrl, err := transport_tpg.AddQueryParams(url, map[string]string{"updateMask": "traffic"})
+	if err != nil {
+		return err
+	}
+	res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+		Config:    config,
+		Method:    "PATCH",
+		Project:   billingProject,
+		RawURL:    patchUrl,
+		UserAgent: userAgent,
+		Body:      map[string]interface{}{"traffic": traffic},
+		Timeout:   d.Timeout(schema.TimeoutUpdate),
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating the traffic of Service %q: %s", d.Id(), err)
+	}
+	return CloudRunV2OperationWaitTime(config, res, project, "Updating Service traffic", userAgent, d.Timeout(schema.TimeoutUpdate))
+}
+
+// cloudRunV2ServiceRolloutCheckHealth checks the error rate of a revision
+// between start and end against the health check of the rollout.
+func cloudRunV2ServiceRolloutCheckHealth(d *schema.ResourceData, config *transport_tpg.Config, project, revision string, start, end time.Time) error {
+	if _, ok := d.GetOk("rollout.0.health_check"); !ok {
+		return nil
+	}
+	maxErrorRate := d.Get("rollout.0.health_check.0.max_error_rate").(float64)
+	minRequestCount := int64(d.Get("rollout.0.health_check.0.min_request_count").(int))
+
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+	filter := fmt.Sprintf(`metric.type = "run.googleapis.com/request_count" AND resource.type = "cloud_run_revision" AND resource.labels.service_name = %q AND resource.labels.revision_name = %q AND resource.labels.location = %q`,
+		tpgresource.GetResourceNameFromSelfLink(d.Get("name").(string)), revision, d.Get("location").(string))
+	url, err := transport_tpg.AddQueryParams(fmt.Sprintf("%sv3/projects/%s/timeSeries", transport_tpg.BaseUrl(monitoring.Product, config), project), map[string]string{
+		"filter":                         filter,
+		"interval.startTime":             start.UTC().Format(time.RFC3339),
+		"interval.endTime":               end.UTC().Format(time.RFC3339),
+		"aggregation.alignmentPeriod":    fmt.Sprintf("%ds", int64(end.Sub(start).Seconds())+1),
+		"aggregation.perSeriesAligner":   "ALIGN_SUM",
+		"aggregation.crossSeriesReducer": "REDUCE_SUM",
+		"aggregation.groupByFields":      "metric.labels.response_code_class",
+	})
+	if err != nil {
+		return err
+	}
+	res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+		Config:    config,
+		Method:    "GET",
+		Project:   project,
+		RawURL:    url,
+		UserAgent: userAgent,
+	})
+	if err != nil {
+		return fmt.Errorf("Error reading the request count of revision %s: %s", revision, err)
+	}
+
+	errors, total := cloudRunV2ServiceRolloutRequestCounts(res)
+	log.Printf("[DEBUG] Revision %s served %d requests, %d with a 5xx response code", revision, total, errors)
+	if total == 0 || total < minRequestCount {
+		return nil
+	}
+	if rate := float64(errors) / float64(total); rate > maxErrorRate {
+		return fmt.Errorf("the error rate of the revision is %.4f (%d of %d requests), more than %g", rate, errors, total, maxErrorRate)
+	}
+	return nil
+}
+
+// cloudRunV2ServiceRolloutRequestCounts sums a list of request_count time
+// series grouped by response code class, returning the number of requests
+// with a 5xx response code and the total number of requests.
+func cloudRunV2ServiceRolloutRequestCounts(res map[string]interface{}) (errors, total int64) {
+	series, _ := res["timeSeries"].([]interface{})
+	for _, raw := range series {
+		ts, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		class := ""
+		if metric, ok := ts["metric"].(map[string]interface{}); ok {
+			if labels, ok := metric["labels"].(map[string]interface{}); ok {
+				class, _ = labels["response_code_class"].(string)
+			}
+		}
+		points, _ := ts["points"].([]interface{})
+		for _, p := range points {
+			point, ok := p.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			value, _ := point["value"].(map[string]interface{})
+			var count int64
+			switch v := value["int64Value"].(type) {
+			case string:
+				count, _ = tpgresource.StringToFixed64(v)
+			case json.Number:
+				count, _ = v.Int64()
+			case float64:
+				count = int64(v)
+			}
+			total += count
+			if class == "5xx" {
+				errors += count
+			}
+		}
+	}
+	return errors, total
+}
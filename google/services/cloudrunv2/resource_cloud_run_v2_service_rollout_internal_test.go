@@ -0,0 +1,140 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/services/cloudrunv2/resource_cloud_run_v2_service_rollout_internal_test.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package cloudrunv2
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCloudRunV2ServiceRolloutRequestCounts(t *testing.T) {
+	t.Parallel()
+
+	series := func(class string, values ...interface{}) interface{} {
+		points := []interface{}{}
+		for _, v := range values {
+			points = append(points, map[string]interface{}{
+				"value": map[string]interface{}{"int64Value": v},
+			})
+		}
+		return map[string]interface{}{
+			"metric": map[string]interface{}{
+				"labels": map[string]interface{}{"response_code_class": class},
+			},
+			"points": points,
+		}
+	}
+
+	cases := map[string]struct {
+		res           map[string]interface{}
+		errors, total int64
+	}{
+		"no series": {
+			res: map[string]interface{}{},
+		},
+		"no errors": {
+			res: map[string]interface{}{
+				"timeSeries": []interface{}{series("2xx", "40", "2")},
+			},
+			total: 42,
+		},
+		"errors": {
+			res: map[string]interface{}{
+				"timeSeries": []interface{}{
+					series("2xx", "90"),
+					series("4xx", float64(4)),
+					series("5xx", "5", "1"),
+				},
+			},
+			errors: 6,
+			total:  100,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			errors, total := cloudRunV2ServiceRolloutRequestCounts(tc.res)
+			if errors != tc.errors || total != tc.total {
+				t.Errorf("got %d errors of %d requests, want %d of %d", errors, total, tc.errors, tc.total)
+			}
+		})
+	}
+}
+
+func TestCloudRunV2ServiceRolloutBaselineTraffic(t *testing.T) {
+	t.Parallel()
+
+	statuses := []interface{}{
+		map[string]interface{}{"type": "TRAFFIC_TARGET_ALLOCATION_TYPE_LATEST", "percent": 90},
+		map[string]interface{}{"type": "TRAFFIC_TARGET_ALLOCATION_TYPE_REVISION", "revision": "srv-00001", "percent": 10, "tag": "old"},
+	}
+	got := cloudRunV2ServiceRolloutBaselineTraffic(statuses, "srv-00002")
+	want := []interface{}{
+		map[string]interface{}{"type": "TRAFFIC_TARGET_ALLOCATION_TYPE_REVISION", "revision": "srv-00002", "percent": 90},
+		map[string]interface{}{"type": "TRAFFIC_TARGET_ALLOCATION_TYPE_REVISION", "revision": "srv-00001", "percent": 10, "tag": "old"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %#v, want %#v", got, want)
+	}
+
+	got = cloudRunV2ServiceRolloutBaselineTraffic(nil, "srv-00002")
+	want = []interface{}{
+		map[string]interface{}{"type": "TRAFFIC_TARGET_ALLOCATION_TYPE_REVISION", "revision": "srv-00002", "percent": 100},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %#v, want all traffic on the latest revision", got)
+	}
+}
+
+func TestCloudRunV2ServiceRolloutTraffic(t *testing.T) {
+	t.Parallel()
+
+	baseline := []interface{}{
+		map[string]interface{}{"type": "TRAFFIC_TARGET_ALLOCATION_TYPE_REVISION", "revision": "srv-00001", "percent": 90},
+		map[string]interface{}{"type": "TRAFFIC_TARGET_ALLOCATION_TYPE_REVISION", "revision": "srv-00002", "percent": 10, "tag": "canary"},
+	}
+
+	got := cloudRunV2ServiceRolloutTraffic(baseline, "srv-00003", 25)
+	want := []interface{}{
+		map[string]interface{}{"type": "TRAFFIC_TARGET_ALLOCATION_TYPE_REVISION", "revision": "srv-00001", "percent": 68},
+		map[string]interface{}{"type": "TRAFFIC_TARGET_ALLOCATION_TYPE_REVISION", "revision": "srv-00002", "percent": 7, "tag": "canary"},
+		map[string]interface{}{"type": "TRAFFIC_TARGET_ALLOCATION_TYPE_REVISION", "revision": "srv-00003", "percent": 25},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %#v, want %#v", got, want)
+	}
+
+	got = cloudRunV2ServiceRolloutTraffic(baseline, "srv-00003", 0)
+	if !reflect.DeepEqual(got, baseline) {
+		t.Errorf("got %#v, want the baseline traffic %#v", got, baseline)
+	}
+	if baseline[0].(map[string]interface{})["percent"] != 90 {
+		t.Errorf("expected the baseline to be left unchanged, got %#v", baseline)
+	}
+}
+
+func TestCloudRunV2ServiceRolloutStepWait(t *testing.T) {
+	t.Parallel()
+
+	if got := cloudRunV2ServiceRolloutStepWait(5*time.Minute, false); got != 5*time.Minute {
+		t.Errorf("expected a step without health check to wait 5m0s, got %s", got)
+	}
+	if got := cloudRunV2ServiceRolloutStepWait(5*time.Minute, true); got != 5*time.Minute+cloudRunV2ServiceRequestCountDelay {
+		t.Errorf("expected a health checked step to wait for its request counts, got %s", got)
+	}
+}
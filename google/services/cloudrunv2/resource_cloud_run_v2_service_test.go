@@ -2031,3 +2031,71 @@ resource "google_cloud_run_v2_service" "default" {
 }
 `, context)
 }
+
+func TestAccCloudRunV2Service_cloudrunv2ServiceRollout(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(t, 10),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckCloudRunV2ServiceDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudRunV2Service_cloudrunv2ServiceRollout(context, "FOO"),
+			},
+			{
+				Config: testAccCloudRunV2Service_cloudrunv2ServiceRollout(context, "BAR"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_cloud_run_v2_service.default", "traffic.0.type", "TRAFFIC_TARGET_ALLOCATION_TYPE_LATEST"),
+					resource.TestCheckResourceAttr("google_cloud_run_v2_service.default", "traffic.0.percent", "100"),
+				),
+			},
+			{
+				ResourceName:            "google_cloud_run_v2_service.default",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"name", "location", "annotations", "labels", "terraform_labels", "deletion_protection", "rollout"},
+			},
+		},
+	})
+}
+
+func testAccCloudRunV2Service_cloudrunv2ServiceRollout(context map[string]interface{}, env string) string {
+	context["env"] = env
+	return acctest.Nprintf(`
+resource "google_cloud_run_v2_service" "default" {
+  name     = "tf-test-cloudrun-srv%{random_suffix}"
+  location = "us-central1"
+  deletion_protection = false
+
+  template {
+    containers {
+      image = "us-docker.pkg.dev/cloudrun/container/hello"
+      env {
+        name  = "ENV"
+        value = "%{env}"
+      }
+    }
+  }
+
+  rollout {
+    steps {
+      percent  = 10
+      duration = "30s"
+    }
+    steps {
+      percent  = 50
+      duration = "30s"
+    }
+    health_check {
+      max_error_rate    = 0.05
+      min_request_count = 10
+    }
+  }
+}
+`, context)
+}
@@ -190,7 +190,7 @@ func deleteResourceColabSchedule(config *transport_tpg.Config, d *tpgresource.Re
 	name = obj["displayName"].(string)
 
 	// Skip resources that shouldn't be sweeped
-	if !sweeper.IsSweepableTestResource(name) {
+	if !sweeper.ShouldSweep(name, obj) {
 		return nil
 	}
 
@@ -108,6 +108,9 @@ func testSweepComposerEnvironments(config *transport_tpg.Config, region string)
 		case "ERROR":
 			fallthrough
 		default:
+			if sweeper.SkipForDryRun(e.Name) {
+				continue
+			}
 			op, deleteErr := NewClient(config, config.UserAgent).Projects.Locations.Environments.Delete(e.Name).Do()
 			if deleteErr != nil {
 				allErrors = errors.Join(allErrors, fmt.Errorf("composer: unable to delete environment %q: %s", e.Name, deleteErr))
@@ -156,6 +159,10 @@ func testSweepComposerEnvironmentBuckets(config *transport_tpg.Config, region st
 }
 
 func testSweepComposerEnvironmentCleanUpBucket(config *transport_tpg.Config, bucket *storage.Bucket) error {
+	if sweeper.SkipForDryRun(bucket.Name) {
+		return nil
+	}
+
 	var allErrors error
 	objList, err := storage_tpg.NewClient(config, config.UserAgent).Objects.List(bucket.Name).Do()
 	if err != nil {
@@ -17,6 +17,7 @@
 package composer
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -83,12 +84,15 @@ func ResourceComposerUserWorkloadsSecret() *schema.Resource {
 				Description:  `Name of the environment.`,
 			},
 			"data": {
-				Type:        schema.TypeMap,
-				Optional:    true,
-				ForceNew:    false,
-				Sensitive:   true,
-				Description: `A map of the secret data.`,
+				Type:          schema.TypeMap,
+				Optional:      true,
+				ForceNew:      false,
+				Sensitive:     true,
+				Description:   `A map of the secret data.`,
+				ConflictsWith: []string{"data_wo"},
 			},
+			"data_wo":         tpgresource.WriteOnlySchema("data", `Write-only JSON-encoded map of the secret data.`, false),
+			"data_wo_version": tpgresource.WriteOnlyVersionSchema("data", false),
 			//UDP schema start
 			"deletion_policy": tpgresource.DeletionPolicySchemaEntry("DELETE"),
 			//UDP schema end
@@ -108,9 +112,14 @@ func resourceComposerUserWorkloadsSecretCreate(d *schema.ResourceData, meta inte
 		return err
 	}
 
+	data, err := resourceComposerUserWorkloadsSecretData(d)
+	if err != nil {
+		return err
+	}
+
 	secret := &composer.UserWorkloadsSecret{
 		Name: secretName.ResourceName(),
-		Data: tpgresource.ConvertStringMap(d.Get("data").(map[string]interface{})),
+		Data: data,
 	}
 
 	log.Printf("[DEBUG] Creating new UserWorkloadsSecret %q", secretName.ParentName())
@@ -185,10 +194,15 @@ func resourceComposerUserWorkloadsSecretUpdate(d *schema.ResourceData, meta inte
 		return err
 	}
 
-	if d.HasChange("data") {
+	if tpgresource.WriteOnlyHasChange(d, "data") {
+		data, err := resourceComposerUserWorkloadsSecretData(d)
+		if err != nil {
+			return err
+		}
+
 		secret := &composer.UserWorkloadsSecret{
 			Name: secretName.ResourceName(),
-			Data: tpgresource.ConvertStringMap(d.Get("data").(map[string]interface{})),
+			Data: data,
 		}
 
 		secretJson, _ := secret.MarshalJSON()
@@ -266,6 +280,20 @@ func resourceComposerUserWorkloadsSecretImport(d *schema.ResourceData, meta inte
 	return []*schema.ResourceData{d}, nil
 }
 
+// resourceComposerUserWorkloadsSecretData returns the decoded data_wo when it's set in the
+// configuration, and data otherwise.
+func resourceComposerUserWorkloadsSecretData(d *schema.ResourceData) (map[string]string, error) {
+	wo := tpgresource.GetRawConfigAttributeAsString(d, "data_wo")
+	if wo == "" {
+		return tpgresource.ConvertStringMap(d.Get("data").(map[string]interface{})), nil
+	}
+	data := map[string]string{}
+	if err := json.Unmarshal([]byte(wo), &data); err != nil {
+		return nil, fmt.Errorf("data_wo must be a JSON-encoded map of strings: %s", err)
+	}
+	return data, nil
+}
+
 func resourceComposerUserWorkloadsSecretName(d *schema.ResourceData, config *transport_tpg.Config) (*UserWorkloadsSecretName, error) {
 	project, err := tpgresource.GetProject(d, config)
 	if err != nil {
@@ -0,0 +1,139 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/services/compute/action_compute_instance_reset.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package compute
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-google/google/fwresource"
+	"github.com/hashicorp/terraform-provider-google/google/registry"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+)
+
+const computeInstanceResetTimeout = 20 * time.Minute
+
+var _ action.ActionWithConfigure = &googleActionComputeInstanceReset{}
+
+func init() {
+	registry.FrameworkAction{
+		Name:        "google_compute_instance_reset",
+		ProductName: "compute",
+		Func:        GoogleActionComputeInstanceReset,
+	}.Register()
+}
+
+func GoogleActionComputeInstanceReset() action.Action {
+	return &googleActionComputeInstanceReset{}
+}
+
+type googleActionComputeInstanceReset struct {
+	providerConfig *transport_tpg.Config
+}
+
+func (a *googleActionComputeInstanceReset) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_compute_instance_reset"
+}
+
+type actionComputeInstanceResetModel struct {
+	Name    types.String `tfsdk:"name"`
+	Zone    types.String `tfsdk:"zone"`
+	Project types.String `tfsdk:"project"`
+}
+
+func (a *googleActionComputeInstanceReset) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Performs a hard reset of a Compute Engine instance. The instance isn't shut down gracefully, and the contents of its memory are lost.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "The name of the instance to reset.",
+				Required:    true,
+			},
+			"zone": schema.StringAttribute{
+				Description: "The zone of the instance. If it is not provided, the provider zone is used.",
+				Optional:    true,
+			},
+			"project": schema.StringAttribute{
+				Description: "The ID of the project in which the instance belongs. If it is not provided, the provider project is used.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (a *googleActionComputeInstanceReset) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	pd, ok := req.ProviderData.(*transport_tpg.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *transport_tpg.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.providerConfig = pd
+}
+
+func (a *googleActionComputeInstanceReset) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data actionComputeInstanceResetModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config := a.providerConfig
+
+	project := fwresource.GetProjectFramework(data.Project, types.StringValue(config.Project), &resp.Diagnostics)
+	zone := fwresource.GetZoneFramework(data.Zone, types.StringValue(config.Zone), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	name := data.Name.ValueString()
+
+	client := NewClient(config, config.UserAgent)
+	if client == nil {
+		resp.Diagnostics.AddError("Error creating Compute client", "failed to get a Compute client")
+		return
+	}
+
+	op, err := client.Instances.Reset(project.ValueString(), zone.ValueString(), name).Context(ctx).Do()
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error resetting instance %q", name), err.Error())
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Resetting instance %s", name),
+	})
+
+	err = ComputeOperationWaitTime(config, op, project.ValueString(), "instance to reset", config.UserAgent, computeInstanceResetTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error waiting for instance %q to reset", name), err.Error())
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Instance %s has been reset", name),
+	})
+}
@@ -1331,8 +1331,11 @@ Allowed only for INTERNET_IP_PORT and INTERNET_FQDN_PORT NEG backends.`,
 										Optional: true,
 										Description: `The access key used for s3 bucket authentication.
 Required for updating or creating a backend that uses AWS v4 signature authentication, but will not be returned as part of the configuration when queried with a REST API GET request.`,
-										Sensitive: true,
+										Sensitive:     true,
+										ConflictsWith: []string{"security_settings.0.aws_v4_authentication.0.access_key_wo"},
 									},
+									"access_key_wo":         tpgresource.WriteOnlySchema("security_settings.0.aws_v4_authentication.0.access_key", `Write-only access key used for s3 bucket authentication.`, false),
+									"access_key_wo_version": tpgresource.WriteOnlyVersionSchema("security_settings.0.aws_v4_authentication.0.access_key", false),
 									"access_key_id": {
 										Type:        schema.TypeString,
 										Optional:    true,
@@ -3903,6 +3906,8 @@ func flattenComputeBackendServiceSecuritySettingsAwsV4Authentication(v interface
 		flattenComputeBackendServiceSecuritySettingsAwsV4AuthenticationAccessKeyId(original["accessKeyId"], d, config)
 	transformed["access_key"] =
 		flattenComputeBackendServiceSecuritySettingsAwsV4AuthenticationAccessKey(original["accessKey"], d, config)
+	transformed["access_key_wo_version"] =
+		flattenComputeBackendServiceSecuritySettingsAwsV4AuthenticationAccessKeyWoVersion(original["accessKeyWoVersion"], d, config)
 	transformed["access_key_version"] =
 		flattenComputeBackendServiceSecuritySettingsAwsV4AuthenticationAccessKeyVersion(original["accessKeyVersion"], d, config)
 	transformed["origin_region"] =
@@ -3917,6 +3922,10 @@ func flattenComputeBackendServiceSecuritySettingsAwsV4AuthenticationAccessKey(v
 	return d.Get("security_settings.0.aws_v4_authentication.0.access_key")
 }
 
+func flattenComputeBackendServiceSecuritySettingsAwsV4AuthenticationAccessKeyWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("security_settings.0.aws_v4_authentication.0.access_key_wo_version")
+}
+
 func flattenComputeBackendServiceSecuritySettingsAwsV4AuthenticationAccessKeyVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -5469,7 +5478,7 @@ func expandComputeBackendServiceSecuritySettingsAwsV4Authentication(v interface{
 		transformed["accessKeyId"] = transformedAccessKeyId
 	}
 
-	transformedAccessKey, err := expandComputeBackendServiceSecuritySettingsAwsV4AuthenticationAccessKey(original["access_key"], d, config)
+	transformedAccessKey, err := expandComputeBackendServiceSecuritySettingsAwsV4AuthenticationAccessKey(tpgresource.GetWriteOnlyValue(d, "security_settings.0.aws_v4_authentication.0.access_key", original["access_key"]), d, config)
 	if err != nil {
 		return nil, err
 	} else {
@@ -145,12 +145,15 @@ func ResourceComputeBackendServiceSignedUrlKey() *schema.Resource {
 			},
 			"key_value": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 				ForceNew: true,
 				Description: `128-bit key value used for signing the URL. The key value must be a
 valid RFC 4648 Section 5 base64url encoded string.`,
-				Sensitive: true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"key_value", "key_value_wo"},
 			},
+			"key_value_wo":         tpgresource.WriteOnlySchema("key_value", `Write-only 128-bit key value used for signing the URL. The key value must be a valid RFC 4648 Section 5 base64url encoded string.`, true),
+			"key_value_wo_version": tpgresource.WriteOnlyVersionSchema("key_value", true),
 			"name": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -195,7 +198,7 @@ func resourceComputeBackendServiceSignedUrlKeyCreate(d *schema.ResourceData, met
 	} else if v, ok := d.GetOkExists("name"); !tpgresource.IsEmptyValue(reflect.ValueOf(nameProp)) && (ok || !reflect.DeepEqual(v, nameProp)) {
 		obj["keyName"] = nameProp
 	}
-	keyValueProp, err := expandNestedComputeBackendServiceSignedUrlKeyKeyValue(d.Get("key_value"), d, config)
+	keyValueProp, err := expandNestedComputeBackendServiceSignedUrlKeyKeyValue(tpgresource.GetWriteOnlyValue(d, "key_value", d.Get("key_value")), d, config)
 	if err != nil {
 		return err
 	} else if v, ok := d.GetOkExists("key_value"); !tpgresource.IsEmptyValue(reflect.ValueOf(keyValueProp)) && (ok || !reflect.DeepEqual(v, keyValueProp)) {
@@ -581,8 +581,11 @@ If absent, the Compute Engine Service Agent service account is used.`,
 							ForceNew: true,
 							Description: `Specifies a 256-bit customer-supplied encryption key, encoded in
 RFC 4648 base64 to either encrypt or decrypt this resource.`,
-							Sensitive: true,
+							Sensitive:     true,
+							ConflictsWith: []string{"disk_encryption_key.0.raw_key_wo"},
 						},
+						"raw_key_wo":         tpgresource.WriteOnlySchema("disk_encryption_key.0.raw_key", `Write-only 256-bit customer-supplied encryption key, encoded in RFC 4648 base64.`, false),
+						"raw_key_wo_version": tpgresource.WriteOnlyVersionSchema("disk_encryption_key.0.raw_key", true),
 						"rsa_encrypted_key": {
 							Type:     schema.TypeString,
 							Optional: true,
@@ -590,8 +593,11 @@ RFC 4648 base64 to either encrypt or decrypt this resource.`,
 							Description: `Specifies an RFC 4648 base64 encoded, RSA-wrapped 2048-bit
 customer-supplied encryption key to either encrypt or decrypt
 this resource. You can provide either the rawKey or the rsaEncryptedKey.`,
-							Sensitive: true,
+							Sensitive:     true,
+							ConflictsWith: []string{"disk_encryption_key.0.rsa_encrypted_key_wo"},
 						},
+						"rsa_encrypted_key_wo":         tpgresource.WriteOnlySchema("disk_encryption_key.0.rsa_encrypted_key", `Write-only RFC 4648 base64 encoded, RSA-wrapped 2048-bit customer-supplied encryption key.`, false),
+						"rsa_encrypted_key_wo_version": tpgresource.WriteOnlyVersionSchema("disk_encryption_key.0.rsa_encrypted_key", true),
 						"sha256": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -783,8 +789,11 @@ If absent, the Compute Engine Service Agent service account is used.`,
 							ForceNew: true,
 							Description: `Specifies a 256-bit customer-supplied encryption key, encoded in
 RFC 4648 base64 to either encrypt or decrypt this resource.`,
-							Sensitive: true,
+							Sensitive:     true,
+							ConflictsWith: []string{"source_image_encryption_key.0.raw_key_wo"},
 						},
+						"raw_key_wo":         tpgresource.WriteOnlySchema("source_image_encryption_key.0.raw_key", `Write-only 256-bit customer-supplied encryption key, encoded in RFC 4648 base64.`, false),
+						"raw_key_wo_version": tpgresource.WriteOnlyVersionSchema("source_image_encryption_key.0.raw_key", true),
 						"sha256": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -840,8 +849,11 @@ If absent, the Compute Engine Service Agent service account is used.`,
 							ForceNew: true,
 							Description: `Specifies a 256-bit customer-supplied encryption key, encoded in
 RFC 4648 base64 to either encrypt or decrypt this resource.`,
-							Sensitive: true,
+							Sensitive:     true,
+							ConflictsWith: []string{"source_snapshot_encryption_key.0.raw_key_wo"},
 						},
+						"raw_key_wo":         tpgresource.WriteOnlySchema("source_snapshot_encryption_key.0.raw_key", `Write-only 256-bit customer-supplied encryption key, encoded in RFC 4648 base64.`, false),
+						"raw_key_wo_version": tpgresource.WriteOnlyVersionSchema("source_snapshot_encryption_key.0.raw_key", true),
 						"sha256": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -980,6 +992,8 @@ project/zones/zone/instances/instance`,
 If your disk is encrypted with customer managed encryption keys these will be reused for the snapshot creation.
 The name of the snapshot by default will be '{{disk-name}}-YYYYMMDD-HHmm'`,
 				Default: false,
+				// Write-only keys aren't kept in state to encrypt the snapshot with
+				ConflictsWith: []string{"disk_encryption_key.0.raw_key_wo", "disk_encryption_key.0.rsa_encrypted_key_wo"},
 			},
 			"create_snapshot_before_destroy_prefix": {
 				Type:        schema.TypeString,
@@ -1774,6 +1788,8 @@ func flattenComputeDiskSourceImageEncryptionKey(v interface{}, d *schema.Resourc
 	transformed := make(map[string]interface{})
 	transformed["raw_key"] =
 		flattenComputeDiskSourceImageEncryptionKeyRawKey(original["rawKey"], d, config)
+	transformed["raw_key_wo_version"] =
+		flattenComputeDiskSourceImageEncryptionKeyRawKeyWoVersion(original["rawKeyWoVersion"], d, config)
 	transformed["sha256"] =
 		flattenComputeDiskSourceImageEncryptionKeySha256(original["sha256"], d, config)
 	transformed["kms_key_self_link"] =
@@ -1786,6 +1802,10 @@ func flattenComputeDiskSourceImageEncryptionKeyRawKey(v interface{}, d *schema.R
 	return v
 }
 
+func flattenComputeDiskSourceImageEncryptionKeyRawKeyWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("source_image_encryption_key.0.raw_key_wo_version")
+}
+
 func flattenComputeDiskSourceImageEncryptionKeySha256(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -1821,8 +1841,12 @@ func flattenComputeDiskDiskEncryptionKey(v interface{}, d *schema.ResourceData,
 	transformed := make(map[string]interface{})
 	transformed["raw_key"] =
 		flattenComputeDiskDiskEncryptionKeyRawKey(original["rawKey"], d, config)
+	transformed["raw_key_wo_version"] =
+		flattenComputeDiskDiskEncryptionKeyRawKeyWoVersion(original["rawKeyWoVersion"], d, config)
 	transformed["rsa_encrypted_key"] =
 		flattenComputeDiskDiskEncryptionKeyRsaEncryptedKey(original["rsaEncryptedKey"], d, config)
+	transformed["rsa_encrypted_key_wo_version"] =
+		flattenComputeDiskDiskEncryptionKeyRsaEncryptedKeyWoVersion(original["rsaEncryptedKeyWoVersion"], d, config)
 	transformed["sha256"] =
 		flattenComputeDiskDiskEncryptionKeySha256(original["sha256"], d, config)
 	transformed["kms_key_self_link"] =
@@ -1835,10 +1859,18 @@ func flattenComputeDiskDiskEncryptionKeyRawKey(v interface{}, d *schema.Resource
 	return v
 }
 
+func flattenComputeDiskDiskEncryptionKeyRawKeyWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("disk_encryption_key.0.raw_key_wo_version")
+}
+
 func flattenComputeDiskDiskEncryptionKeyRsaEncryptedKey(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
 
+func flattenComputeDiskDiskEncryptionKeyRsaEncryptedKeyWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("disk_encryption_key.0.rsa_encrypted_key_wo_version")
+}
+
 func flattenComputeDiskDiskEncryptionKeySha256(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -1862,6 +1894,8 @@ func flattenComputeDiskSourceSnapshotEncryptionKey(v interface{}, d *schema.Reso
 	transformed := make(map[string]interface{})
 	transformed["raw_key"] =
 		flattenComputeDiskSourceSnapshotEncryptionKeyRawKey(original["rawKey"], d, config)
+	transformed["raw_key_wo_version"] =
+		flattenComputeDiskSourceSnapshotEncryptionKeyRawKeyWoVersion(original["rawKeyWoVersion"], d, config)
 	transformed["kms_key_self_link"] =
 		flattenComputeDiskSourceSnapshotEncryptionKeyKmsKeySelfLink(original["kmsKeyName"], d, config)
 	transformed["sha256"] =
@@ -1874,6 +1908,10 @@ func flattenComputeDiskSourceSnapshotEncryptionKeyRawKey(v interface{}, d *schem
 	return v
 }
 
+func flattenComputeDiskSourceSnapshotEncryptionKeyRawKeyWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("source_snapshot_encryption_key.0.raw_key_wo_version")
+}
+
 func flattenComputeDiskSourceSnapshotEncryptionKeyKmsKeySelfLink(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -2134,7 +2172,7 @@ func expandComputeDiskSourceImageEncryptionKey(v interface{}, d tpgresource.Terr
 	original := raw.(map[string]interface{})
 	transformed := make(map[string]interface{})
 
-	transformedRawKey, err := expandComputeDiskSourceImageEncryptionKeyRawKey(original["raw_key"], d, config)
+	transformedRawKey, err := expandComputeDiskSourceImageEncryptionKeyRawKey(tpgresource.GetWriteOnlyValue(d, "source_image_encryption_key.0.raw_key", original["raw_key"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedRawKey); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -2197,14 +2235,14 @@ func expandComputeDiskDiskEncryptionKey(v interface{}, d tpgresource.TerraformRe
 	original := raw.(map[string]interface{})
 	transformed := make(map[string]interface{})
 
-	transformedRawKey, err := expandComputeDiskDiskEncryptionKeyRawKey(original["raw_key"], d, config)
+	transformedRawKey, err := expandComputeDiskDiskEncryptionKeyRawKey(tpgresource.GetWriteOnlyValue(d, "disk_encryption_key.0.raw_key", original["raw_key"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedRawKey); val.IsValid() && !tpgresource.IsEmptyValue(val) {
 		transformed["rawKey"] = transformedRawKey
 	}
 
-	transformedRsaEncryptedKey, err := expandComputeDiskDiskEncryptionKeyRsaEncryptedKey(original["rsa_encrypted_key"], d, config)
+	transformedRsaEncryptedKey, err := expandComputeDiskDiskEncryptionKeyRsaEncryptedKey(tpgresource.GetWriteOnlyValue(d, "disk_encryption_key.0.rsa_encrypted_key", original["rsa_encrypted_key"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedRsaEncryptedKey); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -2267,7 +2305,7 @@ func expandComputeDiskSourceSnapshotEncryptionKey(v interface{}, d tpgresource.T
 	original := raw.(map[string]interface{})
 	transformed := make(map[string]interface{})
 
-	transformedRawKey, err := expandComputeDiskSourceSnapshotEncryptionKeyRawKey(original["raw_key"], d, config)
+	transformedRawKey, err := expandComputeDiskSourceSnapshotEncryptionKeyRawKey(tpgresource.GetWriteOnlyValue(d, "source_snapshot_encryption_key.0.raw_key", original["raw_key"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedRawKey); val.IsValid() && !tpgresource.IsEmptyValue(val) {
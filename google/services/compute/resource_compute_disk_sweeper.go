@@ -88,7 +88,7 @@ func testSweepDisk(region string) error {
 				prefixes := []string{
 					"pvc-", // b/291168201
 				}
-				if !sweeper.IsSweepableTestResource(id) && !sweeper.HasAnyPrefix(id, prefixes) {
+				if !sweeper.ShouldSweep(id, obj, prefixes...) {
 					nonPrefixCount++
 					continue
 				}
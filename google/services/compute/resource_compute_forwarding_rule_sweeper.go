@@ -201,7 +201,7 @@ func deleteResourceComputeForwardingRule(config *transport_tpg.Config, d *tpgres
 	name = tpgresource.GetResourceNameFromSelfLink(obj["name"].(string))
 
 	// Skip resources that shouldn't be sweeped
-	if !sweeper.IsSweepableTestResource(name) {
+	if !sweeper.ShouldSweep(name, obj) {
 		return nil
 	}
 
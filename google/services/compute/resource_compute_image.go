@@ -218,16 +218,22 @@ account is used.`,
 							ForceNew: true,
 							Description: `Specifies a 256-bit customer-supplied encryption key, encoded in
 RFC 4648 base64 to either encrypt or decrypt this resource.`,
-							Sensitive: true,
+							Sensitive:     true,
+							ConflictsWith: []string{"image_encryption_key.0.raw_key_wo"},
 						},
+						"raw_key_wo":         tpgresource.WriteOnlySchema("image_encryption_key.0.raw_key", `Write-only 256-bit customer-supplied encryption key, encoded in RFC 4648 base64.`, false),
+						"raw_key_wo_version": tpgresource.WriteOnlyVersionSchema("image_encryption_key.0.raw_key", true),
 						"rsa_encrypted_key": {
 							Type:     schema.TypeString,
 							Optional: true,
 							ForceNew: true,
 							Description: `Specifies a 256-bit customer-supplied encryption key, encoded in
 RFC 4648 base64 to either encrypt or decrypt this resource.`,
-							Sensitive: true,
+							Sensitive:     true,
+							ConflictsWith: []string{"image_encryption_key.0.rsa_encrypted_key_wo"},
 						},
+						"rsa_encrypted_key_wo":         tpgresource.WriteOnlySchema("image_encryption_key.0.rsa_encrypted_key", `Write-only RFC 4648 base64 encoded, RSA-wrapped 2048-bit customer-supplied encryption key.`, false),
+						"rsa_encrypted_key_wo_version": tpgresource.WriteOnlyVersionSchema("image_encryption_key.0.rsa_encrypted_key", true),
 					},
 				},
 			},
@@ -469,8 +475,11 @@ account is used.`,
 							ForceNew: true,
 							Description: `Specifies a 256-bit customer-supplied encryption key, encoded in
 RFC 4648 base64 to either encrypt or decrypt this resource.`,
-							Sensitive: true,
+							Sensitive:     true,
+							ConflictsWith: []string{"source_disk_encryption_key.0.raw_key_wo"},
 						},
+						"raw_key_wo":         tpgresource.WriteOnlySchema("source_disk_encryption_key.0.raw_key", `Write-only 256-bit customer-supplied encryption key, encoded in RFC 4648 base64.`, false),
+						"raw_key_wo_version": tpgresource.WriteOnlyVersionSchema("source_disk_encryption_key.0.raw_key", true),
 						"rsa_encrypted_key": {
 							Type:     schema.TypeString,
 							Optional: true,
@@ -478,8 +487,11 @@ RFC 4648 base64 to either encrypt or decrypt this resource.`,
 							Description: `Specifies an RFC 4648 base64 encoded, RSA-wrapped 2048-bit
 customer-supplied encryption key to either encrypt or decrypt
 this resource. You can provide either the rawKey or the rsaEncryptedKey.`,
-							Sensitive: true,
+							Sensitive:     true,
+							ConflictsWith: []string{"source_disk_encryption_key.0.rsa_encrypted_key_wo"},
 						},
+						"rsa_encrypted_key_wo":         tpgresource.WriteOnlySchema("source_disk_encryption_key.0.rsa_encrypted_key", `Write-only RFC 4648 base64 encoded, RSA-wrapped 2048-bit customer-supplied encryption key.`, false),
+						"rsa_encrypted_key_wo_version": tpgresource.WriteOnlyVersionSchema("source_disk_encryption_key.0.rsa_encrypted_key", true),
 					},
 				},
 			},
@@ -530,8 +542,11 @@ account is used.`,
 							ForceNew: true,
 							Description: `Specifies a 256-bit customer-supplied encryption key, encoded in
 RFC 4648 base64 to either encrypt or decrypt this resource.`,
-							Sensitive: true,
+							Sensitive:     true,
+							ConflictsWith: []string{"source_image_encryption_key.0.raw_key_wo"},
 						},
+						"raw_key_wo":         tpgresource.WriteOnlySchema("source_image_encryption_key.0.raw_key", `Write-only 256-bit customer-supplied encryption key, encoded in RFC 4648 base64.`, false),
+						"raw_key_wo_version": tpgresource.WriteOnlyVersionSchema("source_image_encryption_key.0.raw_key", true),
 						"rsa_encrypted_key": {
 							Type:     schema.TypeString,
 							Optional: true,
@@ -539,8 +554,11 @@ RFC 4648 base64 to either encrypt or decrypt this resource.`,
 							Description: `Specifies an RFC 4648 base64 encoded, RSA-wrapped 2048-bit
 customer-supplied encryption key to either encrypt or decrypt
 this resource. You can provide either the rawKey or the rsaEncryptedKey.`,
-							Sensitive: true,
+							Sensitive:     true,
+							ConflictsWith: []string{"source_image_encryption_key.0.rsa_encrypted_key_wo"},
 						},
+						"rsa_encrypted_key_wo":         tpgresource.WriteOnlySchema("source_image_encryption_key.0.rsa_encrypted_key", `Write-only RFC 4648 base64 encoded, RSA-wrapped 2048-bit customer-supplied encryption key.`, false),
+						"rsa_encrypted_key_wo_version": tpgresource.WriteOnlyVersionSchema("source_image_encryption_key.0.rsa_encrypted_key", true),
 					},
 				},
 			},
@@ -593,8 +611,11 @@ account is used.`,
 							ForceNew: true,
 							Description: `Specifies a 256-bit customer-supplied encryption key, encoded in
 RFC 4648 base64 to either encrypt or decrypt this resource.`,
-							Sensitive: true,
+							Sensitive:     true,
+							ConflictsWith: []string{"source_snapshot_encryption_key.0.raw_key_wo"},
 						},
+						"raw_key_wo":         tpgresource.WriteOnlySchema("source_snapshot_encryption_key.0.raw_key", `Write-only 256-bit customer-supplied encryption key, encoded in RFC 4648 base64.`, false),
+						"raw_key_wo_version": tpgresource.WriteOnlyVersionSchema("source_snapshot_encryption_key.0.raw_key", true),
 						"rsa_encrypted_key": {
 							Type:     schema.TypeString,
 							Optional: true,
@@ -602,8 +623,11 @@ RFC 4648 base64 to either encrypt or decrypt this resource.`,
 							Description: `Specifies an RFC 4648 base64 encoded, RSA-wrapped 2048-bit
 customer-supplied encryption key to either encrypt or decrypt
 this resource. You can provide either the rawKey or the rsaEncryptedKey.`,
-							Sensitive: true,
+							Sensitive:     true,
+							ConflictsWith: []string{"source_snapshot_encryption_key.0.rsa_encrypted_key_wo"},
 						},
+						"rsa_encrypted_key_wo":         tpgresource.WriteOnlySchema("source_snapshot_encryption_key.0.rsa_encrypted_key", `Write-only RFC 4648 base64 encoded, RSA-wrapped 2048-bit customer-supplied encryption key.`, false),
+						"rsa_encrypted_key_wo_version": tpgresource.WriteOnlyVersionSchema("source_snapshot_encryption_key.0.rsa_encrypted_key", true),
 					},
 				},
 			},
@@ -1240,8 +1264,12 @@ func flattenComputeImageImageEncryptionKey(v interface{}, d *schema.ResourceData
 		flattenComputeImageImageEncryptionKeyKmsKeyServiceAccount(original["kmsKeyServiceAccount"], d, config)
 	transformed["raw_key"] =
 		flattenComputeImageImageEncryptionKeyRawKey(original["rawKey"], d, config)
+	transformed["raw_key_wo_version"] =
+		flattenComputeImageImageEncryptionKeyRawKeyWoVersion(original["rawKeyWoVersion"], d, config)
 	transformed["rsa_encrypted_key"] =
 		flattenComputeImageImageEncryptionKeyRsaEncryptedKey(original["rsaEncryptedKey"], d, config)
+	transformed["rsa_encrypted_key_wo_version"] =
+		flattenComputeImageImageEncryptionKeyRsaEncryptedKeyWoVersion(original["rsaEncryptedKeyWoVersion"], d, config)
 	return []interface{}{transformed}
 }
 func flattenComputeImageImageEncryptionKeyKmsKeySelfLink(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -1260,10 +1288,18 @@ func flattenComputeImageImageEncryptionKeyRawKey(v interface{}, d *schema.Resour
 	return d.Get("image_encryption_key.0.raw_key")
 }
 
+func flattenComputeImageImageEncryptionKeyRawKeyWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("image_encryption_key.0.raw_key_wo_version")
+}
+
 func flattenComputeImageImageEncryptionKeyRsaEncryptedKey(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return d.Get("image_encryption_key.0.rsa_encrypted_key")
 }
 
+func flattenComputeImageImageEncryptionKeyRsaEncryptedKeyWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("image_encryption_key.0.rsa_encrypted_key_wo_version")
+}
+
 func flattenComputeImageLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	if v == nil {
 		return v
@@ -1532,14 +1568,14 @@ func expandComputeImageImageEncryptionKey(v interface{}, d tpgresource.Terraform
 		transformed["kmsKeyServiceAccount"] = transformedKmsKeyServiceAccount
 	}
 
-	transformedRawKey, err := expandComputeImageImageEncryptionKeyRawKey(original["raw_key"], d, config)
+	transformedRawKey, err := expandComputeImageImageEncryptionKeyRawKey(tpgresource.GetWriteOnlyValue(d, "image_encryption_key.0.raw_key", original["raw_key"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedRawKey); val.IsValid() && !tpgresource.IsEmptyValue(val) {
 		transformed["rawKey"] = transformedRawKey
 	}
 
-	transformedRsaEncryptedKey, err := expandComputeImageImageEncryptionKeyRsaEncryptedKey(original["rsa_encrypted_key"], d, config)
+	transformedRsaEncryptedKey, err := expandComputeImageImageEncryptionKeyRsaEncryptedKey(tpgresource.GetWriteOnlyValue(d, "image_encryption_key.0.rsa_encrypted_key", original["rsa_encrypted_key"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedRsaEncryptedKey); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -1690,14 +1726,14 @@ func expandComputeImageSourceDiskEncryptionKey(v interface{}, d tpgresource.Terr
 	original := raw.(map[string]interface{})
 	transformed := make(map[string]interface{})
 
-	transformedRawKey, err := expandComputeImageSourceDiskEncryptionKeyRawKey(original["raw_key"], d, config)
+	transformedRawKey, err := expandComputeImageSourceDiskEncryptionKeyRawKey(tpgresource.GetWriteOnlyValue(d, "source_disk_encryption_key.0.raw_key", original["raw_key"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedRawKey); val.IsValid() && !tpgresource.IsEmptyValue(val) {
 		transformed["rawKey"] = transformedRawKey
 	}
 
-	transformedRsaEncryptedKey, err := expandComputeImageSourceDiskEncryptionKeyRsaEncryptedKey(original["rsa_encrypted_key"], d, config)
+	transformedRsaEncryptedKey, err := expandComputeImageSourceDiskEncryptionKeyRsaEncryptedKey(tpgresource.GetWriteOnlyValue(d, "source_disk_encryption_key.0.rsa_encrypted_key", original["rsa_encrypted_key"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedRsaEncryptedKey); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -1757,14 +1793,14 @@ func expandComputeImageSourceImageEncryptionKey(v interface{}, d tpgresource.Ter
 	original := raw.(map[string]interface{})
 	transformed := make(map[string]interface{})
 
-	transformedRawKey, err := expandComputeImageSourceImageEncryptionKeyRawKey(original["raw_key"], d, config)
+	transformedRawKey, err := expandComputeImageSourceImageEncryptionKeyRawKey(tpgresource.GetWriteOnlyValue(d, "source_image_encryption_key.0.raw_key", original["raw_key"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedRawKey); val.IsValid() && !tpgresource.IsEmptyValue(val) {
 		transformed["rawKey"] = transformedRawKey
 	}
 
-	transformedRsaEncryptedKey, err := expandComputeImageSourceImageEncryptionKeyRsaEncryptedKey(original["rsa_encrypted_key"], d, config)
+	transformedRsaEncryptedKey, err := expandComputeImageSourceImageEncryptionKeyRsaEncryptedKey(tpgresource.GetWriteOnlyValue(d, "source_image_encryption_key.0.rsa_encrypted_key", original["rsa_encrypted_key"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedRsaEncryptedKey); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -2024,14 +2060,14 @@ func expandComputeImageSourceSnapshotEncryptionKey(v interface{}, d tpgresource.
 	original := raw.(map[string]interface{})
 	transformed := make(map[string]interface{})
 
-	transformedRawKey, err := expandComputeImageSourceSnapshotEncryptionKeyRawKey(original["raw_key"], d, config)
+	transformedRawKey, err := expandComputeImageSourceSnapshotEncryptionKeyRawKey(tpgresource.GetWriteOnlyValue(d, "source_snapshot_encryption_key.0.raw_key", original["raw_key"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedRawKey); val.IsValid() && !tpgresource.IsEmptyValue(val) {
 		transformed["rawKey"] = transformedRawKey
 	}
 
-	transformedRsaEncryptedKey, err := expandComputeImageSourceSnapshotEncryptionKeyRsaEncryptedKey(original["rsa_encrypted_key"], d, config)
+	transformedRsaEncryptedKey, err := expandComputeImageSourceSnapshotEncryptionKeyRsaEncryptedKey(tpgresource.GetWriteOnlyValue(d, "source_snapshot_encryption_key.0.rsa_encrypted_key", original["rsa_encrypted_key"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedRsaEncryptedKey); val.IsValid() && !tpgresource.IsEmptyValue(val) {
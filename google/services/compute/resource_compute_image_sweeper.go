@@ -191,7 +191,7 @@ func deleteResourceComputeImage(config *transport_tpg.Config, d *tpgresource.Res
 	name = tpgresource.GetResourceNameFromSelfLink(obj["name"].(string))
 
 	// Skip resources that shouldn't be sweeped
-	if !sweeper.IsSweepableTestResource(name) {
+	if !sweeper.ShouldSweep(name, obj) {
 		return nil
 	}
 
@@ -92,8 +92,10 @@ var (
 		"boot_disk.0.auto_delete",
 		"boot_disk.0.device_name",
 		"boot_disk.0.disk_encryption_key_raw",
+		"boot_disk.0.disk_encryption_key_raw_wo",
 		"boot_disk.0.kms_key_self_link",
 		"boot_disk.0.disk_encryption_key_rsa",
+		"boot_disk.0.disk_encryption_key_rsa_wo",
 		"boot_disk.0.disk_encryption_service_account",
 		"boot_disk.0.initialize_params",
 		"boot_disk.0.mode",
@@ -305,21 +307,45 @@ func ResourceComputeInstance() *schema.Resource {
 							Optional:      true,
 							AtLeastOneOf:  bootDiskKeys,
 							ForceNew:      true,
-							ConflictsWith: []string{"boot_disk.0.kms_key_self_link", "boot_disk.0.disk_encryption_key_rsa"},
+							ConflictsWith: []string{"boot_disk.0.kms_key_self_link", "boot_disk.0.disk_encryption_key_rsa", "boot_disk.0.disk_encryption_key_raw_wo", "boot_disk.0.disk_encryption_key_rsa_wo"},
 							Sensitive:     true,
 							Description:   `A 256-bit customer-supplied encryption key, encoded in RFC 4648 base64 to encrypt this disk. Only one of kms_key_self_link, disk_encryption_key_raw and disk_encryption_key_rsa may be set.`,
 						},
 
+						"disk_encryption_key_raw_wo": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							WriteOnly:     true,
+							AtLeastOneOf:  bootDiskKeys,
+							ConflictsWith: []string{"boot_disk.0.kms_key_self_link", "boot_disk.0.disk_encryption_key_raw", "boot_disk.0.disk_encryption_key_rsa", "boot_disk.0.disk_encryption_key_rsa_wo"},
+							RequiredWith:  []string{"boot_disk.0.disk_encryption_key_raw_wo_version"},
+							Description:   `Write-only 256-bit customer-supplied encryption key, encoded in RFC 4648 base64 to encrypt this disk. Only one of kms_key_self_link, disk_encryption_key_raw and disk_encryption_key_rsa, or their write-only variants, may be set.`,
+						},
+
+						"disk_encryption_key_raw_wo_version": tpgresource.WriteOnlyVersionSchema("boot_disk.0.disk_encryption_key_raw", true),
+
 						"disk_encryption_key_rsa": {
 							Type:          schema.TypeString,
 							Optional:      true,
 							AtLeastOneOf:  bootDiskKeys,
 							ForceNew:      true,
-							ConflictsWith: []string{"boot_disk.0.kms_key_self_link", "boot_disk.0.disk_encryption_key_raw"},
+							ConflictsWith: []string{"boot_disk.0.kms_key_self_link", "boot_disk.0.disk_encryption_key_raw", "boot_disk.0.disk_encryption_key_raw_wo", "boot_disk.0.disk_encryption_key_rsa_wo"},
 							Sensitive:     true,
 							Description:   `Specifies an RFC 4648 base64 encoded, RSA-wrapped 2048-bit customer-supplied encryption key to either encrypt or decrypt this resource. Only one of kms_key_self_link, disk_encryption_key_raw and disk_encryption_key_rsa may be set.`,
 						},
 
+						"disk_encryption_key_rsa_wo": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							WriteOnly:     true,
+							AtLeastOneOf:  bootDiskKeys,
+							ConflictsWith: []string{"boot_disk.0.kms_key_self_link", "boot_disk.0.disk_encryption_key_raw", "boot_disk.0.disk_encryption_key_raw_wo", "boot_disk.0.disk_encryption_key_rsa"},
+							RequiredWith:  []string{"boot_disk.0.disk_encryption_key_rsa_wo_version"},
+							Description:   `Write-only RFC 4648 base64 encoded, RSA-wrapped 2048-bit customer-supplied encryption key to either encrypt or decrypt this resource. Only one of kms_key_self_link, disk_encryption_key_raw and disk_encryption_key_rsa, or their write-only variants, may be set.`,
+						},
+
+						"disk_encryption_key_rsa_wo_version": tpgresource.WriteOnlyVersionSchema("boot_disk.0.disk_encryption_key_rsa", true),
+
 						"disk_encryption_key_sha256": {
 							Type:        schema.TypeString,
 							Computed:    true,
@@ -346,7 +372,7 @@ func ResourceComputeInstance() *schema.Resource {
 							Optional:         true,
 							AtLeastOneOf:     bootDiskKeys,
 							ForceNew:         true,
-							ConflictsWith:    []string{"boot_disk.0.disk_encryption_key_raw", "boot_disk.0.disk_encryption_key_rsa"},
+							ConflictsWith:    []string{"boot_disk.0.disk_encryption_key_raw", "boot_disk.0.disk_encryption_key_rsa", "boot_disk.0.disk_encryption_key_raw_wo", "boot_disk.0.disk_encryption_key_rsa_wo"},
 							DiffSuppressFunc: tpgresource.CompareSelfLinkRelativePaths,
 							Computed:         true,
 							Description:      `The self_link of the encryption key that is stored in Google Cloud KMS to encrypt this disk. Only one of kms_key_self_link, disk_encryption_key_raw and disk_encryption_key_rsa may be set.`,
@@ -925,6 +951,32 @@ func ResourceComputeInstance() *schema.Resource {
 							Description: `Specifies an RFC 4648 base64 encoded, RSA-wrapped 2048-bit customer-supplied encryption key to either encrypt or decrypt this resource. Only one of kms_key_self_link, disk_encryption_key_rsa and disk_encryption_key_raw may be set.`,
 						},
 
+						"disk_encryption_key_raw_wo": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							WriteOnly:   true,
+							Description: `Write-only 256-bit customer-supplied encryption key, encoded in RFC 4648 base64 to encrypt this disk. Only one of kms_key_self_link, disk_encryption_key_rsa and disk_encryption_key_raw, or their write-only variants, may be set.`,
+						},
+
+						"disk_encryption_key_raw_wo_version": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `Triggers update of 'disk_encryption_key_raw_wo' write-only. Increment this value when an update to 'disk_encryption_key_raw_wo' is needed. For more info see [updating write-only arguments](/docs/providers/google/guides/using_write_only_arguments.html#updating-write-only-arguments)`,
+						},
+
+						"disk_encryption_key_rsa_wo": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							WriteOnly:   true,
+							Description: `Write-only RFC 4648 base64 encoded, RSA-wrapped 2048-bit customer-supplied encryption key to either encrypt or decrypt this resource. Only one of kms_key_self_link, disk_encryption_key_rsa and disk_encryption_key_raw, or their write-only variants, may be set.`,
+						},
+
+						"disk_encryption_key_rsa_wo_version": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `Triggers update of 'disk_encryption_key_rsa_wo' write-only. Increment this value when an update to 'disk_encryption_key_rsa_wo' is needed. For more info see [updating write-only arguments](/docs/providers/google/guides/using_write_only_arguments.html#updating-write-only-arguments)`,
+						},
+
 						"kms_key_self_link": {
 							Type:             schema.TypeString,
 							Optional:         true,
@@ -1749,7 +1801,7 @@ func expandComputeInstance(project string, d *schema.ResourceData, config *trans
 
 	for i := 0; i < attachedDisksCount; i++ {
 		diskConfig := d.Get(fmt.Sprintf("attached_disk.%d", i)).(map[string]interface{})
-		disk, err := expandAttachedDiskTyped(diskConfig, d, config)
+		disk, err := expandAttachedDiskTyped(attachedDiskConfigWithWriteOnlyKeys(d, i, diskConfig), d, config)
 		if err != nil {
 			return nil, err
 		}
@@ -2271,6 +2323,11 @@ func populateComputeInstanceResourceData(d *schema.ResourceData, instance *compu
 					if rawKey != "" {
 						di["disk_encryption_key_raw"] = rawKey
 					}
+					for _, version := range []string{"disk_encryption_key_raw_wo_version", "disk_encryption_key_rsa_wo_version"} {
+						if v, ok := d.GetOk(fmt.Sprintf("attached_disk.%d.%s", adIndex, version)); ok {
+							di[version] = v
+						}
+					}
 					if serviceAccount := d.Get(fmt.Sprintf("attached_disk.%d.disk_encryption_service_account", adIndex)); serviceAccount != "" {
 						di["disk_encryption_service_account"] = serviceAccount
 					}
@@ -3223,7 +3280,7 @@ func resourceComputeInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 			if err != nil {
 				return err
 			}
-			hash, err := hashstructure.Hash(*computeDisk, nil)
+			hash, err := attachedDiskHash(computeDisk, diskConfig)
 			if err != nil {
 				return err
 			}
@@ -3238,19 +3295,25 @@ func resourceComputeInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 		// If a disk with a certain hash is only in the new config, it should be attached.
 		nDisks := map[uint64]struct{}{}
 		var attach []*compute.AttachedDisk
-		for _, disk := range n.([]interface{}) {
+		for i, disk := range n.([]interface{}) {
 			diskConfig := disk.(map[string]interface{})
 			computeDisk, err := expandAttachedDiskTyped(diskConfig, d, config)
 			if err != nil {
 				return err
 			}
-			hash, err := hashstructure.Hash(*computeDisk, nil)
+			hash, err := attachedDiskHash(computeDisk, diskConfig)
 			if err != nil {
 				return err
 			}
 			nDisks[hash] = struct{}{}
 
 			if _, ok := oDisks[hash]; !ok {
+				// Write-only keys aren't part of the hash, as they aren't kept in state, but
+				// they're needed to attach the disk.
+				computeDisk, err = expandAttachedDiskTyped(attachedDiskConfigWithWriteOnlyKeys(d, i, diskConfig), d, config)
+				if err != nil {
+					return err
+				}
 				computeDiskV1 := &compute.AttachedDisk{}
 				err = tpgresource.Convert(computeDisk, computeDiskV1)
 				if err != nil {
@@ -3966,6 +4029,29 @@ func expandAttachedDisk(diskConfig map[string]interface{}, d *schema.ResourceDat
 	return disk, nil
 }
 
+// attachedDiskConfigWithWriteOnlyKeys returns a copy of the configuration of the attached disk
+// at index i with its encryption keys read from their write-only variants when they're set.
+func attachedDiskConfigWithWriteOnlyKeys(d *schema.ResourceData, i int, diskConfig map[string]interface{}) map[string]interface{} {
+	withKeys := make(map[string]interface{}, len(diskConfig))
+	for k, v := range diskConfig {
+		withKeys[k] = v
+	}
+	for _, key := range []string{"disk_encryption_key_raw", "disk_encryption_key_rsa"} {
+		withKeys[key] = tpgresource.GetWriteOnlyValue(d, fmt.Sprintf("attached_disk.%d.%s", i, key), diskConfig[key])
+	}
+	return withKeys
+}
+
+// attachedDiskHash identifies an attached disk across updates. Changing the version of a
+// write-only key reattaches the disk, like changing the key itself does.
+func attachedDiskHash(disk *compute.AttachedDisk, diskConfig map[string]interface{}) (uint64, error) {
+	return hashstructure.Hash(struct {
+		Disk          compute.AttachedDisk
+		RawKeyVersion interface{}
+		RsaKeyVersion interface{}
+	}{*disk, diskConfig["disk_encryption_key_raw_wo_version"], diskConfig["disk_encryption_key_rsa_wo_version"]}, nil)
+}
+
 // expandAttachedDiskTyped adapts the map-based expandAttachedDisk output to the
 // typed *compute.AttachedDisk still required by callers that build Apiary
 // request structs directly or read typed fields.
@@ -4263,19 +4349,15 @@ func expandBootDisk(d *schema.ResourceData, config *transport_tpg.Config, projec
 	}
 
 	var diskEncryptionKey map[string]interface{}
-	if v, ok := d.GetOk("boot_disk.0.disk_encryption_key_raw"); ok {
-		if v != "" {
-			diskEncryptionKey = map[string]interface{}{
-				"rawKey": v.(string),
-			}
+	if v := tpgresource.GetWriteOnlyValue(d, "boot_disk.0.disk_encryption_key_raw", d.Get("boot_disk.0.disk_encryption_key_raw")); v != "" {
+		diskEncryptionKey = map[string]interface{}{
+			"rawKey": v.(string),
 		}
 	}
 
-	if v, ok := d.GetOk("boot_disk.0.disk_encryption_key_rsa"); ok {
-		if v != "" {
-			diskEncryptionKey = map[string]interface{}{
-				"rsaEncryptedKey": v.(string),
-			}
+	if v := tpgresource.GetWriteOnlyValue(d, "boot_disk.0.disk_encryption_key_rsa", d.Get("boot_disk.0.disk_encryption_key_rsa")); v != "" {
+		diskEncryptionKey = map[string]interface{}{
+			"rsaEncryptedKey": v.(string),
 		}
 	}
 
@@ -4459,6 +4541,13 @@ func flattenBootDisk(d *schema.ResourceData, disk *compute.AttachedDisk, config
 		"disk_encryption_key_raw": d.Get("boot_disk.0.disk_encryption_key_raw"),
 		"disk_encryption_key_rsa": d.Get("boot_disk.0.disk_encryption_key_rsa"),
 	}
+	// The versions of the write-only keys are copied from state too, and aren't part of
+	// the schema of google_compute_instance_from_template.
+	for _, version := range []string{"disk_encryption_key_raw_wo_version", "disk_encryption_key_rsa_wo_version"} {
+		if v, ok := d.GetOk("boot_disk.0." + version); ok {
+			result[version] = v
+		}
+	}
 	if _, ok := d.GetOk("boot_disk.0.interface"); ok {
 		result["interface"] = disk.Interface
 	}
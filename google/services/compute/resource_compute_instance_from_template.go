@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"slices"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
@@ -71,11 +72,27 @@ func computeInstanceFromTemplateSchema() map[string]*schema.Schema {
 		delete(s, field)
 	}
 
+	// Write-only arguments can't be set in the computed blocks below, so the disk
+	// encryption keys can only be overridden with their sensitive arguments.
+	for _, field := range []string{"boot_disk", "attached_disk"} {
+		disk := s[field].Elem.(*schema.Resource).Schema
+		for _, key := range []string{"disk_encryption_key_raw", "disk_encryption_key_rsa"} {
+			delete(disk, key+tpgresource.WriteOnlySuffix)
+			delete(disk, key+tpgresource.WriteOnlyVersionSuffix)
+		}
+	}
+	isWriteOnlyKey := func(k string) bool {
+		return strings.HasSuffix(k, tpgresource.WriteOnlySuffix)
+	}
+
 	recurseOnSchema(s, func(field *schema.Schema) {
 		// We don't want to accidentally use default values to override the instance
 		// template, so remove defaults.
 		field.Default = nil
 
+		field.ConflictsWith = slices.DeleteFunc(slices.Clone(field.ConflictsWith), isWriteOnlyKey)
+		field.AtLeastOneOf = slices.DeleteFunc(slices.Clone(field.AtLeastOneOf), isWriteOnlyKey)
+
 		// Make non-required fields computed since they'll be set by the template.
 		// Leave deprecated and removed fields alone because we don't set them.
 		if !field.Required && !(field.Deprecated != "") {
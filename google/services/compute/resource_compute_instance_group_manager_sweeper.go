@@ -56,7 +56,7 @@ func testSweepComputeInstanceGroupManager(region string) error {
 	nonPrefixCount := 0
 	for zone, itemList := range found.Items {
 		for _, igm := range itemList.InstanceGroupManagers {
-			if !sweeper.IsSweepableTestResource(igm.Name) {
+			if !sweeper.ShouldSweepTyped(igm.Name, igm) {
 				nonPrefixCount++
 				continue
 			}
@@ -56,7 +56,7 @@ func testSweepComputeInstance(region string) error {
 	nonPrefixCount := 0
 	for zone, itemList := range found.Items {
 		for _, instance := range itemList.Instances {
-			if !sweeper.IsSweepableTestResource(instance.Name) {
+			if !sweeper.ShouldSweepTyped(instance.Name, instance) {
 				nonPrefixCount++
 				continue
 			}
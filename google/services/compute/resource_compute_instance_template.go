@@ -262,6 +262,30 @@ images are encrypted with your own keys.`,
 										Description: `Specifies an RFC 4648 base64 encoded, RSA-wrapped 2048-bit customer-supplied encryption key to either encrypt or decrypt this resource. Only one of kms_key_self_link, rsa_encrypted_key and raw_key may be set.`,
 										Sensitive:   true,
 									},
+									"raw_key_wo": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										WriteOnly:   true,
+										Description: `Write-only 256-bit customer-supplied encryption key, encoded in RFC 4648 base64 to either encrypt or decrypt this resource. Only one of kms_key_self_link, rsa_encrypted_key and raw_key, or their write-only variants, may be set.`,
+									},
+									"raw_key_wo_version": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										ForceNew:    true,
+										Description: `Triggers update of 'raw_key_wo' write-only. Increment this value when an update to 'raw_key_wo' is needed. For more info see [updating write-only arguments](/docs/providers/google/guides/using_write_only_arguments.html#updating-write-only-arguments)`,
+									},
+									"rsa_encrypted_key_wo": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										WriteOnly:   true,
+										Description: `Write-only RFC 4648 base64 encoded, RSA-wrapped 2048-bit customer-supplied encryption key to either encrypt or decrypt this resource. Only one of kms_key_self_link, rsa_encrypted_key and raw_key, or their write-only variants, may be set.`,
+									},
+									"rsa_encrypted_key_wo_version": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										ForceNew:    true,
+										Description: `Triggers update of 'rsa_encrypted_key_wo' write-only. Increment this value when an update to 'rsa_encrypted_key_wo' is needed. For more info see [updating write-only arguments](/docs/providers/google/guides/using_write_only_arguments.html#updating-write-only-arguments)`,
+									},
 									"kms_key_service_account": {
 										Type:     schema.TypeString,
 										Optional: true,
@@ -312,6 +336,30 @@ required except for local SSD.`,
 										Description: `Specifies an RFC 4648 base64 encoded, RSA-wrapped 2048-bit customer-supplied encryption key to either encrypt or decrypt this resource. Only one of kms_key_self_link, rsa_encrypted_key and raw_key may be set.`,
 										Sensitive:   true,
 									},
+									"raw_key_wo": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										WriteOnly:   true,
+										Description: `Write-only 256-bit customer-supplied encryption key, encoded in RFC 4648 base64 to either encrypt or decrypt this resource. Only one of kms_key_self_link, rsa_encrypted_key and raw_key, or their write-only variants, may be set.`,
+									},
+									"raw_key_wo_version": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										ForceNew:    true,
+										Description: `Triggers update of 'raw_key_wo' write-only. Increment this value when an update to 'raw_key_wo' is needed. For more info see [updating write-only arguments](/docs/providers/google/guides/using_write_only_arguments.html#updating-write-only-arguments)`,
+									},
+									"rsa_encrypted_key_wo": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										WriteOnly:   true,
+										Description: `Write-only RFC 4648 base64 encoded, RSA-wrapped 2048-bit customer-supplied encryption key to either encrypt or decrypt this resource. Only one of kms_key_self_link, rsa_encrypted_key and raw_key, or their write-only variants, may be set.`,
+									},
+									"rsa_encrypted_key_wo_version": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										ForceNew:    true,
+										Description: `Triggers update of 'rsa_encrypted_key_wo' write-only. Increment this value when an update to 'rsa_encrypted_key_wo' is needed. For more info see [updating write-only arguments](/docs/providers/google/guides/using_write_only_arguments.html#updating-write-only-arguments)`,
+									},
 									"kms_key_service_account": {
 										Type:     schema.TypeString,
 										Optional: true,
@@ -1429,10 +1477,10 @@ func buildDisks(d *schema.ResourceData, config *transport_tpg.Config) ([]*comput
 
 			if _, ok := d.GetOk(prefix + ".source_image_encryption_key"); ok {
 				disk.InitializeParams.SourceImageEncryptionKey = &compute.CustomerEncryptionKey{}
-				if v, ok := d.GetOk(prefix + ".source_image_encryption_key.0.raw_key"); ok {
+				if v := tpgresource.GetWriteOnlyValue(d, prefix+".source_image_encryption_key.0.raw_key", d.Get(prefix+".source_image_encryption_key.0.raw_key")); v != "" {
 					disk.InitializeParams.SourceImageEncryptionKey.RawKey = v.(string)
 				}
-				if v, ok := d.GetOk(prefix + ".source_image_encryption_key.0.rsa_encrypted_key"); ok {
+				if v := tpgresource.GetWriteOnlyValue(d, prefix+".source_image_encryption_key.0.rsa_encrypted_key", d.Get(prefix+".source_image_encryption_key.0.rsa_encrypted_key")); v != "" {
 					disk.InitializeParams.SourceImageEncryptionKey.RsaEncryptedKey = v.(string)
 				}
 				if v, ok := d.GetOk(prefix + ".source_image_encryption_key.0.kms_key_self_link"); ok {
@@ -1449,10 +1497,10 @@ func buildDisks(d *schema.ResourceData, config *transport_tpg.Config) ([]*comput
 
 			if _, ok := d.GetOk(prefix + ".source_snapshot_encryption_key"); ok {
 				disk.InitializeParams.SourceSnapshotEncryptionKey = &compute.CustomerEncryptionKey{}
-				if v, ok := d.GetOk(prefix + ".source_snapshot_encryption_key.0.raw_key"); ok {
+				if v := tpgresource.GetWriteOnlyValue(d, prefix+".source_snapshot_encryption_key.0.raw_key", d.Get(prefix+".source_snapshot_encryption_key.0.raw_key")); v != "" {
 					disk.InitializeParams.SourceSnapshotEncryptionKey.RawKey = v.(string)
 				}
-				if v, ok := d.GetOk(prefix + ".source_snapshot_encryption_key.0.rsa_encrypted_key"); ok {
+				if v := tpgresource.GetWriteOnlyValue(d, prefix+".source_snapshot_encryption_key.0.rsa_encrypted_key", d.Get(prefix+".source_snapshot_encryption_key.0.rsa_encrypted_key")); v != "" {
 					disk.InitializeParams.SourceSnapshotEncryptionKey.RsaEncryptedKey = v.(string)
 				}
 				if v, ok := d.GetOk(prefix + ".source_snapshot_encryption_key.0.kms_key_self_link"); ok {
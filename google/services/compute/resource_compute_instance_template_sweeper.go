@@ -62,7 +62,7 @@ func testSweepComputeInstanceTemplate(region string) error {
 	nonPrefixCount := 0
 	for _, instanceTemplate := range instanceTemplates.Items {
 		// Increment count and skip if resource is not sweepable.
-		if !sweeper.IsSweepableTestResource(instanceTemplate.Name) {
+		if !sweeper.ShouldSweepTyped(instanceTemplate.Name, instanceTemplate) {
 			nonPrefixCount++
 			continue
 		}
@@ -201,7 +201,7 @@ func deleteResourceComputeInstantSnapshot(config *transport_tpg.Config, d *tpgre
 	name = tpgresource.GetResourceNameFromSelfLink(obj["name"].(string))
 
 	// Skip resources that shouldn't be sweeped
-	if !sweeper.IsSweepableTestResource(name) {
+	if !sweeper.ShouldSweep(name, obj) {
 		return nil
 	}
 
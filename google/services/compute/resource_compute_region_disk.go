@@ -239,8 +239,11 @@ you do not need to provide a key to use the disk later.`,
 							ForceNew: true,
 							Description: `Specifies a 256-bit customer-supplied encryption key, encoded in
 RFC 4648 base64 to either encrypt or decrypt this resource.`,
-							Sensitive: true,
+							Sensitive:     true,
+							ConflictsWith: []string{"disk_encryption_key.0.raw_key_wo"},
 						},
+						"raw_key_wo":         tpgresource.WriteOnlySchema("disk_encryption_key.0.raw_key", `Write-only 256-bit customer-supplied encryption key, encoded in RFC 4648 base64.`, false),
+						"raw_key_wo_version": tpgresource.WriteOnlyVersionSchema("disk_encryption_key.0.raw_key", true),
 						"rsa_encrypted_key": {
 							Type:     schema.TypeString,
 							Optional: true,
@@ -248,8 +251,11 @@ RFC 4648 base64 to either encrypt or decrypt this resource.`,
 							Description: `Specifies an RFC 4648 base64 encoded, RSA-wrapped 2048-bit
 customer-supplied encryption key to either encrypt or decrypt
 this resource. You can provide either the rawKey or the rsaEncryptedKey.`,
-							Sensitive: true,
+							Sensitive:     true,
+							ConflictsWith: []string{"disk_encryption_key.0.rsa_encrypted_key_wo"},
 						},
+						"rsa_encrypted_key_wo":         tpgresource.WriteOnlySchema("disk_encryption_key.0.rsa_encrypted_key", `Write-only RFC 4648 base64 encoded, RSA-wrapped 2048-bit customer-supplied encryption key.`, false),
+						"rsa_encrypted_key_wo_version": tpgresource.WriteOnlyVersionSchema("disk_encryption_key.0.rsa_encrypted_key", true),
 						"sha256": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -409,8 +415,11 @@ If absent, the Compute Engine Service Agent service account is used.`,
 							ForceNew: true,
 							Description: `Specifies a 256-bit customer-supplied encryption key, encoded in
 RFC 4648 base64 to either encrypt or decrypt this resource.`,
-							Sensitive: true,
+							Sensitive:     true,
+							ConflictsWith: []string{"source_image_encryption_key.0.raw_key_wo"},
 						},
+						"raw_key_wo":         tpgresource.WriteOnlySchema("source_image_encryption_key.0.raw_key", `Write-only 256-bit customer-supplied encryption key, encoded in RFC 4648 base64.`, false),
+						"raw_key_wo_version": tpgresource.WriteOnlyVersionSchema("source_image_encryption_key.0.raw_key", true),
 						"rsa_encrypted_key": {
 							Type:     schema.TypeString,
 							Optional: true,
@@ -418,8 +427,11 @@ RFC 4648 base64 to either encrypt or decrypt this resource.`,
 							Description: `Specifies an RFC 4648 base64 encoded, RSA-wrapped 2048-bit
 customer-supplied encryption key to either encrypt or decrypt
 this resource. You can provide either the rawKey or the rsaEncryptedKey.`,
-							Sensitive: true,
+							Sensitive:     true,
+							ConflictsWith: []string{"source_image_encryption_key.0.rsa_encrypted_key_wo"},
 						},
+						"rsa_encrypted_key_wo":         tpgresource.WriteOnlySchema("source_image_encryption_key.0.rsa_encrypted_key", `Write-only RFC 4648 base64 encoded, RSA-wrapped 2048-bit customer-supplied encryption key.`, false),
+						"rsa_encrypted_key_wo_version": tpgresource.WriteOnlyVersionSchema("source_image_encryption_key.0.rsa_encrypted_key", true),
 						"sha256": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -445,8 +457,11 @@ key.`,
 							ForceNew: true,
 							Description: `Specifies a 256-bit customer-supplied encryption key, encoded in
 RFC 4648 base64 to either encrypt or decrypt this resource.`,
-							Sensitive: true,
+							Sensitive:     true,
+							ConflictsWith: []string{"source_snapshot_encryption_key.0.raw_key_wo"},
 						},
+						"raw_key_wo":         tpgresource.WriteOnlySchema("source_snapshot_encryption_key.0.raw_key", `Write-only 256-bit customer-supplied encryption key, encoded in RFC 4648 base64.`, false),
+						"raw_key_wo_version": tpgresource.WriteOnlyVersionSchema("source_snapshot_encryption_key.0.raw_key", true),
 						"sha256": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -546,6 +561,8 @@ project/zones/zone/instances/instance`,
 If your disk is encrypted with customer managed encryption keys these will be reused for the snapshot creation.
 The name of the snapshot by default will be '{{disk-name}}-YYYYMMDD-HHmm'`,
 				Default: false,
+				// Write-only keys aren't kept in state to encrypt the snapshot with
+				ConflictsWith: []string{"disk_encryption_key.0.raw_key_wo", "disk_encryption_key.0.rsa_encrypted_key_wo"},
 			},
 			"create_snapshot_before_destroy_prefix": {
 				Type:        schema.TypeString,
@@ -1368,8 +1385,12 @@ func flattenComputeRegionDiskDiskEncryptionKey(v interface{}, d *schema.Resource
 	transformed := make(map[string]interface{})
 	transformed["raw_key"] =
 		flattenComputeRegionDiskDiskEncryptionKeyRawKey(original["rawKey"], d, config)
+	transformed["raw_key_wo_version"] =
+		flattenComputeRegionDiskDiskEncryptionKeyRawKeyWoVersion(original["rawKeyWoVersion"], d, config)
 	transformed["rsa_encrypted_key"] =
 		flattenComputeRegionDiskDiskEncryptionKeyRsaEncryptedKey(original["rsaEncryptedKey"], d, config)
+	transformed["rsa_encrypted_key_wo_version"] =
+		flattenComputeRegionDiskDiskEncryptionKeyRsaEncryptedKeyWoVersion(original["rsaEncryptedKeyWoVersion"], d, config)
 	transformed["sha256"] =
 		flattenComputeRegionDiskDiskEncryptionKeySha256(original["sha256"], d, config)
 	transformed["kms_key_name"] =
@@ -1380,10 +1401,18 @@ func flattenComputeRegionDiskDiskEncryptionKeyRawKey(v interface{}, d *schema.Re
 	return v
 }
 
+func flattenComputeRegionDiskDiskEncryptionKeyRawKeyWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("disk_encryption_key.0.raw_key_wo_version")
+}
+
 func flattenComputeRegionDiskDiskEncryptionKeyRsaEncryptedKey(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
 
+func flattenComputeRegionDiskDiskEncryptionKeyRsaEncryptedKeyWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("disk_encryption_key.0.rsa_encrypted_key_wo_version")
+}
+
 func flattenComputeRegionDiskDiskEncryptionKeySha256(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -1407,6 +1436,8 @@ func flattenComputeRegionDiskSourceSnapshotEncryptionKey(v interface{}, d *schem
 	transformed := make(map[string]interface{})
 	transformed["raw_key"] =
 		flattenComputeRegionDiskSourceSnapshotEncryptionKeyRawKey(original["rawKey"], d, config)
+	transformed["raw_key_wo_version"] =
+		flattenComputeRegionDiskSourceSnapshotEncryptionKeyRawKeyWoVersion(original["rawKeyWoVersion"], d, config)
 	transformed["sha256"] =
 		flattenComputeRegionDiskSourceSnapshotEncryptionKeySha256(original["sha256"], d, config)
 	return []interface{}{transformed}
@@ -1415,6 +1446,10 @@ func flattenComputeRegionDiskSourceSnapshotEncryptionKeyRawKey(v interface{}, d
 	return v
 }
 
+func flattenComputeRegionDiskSourceSnapshotEncryptionKeyRawKeyWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("source_snapshot_encryption_key.0.raw_key_wo_version")
+}
+
 func flattenComputeRegionDiskSourceSnapshotEncryptionKeySha256(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -1663,14 +1698,14 @@ func expandComputeRegionDiskDiskEncryptionKey(v interface{}, d tpgresource.Terra
 	original := raw.(map[string]interface{})
 	transformed := make(map[string]interface{})
 
-	transformedRawKey, err := expandComputeRegionDiskDiskEncryptionKeyRawKey(original["raw_key"], d, config)
+	transformedRawKey, err := expandComputeRegionDiskDiskEncryptionKeyRawKey(tpgresource.GetWriteOnlyValue(d, "disk_encryption_key.0.raw_key", original["raw_key"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedRawKey); val.IsValid() && !tpgresource.IsEmptyValue(val) {
 		transformed["rawKey"] = transformedRawKey
 	}
 
-	transformedRsaEncryptedKey, err := expandComputeRegionDiskDiskEncryptionKeyRsaEncryptedKey(original["rsa_encrypted_key"], d, config)
+	transformedRsaEncryptedKey, err := expandComputeRegionDiskDiskEncryptionKeyRsaEncryptedKey(tpgresource.GetWriteOnlyValue(d, "disk_encryption_key.0.rsa_encrypted_key", original["rsa_encrypted_key"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedRsaEncryptedKey); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -1722,14 +1757,14 @@ func expandComputeRegionDiskSourceImageEncryptionKey(v interface{}, d tpgresourc
 	original := raw.(map[string]interface{})
 	transformed := make(map[string]interface{})
 
-	transformedRawKey, err := expandComputeRegionDiskSourceImageEncryptionKeyRawKey(original["raw_key"], d, config)
+	transformedRawKey, err := expandComputeRegionDiskSourceImageEncryptionKeyRawKey(tpgresource.GetWriteOnlyValue(d, "source_image_encryption_key.0.raw_key", original["raw_key"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedRawKey); val.IsValid() && !tpgresource.IsEmptyValue(val) {
 		transformed["rawKey"] = transformedRawKey
 	}
 
-	transformedRsaEncryptedKey, err := expandComputeRegionDiskSourceImageEncryptionKeyRsaEncryptedKey(original["rsa_encrypted_key"], d, config)
+	transformedRsaEncryptedKey, err := expandComputeRegionDiskSourceImageEncryptionKeyRsaEncryptedKey(tpgresource.GetWriteOnlyValue(d, "source_image_encryption_key.0.rsa_encrypted_key", original["rsa_encrypted_key"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedRsaEncryptedKey); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -1792,7 +1827,7 @@ func expandComputeRegionDiskSourceSnapshotEncryptionKey(v interface{}, d tpgreso
 	original := raw.(map[string]interface{})
 	transformed := make(map[string]interface{})
 
-	transformedRawKey, err := expandComputeRegionDiskSourceSnapshotEncryptionKeyRawKey(original["raw_key"], d, config)
+	transformedRawKey, err := expandComputeRegionDiskSourceSnapshotEncryptionKeyRawKey(tpgresource.GetWriteOnlyValue(d, "source_snapshot_encryption_key.0.raw_key", original["raw_key"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedRawKey); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -191,7 +191,7 @@ func deleteResourceComputeRegionHealthAggregationPolicy(config *transport_tpg.Co
 	name = tpgresource.GetResourceNameFromSelfLink(obj["name"].(string))
 
 	// Skip resources that shouldn't be sweeped
-	if !sweeper.IsSweepableTestResource(name) {
+	if !sweeper.ShouldSweep(name, obj) {
 		return nil
 	}
 
@@ -195,7 +195,7 @@ func deleteResourceComputeRegionHealthCheck(config *transport_tpg.Config, d *tpg
 	name = tpgresource.GetResourceNameFromSelfLink(obj["name"].(string))
 
 	// Skip resources that shouldn't be sweeped
-	if !sweeper.IsSweepableTestResource(name) {
+	if !sweeper.ShouldSweep(name, obj) {
 		return nil
 	}
 
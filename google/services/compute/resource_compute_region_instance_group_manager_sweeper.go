@@ -54,7 +54,7 @@ func testSweepComputeRegionInstanceGroupManager(region string) error {
 	// Keep count of items that aren't sweepable for logging.
 	nonPrefixCount := 0
 	for _, rigm := range found.Items {
-		if !sweeper.IsSweepableTestResource(rigm.Name) {
+		if !sweeper.ShouldSweepTyped(rigm.Name, rigm) {
 			nonPrefixCount++
 			continue
 		}
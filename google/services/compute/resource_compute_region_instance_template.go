@@ -239,6 +239,30 @@ images are encrypted with your own keys.`,
 										Description: `Specifies an RFC 4648 base64 encoded, RSA-wrapped 2048-bit customer-supplied encryption key to either encrypt or decrypt this resource.  Only one of kms_key_self_link, rsa_encrypted_key and raw_key may be set.`,
 										Sensitive:   true,
 									},
+									"raw_key_wo": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										WriteOnly:   true,
+										Description: `Write-only 256-bit customer-supplied encryption key, encoded in RFC 4648 base64 to either encrypt or decrypt this resource. Only one of kms_key_self_link, rsa_encrypted_key and raw_key, or their write-only variants, may be set.`,
+									},
+									"raw_key_wo_version": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										ForceNew:    true,
+										Description: `Triggers update of 'raw_key_wo' write-only. Increment this value when an update to 'raw_key_wo' is needed. For more info see [updating write-only arguments](/docs/providers/google/guides/using_write_only_arguments.html#updating-write-only-arguments)`,
+									},
+									"rsa_encrypted_key_wo": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										WriteOnly:   true,
+										Description: `Write-only RFC 4648 base64 encoded, RSA-wrapped 2048-bit customer-supplied encryption key to either encrypt or decrypt this resource. Only one of kms_key_self_link, rsa_encrypted_key and raw_key, or their write-only variants, may be set.`,
+									},
+									"rsa_encrypted_key_wo_version": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										ForceNew:    true,
+										Description: `Triggers update of 'rsa_encrypted_key_wo' write-only. Increment this value when an update to 'rsa_encrypted_key_wo' is needed. For more info see [updating write-only arguments](/docs/providers/google/guides/using_write_only_arguments.html#updating-write-only-arguments)`,
+									},
 									"kms_key_service_account": {
 										Type:     schema.TypeString,
 										Optional: true,
@@ -288,6 +312,30 @@ required except for local SSD.`,
 										Description: `Specifies an RFC 4648 base64 encoded, RSA-wrapped 2048-bit customer-supplied encryption key to either encrypt or decrypt this resource.  Only one of kms_key_self_link, rsa_encrypted_key and raw_key may be set.`,
 										Sensitive:   true,
 									},
+									"raw_key_wo": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										WriteOnly:   true,
+										Description: `Write-only 256-bit customer-supplied encryption key, encoded in RFC 4648 base64 to either encrypt or decrypt this resource. Only one of kms_key_self_link, rsa_encrypted_key and raw_key, or their write-only variants, may be set.`,
+									},
+									"raw_key_wo_version": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										ForceNew:    true,
+										Description: `Triggers update of 'raw_key_wo' write-only. Increment this value when an update to 'raw_key_wo' is needed. For more info see [updating write-only arguments](/docs/providers/google/guides/using_write_only_arguments.html#updating-write-only-arguments)`,
+									},
+									"rsa_encrypted_key_wo": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										WriteOnly:   true,
+										Description: `Write-only RFC 4648 base64 encoded, RSA-wrapped 2048-bit customer-supplied encryption key to either encrypt or decrypt this resource. Only one of kms_key_self_link, rsa_encrypted_key and raw_key, or their write-only variants, may be set.`,
+									},
+									"rsa_encrypted_key_wo_version": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										ForceNew:    true,
+										Description: `Triggers update of 'rsa_encrypted_key_wo' write-only. Increment this value when an update to 'rsa_encrypted_key_wo' is needed. For more info see [updating write-only arguments](/docs/providers/google/guides/using_write_only_arguments.html#updating-write-only-arguments)`,
+									},
 									"kms_key_service_account": {
 										Type:     schema.TypeString,
 										Optional: true,
@@ -193,7 +193,7 @@ func deleteResourceComputeRegionNetworkEndpointGroup(config *transport_tpg.Confi
 	name = tpgresource.GetResourceNameFromSelfLink(obj["name"].(string))
 
 	// Skip resources that shouldn't be sweeped
-	if !sweeper.IsSweepableTestResource(name) {
+	if !sweeper.ShouldSweep(name, obj) {
 		return nil
 	}
 
@@ -189,7 +189,7 @@ func deleteResourceComputeRegionNetworkFirewallPolicyWithRules(config *transport
 	name = tpgresource.GetResourceNameFromSelfLink(obj["name"].(string))
 
 	// Skip resources that shouldn't be sweeped
-	if !sweeper.IsSweepableTestResource(name) {
+	if !sweeper.ShouldSweep(name, obj) {
 		return nil
 	}
 
@@ -193,7 +193,7 @@ func deleteResourceComputeRegionUrlMap(config *transport_tpg.Config, d *tpgresou
 	name = tpgresource.GetResourceNameFromSelfLink(obj["name"].(string))
 
 	// Skip resources that shouldn't be sweeped
-	if !sweeper.IsSweepableTestResource(name) {
+	if !sweeper.ShouldSweep(name, obj) {
 		return nil
 	}
 
@@ -381,11 +381,14 @@ of one of the entries in the Router.md5_authentication_keys. The field must comp
 Must be unique within a router. Must be referenced by exactly one bgpPeer. Must comply with RFC1035.`,
 						},
 						"key": {
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: `Value of the key.`,
-							Sensitive:   true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							ExactlyOneOf: []string{"md5_authentication_key.0.key", "md5_authentication_key.0.key_wo"},
+							Description:  `Value of the key.`,
+							Sensitive:    true,
 						},
+						"key_wo":         tpgresource.WriteOnlySchema("md5_authentication_key.0.key", `Write-only value of the key.`, true),
+						"key_wo_version": tpgresource.WriteOnlyVersionSchema("md5_authentication_key.0.key", false),
 					},
 				},
 			},
@@ -1264,6 +1267,7 @@ func flattenNestedComputeRouterBgpPeerMd5AuthenticationKey(v interface{}, d *sch
 	//key value is not returned as it is a sensitive field
 	if len(originalKeyValue) != 0 {
 		transformed["key"] = originalKeyValue[0].(map[string]interface{})["key"]
+		transformed["key_wo_version"] = originalKeyValue[0].(map[string]interface{})["key_wo_version"]
 	}
 	return []interface{}{transformed}
 }
@@ -1548,7 +1552,7 @@ func expandNestedComputeRouterBgpPeerMd5AuthenticationKey(v interface{}, d tpgre
 		transformed["name"] = transformedMd5AuthenticationKeyName
 	}
 
-	transformedMd5AuthenticationKeyValue, err := expandNestedComputeRouterBgpPeerMd5AuthenticationKeyMd5AuthenticationKeyValue(original["key"], d, config)
+	transformedMd5AuthenticationKeyValue, err := expandNestedComputeRouterBgpPeerMd5AuthenticationKeyMd5AuthenticationKeyValue(tpgresource.GetWriteOnlyValue(d, "md5_authentication_key.0.key", original["key"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedMd5AuthenticationKeyValue); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -102,7 +102,7 @@ func testSweepComputeRouterBgpPeer(region string) error {
 
 		name := tpgresource.GetResourceNameFromSelfLink(obj["name"].(string))
 		// Skip resources that shouldn't be sweeped
-		if !sweeper.IsSweepableTestResource(name) {
+		if !sweeper.ShouldSweep(name, obj) {
 			nonPrefixCount++
 			continue
 		}
@@ -196,7 +196,7 @@ func deleteResourceComputeRouter(config *transport_tpg.Config, d *tpgresource.Re
 	prefixes := []string{
 		"swg-autogen-router",
 	}
-	if !sweeper.IsSweepableTestResource(name) && !sweeper.HasAnyPrefix(name, prefixes) {
+	if !sweeper.ShouldSweep(name, obj, prefixes...) {
 		return nil
 	}
 
@@ -235,16 +235,22 @@ If absent, the Compute Engine Service Agent service account is used.`,
 							ForceNew: true,
 							Description: `Specifies a 256-bit customer-supplied encryption key, encoded in
 RFC 4648 base64 to either encrypt or decrypt this resource.`,
-							Sensitive: true,
+							Sensitive:     true,
+							ConflictsWith: []string{"snapshot_encryption_key.0.raw_key_wo"},
 						},
+						"raw_key_wo":         tpgresource.WriteOnlySchema("snapshot_encryption_key.0.raw_key", `Write-only 256-bit customer-supplied encryption key, encoded in RFC 4648 base64.`, false),
+						"raw_key_wo_version": tpgresource.WriteOnlyVersionSchema("snapshot_encryption_key.0.raw_key", true),
 						"rsa_encrypted_key": {
 							Type:     schema.TypeString,
 							Optional: true,
 							ForceNew: true,
 							Description: `Specifies an encryption key stored in Google Cloud KMS, encoded in
 RFC 4648 base64 to either encrypt or decrypt this resource.`,
-							Sensitive: true,
+							Sensitive:     true,
+							ConflictsWith: []string{"snapshot_encryption_key.0.rsa_encrypted_key_wo"},
 						},
+						"rsa_encrypted_key_wo":         tpgresource.WriteOnlySchema("snapshot_encryption_key.0.rsa_encrypted_key", `Write-only RFC 4648 base64 encoded, RSA-wrapped 2048-bit customer-supplied encryption key.`, false),
+						"rsa_encrypted_key_wo_version": tpgresource.WriteOnlyVersionSchema("snapshot_encryption_key.0.rsa_encrypted_key", true),
 						"sha256": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -299,16 +305,22 @@ If absent, the Compute Engine Service Agent service account is used.`,
 							ForceNew: true,
 							Description: `Specifies a 256-bit customer-supplied encryption key, encoded in
 RFC 4648 base64 to either encrypt or decrypt this resource.`,
-							Sensitive: true,
+							Sensitive:     true,
+							ConflictsWith: []string{"source_disk_encryption_key.0.raw_key_wo"},
 						},
+						"raw_key_wo":         tpgresource.WriteOnlySchema("source_disk_encryption_key.0.raw_key", `Write-only 256-bit customer-supplied encryption key, encoded in RFC 4648 base64.`, false),
+						"raw_key_wo_version": tpgresource.WriteOnlyVersionSchema("source_disk_encryption_key.0.raw_key", true),
 						"rsa_encrypted_key": {
 							Type:     schema.TypeString,
 							Optional: true,
 							ForceNew: true,
 							Description: `Specifies an encryption key stored in Google Cloud KMS, encoded in
 RFC 4648 base64 to either encrypt or decrypt this resource.`,
-							Sensitive: true,
+							Sensitive:     true,
+							ConflictsWith: []string{"source_disk_encryption_key.0.rsa_encrypted_key_wo"},
 						},
+						"rsa_encrypted_key_wo":         tpgresource.WriteOnlySchema("source_disk_encryption_key.0.rsa_encrypted_key", `Write-only RFC 4648 base64 encoded, RSA-wrapped 2048-bit customer-supplied encryption key.`, false),
+						"rsa_encrypted_key_wo_version": tpgresource.WriteOnlyVersionSchema("source_disk_encryption_key.0.rsa_encrypted_key", true),
 					},
 				},
 			},
@@ -1002,8 +1014,12 @@ func flattenComputeSnapshotSnapshotEncryptionKey(v interface{}, d *schema.Resour
 	transformed := make(map[string]interface{})
 	transformed["raw_key"] =
 		flattenComputeSnapshotSnapshotEncryptionKeyRawKey(original["rawKey"], d, config)
+	transformed["raw_key_wo_version"] =
+		flattenComputeSnapshotSnapshotEncryptionKeyRawKeyWoVersion(original["rawKeyWoVersion"], d, config)
 	transformed["rsa_encrypted_key"] =
 		flattenComputeSnapshotSnapshotEncryptionKeyRsaEncryptedKey(original["rsaEncryptedKey"], d, config)
+	transformed["rsa_encrypted_key_wo_version"] =
+		flattenComputeSnapshotSnapshotEncryptionKeyRsaEncryptedKeyWoVersion(original["rsaEncryptedKeyWoVersion"], d, config)
 	transformed["sha256"] =
 		flattenComputeSnapshotSnapshotEncryptionKeySha256(original["sha256"], d, config)
 	transformed["kms_key_self_link"] =
@@ -1016,10 +1032,18 @@ func flattenComputeSnapshotSnapshotEncryptionKeyRawKey(v interface{}, d *schema.
 	return d.Get("snapshot_encryption_key.0.raw_key")
 }
 
+func flattenComputeSnapshotSnapshotEncryptionKeyRawKeyWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("snapshot_encryption_key.0.raw_key_wo_version")
+}
+
 func flattenComputeSnapshotSnapshotEncryptionKeyRsaEncryptedKey(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return d.Get("snapshot_encryption_key.0.rsa_encrypted_key")
 }
 
+func flattenComputeSnapshotSnapshotEncryptionKeyRsaEncryptedKeyWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("snapshot_encryption_key.0.rsa_encrypted_key_wo_version")
+}
+
 func flattenComputeSnapshotSnapshotEncryptionKeySha256(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -1136,14 +1160,14 @@ func expandComputeSnapshotSnapshotEncryptionKey(v interface{}, d tpgresource.Ter
 	original := raw.(map[string]interface{})
 	transformed := make(map[string]interface{})
 
-	transformedRawKey, err := expandComputeSnapshotSnapshotEncryptionKeyRawKey(original["raw_key"], d, config)
+	transformedRawKey, err := expandComputeSnapshotSnapshotEncryptionKeyRawKey(tpgresource.GetWriteOnlyValue(d, "snapshot_encryption_key.0.raw_key", original["raw_key"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedRawKey); val.IsValid() && !tpgresource.IsEmptyValue(val) {
 		transformed["rawKey"] = transformedRawKey
 	}
 
-	transformedRsaEncryptedKey, err := expandComputeSnapshotSnapshotEncryptionKeyRsaEncryptedKey(original["rsa_encrypted_key"], d, config)
+	transformedRsaEncryptedKey, err := expandComputeSnapshotSnapshotEncryptionKeyRsaEncryptedKey(tpgresource.GetWriteOnlyValue(d, "snapshot_encryption_key.0.rsa_encrypted_key", original["rsa_encrypted_key"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedRsaEncryptedKey); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -1206,14 +1230,14 @@ func expandComputeSnapshotSourceDiskEncryptionKey(v interface{}, d tpgresource.T
 	original := raw.(map[string]interface{})
 	transformed := make(map[string]interface{})
 
-	transformedRawKey, err := expandComputeSnapshotSourceDiskEncryptionKeyRawKey(original["raw_key"], d, config)
+	transformedRawKey, err := expandComputeSnapshotSourceDiskEncryptionKeyRawKey(tpgresource.GetWriteOnlyValue(d, "source_disk_encryption_key.0.raw_key", original["raw_key"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedRawKey); val.IsValid() && !tpgresource.IsEmptyValue(val) {
 		transformed["rawKey"] = transformedRawKey
 	}
 
-	transformedRsaEncryptedKey, err := expandComputeSnapshotSourceDiskEncryptionKeyRsaEncryptedKey(original["rsa_encrypted_key"], d, config)
+	transformedRsaEncryptedKey, err := expandComputeSnapshotSourceDiskEncryptionKeyRsaEncryptedKey(tpgresource.GetWriteOnlyValue(d, "source_disk_encryption_key.0.rsa_encrypted_key", original["rsa_encrypted_key"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedRsaEncryptedKey); val.IsValid() && !tpgresource.IsEmptyValue(val) {
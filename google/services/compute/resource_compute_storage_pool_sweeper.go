@@ -81,7 +81,7 @@ func testSweepStoragePool(region string) error {
 
 			id := obj["name"].(string)
 			// Increment count and skip if resource is not sweepable.
-			if !sweeper.IsSweepableTestResource(id) {
+			if !sweeper.ShouldSweep(id, obj) {
 				nonPrefixCount++
 				continue
 			}
@@ -56,7 +56,7 @@ func testSweepTargetPool(region string) error {
 	nonPrefixCount := 0
 	for zone, itemList := range found.Items {
 		for _, tp := range itemList.TargetPools {
-			if !sweeper.IsSweepableTestResource(tp.Name) {
+			if !sweeper.ShouldSweepTyped(tp.Name, tp) {
 				nonPrefixCount++
 				continue
 			}
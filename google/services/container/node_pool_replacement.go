@@ -0,0 +1,388 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/services/container/node_pool_replacement.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package container
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math/rand"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+	"github.com/hashicorp/terraform-provider-google/google/verify"
+
+	"google.golang.org/api/container/v1"
+)
+
+const (
+	// GKE node pool names are limited to 40 characters.
+	nodePoolNameMaxLength = 40
+	// Length of the random suffix appended to the name of a replacement node pool.
+	nodePoolReplacementSuffixLength = 6
+	// Taint applied to the nodes of a node pool that is being replaced so that no new pods are
+	// scheduled on them while it's drained.
+	nodePoolReplacementTaintKey = "node-pool.terraform.io/replaced"
+)
+
+var nodePoolReplacementSuffixRegex = regexp.MustCompile(fmt.Sprintf("-[a-z0-9]{%d}$", nodePoolReplacementSuffixLength))
+
+var schemaNodePoolReplacementStrategy = &schema.Schema{
+	Type:     schema.TypeList,
+	Optional: true,
+	MaxItems: 1,
+	Description: `When set, changes to immutable node_config fields replace the node pool in place instead of destroying and recreating it. ` +
+		`A successor node pool with a suffixed name is created. Once it's running, the nodes of the old node pool are tainted so that no new pods are scheduled on them, ` +
+		`and the old node pool is deleted, which drains its nodes. The replacement must complete within the update timeout.`,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"cordon_old_pool": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: `Whether to taint the nodes of the old node pool with NoSchedule before it's drained, so that evicted pods are only rescheduled on the successor node pool.`,
+			},
+			"soak_duration": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidateNonNegativeDuration(),
+				Description:  `Time to wait after the successor node pool is running before the old node pool is drained. A duration in seconds with up to nine fractional digits, ending with 's'. Example: "300s".`,
+			},
+		},
+	},
+}
+
+// resourceContainerNodePoolReplaceableSchema returns a copy of the node_config schema of the node
+// pool resource with ForceNew cleared from its immutable fields, and the paths of those fields.
+// ForceNew is restored at plan time by nodePoolReplacementCustomizeDiff unless a replacement
+// strategy is configured. Fields nested in lists or sets of more than one element keep ForceNew
+// since changes to them can't be addressed individually.
+func resourceContainerNodePoolReplaceableSchema() (*schema.Schema, []string) {
+	nodeConfig := copyNodePoolSchema(schemaNodeConfig())
+	var paths []string
+	clearNodePoolForceNew(nodeConfig.Elem.(*schema.Resource).Schema, "node_config.0.", &paths)
+	sort.Strings(paths)
+	return nodeConfig, paths
+}
+
+func clearNodePoolForceNew(m map[string]*schema.Schema, prefix string, paths *[]string) {
+	for k, s := range m {
+		if s.ForceNew {
+			*paths = append(*paths, prefix+k)
+			clearNodePoolForceNewRecursive(s)
+			continue
+		}
+		if r, ok := s.Elem.(*schema.Resource); ok && s.Type == schema.TypeList && s.MaxItems == 1 {
+			clearNodePoolForceNew(r.Schema, prefix+k+".0.", paths)
+		}
+	}
+}
+
+func clearNodePoolForceNewRecursive(s *schema.Schema) {
+	s.ForceNew = false
+	if r, ok := s.Elem.(*schema.Resource); ok {
+		for _, v := range r.Schema {
+			clearNodePoolForceNewRecursive(v)
+		}
+	}
+}
+
+// copyNodePoolSchema deep copies the nested blocks of a schema, so that it can be modified
+// without affecting schemas shared with google_container_cluster.
+func copyNodePoolSchema(s *schema.Schema) *schema.Schema {
+	c := *s
+	if r, ok := s.Elem.(*schema.Resource); ok {
+		rc := *r
+		rc.Schema = make(map[string]*schema.Schema, len(r.Schema))
+		for k, v := range r.Schema {
+			rc.Schema[k] = copyNodePoolSchema(v)
+		}
+		c.Elem = &rc
+	} else if e, ok := s.Elem.(*schema.Schema); ok {
+		c.Elem = copyNodePoolSchema(e)
+	}
+	return &c
+}
+
+// nodePoolReplacementCustomizeDiff forces a new node pool when an immutable field changes and no
+// replacement strategy is configured.
+func nodePoolReplacementCustomizeDiff(paths []string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		if diff.Id() == "" {
+			return nil
+		}
+		if v, ok := diff.GetOk("replacement_strategy"); ok && len(v.([]interface{})) > 0 {
+			return nil
+		}
+		for _, p := range paths {
+			if diff.HasChange(p) {
+				if err := diff.ForceNew(p); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+}
+
+// nodePoolReplacementBase returns the part of the name of a successor node pool preceding its
+// suffix, which is base truncated so that the name fits the GKE limit.
+func nodePoolReplacementBase(base string) string {
+	if maxBase := nodePoolNameMaxLength - nodePoolReplacementSuffixLength - 1; len(base) > maxBase {
+		return strings.TrimRight(base[:maxBase], "-")
+	}
+	return base
+}
+
+// nodePoolReplacementName returns a name for the successor of the node pool currently named
+// current, made of base and a random suffix.
+func nodePoolReplacementName(base, current string) string {
+	base = nodePoolReplacementBase(base)
+	const chars = "abcdefghijklmnopqrstuvwxyz0123456789"
+	for {
+		suffix := make([]byte, nodePoolReplacementSuffixLength)
+		for i := range suffix {
+			suffix[i] = chars[rand.Intn(len(chars))]
+		}
+		if name := fmt.Sprintf("%s-%s", base, suffix); name != current {
+			return name
+		}
+	}
+}
+
+// isNodePoolReplacementOf returns whether name is the name of a successor of the node pool
+// named base.
+func isNodePoolReplacementOf(name, base string) bool {
+	if !nodePoolReplacementSuffixRegex.MatchString(name) {
+		return false
+	}
+	return name[:len(name)-nodePoolReplacementSuffixLength-1] == nodePoolReplacementBase(base)
+}
+
+// nodePoolReplacementHasChange returns whether any of the given immutable fields changed.
+func nodePoolReplacementHasChange(d *schema.ResourceData, paths []string) bool {
+	for _, p := range paths {
+		if d.HasChange(p) {
+			return true
+		}
+	}
+	return false
+}
+
+// nodePoolReplacementProgress reports the phases of a node pool replacement as it progresses,
+// all of which share the deadline of the update.
+type nodePoolReplacementProgress struct {
+	oldName  string
+	deadline time.Time
+	phase    int
+}
+
+// nodePoolReplacementPhaseCount is the number of phases of a node pool replacement: creating the
+// successor, waiting for it, soaking it, cordoning the old node pool and deleting it.
+const nodePoolReplacementPhaseCount = 5
+
+// start logs the start of the next phase, and returns the time left for it.
+func (p *nodePoolReplacementProgress) start(description string) (time.Duration, error) {
+	p.phase++
+	remaining := time.Until(p.deadline)
+	if remaining <= 0 {
+		return 0, fmt.Errorf("timed out before phase %d/%d (%s)", p.phase, nodePoolReplacementPhaseCount, description)
+	}
+	log.Printf("[INFO] Replacing GKE NodePool %s, phase %d/%d: %s (%s left)", p.oldName, p.phase, nodePoolReplacementPhaseCount, description, remaining.Round(time.Second))
+	return remaining, nil
+}
+
+// nodePoolReplace replaces the node pool of d with a successor that has the planned
+// configuration. The successor is created and awaited first, so that workloads are only evicted
+// from the old node pool once there's capacity to reschedule them. All phases must complete
+// within timeout.
+func nodePoolReplace(d *schema.ResourceData, meta interface{}, nodePoolInfo *NodePoolInformation, timeout time.Duration) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	oldName := getNodePoolName(d.Id())
+	strategy := d.Get("replacement_strategy.0").(map[string]interface{})
+	progress := &nodePoolReplacementProgress{oldName: oldName, deadline: time.Now().Add(timeout)}
+
+	var soak time.Duration
+	if v := strategy["soak_duration"].(string); v != "" {
+		if soak, err = time.ParseDuration(v); err != nil {
+			return err
+		}
+		if soak >= timeout {
+			return fmt.Errorf("replacement_strategy.0.soak_duration (%s) must be shorter than the update timeout (%s)", soak, timeout)
+		}
+	}
+
+	nodePool, err := expandNodePool(d, "")
+	if err != nil {
+		return err
+	}
+	nodePool.Name = nodePoolReplacementName(d.Get("name").(string), oldName)
+	newId := fmt.Sprintf("projects/%s/locations/%s/clusters/%s/nodePools/%s", nodePoolInfo.project, nodePoolInfo.location, nodePoolInfo.cluster, nodePool.Name)
+
+	// Acquire read-lock on cluster.
+	clusterLockKey := nodePoolInfo.clusterLockKey()
+	transport_tpg.MutexStore.RLock(clusterLockKey)
+	defer transport_tpg.MutexStore.RUnlock(clusterLockKey)
+
+	remaining, err := progress.start(fmt.Sprintf("creating successor node pool %s", nodePool.Name))
+	if err != nil {
+		return fmt.Errorf("Error replacing NodePool %s: %s", oldName, err)
+	}
+	createF := func() error {
+		clusterNodePoolsCreateCall := NewClient(config, userAgent).Projects.Locations.Clusters.NodePools.Create(nodePoolInfo.parent(), &container.CreateNodePoolRequest{NodePool: nodePool})
+		if config.UserProjectOverride {
+			clusterNodePoolsCreateCall.Header().Add("X-Goog-User-Project", nodePoolInfo.project)
+		}
+		op, err := clusterNodePoolsCreateCall.Do()
+		if err != nil {
+			return err
+		}
+		return ContainerOperationWait(config, op, nodePoolInfo.project, nodePoolInfo.location, "creating successor GKE NodePool", userAgent, remaining)
+	}
+	if err := retryWhileIncompatibleOperation(remaining, nodePoolInfo.nodePoolLockKey(nodePool.Name), createF); err != nil {
+		return fmt.Errorf("Error creating successor of NodePool %s: %s", oldName, err)
+	}
+
+	remaining, err = progress.start(fmt.Sprintf("waiting for successor node pool %s to be running", nodePool.Name))
+	if err == nil {
+		var state string
+		state, err = containerNodePoolAwaitRestingState(config, nodePoolInfo.fullyQualifiedName(nodePool.Name), nodePoolInfo.project, userAgent, remaining)
+		if err == nil && containerNodePoolRestingStates[state] == ErrorState {
+			err = fmt.Errorf("successor NodePool %s is in the error state %q", nodePool.Name, state)
+		}
+	}
+	if err != nil {
+		// Leave the old node pool serving and clean up the successor, so that the replacement can
+		// be retried on the next apply. The cleanup isn't bounded by the deadline, as it would
+		// otherwise leave the successor behind.
+		log.Printf("[WARN] Replacing GKE NodePool %s: deleting successor node pool %s that didn't become ready", oldName, nodePool.Name)
+		if delErr := nodePoolReplacementDelete(config, userAgent, nodePoolInfo, nodePool.Name, timeout); delErr != nil {
+			log.Printf("[WARN] Error deleting successor NodePool %s: %s", nodePool.Name, delErr)
+		}
+		return fmt.Errorf("Error waiting for successor of NodePool %s: %s", oldName, err)
+	}
+
+	// The successor is now the node pool managed by this resource.
+	d.SetId(newId)
+
+	// From here on, the old node pool is left behind if a phase fails, as the successor is
+	// serving the planned configuration.
+	remaining, err = progress.start(fmt.Sprintf("soaking successor node pool %s for %s", nodePool.Name, soak))
+	if err == nil && soak >= remaining {
+		err = fmt.Errorf("soak_duration (%s) doesn't leave time to delete the old node pool before the update timeout", soak)
+	}
+	if err != nil {
+		return fmt.Errorf("Error replacing NodePool %s, it must be deleted manually: %s", oldName, err)
+	}
+	time.Sleep(soak)
+
+	if strategy["cordon_old_pool"].(bool) {
+		remaining, err = progress.start("tainting the nodes of the old node pool with NoSchedule")
+		if err == nil {
+			err = nodePoolReplacementCordon(config, userAgent, nodePoolInfo, oldName, remaining)
+		}
+		if err != nil {
+			return fmt.Errorf("Error cordoning NodePool %s, it must be deleted manually: %s", oldName, err)
+		}
+	} else {
+		progress.phase++
+	}
+
+	// Deleting a node pool drains its nodes following its node_drain_config.
+	remaining, err = progress.start("draining and deleting the old node pool")
+	if err == nil {
+		err = nodePoolReplacementDelete(config, userAgent, nodePoolInfo, oldName, remaining)
+	}
+	if err != nil {
+		return fmt.Errorf("Error deleting NodePool %s, it must be deleted manually: %s", oldName, err)
+	}
+	npCache.remove(nodePoolInfo.fullyQualifiedName(oldName))
+
+	log.Printf("[INFO] GKE NodePool %s has been replaced by %s", oldName, nodePool.Name)
+	return nil
+}
+
+func nodePoolReplacementCordon(config *transport_tpg.Config, userAgent string, nodePoolInfo *NodePoolInformation, name string, timeout time.Duration) error {
+	clusterNodePoolsGetCall := NewClient(config, userAgent).Projects.Locations.Clusters.NodePools.Get(nodePoolInfo.fullyQualifiedName(name))
+	if config.UserProjectOverride {
+		clusterNodePoolsGetCall.Header().Add("X-Goog-User-Project", nodePoolInfo.project)
+	}
+	np, err := clusterNodePoolsGetCall.Do()
+	if err != nil {
+		return err
+	}
+
+	taints := []*container.NodeTaint{}
+	if np.Config != nil {
+		for _, t := range np.Config.Taints {
+			if t.Key != nodePoolReplacementTaintKey {
+				taints = append(taints, t)
+			}
+		}
+	}
+	taints = append(taints, &container.NodeTaint{
+		Key:    nodePoolReplacementTaintKey,
+		Value:  "true",
+		Effect: "NO_SCHEDULE",
+	})
+	req := &container.UpdateNodePoolRequest{
+		Name:   name,
+		Taints: &container.NodeTaints{Taints: taints},
+	}
+
+	updateF := func() error {
+		clusterNodePoolsUpdateCall := NewClient(config, userAgent).Projects.Locations.Clusters.NodePools.Update(nodePoolInfo.fullyQualifiedName(name), req)
+		if config.UserProjectOverride {
+			clusterNodePoolsUpdateCall.Header().Add("X-Goog-User-Project", nodePoolInfo.project)
+		}
+		op, err := clusterNodePoolsUpdateCall.Do()
+		if err != nil {
+			return err
+		}
+		return ContainerOperationWait(config, op, nodePoolInfo.project, nodePoolInfo.location, "cordoning GKE NodePool", userAgent, timeout)
+	}
+	return retryWhileIncompatibleOperation(timeout, nodePoolInfo.nodePoolLockKey(name), updateF)
+}
+
+func nodePoolReplacementDelete(config *transport_tpg.Config, userAgent string, nodePoolInfo *NodePoolInformation, name string, timeout time.Duration) error {
+	deleteF := func() error {
+		clusterNodePoolsDeleteCall := NewClient(config, userAgent).Projects.Locations.Clusters.NodePools.Delete(nodePoolInfo.fullyQualifiedName(name))
+		if config.UserProjectOverride {
+			clusterNodePoolsDeleteCall.Header().Add("X-Goog-User-Project", nodePoolInfo.project)
+		}
+		op, err := clusterNodePoolsDeleteCall.Do()
+		if err != nil {
+			if transport_tpg.IsGoogleApiErrorWithCode(err, 404) {
+				return nil
+			}
+			return err
+		}
+		return ContainerOperationWait(config, op, nodePoolInfo.project, nodePoolInfo.location, "deleting GKE NodePool", userAgent, timeout)
+	}
+	return retryWhileIncompatibleOperation(timeout, nodePoolInfo.nodePoolLockKey(name), deleteF)
+}
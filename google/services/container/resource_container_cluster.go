@@ -264,6 +264,7 @@ func ResourceContainerCluster() *schema.Resource {
 			containerClusterEnableK8sBetaApisCustomizeDiff,
 			containerClusterNodeVersionCustomizeDiff,
 			containerClusterSkipNodePoolRefreshCustomizeDiff,
+			containerClusterUpgradeOrchestrationCustomizeDiff,
 			tpgresource.SetDiffForLabelsWithCustomizedName("resource_labels"),
 			clusterAcceleratorNetworkProfileCustomizeDiff,
 		),
@@ -1189,6 +1190,7 @@ func ResourceContainerCluster() *schema.Resource {
 				ValidateFunc: validation.StringInSlice([]string{"logging.googleapis.com", "logging.googleapis.com/kubernetes", "none"}, false),
 				Description:  `The logging service that the cluster should write logs to. Available options include logging.googleapis.com(Legacy Stackdriver), logging.googleapis.com/kubernetes(Stackdriver Kubernetes Engine Logging), and none. Defaults to logging.googleapis.com/kubernetes.`,
 			},
+			"upgrade_orchestration": schemaContainerClusterUpgradeOrchestration,
 			"rollback_safe_upgrade": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -4660,6 +4662,16 @@ func resourceContainerClusterUpdate(d *schema.ResourceData, meta interface{}) er
 		log.Printf("[INFO] GKE cluster %s's NetworkTierConfig has been updated", d.Id())
 	}
 
+	// With upgrade_orchestration, the master is upgraded before the nodes, and the node pools
+	// then follow.
+	orchestratedUpgrade := false
+	if _, ok := d.GetOk("upgrade_orchestration"); ok && d.HasChange("min_master_version") && d.Get("min_master_version").(string) != "" {
+		if err := containerClusterOrchestrateUpgrade(d, config, userAgent, project, location, clusterName, updateFunc); err != nil {
+			return err
+		}
+		orchestratedUpgrade = true
+	}
+
 	if n, ok := d.GetOk("node_pool.#"); ok {
 		for i := 0; i < n.(int); i++ {
 			nodePoolInfo, err := extractNodePoolInformationFromCluster(d, config, clusterName)
@@ -4675,7 +4687,7 @@ func resourceContainerClusterUpdate(d *schema.ResourceData, meta interface{}) er
 
 	// The master must be updated before the nodes
 	// If set to "", skip this step- any master version satisfies that minimum.
-	if ver := d.Get("min_master_version").(string); d.HasChange("min_master_version") && ver != "" {
+	if ver := d.Get("min_master_version").(string); d.HasChange("min_master_version") && ver != "" && !orchestratedUpgrade {
 		des, err := version.NewVersion(ver)
 		if err != nil {
 			return err
@@ -4689,24 +4701,7 @@ func resourceContainerClusterUpdate(d *schema.ResourceData, meta interface{}) er
 
 		// Only upgrade the master if the current version is lower than the desired version
 		if cur.LessThan(des) {
-			req := &container.UpdateClusterRequest{
-				Update: &container.ClusterUpdate{
-					DesiredMasterVersion: ver,
-				},
-			}
-
-			if r, ok := d.GetOk("rollback_safe_upgrade"); ok {
-				rls := r.([]interface{})
-				if len(rls) > 0 && rls[0] != nil {
-					req.Update.DesiredRollbackSafeUpgrade = &container.RollbackSafeUpgrade{}
-					rl := rls[0].(map[string]interface{})
-					if soakDuration, ok := rl["control_plane_soak_duration"].(string); ok && soakDuration != "" {
-						req.Update.DesiredRollbackSafeUpgrade.ControlPlaneSoakDuration = soakDuration
-					}
-				}
-			}
-
-			updateF := updateFunc(req, "updating GKE master version")
+			updateF := updateFunc(containerClusterMasterUpgradeRequest(d, ver), "updating GKE master version")
 			// Call update serially.
 			if err := transport_tpg.LockedCall(lockKey, updateF); err != nil {
 				return err
@@ -52,7 +52,7 @@ func testSweepContainerClusters(region string) error {
 	}
 
 	for _, cluster := range found.Clusters {
-		if sweeper.IsSweepableTestResource(cluster.Name) {
+		if sweeper.ShouldSweepTyped(cluster.Name, cluster) {
 			log.Printf("Sweeping Container Cluster: %s", cluster.Name)
 			clusterURL := fmt.Sprintf("projects/%s/locations/%s/clusters/%s", config.Project, cluster.Location, cluster.Name)
 			_, err := NewClient(config, config.UserAgent).Projects.Locations.Clusters.Delete(clusterURL).Do()
@@ -204,6 +204,8 @@ var (
 )
 
 func ResourceContainerNodePool() *schema.Resource {
+	nodeConfig, replaceableFields := resourceContainerNodePoolReplaceableSchema()
+
 	return &schema.Resource{
 		Create: resourceContainerNodePoolCreate,
 		Read:   resourceContainerNodePoolRead,
@@ -229,6 +231,7 @@ func ResourceContainerNodePool() *schema.Resource {
 			tpgresource.DefaultProviderProject,
 			resourceNodeConfigEmptyGuestAccelerator,
 			nodePoolAcceleratorNetworkProfileCustomizeDiff,
+			nodePoolReplacementCustomizeDiff(replaceableFields),
 		),
 
 		UseJSONNumber: true,
@@ -260,6 +263,10 @@ func ResourceContainerNodePool() *schema.Resource {
 					Type:     schema.TypeString,
 					Computed: true,
 				},
+				// Immutable node_config fields only force a new node pool when
+				// replacement_strategy isn't set, see nodePoolReplacementCustomizeDiff.
+				"node_config":          nodeConfig,
+				"replacement_strategy": schemaNodePoolReplacementStrategy,
 				//UDP schema start
 				"deletion_policy": tpgresource.DeletionPolicySchemaEntry("DELETE"),
 				//UDP schema end
@@ -990,6 +997,11 @@ func resourceContainerNodePoolRead(d *schema.ResourceData, meta interface{}) err
 		return err
 	}
 
+	// Successors created by replacement_strategy keep the configured name in state.
+	if _, ok := d.GetOk("replacement_strategy"); ok && isNodePoolReplacementOf(nodePool.Name, d.Get("name").(string)) {
+		npMap["name"] = d.Get("name")
+	}
+
 	for k, v := range npMap {
 		if err := d.Set(k, v); err != nil {
 			return fmt.Errorf("Error setting %s: %s", k, err)
@@ -1034,7 +1046,13 @@ func resourceContainerNodePoolUpdate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	d.Partial(true)
-	if err := nodePoolUpdate(d, meta, nodePoolInfo, "", d.Timeout(schema.TimeoutUpdate)); err != nil {
+	if _, replaceableFields := resourceContainerNodePoolReplaceableSchema(); nodePoolReplacementHasChange(d, replaceableFields) {
+		// The successor node pool is created with the whole planned configuration.
+		if err := nodePoolReplace(d, meta, nodePoolInfo, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+		name = getNodePoolName(d.Id())
+	} else if err := nodePoolUpdate(d, meta, nodePoolInfo, "", d.Timeout(schema.TimeoutUpdate)); err != nil {
 		return err
 	}
 	d.Partial(false)
@@ -1754,6 +1772,11 @@ func expandNodeNetworkConfig(v interface{}) *container.NodeNetworkConfig {
 func nodePoolUpdate(d *schema.ResourceData, meta interface{}, nodePoolInfo *NodePoolInformation, prefix string, timeout time.Duration) error {
 	config := meta.(*transport_tpg.Config)
 	name := d.Get(prefix + "name").(string)
+	if prefix == "" {
+		// The node pool resource runs under a suffixed name once it's been replaced, see
+		// replacement_strategy.
+		name = getNodePoolName(d.Id())
+	}
 
 	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
 	if err != nil {
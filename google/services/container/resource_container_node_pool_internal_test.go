@@ -17,6 +17,7 @@
 package container
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -177,3 +178,71 @@ func TestUnitFlattenNodePool(t *testing.T) {
 		})
 	}
 }
+
+func TestUnitNodePoolReplaceableSchema(t *testing.T) {
+	t.Parallel()
+
+	nodeConfig, paths := resourceContainerNodePoolReplaceableSchema()
+	if len(paths) == 0 {
+		t.Fatal("expected immutable node_config fields")
+	}
+
+	resourceFields := nodeConfig.Elem.(*schema.Resource).Schema
+	sharedFields := schemaNodePool["node_config"].Elem.(*schema.Resource).Schema
+	for _, p := range paths {
+		parts := strings.Split(strings.TrimPrefix(p, "node_config.0."), ".0.")
+		resourceField, sharedField := resourceFields[parts[0]], sharedFields[parts[0]]
+		for _, part := range parts[1:] {
+			resourceField = resourceField.Elem.(*schema.Resource).Schema[part]
+			sharedField = sharedField.Elem.(*schema.Resource).Schema[part]
+		}
+		if resourceField.ForceNew {
+			t.Errorf("expected ForceNew to be cleared on %s", p)
+		}
+		if !sharedField.ForceNew {
+			t.Errorf("expected ForceNew to be kept on %s in the schema shared with google_container_cluster", p)
+		}
+	}
+}
+
+func TestUnitNodePoolReplacementName(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		Base           string
+		ExpectedPrefix string
+	}{
+		"short name": {
+			Base:           "default-pool",
+			ExpectedPrefix: "default-pool-",
+		},
+		"name at the length limit": {
+			Base:           "a-node-pool-name-with-forty-characters-x",
+			ExpectedPrefix: "a-node-pool-name-with-forty-chara-",
+		},
+		"truncated at a dash": {
+			Base:           "a-node-pool-name-with-forty-char-acters",
+			ExpectedPrefix: "a-node-pool-name-with-forty-char-",
+		},
+	}
+
+	for tn, tc := range cases {
+		name := nodePoolReplacementName(tc.Base, tc.Base)
+		if !strings.HasPrefix(name, tc.ExpectedPrefix) || len(name) > nodePoolNameMaxLength {
+			t.Errorf("%s: unexpected successor name %q", tn, name)
+		}
+		if !isNodePoolReplacementOf(name, tc.Base) {
+			t.Errorf("%s: expected %q to be a successor of %q", tn, name, tc.Base)
+		}
+		if next := nodePoolReplacementName(tc.Base, name); next == name || !isNodePoolReplacementOf(next, tc.Base) {
+			t.Errorf("%s: unexpected successor name %q of %q", tn, next, name)
+		}
+	}
+
+	if isNodePoolReplacementOf("other-pool-abc123", "default-pool") {
+		t.Error("expected other-pool-abc123 not to be a successor of default-pool")
+	}
+	if isNodePoolReplacementOf("default-pool", "default-pool") {
+		t.Error("expected default-pool not to be a successor of itself")
+	}
+}
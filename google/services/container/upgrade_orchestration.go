@@ -0,0 +1,496 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/services/container/upgrade_orchestration.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package container
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+
+	"google.golang.org/api/container/v1"
+)
+
+// containerNodeVersionSkew is the number of minor versions the nodes may be behind the control
+// plane.
+// See https://cloud.google.com/kubernetes-engine/versioning#version_skew
+const containerNodeVersionSkew = 2
+
+var schemaContainerClusterUpgradeOrchestration = &schema.Schema{
+	Type:     schema.TypeList,
+	Optional: true,
+	MaxItems: 1,
+	Description: `Orchestrates the upgrades triggered by changes to min_master_version. The target version is checked against the versions offered by GKE and the version skew policy, ` +
+		`the control plane is upgraded first, and the inline node pools are then upgraded to the control plane version.`,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"upgrade_node_pools": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: `Whether to upgrade the inline node pools after the control plane. When false, only the control plane is upgraded once it's checked that the node pools stay within the version skew policy.`,
+			},
+			"node_pool_order": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: `Names of the inline node pools to upgrade first, in order. The other inline node pools are upgraded afterwards, in alphabetical order.`,
+			},
+			"max_concurrent_node_pool_upgrades": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  `The number of node pools upgraded at the same time. No further node pool upgrade is started once one fails.`,
+			},
+		},
+	},
+}
+
+// isContainerVersionAlias returns whether v selects a version chosen by GKE rather than a
+// specific one.
+func isContainerVersionAlias(v string) bool {
+	return v == "" || v == "-" || v == "latest"
+}
+
+// containerMinorVersion returns the major and minor components of a GKE version, which may be
+// fuzzy such as "1.30".
+func containerMinorVersion(v string) (int, int, error) {
+	parsed, err := version.NewVersion(strings.SplitN(v, "-", 2)[0])
+	if err != nil {
+		return 0, 0, fmt.Errorf("Error parsing version %q: %s", v, err)
+	}
+	segments := parsed.Segments()
+	return segments[0], segments[1], nil
+}
+
+// containerVersionIsValid returns whether v, which may be fuzzy, matches one of the versions
+// offered by GKE.
+func containerVersionIsValid(v string, validVersions []string) bool {
+	if isContainerVersionAlias(v) {
+		return true
+	}
+	for _, valid := range validVersions {
+		if valid == v || strings.HasPrefix(valid, v+".") || strings.HasPrefix(valid, v+"-") {
+			return true
+		}
+	}
+	return false
+}
+
+// containerMasterUpgradePreflight checks that the control plane can be upgraded from current to
+// target. The control plane can only be upgraded one minor version at a time.
+func containerMasterUpgradePreflight(current, target string, validVersions []string) error {
+	if isContainerVersionAlias(target) {
+		return nil
+	}
+	if !containerVersionIsValid(target, validVersions) {
+		return fmt.Errorf("min_master_version %q isn't offered by GKE in this location, see the valid_master_versions of the google_container_engine_versions data source", target)
+	}
+	curMajor, curMinor, err := containerMinorVersion(current)
+	if err != nil {
+		return err
+	}
+	major, minor, err := containerMinorVersion(target)
+	if err != nil {
+		return err
+	}
+	if major != curMajor || minor > curMinor+1 {
+		return fmt.Errorf("the control plane can only be upgraded one minor version at a time, from %s to at most %d.%d, but min_master_version is %q", current, curMajor, curMinor+1, target)
+	}
+	return nil
+}
+
+// containerNodeVersionPreflight checks that nodes at nodeVersion are supported by a control plane
+// at masterVersion. validVersions is only checked when it isn't nil.
+func containerNodeVersionPreflight(masterVersion, nodeVersion string, validVersions []string) error {
+	if isContainerVersionAlias(nodeVersion) || isContainerVersionAlias(masterVersion) {
+		return nil
+	}
+	if validVersions != nil && !containerVersionIsValid(nodeVersion, validVersions) {
+		return fmt.Errorf("node version %q isn't offered by GKE in this location, see the valid_node_versions of the google_container_engine_versions data source", nodeVersion)
+	}
+	masterMajor, masterMinor, err := containerMinorVersion(masterVersion)
+	if err != nil {
+		return err
+	}
+	major, minor, err := containerMinorVersion(nodeVersion)
+	if err != nil {
+		return err
+	}
+	if major > masterMajor || major == masterMajor && minor > masterMinor {
+		return fmt.Errorf("node version %q can't be newer than the control plane version %q, upgrade the control plane first", nodeVersion, masterVersion)
+	}
+	if major < masterMajor || masterMinor-minor > containerNodeVersionSkew {
+		return fmt.Errorf("node version %q is more than %d minor versions older than the control plane version %q", nodeVersion, containerNodeVersionSkew, masterVersion)
+	}
+	return nil
+}
+
+// containerNodePoolUpgradeOrder returns the names of the node pools in the order they're upgraded
+// in: the ones listed in order first, then the others in alphabetical order. Names in order that
+// aren't in names are skipped.
+func containerNodePoolUpgradeOrder(names, order []string) []string {
+	remaining := make(map[string]bool, len(names))
+	for _, name := range names {
+		remaining[name] = true
+	}
+
+	result := make([]string, 0, len(names))
+	for _, name := range order {
+		if !remaining[name] {
+			continue
+		}
+		delete(remaining, name)
+		result = append(result, name)
+	}
+
+	rest := make([]string, 0, len(remaining))
+	for name := range remaining {
+		rest = append(rest, name)
+	}
+	sort.Strings(rest)
+	return append(result, rest...)
+}
+
+// containerUpgradeNodePools calls upgrade for each node pool in order, running up to
+// maxConcurrent upgrades at the same time. No further upgrade is started after one fails, and the
+// first error is returned once the upgrades in progress are done.
+func containerUpgradeNodePools(names []string, maxConcurrent int, upgrade func(name string) error) error {
+	var wg sync.WaitGroup
+	var mutex sync.Mutex
+	var firstErr error
+	sem := make(chan struct{}, maxConcurrent)
+
+	for _, name := range names {
+		sem <- struct{}{}
+		mutex.Lock()
+		failed := firstErr != nil
+		mutex.Unlock()
+		if failed {
+			<-sem
+			break
+		}
+
+		wg.Add(1)
+		go func(name string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			if err := upgrade(name); err != nil {
+				mutex.Lock()
+				if firstErr == nil {
+					firstErr = fmt.Errorf("Error upgrading node pool %s: %s", name, err)
+				}
+				mutex.Unlock()
+			}
+		}(name)
+	}
+	wg.Wait()
+
+	return firstErr
+}
+
+// containerClusterMasterUpgradeRequest returns the request upgrading the control plane to ver.
+func containerClusterMasterUpgradeRequest(d *schema.ResourceData, ver string) *container.UpdateClusterRequest {
+	req := &container.UpdateClusterRequest{
+		Update: &container.ClusterUpdate{
+			DesiredMasterVersion: ver,
+		},
+	}
+
+	if r, ok := d.GetOk("rollback_safe_upgrade"); ok {
+		rls := r.([]interface{})
+		if len(rls) > 0 && rls[0] != nil {
+			req.Update.DesiredRollbackSafeUpgrade = &container.RollbackSafeUpgrade{}
+			rl := rls[0].(map[string]interface{})
+			if soakDuration, ok := rl["control_plane_soak_duration"].(string); ok && soakDuration != "" {
+				req.Update.DesiredRollbackSafeUpgrade.ControlPlaneSoakDuration = soakDuration
+			}
+		}
+	}
+
+	return req
+}
+
+func containerServerConfig(config *transport_tpg.Config, userAgent, project, location string) (*container.ServerConfig, error) {
+	serverConfigCall := NewClient(config, userAgent).Projects.Locations.GetServerConfig(fmt.Sprintf("projects/%s/locations/%s", project, location))
+	if config.UserProjectOverride {
+		serverConfigCall.Header().Add("X-Goog-User-Project", project)
+	}
+	serverConfig, err := serverConfigCall.Do()
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving available container cluster versions: %s", err)
+	}
+	return serverConfig, nil
+}
+
+// containerClusterVersionsData is implemented by both schema.ResourceData and schema.ResourceDiff.
+type containerClusterVersionsData interface {
+	HasChange(string) bool
+	Get(string) interface{}
+	GetRawConfig() cty.Value
+}
+
+// containerClusterNodePools describes the node pools of a cluster as seen by an orchestrated
+// upgrade.
+type containerClusterNodePools struct {
+	// planned is the planned version of the inline node pools whose version changes.
+	planned map[string]string
+	// pinned holds the inline node pools whose version is set in the configuration.
+	pinned map[string]bool
+	// inline holds the node pools defined in node_pool blocks. node_pool is computed, so the
+	// state also lists node pools managed by google_container_node_pool.
+	inline map[string]bool
+}
+
+// containerClusterNodePoolVersions reads the inline node pools of a cluster from its
+// configuration and planned state.
+func containerClusterNodePoolVersions(d containerClusterVersionsData) containerClusterNodePools {
+	nodePools := containerClusterNodePools{
+		planned: map[string]string{},
+		pinned:  map[string]bool{},
+		inline:  map[string]bool{},
+	}
+
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return nodePools
+	}
+	rawNodePools := rawConfig.GetAttr("node_pool")
+	if rawNodePools.IsNull() || !rawNodePools.IsKnown() {
+		return nodePools
+	}
+	it := rawNodePools.ElementIterator()
+	for it.Next() {
+		idx, np := it.Element()
+		if np.IsNull() || !np.IsKnown() {
+			continue
+		}
+		i, _ := idx.AsBigFloat().Int64()
+		prefix := fmt.Sprintf("node_pool.%d.", i)
+		// Node pools without a name in the configuration use the one in state
+		name := d.Get(prefix + "name").(string)
+		if rawName := np.GetAttr("name"); !rawName.IsNull() && rawName.IsKnown() {
+			name = rawName.AsString()
+		}
+		if name == "" {
+			continue
+		}
+		nodePools.inline[name] = true
+		if !np.GetAttr("version").IsNull() {
+			nodePools.pinned[name] = true
+		}
+		if d.HasChange(prefix + "version") {
+			nodePools.planned[name] = d.Get(prefix + "version").(string)
+		}
+	}
+	return nodePools
+}
+
+// containerClusterNodePoolsToUpgrade checks the node pools against an upgrade of the control plane
+// to target, and returns the names of the inline node pools that are upgraded to the control plane
+// version. Node pools with a planned version are upgraded to it by nodePoolUpdate. Inline node
+// pools whose version is set in the configuration keep it, and node pools managed by
+// google_container_node_pool are left to that resource, so they must stay within the version
+// skew policy, like all node pools when upgradeNodePools is false. validNodeVersions is only
+// checked when it isn't nil.
+func containerClusterNodePoolsToUpgrade(nodePools []*container.NodePool, target string, upgradeNodePools bool, configured containerClusterNodePools, validNodeVersions []string) ([]string, error) {
+	names := []string{}
+	for _, np := range nodePools {
+		if v, ok := configured.planned[np.Name]; ok {
+			if err := containerNodeVersionPreflight(target, v, validNodeVersions); err != nil {
+				return nil, fmt.Errorf("node pool %s: %s", np.Name, err)
+			}
+			continue
+		}
+		if !configured.inline[np.Name] {
+			if err := containerNodeVersionPreflight(target, np.Version, nil); err != nil {
+				return nil, fmt.Errorf("node pool %s isn't defined in the cluster's node_pool blocks and isn't upgraded: %s", np.Name, err)
+			}
+			continue
+		}
+		if configured.pinned[np.Name] {
+			if err := containerNodeVersionPreflight(target, np.Version, nil); err != nil {
+				return nil, fmt.Errorf("node pool %s has its version set in the configuration: %s", np.Name, err)
+			}
+			continue
+		}
+		if !upgradeNodePools {
+			if err := containerNodeVersionPreflight(target, np.Version, nil); err != nil {
+				return nil, fmt.Errorf("node pool %s: %s", np.Name, err)
+			}
+			continue
+		}
+		names = append(names, np.Name)
+	}
+	return names, nil
+}
+
+func containerClusterGet(config *transport_tpg.Config, userAgent, project, location, clusterName string) (*container.Cluster, error) {
+	clusterGetCall := NewClient(config, userAgent).Projects.Locations.Clusters.Get(containerClusterFullName(project, location, clusterName))
+	if config.UserProjectOverride {
+		clusterGetCall.Header().Add("X-Goog-User-Project", project)
+	}
+	return clusterGetCall.Do()
+}
+
+// containerClusterUpgradeOrchestrationCustomizeDiff checks an orchestrated upgrade at plan time,
+// so that an upgrade that can't go through fails before anything is upgraded.
+func containerClusterUpgradeOrchestrationCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("min_master_version") || !diff.NewValueKnown("min_master_version") {
+		return nil
+	}
+	orchestrations, _ := diff.Get("upgrade_orchestration").([]interface{})
+	if len(orchestrations) == 0 || orchestrations[0] == nil {
+		return nil
+	}
+	orchestration := orchestrations[0].(map[string]interface{})
+	target := diff.Get("min_master_version").(string)
+	if target == "" {
+		return nil
+	}
+	fieldValues := clusterIdRegex.FindStringSubmatch(diff.Id())
+	if fieldValues == nil {
+		return nil
+	}
+	project, location, clusterName := fieldValues[1], fieldValues[2], fieldValues[3]
+
+	config := meta.(*transport_tpg.Config)
+	cluster, err := containerClusterGet(config, config.UserAgent, project, location, clusterName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving cluster %s: %s", clusterName, err)
+	}
+	serverConfig, err := containerServerConfig(config, config.UserAgent, project, location)
+	if err != nil {
+		return err
+	}
+
+	if err := containerMasterUpgradePreflight(cluster.CurrentMasterVersion, target, serverConfig.ValidMasterVersions); err != nil {
+		return err
+	}
+	configured := containerClusterNodePoolVersions(diff)
+	for _, name := range tpgresource.ConvertStringArr(orchestration["node_pool_order"].([]interface{})) {
+		if !configured.inline[name] {
+			return fmt.Errorf("node_pool_order lists node pool %q, which isn't defined in the cluster's node_pool blocks", name)
+		}
+	}
+
+	_, err = containerClusterNodePoolsToUpgrade(cluster.NodePools, target, orchestration["upgrade_node_pools"].(bool), configured, serverConfig.ValidNodeVersions)
+	return err
+}
+
+// containerClusterOrchestrateUpgrade upgrades the control plane to min_master_version and then
+// the inline node pools of the cluster to the control plane version, following upgrade_orchestration.
+// The upgrade is checked at plan time by containerClusterUpgradeOrchestrationCustomizeDiff.
+// Inline node pools whose version changes are left to nodePoolUpdate, which runs afterwards.
+func containerClusterOrchestrateUpgrade(d *schema.ResourceData, config *transport_tpg.Config, userAgent, project, location, clusterName string, updateFunc func(*container.UpdateClusterRequest, string) func() error) error {
+	lockKey := containerClusterMutexKey(project, location, clusterName)
+	target := d.Get("min_master_version").(string)
+	orchestration := d.Get("upgrade_orchestration.0").(map[string]interface{})
+	timeout := d.Timeout(schema.TimeoutUpdate)
+
+	cluster, err := containerClusterGet(config, userAgent, project, location, clusterName)
+	if err != nil {
+		return err
+	}
+
+	// The node pools may have changed since the plan was checked.
+	names, err := containerClusterNodePoolsToUpgrade(cluster.NodePools, target, orchestration["upgrade_node_pools"].(bool), containerClusterNodePoolVersions(d), nil)
+	if err != nil {
+		return err
+	}
+	names = containerNodePoolUpgradeOrder(names, tpgresource.ConvertStringArr(orchestration["node_pool_order"].([]interface{})))
+
+	masterVersion := cluster.CurrentMasterVersion
+	des, err := version.NewVersion(target)
+	if err != nil {
+		return err
+	}
+	cur, err := version.NewVersion(masterVersion)
+	if err != nil {
+		return err
+	}
+	// Only upgrade the master if the current version is lower than the desired version
+	if cur.LessThan(des) {
+		updateF := updateFunc(containerClusterMasterUpgradeRequest(d, target), "updating GKE master version")
+		// Call update serially.
+		if err := transport_tpg.LockedCall(lockKey, updateF); err != nil {
+			return err
+		}
+		log.Printf("[INFO] GKE cluster %s: master has been updated to %s", d.Id(), target)
+
+		cluster, err = containerClusterGet(config, userAgent, project, location, clusterName)
+		if err != nil {
+			return err
+		}
+		masterVersion = cluster.CurrentMasterVersion
+	}
+
+	// Acquire read-lock on cluster, as node pool updates do.
+	transport_tpg.MutexStore.RLock(lockKey)
+	defer transport_tpg.MutexStore.RUnlock(lockKey)
+
+	current := map[string]string{}
+	for _, np := range cluster.NodePools {
+		current[np.Name] = np.Version
+	}
+	nodePoolInfo := &NodePoolInformation{
+		project:  project,
+		location: location,
+		cluster:  clusterName,
+	}
+
+	return containerUpgradeNodePools(names, orchestration["max_concurrent_node_pool_upgrades"].(int), func(name string) error {
+		if current[name] == masterVersion {
+			log.Printf("[DEBUG] GKE cluster %s: node pool %s is already at version %s", d.Id(), name, masterVersion)
+			return nil
+		}
+		log.Printf("[INFO] GKE cluster %s: upgrading node pool %s from %s to %s", d.Id(), name, current[name], masterVersion)
+		req := &container.UpdateNodePoolRequest{
+			NodePoolId:  name,
+			NodeVersion: masterVersion,
+		}
+		updateF := func() error {
+			clusterNodePoolsUpdateCall := NewClient(config, userAgent).Projects.Locations.Clusters.NodePools.Update(nodePoolInfo.fullyQualifiedName(name), req)
+			if config.UserProjectOverride {
+				clusterNodePoolsUpdateCall.Header().Add("X-Goog-User-Project", project)
+			}
+			op, err := clusterNodePoolsUpdateCall.Do()
+			if err != nil {
+				return err
+			}
+
+			// Wait until it's updated
+			return ContainerOperationWait(config, op, project, location, "updating GKE node pool version", userAgent, timeout)
+		}
+		if err := retryWhileIncompatibleOperation(timeout, nodePoolInfo.nodePoolLockKey(name), updateF); err != nil {
+			return err
+		}
+		log.Printf("[INFO] GKE cluster %s: node pool %s has been upgraded to %s", d.Id(), name, masterVersion)
+		return nil
+	})
+}
@@ -0,0 +1,242 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/services/container/upgrade_orchestration_internal_test.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package container
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+
+	"google.golang.org/api/container/v1"
+)
+
+func TestContainerMasterUpgradePreflight(t *testing.T) {
+	t.Parallel()
+
+	validVersions := []string{"1.31.1-gke.100", "1.30.5-gke.200", "1.30.4-gke.300", "1.29.8-gke.400"}
+
+	cases := map[string]struct {
+		Current     string
+		Target      string
+		ExpectError bool
+	}{
+		"next minor version": {
+			Current: "1.29.8-gke.400",
+			Target:  "1.30.5-gke.200",
+		},
+		"fuzzy version": {
+			Current: "1.29.8-gke.400",
+			Target:  "1.30",
+		},
+		"patch version": {
+			Current: "1.30.4-gke.300",
+			Target:  "1.30.5-gke.200",
+		},
+		"alias": {
+			Current: "1.29.8-gke.400",
+			Target:  "latest",
+		},
+		"skipped minor version": {
+			Current:     "1.29.8-gke.400",
+			Target:      "1.31.1-gke.100",
+			ExpectError: true,
+		},
+		"version not offered": {
+			Current:     "1.29.8-gke.400",
+			Target:      "1.30.1-gke.1",
+			ExpectError: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		err := containerMasterUpgradePreflight(tc.Current, tc.Target, validVersions)
+		if (err != nil) != tc.ExpectError {
+			t.Errorf("%s: unexpected error: %v", tn, err)
+		}
+	}
+}
+
+func TestContainerNodeVersionPreflight(t *testing.T) {
+	t.Parallel()
+
+	validVersions := []string{"1.30.5-gke.200", "1.29.8-gke.400", "1.28.9-gke.500", "1.27.16-gke.600"}
+
+	cases := map[string]struct {
+		Master        string
+		Node          string
+		ValidVersions []string
+		ExpectError   bool
+	}{
+		"same version": {
+			Master:        "1.30.5-gke.200",
+			Node:          "1.30.5-gke.200",
+			ValidVersions: validVersions,
+		},
+		"within skew": {
+			Master:        "1.30.5-gke.200",
+			Node:          "1.28",
+			ValidVersions: validVersions,
+		},
+		"newer than the control plane": {
+			Master:        "1.29.8-gke.400",
+			Node:          "1.30.5-gke.200",
+			ValidVersions: validVersions,
+			ExpectError:   true,
+		},
+		"beyond skew": {
+			Master:        "1.30.5-gke.200",
+			Node:          "1.27.16-gke.600",
+			ValidVersions: validVersions,
+			ExpectError:   true,
+		},
+		"version not offered": {
+			Master:        "1.30.5-gke.200",
+			Node:          "1.30.1-gke.1",
+			ValidVersions: validVersions,
+			ExpectError:   true,
+		},
+		"valid versions not checked": {
+			Master: "1.30.5-gke.200",
+			Node:   "1.30.1-gke.1",
+		},
+	}
+
+	for tn, tc := range cases {
+		err := containerNodeVersionPreflight(tc.Master, tc.Node, tc.ValidVersions)
+		if (err != nil) != tc.ExpectError {
+			t.Errorf("%s: unexpected error: %v", tn, err)
+		}
+	}
+}
+
+func TestContainerNodePoolUpgradeOrder(t *testing.T) {
+	t.Parallel()
+
+	got := containerNodePoolUpgradeOrder([]string{"pool-c", "pool-a", "pool-d", "pool-b"}, []string{"pool-d", "pool-x", "pool-b"})
+	expected := []string{"pool-d", "pool-b", "pool-a", "pool-c"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
+
+func TestContainerClusterNodePoolsToUpgrade(t *testing.T) {
+	t.Parallel()
+
+	nodePools := []*container.NodePool{
+		{Name: "pool-a", Version: "1.29.8-gke.400"},
+		{Name: "pool-b", Version: "1.29.8-gke.400"},
+		{Name: "pool-c", Version: "1.28.9-gke.500"},
+	}
+	configured := func(planned map[string]string, pinned map[string]bool) containerClusterNodePools {
+		return containerClusterNodePools{
+			planned: planned,
+			pinned:  pinned,
+			inline:  map[string]bool{"pool-a": true, "pool-b": true, "pool-c": true},
+		}
+	}
+
+	names, err := containerClusterNodePoolsToUpgrade(nodePools, "1.30.5-gke.200", true, configured(map[string]string{"pool-b": "1.30.5-gke.200"}, nil), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if expected := []string{"pool-a", "pool-c"}; !reflect.DeepEqual(names, expected) {
+		t.Errorf("expected %v, got %v", expected, names)
+	}
+
+	// A node pool with a pinned version keeps it, so it isn't upgraded.
+	names, err = containerClusterNodePoolsToUpgrade(nodePools, "1.30.5-gke.200", true, configured(nil, map[string]bool{"pool-a": true}), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if expected := []string{"pool-b", "pool-c"}; !reflect.DeepEqual(names, expected) {
+		t.Errorf("expected %v, got %v", expected, names)
+	}
+
+	// Node pools managed outside the cluster resource aren't upgraded.
+	names, err = containerClusterNodePoolsToUpgrade(nodePools, "1.30.5-gke.200", true, containerClusterNodePools{inline: map[string]bool{"pool-b": true}}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if expected := []string{"pool-b"}; !reflect.DeepEqual(names, expected) {
+		t.Errorf("expected %v, got %v", expected, names)
+	}
+
+	// ... so they must stay within the version skew policy.
+	if _, err := containerClusterNodePoolsToUpgrade(nodePools, "1.31.1-gke.100", true, containerClusterNodePools{inline: map[string]bool{"pool-a": true, "pool-b": true}}, nil); err == nil {
+		t.Errorf("expected an error for a separately managed node pool outside the version skew policy")
+	}
+
+	// A pinned version that would fall outside the version skew policy fails the check.
+	if _, err := containerClusterNodePoolsToUpgrade(nodePools, "1.31.1-gke.100", true, configured(nil, map[string]bool{"pool-c": true}), nil); err == nil {
+		t.Errorf("expected an error for a pinned version outside the version skew policy")
+	}
+
+	// Without upgrade_node_pools, every node pool must stay within the version skew policy.
+	if _, err := containerClusterNodePoolsToUpgrade(nodePools, "1.31.1-gke.100", false, configured(nil, nil), nil); err == nil {
+		t.Errorf("expected an error for node pools outside the version skew policy")
+	}
+
+	// A planned version must be offered by GKE.
+	if _, err := containerClusterNodePoolsToUpgrade(nodePools, "1.30.5-gke.200", true, configured(map[string]string{"pool-b": "1.30.9-gke.999"}, nil), []string{"1.30.5-gke.200"}); err == nil {
+		t.Errorf("expected an error for a planned version that isn't offered")
+	}
+}
+
+func TestContainerUpgradeNodePools(t *testing.T) {
+	t.Parallel()
+
+	names := []string{"pool-a", "pool-b", "pool-c", "pool-d"}
+
+	var mutex sync.Mutex
+	upgraded := []string{}
+	err := containerUpgradeNodePools(names, 1, func(name string) error {
+		mutex.Lock()
+		defer mutex.Unlock()
+		upgraded = append(upgraded, name)
+		if name == "pool-b" {
+			return fmt.Errorf("upgrade failed")
+		}
+		return nil
+	})
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if expected := []string{"pool-a", "pool-b"}; !reflect.DeepEqual(upgraded, expected) {
+		t.Errorf("expected the upgrades to stop after the failing node pool, upgraded %v", upgraded)
+	}
+
+	running, maxRunning := 0, 0
+	err = containerUpgradeNodePools(names, 2, func(name string) error {
+		mutex.Lock()
+		running++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		mutex.Unlock()
+		mutex.Lock()
+		running--
+		mutex.Unlock()
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if maxRunning > 2 {
+		t.Errorf("expected at most 2 concurrent upgrades, got %d", maxRunning)
+	}
+}
@@ -177,11 +177,14 @@ func ResourceDatabaseMigrationServiceConnectionProfile() *schema.Resource {
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												"password": {
-													Type:        schema.TypeString,
-													Required:    true,
-													Description: `The initial password for the user.`,
-													Sensitive:   true,
+													Type:         schema.TypeString,
+													Optional:     true,
+													Description:  `The initial password for the user.`,
+													Sensitive:    true,
+													ExactlyOneOf: []string{"alloydb.0.settings.0.initial_user.0.password", "alloydb.0.settings.0.initial_user.0.password_wo"},
 												},
+												"password_wo":         tpgresource.WriteOnlySchema("alloydb.0.settings.0.initial_user.0.password", `Write-only initial password for the user.`, true),
+												"password_wo_version": tpgresource.WriteOnlyVersionSchema("alloydb.0.settings.0.initial_user.0.password", true),
 												"user": {
 													Type:        schema.TypeString,
 													Required:    true,
@@ -391,12 +394,15 @@ This setting can be updated, but it cannot be removed after it is set.`,
 										},
 									},
 									"root_password": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										ForceNew:    true,
-										Description: `Input only. Initial root password.`,
-										Sensitive:   true,
+										Type:          schema.TypeString,
+										Optional:      true,
+										ForceNew:      true,
+										Description:   `Input only. Initial root password.`,
+										Sensitive:     true,
+										ConflictsWith: []string{"cloudsql.0.settings.0.root_password_wo"},
 									},
+									"root_password_wo":         tpgresource.WriteOnlySchema("cloudsql.0.settings.0.root_password", `Write-only initial root password.`, false),
+									"root_password_wo_version": tpgresource.WriteOnlyVersionSchema("cloudsql.0.settings.0.root_password", true),
 									"storage_auto_resize_limit": {
 										Type:        schema.TypeString,
 										Optional:    true,
@@ -491,8 +497,11 @@ Please refer to the field 'effective_labels' for all of the labels present on th
 							ForceNew: true,
 							Description: `Input only. The password for the user that Database Migration Service will be using to connect to the database.
 This field is not returned on request, and the value is encrypted when stored in Database Migration Service.`,
-							Sensitive: true,
+							Sensitive:     true,
+							ConflictsWith: []string{"mysql.0.password_wo"},
 						},
+						"password_wo":         tpgresource.WriteOnlySchema("mysql.0.password", `Write-only password for the user that Database Migration Service will be using to connect to the database.`, false),
+						"password_wo_version": tpgresource.WriteOnlyVersionSchema("mysql.0.password", true),
 						"port": {
 							Type:         schema.TypeInt,
 							Optional:     true,
@@ -573,12 +582,15 @@ If this field is used then the 'clientCertificate' field is mandatory.`,
 						},
 						"password": {
 							Type:     schema.TypeString,
-							Required: true,
+							Optional: true,
 							ForceNew: true,
 							Description: `Required. Input only. The password for the user that Database Migration Service will be using to connect to the database.
 This field is not returned on request, and the value is encrypted when stored in Database Migration Service.`,
-							Sensitive: true,
+							Sensitive:    true,
+							ExactlyOneOf: []string{"oracle.0.password", "oracle.0.password_wo"},
 						},
+						"password_wo":         tpgresource.WriteOnlySchema("oracle.0.password", `Write-only password for the user that Database Migration Service will be using to connect to the database.`, true),
+						"password_wo_version": tpgresource.WriteOnlyVersionSchema("oracle.0.password", true),
 						"port": {
 							Type:        schema.TypeInt,
 							Required:    true,
@@ -612,21 +624,26 @@ This field is not returned on request, and the value is encrypted when stored in
 										Description: `Required. Username for the SSH tunnel.`,
 									},
 									"password": {
-										Type:         schema.TypeString,
-										Optional:     true,
-										ForceNew:     true,
-										Description:  `Input only. SSH password. Only one of 'password' and 'private_key' can be configured.`,
-										Sensitive:    true,
-										ExactlyOneOf: []string{},
+										Type:          schema.TypeString,
+										Optional:      true,
+										ForceNew:      true,
+										Description:   `Input only. SSH password. Only one of 'password' and 'private_key' can be configured.`,
+										Sensitive:     true,
+										ExactlyOneOf:  []string{},
+										ConflictsWith: []string{"oracle.0.forward_ssh_connectivity.0.password_wo"},
 									},
+									"password_wo":         tpgresource.WriteOnlySchema("oracle.0.forward_ssh_connectivity.0.password", `Write-only SSH password.`, false),
+									"password_wo_version": tpgresource.WriteOnlyVersionSchema("oracle.0.forward_ssh_connectivity.0.password", true),
 									"private_key": {
 										Type:         schema.TypeString,
 										Optional:     true,
 										ForceNew:     true,
 										Description:  `Input only. SSH private key. Only one of 'password' and 'private_key' can be configured.`,
 										Sensitive:    true,
-										ExactlyOneOf: []string{"oracle.0.forward_ssh_connectivity.0.password", "oracle.0.forward_ssh_connectivity.0.private_key"},
+										ExactlyOneOf: []string{"oracle.0.forward_ssh_connectivity.0.password", "oracle.0.forward_ssh_connectivity.0.private_key", "oracle.0.forward_ssh_connectivity.0.password_wo", "oracle.0.forward_ssh_connectivity.0.private_key_wo"},
 									},
+									"private_key_wo":         tpgresource.WriteOnlySchema("oracle.0.forward_ssh_connectivity.0.private_key", `Write-only SSH private key.`, false),
+									"private_key_wo_version": tpgresource.WriteOnlyVersionSchema("oracle.0.forward_ssh_connectivity.0.private_key", true),
 								},
 							},
 							ExactlyOneOf: []string{},
@@ -744,9 +761,12 @@ Static IP address connectivity configured on service project.`,
 							ForceNew: true,
 							Description: `Input only. The password for the user that Database Migration Service will be using to connect to the database.
 This field is not returned on request, and the value is encrypted when stored in Database Migration Service.`,
-							Sensitive:    true,
-							RequiredWith: []string{"postgresql.0.username"},
+							Sensitive:     true,
+							RequiredWith:  []string{"postgresql.0.username"},
+							ConflictsWith: []string{"postgresql.0.password_wo"},
 						},
+						"password_wo":         tpgresource.WriteOnlySchema("postgresql.0.password", `Write-only password for the user that Database Migration Service will be using to connect to the database.`, false),
+						"password_wo_version": tpgresource.WriteOnlyVersionSchema("postgresql.0.password", true),
 						"port": {
 							Type:         schema.TypeInt,
 							Optional:     true,
@@ -1510,6 +1530,8 @@ func flattenDatabaseMigrationServiceConnectionProfileMysql(v interface{}, d *sch
 		flattenDatabaseMigrationServiceConnectionProfileMysqlSsl(original["ssl"], d, config)
 	transformed["cloud_sql_id"] =
 		flattenDatabaseMigrationServiceConnectionProfileMysqlCloudSqlId(original["cloudSqlId"], d, config)
+	transformed["password_wo_version"] =
+		flattenDatabaseMigrationServiceConnectionProfileMysqlPasswordWoVersion(original["passwordWoVersion"], d, config)
 	return []interface{}{transformed}
 }
 func flattenDatabaseMigrationServiceConnectionProfileMysqlHost(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -1541,6 +1563,10 @@ func flattenDatabaseMigrationServiceConnectionProfileMysqlPassword(v interface{}
 	return d.Get("mysql.0.password")
 }
 
+func flattenDatabaseMigrationServiceConnectionProfileMysqlPasswordWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("mysql.0.password_wo_version")
+}
+
 func flattenDatabaseMigrationServiceConnectionProfileMysqlPasswordSet(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -1615,6 +1641,8 @@ func flattenDatabaseMigrationServiceConnectionProfilePostgresql(v interface{}, d
 		flattenDatabaseMigrationServiceConnectionProfilePostgresqlNetworkArchitecture(original["networkArchitecture"], d, config)
 	transformed["private_connectivity"] =
 		flattenDatabaseMigrationServiceConnectionProfilePostgresqlPrivateConnectivity(original["privateConnectivity"], d, config)
+	transformed["password_wo_version"] =
+		flattenDatabaseMigrationServiceConnectionProfilePostgresqlPasswordWoVersion(original["passwordWoVersion"], d, config)
 	return []interface{}{transformed}
 }
 func flattenDatabaseMigrationServiceConnectionProfilePostgresqlHost(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -1646,6 +1674,10 @@ func flattenDatabaseMigrationServiceConnectionProfilePostgresqlPassword(v interf
 	return d.Get("postgresql.0.password")
 }
 
+func flattenDatabaseMigrationServiceConnectionProfilePostgresqlPasswordWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("postgresql.0.password_wo_version")
+}
+
 func flattenDatabaseMigrationServiceConnectionProfilePostgresqlPasswordSet(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -1747,6 +1779,8 @@ func flattenDatabaseMigrationServiceConnectionProfileOracle(v interface{}, d *sc
 		flattenDatabaseMigrationServiceConnectionProfileOracleForwardSshConnectivity(original["forwardSshConnectivity"], d, config)
 	transformed["private_connectivity"] =
 		flattenDatabaseMigrationServiceConnectionProfileOraclePrivateConnectivity(original["privateConnectivity"], d, config)
+	transformed["password_wo_version"] =
+		flattenDatabaseMigrationServiceConnectionProfileOraclePasswordWoVersion(original["passwordWoVersion"], d, config)
 	return []interface{}{transformed}
 }
 func flattenDatabaseMigrationServiceConnectionProfileOracleHost(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -1778,6 +1812,10 @@ func flattenDatabaseMigrationServiceConnectionProfileOraclePassword(v interface{
 	return d.Get("oracle.0.password")
 }
 
+func flattenDatabaseMigrationServiceConnectionProfileOraclePasswordWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("oracle.0.password_wo_version")
+}
+
 func flattenDatabaseMigrationServiceConnectionProfileOraclePasswordSet(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -1848,6 +1886,10 @@ func flattenDatabaseMigrationServiceConnectionProfileOracleForwardSshConnectivit
 		flattenDatabaseMigrationServiceConnectionProfileOracleForwardSshConnectivityPassword(original["password"], d, config)
 	transformed["private_key"] =
 		flattenDatabaseMigrationServiceConnectionProfileOracleForwardSshConnectivityPrivateKey(original["privateKey"], d, config)
+	transformed["private_key_wo_version"] =
+		flattenDatabaseMigrationServiceConnectionProfileOracleForwardSshConnectivityPrivateKeyWoVersion(original["privateKeyWoVersion"], d, config)
+	transformed["password_wo_version"] =
+		flattenDatabaseMigrationServiceConnectionProfileOracleForwardSshConnectivityPasswordWoVersion(original["passwordWoVersion"], d, config)
 	return []interface{}{transformed}
 }
 func flattenDatabaseMigrationServiceConnectionProfileOracleForwardSshConnectivityHostname(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -1879,10 +1921,18 @@ func flattenDatabaseMigrationServiceConnectionProfileOracleForwardSshConnectivit
 	return d.Get("oracle.0.forward_ssh_connectivity.0.password")
 }
 
+func flattenDatabaseMigrationServiceConnectionProfileOracleForwardSshConnectivityPasswordWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("oracle.0.forward_ssh_connectivity.0.password_wo_version")
+}
+
 func flattenDatabaseMigrationServiceConnectionProfileOracleForwardSshConnectivityPrivateKey(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return d.Get("oracle.0.forward_ssh_connectivity.0.private_key")
 }
 
+func flattenDatabaseMigrationServiceConnectionProfileOracleForwardSshConnectivityPrivateKeyWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("oracle.0.forward_ssh_connectivity.0.private_key_wo_version")
+}
+
 func flattenDatabaseMigrationServiceConnectionProfileOraclePrivateConnectivity(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	if v == nil {
 		return nil
@@ -1966,6 +2016,8 @@ func flattenDatabaseMigrationServiceConnectionProfileCloudsqlSettings(v interfac
 		flattenDatabaseMigrationServiceConnectionProfileCloudsqlSettingsCmekKeyName(original["cmekKeyName"], d, config)
 	transformed["edition"] =
 		flattenDatabaseMigrationServiceConnectionProfileCloudsqlSettingsEdition(original["edition"], d, config)
+	transformed["root_password_wo_version"] =
+		flattenDatabaseMigrationServiceConnectionProfileCloudsqlSettingsRootPasswordWoVersion(original["rootPasswordWoVersion"], d, config)
 	return []interface{}{transformed}
 }
 func flattenDatabaseMigrationServiceConnectionProfileCloudsqlSettingsDatabaseVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -2085,6 +2137,10 @@ func flattenDatabaseMigrationServiceConnectionProfileCloudsqlSettingsRootPasswor
 	return d.Get("cloudsql.0.settings.0.root_password")
 }
 
+func flattenDatabaseMigrationServiceConnectionProfileCloudsqlSettingsRootPasswordWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("cloudsql.0.settings.0.root_password_wo_version")
+}
+
 func flattenDatabaseMigrationServiceConnectionProfileCloudsqlSettingsRootPasswordSet(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -2162,6 +2218,8 @@ func flattenDatabaseMigrationServiceConnectionProfileAlloydbSettingsInitialUser(
 		flattenDatabaseMigrationServiceConnectionProfileAlloydbSettingsInitialUserPassword(original["password"], d, config)
 	transformed["password_set"] =
 		flattenDatabaseMigrationServiceConnectionProfileAlloydbSettingsInitialUserPasswordSet(original["passwordSet"], d, config)
+	transformed["password_wo_version"] =
+		flattenDatabaseMigrationServiceConnectionProfileAlloydbSettingsInitialUserPasswordWoVersion(original["passwordWoVersion"], d, config)
 	return []interface{}{transformed}
 }
 func flattenDatabaseMigrationServiceConnectionProfileAlloydbSettingsInitialUserUser(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -2172,6 +2230,10 @@ func flattenDatabaseMigrationServiceConnectionProfileAlloydbSettingsInitialUserP
 	return d.Get("alloydb.0.settings.0.initial_user.0.password")
 }
 
+func flattenDatabaseMigrationServiceConnectionProfileAlloydbSettingsInitialUserPasswordWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("alloydb.0.settings.0.initial_user.0.password_wo_version")
+}
+
 func flattenDatabaseMigrationServiceConnectionProfileAlloydbSettingsInitialUserPasswordSet(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -2311,7 +2373,7 @@ func expandDatabaseMigrationServiceConnectionProfileMysql(v interface{}, d tpgre
 		transformed["username"] = transformedUsername
 	}
 
-	transformedPassword, err := expandDatabaseMigrationServiceConnectionProfileMysqlPassword(original["password"], d, config)
+	transformedPassword, err := expandDatabaseMigrationServiceConnectionProfileMysqlPassword(tpgresource.GetWriteOnlyValue(d, "mysql.0.password", original["password"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedPassword); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -2458,7 +2520,7 @@ func expandDatabaseMigrationServiceConnectionProfilePostgresql(v interface{}, d
 		transformed["username"] = transformedUsername
 	}
 
-	transformedPassword, err := expandDatabaseMigrationServiceConnectionProfilePostgresqlPassword(original["password"], d, config)
+	transformedPassword, err := expandDatabaseMigrationServiceConnectionProfilePostgresqlPassword(tpgresource.GetWriteOnlyValue(d, "postgresql.0.password", original["password"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedPassword); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -2671,7 +2733,7 @@ func expandDatabaseMigrationServiceConnectionProfileOracle(v interface{}, d tpgr
 		transformed["username"] = transformedUsername
 	}
 
-	transformedPassword, err := expandDatabaseMigrationServiceConnectionProfileOraclePassword(original["password"], d, config)
+	transformedPassword, err := expandDatabaseMigrationServiceConnectionProfileOraclePassword(tpgresource.GetWriteOnlyValue(d, "oracle.0.password", original["password"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedPassword); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -2857,14 +2919,14 @@ func expandDatabaseMigrationServiceConnectionProfileOracleForwardSshConnectivity
 		transformed["port"] = transformedPort
 	}
 
-	transformedPassword, err := expandDatabaseMigrationServiceConnectionProfileOracleForwardSshConnectivityPassword(original["password"], d, config)
+	transformedPassword, err := expandDatabaseMigrationServiceConnectionProfileOracleForwardSshConnectivityPassword(tpgresource.GetWriteOnlyValue(d, "oracle.0.forward_ssh_connectivity.0.password", original["password"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedPassword); val.IsValid() && !tpgresource.IsEmptyValue(val) {
 		transformed["password"] = transformedPassword
 	}
 
-	transformedPrivateKey, err := expandDatabaseMigrationServiceConnectionProfileOracleForwardSshConnectivityPrivateKey(original["private_key"], d, config)
+	transformedPrivateKey, err := expandDatabaseMigrationServiceConnectionProfileOracleForwardSshConnectivityPrivateKey(tpgresource.GetWriteOnlyValue(d, "oracle.0.forward_ssh_connectivity.0.private_key", original["private_key"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedPrivateKey); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -3063,7 +3125,7 @@ func expandDatabaseMigrationServiceConnectionProfileCloudsqlSettings(v interface
 		transformed["sourceId"] = transformedSourceId
 	}
 
-	transformedRootPassword, err := expandDatabaseMigrationServiceConnectionProfileCloudsqlSettingsRootPassword(original["root_password"], d, config)
+	transformedRootPassword, err := expandDatabaseMigrationServiceConnectionProfileCloudsqlSettingsRootPassword(tpgresource.GetWriteOnlyValue(d, "cloudsql.0.settings.0.root_password", original["root_password"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedRootPassword); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -3399,7 +3461,7 @@ func expandDatabaseMigrationServiceConnectionProfileAlloydbSettingsInitialUser(v
 		transformed["user"] = transformedUser
 	}
 
-	transformedPassword, err := expandDatabaseMigrationServiceConnectionProfileAlloydbSettingsInitialUserPassword(original["password"], d, config)
+	transformedPassword, err := expandDatabaseMigrationServiceConnectionProfileAlloydbSettingsInitialUserPassword(tpgresource.GetWriteOnlyValue(d, "alloydb.0.settings.0.initial_user.0.password", original["password"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedPassword); val.IsValid() && !tpgresource.IsEmptyValue(val) {
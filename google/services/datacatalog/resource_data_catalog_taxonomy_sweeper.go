@@ -190,7 +190,7 @@ func deleteResourceDataCatalogTaxonomy(config *transport_tpg.Config, d *tpgresou
 	name = obj["displayName"].(string)
 
 	// Skip resources that shouldn't be sweeped
-	if !sweeper.IsSweepableTestResource(name) {
+	if !sweeper.ShouldSweep(name, obj) {
 		return nil
 	}
 
@@ -0,0 +1,181 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/services/dataflow/action_dataflow_job_drain.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package dataflow
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-google/google/fwresource"
+	"github.com/hashicorp/terraform-provider-google/google/registry"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+	dataflow "google.golang.org/api/dataflow/v1b3"
+	"google.golang.org/api/googleapi"
+)
+
+const dataflowJobDrainTimeout = 60 * time.Minute
+
+var _ action.ActionWithConfigure = &googleActionDataflowJobDrain{}
+
+func init() {
+	registry.FrameworkAction{
+		Name:        "google_dataflow_job_drain",
+		ProductName: "dataflow",
+		Func:        GoogleActionDataflowJobDrain,
+	}.Register()
+}
+
+func GoogleActionDataflowJobDrain() action.Action {
+	return &googleActionDataflowJobDrain{}
+}
+
+type googleActionDataflowJobDrain struct {
+	providerConfig *transport_tpg.Config
+}
+
+func (a *googleActionDataflowJobDrain) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dataflow_job_drain"
+}
+
+type actionDataflowJobDrainModel struct {
+	JobId             types.String `tfsdk:"job_id"`
+	Region            types.String `tfsdk:"region"`
+	Project           types.String `tfsdk:"project"`
+	WaitForCompletion types.Bool   `tfsdk:"wait_for_completion"`
+}
+
+func (a *googleActionDataflowJobDrain) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Drains a streaming Dataflow job. The job stops reading new data, and finishes processing the data it has already read.",
+		Attributes: map[string]schema.Attribute{
+			"job_id": schema.StringAttribute{
+				Description: "The unique ID of the Dataflow job to drain.",
+				Required:    true,
+			},
+			"region": schema.StringAttribute{
+				Description: "The region in which the job runs. If it is not provided, the provider region is used.",
+				Optional:    true,
+			},
+			"project": schema.StringAttribute{
+				Description: "The ID of the project in which the job belongs. If it is not provided, the provider project is used.",
+				Optional:    true,
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				Description: "Whether to wait for the job to be drained. Defaults to true.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (a *googleActionDataflowJobDrain) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	pd, ok := req.ProviderData.(*transport_tpg.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *transport_tpg.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.providerConfig = pd
+}
+
+func (a *googleActionDataflowJobDrain) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data actionDataflowJobDrainModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config := a.providerConfig
+
+	project := fwresource.GetProjectFramework(data.Project, types.StringValue(config.Project), &resp.Diagnostics).ValueString()
+	region := fwresource.GetRegionFramework(data.Region, types.StringValue(config.Region), &resp.Diagnostics).ValueString()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	id := data.JobId.ValueString()
+
+	requestedState, err := resourceDataflowJobMapRequestedState("drain")
+	if err != nil {
+		resp.Diagnostics.AddError("Error draining Dataflow job", err.Error())
+		return
+	}
+
+	// Retry updating the state while the job is not ready to be drained.
+	err = retry.RetryContext(ctx, dataflowJobDrainTimeout, func() *retry.RetryError {
+		_, updateErr := resourceDataflowJobUpdateJob(config, project, region, config.UserAgent, id, &dataflow.Job{
+			RequestedState: requestedState,
+		})
+		if updateErr == nil {
+			return nil
+		}
+		if gerr, ok := updateErr.(*googleapi.Error); ok && strings.Contains(gerr.Message, "not yet ready for canceling") {
+			// Sleep to avoid hitting update quota with repeated attempts.
+			time.Sleep(5 * time.Second)
+			return retry.RetryableError(updateErr)
+		}
+		return retry.NonRetryableError(updateErr)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error draining Dataflow job %q", id), err.Error())
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Draining Dataflow job %s", id),
+	})
+
+	if !data.WaitForCompletion.IsNull() && !data.WaitForCompletion.ValueBool() {
+		return
+	}
+
+	var state string
+	err = retry.RetryContext(ctx, dataflowJobDrainTimeout, func() *retry.RetryError {
+		job, err := resourceDataflowJobGetJob(config, project, region, config.UserAgent, id)
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+		state = job.CurrentState
+		if _, ok := DataflowTerminalStatesMap[state]; ok {
+			return nil
+		}
+		return retry.RetryableError(fmt.Errorf("Dataflow job %s is %s", id, state))
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error waiting for Dataflow job %q to be drained", id), err.Error())
+		return
+	}
+	if state != "JOB_STATE_DRAINED" {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error draining Dataflow job %q", id), fmt.Sprintf("the job terminated in state %s", state))
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Dataflow job %s has been drained", id),
+	})
+}
@@ -0,0 +1,96 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/services/dataflow/action_dataflow_job_drain_test.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package dataflow_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-google/google/acctest"
+)
+
+func TestAccActionDataflowJobDrain_basic(t *testing.T) {
+	// Dataflow responses include serialized java classes and bash commands
+	// This makes body comparison infeasible
+	acctest.SkipIfVcr(t)
+	t.Parallel()
+
+	suffix := acctest.RandString(t, 10)
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckDataflowJobDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				// The action fails unless the job is drained, and drained jobs are
+				// removed from state when it's refreshed.
+				Config:             testAccActionDataflowJobDrain_basic(suffix),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccActionDataflowJobDrain_basic(suffix string) string {
+	return fmt.Sprintf(`
+resource "google_pubsub_topic" "topic" {
+  name = "tf-test-dataflow-job-%s"
+}
+
+resource "google_storage_bucket" "bucket" {
+  name                        = "tf-test-bucket-%s"
+  location                    = "US"
+  force_destroy               = true
+  uniform_bucket_level_access = true
+}
+
+resource "google_dataflow_job" "pubsub_stream" {
+  name              = "tf-test-dataflow-job-%s"
+  template_gcs_path = "%s"
+  temp_gcs_location = google_storage_bucket.bucket.url
+  parameters = {
+    inputFilePattern = "${google_storage_bucket.bucket.url}/*.json"
+    outputTopic      = google_pubsub_topic.topic.id
+  }
+  on_delete = "cancel"
+}
+
+action "google_dataflow_job_drain" "drain" {
+  config {
+    job_id = google_dataflow_job.pubsub_stream.job_id
+    region = google_dataflow_job.pubsub_stream.region
+  }
+}
+
+resource "terraform_data" "drain" {
+  input = google_dataflow_job.pubsub_stream.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.google_dataflow_job_drain.drain]
+    }
+  }
+}
+`, suffix, suffix, suffix, testDataflowJobTemplateTextToPubsub)
+}
@@ -189,7 +189,7 @@ func deleteResourceDataFusionInstance(config *transport_tpg.Config, d *tpgresour
 	name = tpgresource.GetResourceNameFromSelfLink(obj["name"].(string))
 
 	// Skip resources that shouldn't be sweeped
-	if !sweeper.IsSweepableTestResource(name) {
+	if !sweeper.ShouldSweep(name, obj) {
 		return nil
 	}
 
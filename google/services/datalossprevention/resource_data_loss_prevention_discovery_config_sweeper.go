@@ -97,6 +97,9 @@ func testSweepDataLossPreventionDiscoveryConfig(region string) error {
 		// Note that we do not check for a sweepable prefix here.
 		// We can have at most 1 DiscoveryConfig for a storage type in the same project/location, so ensure we delete everything.
 		name := tpgresource.GetResourceNameFromSelfLink(obj["name"].(string))
+		if sweeper.SkipForDryRun(obj["name"].(string)) {
+			continue
+		}
 
 		deleteTemplate := "https://dlp.googleapis.com/v2/projects/{{project}}/locations/{{location}}/discoveryConfigs/{{name}}"
 		deleteUrl, err := tpgresource.ReplaceVars(d, config, deleteTemplate)
@@ -192,6 +195,9 @@ func testSweepDataLossPreventionDiscoveryConfigOrgScope(region string) error {
 		// Note that we do not check for a sweepable prefix here.
 		// We can have at most 1 DiscoveryConfig for a storage type in the same project/location, so ensure we delete everything.
 		name := tpgresource.GetResourceNameFromSelfLink(obj["name"].(string))
+		if sweeper.SkipForDryRun(obj["name"].(string)) {
+			continue
+		}
 
 		deleteTemplate := "https://dlp.googleapis.com/v2/organizations/{{org}}/locations/{{location}}/discoveryConfigs/{{name}}"
 		deleteUrl, err := tpgresource.ReplaceVars(d, config, deleteTemplate)
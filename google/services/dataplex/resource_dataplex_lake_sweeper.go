@@ -65,5 +65,5 @@ func testSweepDataplexLake(region string) error {
 }
 
 func isDeletableDataplexLake(r *Lake) bool {
-	return sweeper.IsSweepableTestResource(*r.Name)
+	return sweeper.ShouldSweepTyped(*r.Name, r)
 }
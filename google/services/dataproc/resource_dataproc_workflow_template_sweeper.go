@@ -65,5 +65,5 @@ func testSweepDataprocWorkflowTemplate(region string) error {
 }
 
 func isDeletableDataprocWorkflowTemplate(r *WorkflowTemplate) bool {
-	return sweeper.IsSweepableTestResource(*r.Name)
+	return sweeper.ShouldSweepTyped(*r.Name, r)
 }
@@ -194,7 +194,7 @@ func deleteResourceDataprocMetastoreService(config *transport_tpg.Config, d *tpg
 	}
 
 	// Skip resources that shouldn't be sweeped
-	if !sweeper.IsSweepableTestResource(name) {
+	if !sweeper.ShouldSweep(name, obj) {
 		return nil
 	}
 
@@ -211,8 +211,10 @@ func ResourceDatastreamConnectionProfile() *schema.Resource {
 							ForceNew:      true,
 							Description:   `SSH password.`,
 							Sensitive:     true,
-							ConflictsWith: []string{"forward_ssh_connectivity.0.private_key"},
+							ConflictsWith: []string{"forward_ssh_connectivity.0.private_key", "forward_ssh_connectivity.0.password_wo"},
 						},
+						"password_wo":         tpgresource.WriteOnlySchema("forward_ssh_connectivity.0.password", `Write-only SSH password.`, false),
+						"password_wo_version": tpgresource.WriteOnlyVersionSchema("forward_ssh_connectivity.0.password", true),
 						"port": {
 							Type:        schema.TypeInt,
 							Optional:    true,
@@ -224,8 +226,10 @@ func ResourceDatastreamConnectionProfile() *schema.Resource {
 							Optional:      true,
 							Description:   `SSH private key.`,
 							Sensitive:     true,
-							ConflictsWith: []string{"forward_ssh_connectivity.0.password"},
+							ConflictsWith: []string{"forward_ssh_connectivity.0.password", "forward_ssh_connectivity.0.private_key_wo"},
 						},
+						"private_key_wo":         tpgresource.WriteOnlySchema("forward_ssh_connectivity.0.private_key", `Write-only SSH private key.`, false),
+						"private_key_wo_version": tpgresource.WriteOnlyVersionSchema("forward_ssh_connectivity.0.private_key", false),
 					},
 				},
 				ConflictsWith: []string{"private_connectivity"},
@@ -304,8 +308,11 @@ MongoDB connection string options: https://www.mongodb.com/docs/manual/reference
 							Optional: true,
 							Description: `Password for the MongoDB connection. Mutually exclusive with
 secretManagerStoredPassword.`,
-							Sensitive: true,
+							Sensitive:     true,
+							ConflictsWith: []string{"mongodb_profile.0.password_wo"},
 						},
+						"password_wo":         tpgresource.WriteOnlySchema("mongodb_profile.0.password", `Write-only password for the MongoDB connection.`, false),
+						"password_wo_version": tpgresource.WriteOnlyVersionSchema("mongodb_profile.0.password", false),
 						"replica_set": {
 							Type:        schema.TypeString,
 							Optional:    true,
@@ -428,11 +435,14 @@ host[:port] in the connection URI.`,
 							Description: `Username for the MySQL connection.`,
 						},
 						"password": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: `Password for the MySQL connection.`,
-							Sensitive:   true,
+							Type:          schema.TypeString,
+							Optional:      true,
+							Description:   `Password for the MySQL connection.`,
+							Sensitive:     true,
+							ConflictsWith: []string{"mysql_profile.0.password_wo"},
 						},
+						"password_wo":         tpgresource.WriteOnlySchema("mysql_profile.0.password", `Write-only password for the MySQL connection.`, false),
+						"password_wo_version": tpgresource.WriteOnlyVersionSchema("mysql_profile.0.password", false),
 						"port": {
 							Type:        schema.TypeInt,
 							Optional:    true,
@@ -529,11 +539,14 @@ If this field is used then the 'client_certificate' and the
 							Elem:        &schema.Schema{Type: schema.TypeString},
 						},
 						"password": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: `Password for the Oracle connection.`,
-							Sensitive:   true,
+							Type:          schema.TypeString,
+							Optional:      true,
+							Description:   `Password for the Oracle connection.`,
+							Sensitive:     true,
+							ConflictsWith: []string{"oracle_profile.0.password_wo"},
 						},
+						"password_wo":         tpgresource.WriteOnlySchema("oracle_profile.0.password", `Write-only password for the Oracle connection.`, false),
+						"password_wo_version": tpgresource.WriteOnlyVersionSchema("oracle_profile.0.password", false),
 						"port": {
 							Type:        schema.TypeInt,
 							Optional:    true,
@@ -572,11 +585,14 @@ If this field is used then the 'client_certificate' and the
 							Description: `Username for the PostgreSQL connection.`,
 						},
 						"password": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: `Password for the PostgreSQL connection.`,
-							Sensitive:   true,
+							Type:          schema.TypeString,
+							Optional:      true,
+							Description:   `Password for the PostgreSQL connection.`,
+							Sensitive:     true,
+							ConflictsWith: []string{"postgresql_profile.0.password_wo"},
 						},
+						"password_wo":         tpgresource.WriteOnlySchema("postgresql_profile.0.password", `Write-only password for the PostgreSQL connection.`, false),
+						"password_wo_version": tpgresource.WriteOnlyVersionSchema("postgresql_profile.0.password", false),
 						"port": {
 							Type:        schema.TypeInt,
 							Optional:    true,
@@ -700,11 +716,14 @@ and the server identity will be authenticated.`,
 							Description: `Username for the SQL Server connection.`,
 						},
 						"password": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: `Password for the SQL Server connection.`,
-							Sensitive:   true,
+							Type:          schema.TypeString,
+							Optional:      true,
+							Description:   `Password for the SQL Server connection.`,
+							Sensitive:     true,
+							ConflictsWith: []string{"sql_server_profile.0.password_wo"},
 						},
+						"password_wo":         tpgresource.WriteOnlySchema("sql_server_profile.0.password", `Write-only password for the SQL Server connection.`, false),
+						"password_wo_version": tpgresource.WriteOnlyVersionSchema("sql_server_profile.0.password", false),
 						"port": {
 							Type:        schema.TypeInt,
 							Optional:    true,
@@ -1185,7 +1204,7 @@ func resourceDatastreamConnectionProfileUpdate(d *schema.ResourceData, meta inte
 	if d.HasChange("mongodb_profile.0.username") {
 		updateMask = append(updateMask, "mongodbProfile.username")
 	}
-	if d.HasChange("mongodb_profile.0.password") {
+	if tpgresource.WriteOnlyHasChange(d, "mongodb_profile.0.password") {
 		// Note: Password updates might require special handling
 		updateMask = append(updateMask, "mongodbProfile.password")
 	}
@@ -1377,6 +1396,8 @@ func flattenDatastreamConnectionProfileOracleProfile(v interface{}, d *schema.Re
 		flattenDatastreamConnectionProfileOracleProfileDatabaseService(original["databaseService"], d, config)
 	transformed["connection_attributes"] =
 		flattenDatastreamConnectionProfileOracleProfileConnectionAttributes(original["connectionAttributes"], d, config)
+	transformed["password_wo_version"] =
+		flattenDatastreamConnectionProfileOracleProfilePasswordWoVersion(original["passwordWoVersion"], d, config)
 	return []interface{}{transformed}
 }
 func flattenDatastreamConnectionProfileOracleProfileHostname(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -1408,6 +1429,10 @@ func flattenDatastreamConnectionProfileOracleProfilePassword(v interface{}, d *s
 	return d.Get("oracle_profile.0.password")
 }
 
+func flattenDatastreamConnectionProfileOracleProfilePasswordWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("oracle_profile.0.password_wo_version")
+}
+
 func flattenDatastreamConnectionProfileOracleProfileSecretManagerStoredPassword(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -1464,6 +1489,8 @@ func flattenDatastreamConnectionProfileMysqlProfile(v interface{}, d *schema.Res
 		flattenDatastreamConnectionProfileMysqlProfileSecretManagerStoredPassword(original["secretManagerStoredPassword"], d, config)
 	transformed["ssl_config"] =
 		flattenDatastreamConnectionProfileMysqlProfileSslConfig(original["sslConfig"], d, config)
+	transformed["password_wo_version"] =
+		flattenDatastreamConnectionProfileMysqlProfilePasswordWoVersion(original["passwordWoVersion"], d, config)
 	return []interface{}{transformed}
 }
 func flattenDatastreamConnectionProfileMysqlProfileHostname(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -1495,6 +1522,10 @@ func flattenDatastreamConnectionProfileMysqlProfilePassword(v interface{}, d *sc
 	return d.Get("mysql_profile.0.password")
 }
 
+func flattenDatastreamConnectionProfileMysqlProfilePasswordWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("mysql_profile.0.password_wo_version")
+}
+
 func flattenDatastreamConnectionProfileMysqlProfileSecretManagerStoredPassword(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -1578,6 +1609,8 @@ func flattenDatastreamConnectionProfilePostgresqlProfile(v interface{}, d *schem
 		flattenDatastreamConnectionProfilePostgresqlProfileDatabase(original["database"], d, config)
 	transformed["ssl_config"] =
 		flattenDatastreamConnectionProfilePostgresqlProfileSslConfig(original["sslConfig"], d, config)
+	transformed["password_wo_version"] =
+		flattenDatastreamConnectionProfilePostgresqlProfilePasswordWoVersion(original["passwordWoVersion"], d, config)
 	return []interface{}{transformed}
 }
 func flattenDatastreamConnectionProfilePostgresqlProfileHostname(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -1609,6 +1642,10 @@ func flattenDatastreamConnectionProfilePostgresqlProfilePassword(v interface{},
 	return d.Get("postgresql_profile.0.password")
 }
 
+func flattenDatastreamConnectionProfilePostgresqlProfilePasswordWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("postgresql_profile.0.password_wo_version")
+}
+
 func flattenDatastreamConnectionProfilePostgresqlProfileSecretManagerStoredPassword(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -1685,6 +1722,8 @@ func flattenDatastreamConnectionProfileSqlServerProfile(v interface{}, d *schema
 		flattenDatastreamConnectionProfileSqlServerProfileSecretManagerStoredPassword(original["secretManagerStoredPassword"], d, config)
 	transformed["database"] =
 		flattenDatastreamConnectionProfileSqlServerProfileDatabase(original["database"], d, config)
+	transformed["password_wo_version"] =
+		flattenDatastreamConnectionProfileSqlServerProfilePasswordWoVersion(original["passwordWoVersion"], d, config)
 	return []interface{}{transformed}
 }
 func flattenDatastreamConnectionProfileSqlServerProfileHostname(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -1716,6 +1755,10 @@ func flattenDatastreamConnectionProfileSqlServerProfilePassword(v interface{}, d
 	return d.Get("sql_server_profile.0.password")
 }
 
+func flattenDatastreamConnectionProfileSqlServerProfilePasswordWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("sql_server_profile.0.password_wo_version")
+}
+
 func flattenDatastreamConnectionProfileSqlServerProfileSecretManagerStoredPassword(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -1751,6 +1794,8 @@ func flattenDatastreamConnectionProfileMongodbProfile(v interface{}, d *schema.R
 		flattenDatastreamConnectionProfileMongodbProfileSrvConnectionFormat(original["srvConnectionFormat"], d, config)
 	transformed["standard_connection_format"] =
 		flattenDatastreamConnectionProfileMongodbProfileStandardConnectionFormat(original["standardConnectionFormat"], d, config)
+	transformed["password_wo_version"] =
+		flattenDatastreamConnectionProfileMongodbProfilePasswordWoVersion(original["passwordWoVersion"], d, config)
 	return []interface{}{transformed}
 }
 func flattenDatastreamConnectionProfileMongodbProfileHostAddresses(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -1806,6 +1851,10 @@ func flattenDatastreamConnectionProfileMongodbProfilePassword(v interface{}, d *
 	return v
 }
 
+func flattenDatastreamConnectionProfileMongodbProfilePasswordWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("mongodb_profile.0.password_wo_version")
+}
+
 func flattenDatastreamConnectionProfileMongodbProfileSecretManagerStoredPassword(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -1908,6 +1957,10 @@ func flattenDatastreamConnectionProfileForwardSshConnectivity(v interface{}, d *
 		flattenDatastreamConnectionProfileForwardSshConnectivityPassword(original["password"], d, config)
 	transformed["private_key"] =
 		flattenDatastreamConnectionProfileForwardSshConnectivityPrivateKey(original["privateKey"], d, config)
+	transformed["private_key_wo_version"] =
+		flattenDatastreamConnectionProfileForwardSshConnectivityPrivateKeyWoVersion(original["privateKeyWoVersion"], d, config)
+	transformed["password_wo_version"] =
+		flattenDatastreamConnectionProfileForwardSshConnectivityPasswordWoVersion(original["passwordWoVersion"], d, config)
 	return []interface{}{transformed}
 }
 func flattenDatastreamConnectionProfileForwardSshConnectivityHostname(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -1939,10 +1992,18 @@ func flattenDatastreamConnectionProfileForwardSshConnectivityPassword(v interfac
 	return d.Get("forward_ssh_connectivity.0.password")
 }
 
+func flattenDatastreamConnectionProfileForwardSshConnectivityPasswordWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("forward_ssh_connectivity.0.password_wo_version")
+}
+
 func flattenDatastreamConnectionProfileForwardSshConnectivityPrivateKey(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return d.Get("forward_ssh_connectivity.0.private_key")
 }
 
+func flattenDatastreamConnectionProfileForwardSshConnectivityPrivateKeyWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("forward_ssh_connectivity.0.private_key_wo_version")
+}
+
 func flattenDatastreamConnectionProfilePrivateConnectivity(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	if v == nil {
 		return nil
@@ -2016,7 +2077,7 @@ func expandDatastreamConnectionProfileOracleProfile(v interface{}, d tpgresource
 		transformed["username"] = transformedUsername
 	}
 
-	transformedPassword, err := expandDatastreamConnectionProfileOracleProfilePassword(original["password"], d, config)
+	transformedPassword, err := expandDatastreamConnectionProfileOracleProfilePassword(tpgresource.GetWriteOnlyValue(d, "oracle_profile.0.password", original["password"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedPassword); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -2152,7 +2213,7 @@ func expandDatastreamConnectionProfileMysqlProfile(v interface{}, d tpgresource.
 		transformed["username"] = transformedUsername
 	}
 
-	transformedPassword, err := expandDatastreamConnectionProfileMysqlProfilePassword(original["password"], d, config)
+	transformedPassword, err := expandDatastreamConnectionProfileMysqlProfilePassword(tpgresource.GetWriteOnlyValue(d, "mysql_profile.0.password", original["password"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedPassword); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -2328,7 +2389,7 @@ func expandDatastreamConnectionProfilePostgresqlProfile(v interface{}, d tpgreso
 		transformed["username"] = transformedUsername
 	}
 
-	transformedPassword, err := expandDatastreamConnectionProfilePostgresqlProfilePassword(original["password"], d, config)
+	transformedPassword, err := expandDatastreamConnectionProfilePostgresqlProfilePassword(tpgresource.GetWriteOnlyValue(d, "postgresql_profile.0.password", original["password"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedPassword); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -2519,7 +2580,7 @@ func expandDatastreamConnectionProfileSqlServerProfile(v interface{}, d tpgresou
 		transformed["username"] = transformedUsername
 	}
 
-	transformedPassword, err := expandDatastreamConnectionProfileSqlServerProfilePassword(original["password"], d, config)
+	transformedPassword, err := expandDatastreamConnectionProfileSqlServerProfilePassword(tpgresource.GetWriteOnlyValue(d, "sql_server_profile.0.password", original["password"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedPassword); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -2600,7 +2661,7 @@ func expandDatastreamConnectionProfileMongodbProfile(v interface{}, d tpgresourc
 		transformed["username"] = transformedUsername
 	}
 
-	transformedPassword, err := expandDatastreamConnectionProfileMongodbProfilePassword(original["password"], d, config)
+	transformedPassword, err := expandDatastreamConnectionProfileMongodbProfilePassword(tpgresource.GetWriteOnlyValue(d, "mongodb_profile.0.password", original["password"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedPassword); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -2886,14 +2947,14 @@ func expandDatastreamConnectionProfileForwardSshConnectivity(v interface{}, d tp
 		transformed["port"] = transformedPort
 	}
 
-	transformedPassword, err := expandDatastreamConnectionProfileForwardSshConnectivityPassword(original["password"], d, config)
+	transformedPassword, err := expandDatastreamConnectionProfileForwardSshConnectivityPassword(tpgresource.GetWriteOnlyValue(d, "forward_ssh_connectivity.0.password", original["password"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedPassword); val.IsValid() && !tpgresource.IsEmptyValue(val) {
 		transformed["password"] = transformedPassword
 	}
 
-	transformedPrivateKey, err := expandDatastreamConnectionProfileForwardSshConnectivityPrivateKey(original["private_key"], d, config)
+	transformedPrivateKey, err := expandDatastreamConnectionProfileForwardSshConnectivityPrivateKey(tpgresource.GetWriteOnlyValue(d, "forward_ssh_connectivity.0.private_key", original["private_key"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedPrivateKey); val.IsValid() && !tpgresource.IsEmptyValue(val) {
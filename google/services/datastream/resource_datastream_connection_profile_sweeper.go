@@ -194,7 +194,7 @@ func deleteResourceDatastreamConnectionProfile(config *transport_tpg.Config, d *
 	}
 
 	// Skip resources that shouldn't be sweeped
-	if !sweeper.IsSweepableTestResource(name) {
+	if !sweeper.ShouldSweep(name, obj) {
 		return nil
 	}
 
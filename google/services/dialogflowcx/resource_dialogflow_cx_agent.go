@@ -391,11 +391,14 @@ Format: projects/{Project ID}/locations/{Location ID}/collections/{Collection ID
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"access_token": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										Description: `The access token used to authenticate the access to the GitHub repository.`,
-										Sensitive:   true,
+										Type:          schema.TypeString,
+										Optional:      true,
+										Description:   `The access token used to authenticate the access to the GitHub repository.`,
+										Sensitive:     true,
+										ConflictsWith: []string{"git_integration_settings.0.github_settings.0.access_token_wo"},
 									},
+									"access_token_wo":         tpgresource.WriteOnlySchema("git_integration_settings.0.github_settings.0.access_token", `Write-only access token used to authenticate the access to the GitHub repository.`, false),
+									"access_token_wo_version": tpgresource.WriteOnlyVersionSchema("git_integration_settings.0.github_settings.0.access_token", false),
 									"branches": {
 										Type:        schema.TypeList,
 										Optional:    true,
@@ -1507,6 +1510,7 @@ func flattenDialogflowCXAgentGitIntegrationSettingsGithubSettings(v interface{},
 	transformed["repository_uri"] = original["repositoryUri"]
 	transformed["tracking_branch"] = original["trackingBranch"]
 	transformed["access_token"] = d.Get("git_integration_settings.0.github_settings.0.access_token")
+	transformed["access_token_wo_version"] = d.Get("git_integration_settings.0.github_settings.0.access_token_wo_version")
 	transformed["branches"] = original["branches"]
 	return []interface{}{transformed}
 }
@@ -1973,7 +1977,7 @@ func expandDialogflowCXAgentGitIntegrationSettingsGithubSettings(v interface{},
 		transformed["trackingBranch"] = transformedTrackingBranch
 	}
 
-	transformedAccessToken, err := expandDialogflowCXAgentGitIntegrationSettingsGithubSettingsAccessToken(original["access_token"], d, config)
+	transformedAccessToken, err := expandDialogflowCXAgentGitIntegrationSettingsGithubSettingsAccessToken(tpgresource.GetWriteOnlyValue(d, "git_integration_settings.0.github_settings.0.access_token", original["access_token"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedAccessToken); val.IsValid() && !tpgresource.IsEmptyValue(val) {
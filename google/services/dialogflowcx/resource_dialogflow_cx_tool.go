@@ -257,11 +257,14 @@ E.g., If the API request is "https://example.com/act?X-Api-Key=", "X-Api-Key" wo
 See [RequestLocation](https://cloud.google.com/dialogflow/cx/docs/reference/rest/v3/projects.locations.agents.tools#requestlocation) for valid values.`,
 												},
 												"api_key": {
-													Type:        schema.TypeString,
-													Optional:    true,
-													Description: `Optional. The API key. If the 'secretVersionForApiKey'' field is set, this field will be ignored.`,
-													Sensitive:   true,
+													Type:          schema.TypeString,
+													Optional:      true,
+													Description:   `Optional. The API key. If the 'secretVersionForApiKey'' field is set, this field will be ignored.`,
+													Sensitive:     true,
+													ConflictsWith: []string{"open_api_spec.0.authentication.0.api_key_config.0.api_key_wo"},
 												},
+												"api_key_wo":         tpgresource.WriteOnlySchema("open_api_spec.0.authentication.0.api_key_config.0.api_key", `Write-only API key. If the 'secretVersionForApiKey' field is set, this field will be ignored.`, false),
+												"api_key_wo_version": tpgresource.WriteOnlyVersionSchema("open_api_spec.0.authentication.0.api_key_config.0.api_key", false),
 												"secret_version_for_api_key": {
 													Type:     schema.TypeString,
 													Optional: true,
@@ -291,8 +294,11 @@ Format: projects/{project}/secrets/{secret}/versions/{version}`,
 													Optional: true,
 													Description: `Optional. The text token appended to the text Bearer to the request Authorization header.
 [Session parameters reference](https://cloud.google.com/dialogflow/cx/docs/concept/parameter#session-ref) can be used to pass the token dynamically, e.g. '$session.params.parameter-id'.`,
-													Sensitive: true,
+													Sensitive:     true,
+													ConflictsWith: []string{"open_api_spec.0.authentication.0.bearer_token_config.0.token_wo"},
 												},
+												"token_wo":         tpgresource.WriteOnlySchema("open_api_spec.0.authentication.0.bearer_token_config.0.token", `Write-only text token appended to the text Bearer to the request Authorization header.`, false),
+												"token_wo_version": tpgresource.WriteOnlyVersionSchema("open_api_spec.0.authentication.0.bearer_token_config.0.token", false),
 											},
 										},
 									},
@@ -321,11 +327,14 @@ See [OauthGrantType](https://cloud.google.com/dialogflow/cx/docs/reference/rest/
 													Description: `The token endpoint in the OAuth provider to exchange for an access token.`,
 												},
 												"client_secret": {
-													Type:        schema.TypeString,
-													Optional:    true,
-													Description: `Optional. The client secret from the OAuth provider. If the 'secretVersionForClientSecret' field is set, this field will be ignored.`,
-													Sensitive:   true,
+													Type:          schema.TypeString,
+													Optional:      true,
+													Description:   `Optional. The client secret from the OAuth provider. If the 'secretVersionForClientSecret' field is set, this field will be ignored.`,
+													Sensitive:     true,
+													ConflictsWith: []string{"open_api_spec.0.authentication.0.oauth_config.0.client_secret_wo"},
 												},
+												"client_secret_wo":         tpgresource.WriteOnlySchema("open_api_spec.0.authentication.0.oauth_config.0.client_secret", `Write-only client secret from the OAuth provider. If the 'secretVersionForClientSecret' field is set, this field will be ignored.`, false),
+												"client_secret_wo_version": tpgresource.WriteOnlyVersionSchema("open_api_spec.0.authentication.0.oauth_config.0.client_secret", false),
 												"scopes": {
 													Type:        schema.TypeList,
 													Optional:    true,
@@ -1018,6 +1027,8 @@ func flattenDialogflowCXToolOpenApiSpecAuthenticationApiKeyConfig(v interface{},
 		flattenDialogflowCXToolOpenApiSpecAuthenticationApiKeyConfigKeyName(original["keyName"], d, config)
 	transformed["api_key"] =
 		flattenDialogflowCXToolOpenApiSpecAuthenticationApiKeyConfigApiKey(original["apiKey"], d, config)
+	transformed["api_key_wo_version"] =
+		flattenDialogflowCXToolOpenApiSpecAuthenticationApiKeyConfigApiKeyWoVersion(original["apiKeyWoVersion"], d, config)
 	transformed["secret_version_for_api_key"] =
 		flattenDialogflowCXToolOpenApiSpecAuthenticationApiKeyConfigSecretVersionForApiKey(original["secretVersionForApiKey"], d, config)
 	transformed["request_location"] =
@@ -1032,6 +1043,10 @@ func flattenDialogflowCXToolOpenApiSpecAuthenticationApiKeyConfigApiKey(v interf
 	return d.Get("open_api_spec.0.authentication.0.api_key_config.0.api_key")
 }
 
+func flattenDialogflowCXToolOpenApiSpecAuthenticationApiKeyConfigApiKeyWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("open_api_spec.0.authentication.0.api_key_config.0.api_key_wo_version")
+}
+
 func flattenDialogflowCXToolOpenApiSpecAuthenticationApiKeyConfigSecretVersionForApiKey(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -1055,6 +1070,8 @@ func flattenDialogflowCXToolOpenApiSpecAuthenticationOauthConfig(v interface{},
 		flattenDialogflowCXToolOpenApiSpecAuthenticationOauthConfigClientId(original["clientId"], d, config)
 	transformed["client_secret"] =
 		flattenDialogflowCXToolOpenApiSpecAuthenticationOauthConfigClientSecret(original["clientSecret"], d, config)
+	transformed["client_secret_wo_version"] =
+		flattenDialogflowCXToolOpenApiSpecAuthenticationOauthConfigClientSecretWoVersion(original["clientSecretWoVersion"], d, config)
 	transformed["secret_version_for_client_secret"] =
 		flattenDialogflowCXToolOpenApiSpecAuthenticationOauthConfigSecretVersionForClientSecret(original["secretVersionForClientSecret"], d, config)
 	transformed["token_endpoint"] =
@@ -1075,6 +1092,10 @@ func flattenDialogflowCXToolOpenApiSpecAuthenticationOauthConfigClientSecret(v i
 	return d.Get("open_api_spec.0.authentication.0.oauth_config.0.client_secret")
 }
 
+func flattenDialogflowCXToolOpenApiSpecAuthenticationOauthConfigClientSecretWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("open_api_spec.0.authentication.0.oauth_config.0.client_secret_wo_version")
+}
+
 func flattenDialogflowCXToolOpenApiSpecAuthenticationOauthConfigSecretVersionForClientSecret(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -1115,6 +1136,8 @@ func flattenDialogflowCXToolOpenApiSpecAuthenticationBearerTokenConfig(v interfa
 	transformed := make(map[string]interface{})
 	transformed["token"] =
 		flattenDialogflowCXToolOpenApiSpecAuthenticationBearerTokenConfigToken(original["token"], d, config)
+	transformed["token_wo_version"] =
+		flattenDialogflowCXToolOpenApiSpecAuthenticationBearerTokenConfigTokenWoVersion(original["tokenWoVersion"], d, config)
 	transformed["secret_version_for_token"] =
 		flattenDialogflowCXToolOpenApiSpecAuthenticationBearerTokenConfigSecretVersionForToken(original["secretVersionForToken"], d, config)
 	return []interface{}{transformed}
@@ -1123,6 +1146,10 @@ func flattenDialogflowCXToolOpenApiSpecAuthenticationBearerTokenConfigToken(v in
 	return d.Get("open_api_spec.0.authentication.0.bearer_token_config.0.token")
 }
 
+func flattenDialogflowCXToolOpenApiSpecAuthenticationBearerTokenConfigTokenWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("open_api_spec.0.authentication.0.bearer_token_config.0.token_wo_version")
+}
+
 func flattenDialogflowCXToolOpenApiSpecAuthenticationBearerTokenConfigSecretVersionForToken(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -1397,7 +1424,7 @@ func expandDialogflowCXToolOpenApiSpecAuthenticationApiKeyConfig(v interface{},
 		transformed["keyName"] = transformedKeyName
 	}
 
-	transformedApiKey, err := expandDialogflowCXToolOpenApiSpecAuthenticationApiKeyConfigApiKey(original["api_key"], d, config)
+	transformedApiKey, err := expandDialogflowCXToolOpenApiSpecAuthenticationApiKeyConfigApiKey(tpgresource.GetWriteOnlyValue(d, "open_api_spec.0.authentication.0.api_key_config.0.api_key", original["api_key"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedApiKey); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -1463,7 +1490,7 @@ func expandDialogflowCXToolOpenApiSpecAuthenticationOauthConfig(v interface{}, d
 		transformed["clientId"] = transformedClientId
 	}
 
-	transformedClientSecret, err := expandDialogflowCXToolOpenApiSpecAuthenticationOauthConfigClientSecret(original["client_secret"], d, config)
+	transformedClientSecret, err := expandDialogflowCXToolOpenApiSpecAuthenticationOauthConfigClientSecret(tpgresource.GetWriteOnlyValue(d, "open_api_spec.0.authentication.0.oauth_config.0.client_secret", original["client_secret"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedClientSecret); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -1556,7 +1583,7 @@ func expandDialogflowCXToolOpenApiSpecAuthenticationBearerTokenConfig(v interfac
 	original := raw.(map[string]interface{})
 	transformed := make(map[string]interface{})
 
-	transformedToken, err := expandDialogflowCXToolOpenApiSpecAuthenticationBearerTokenConfigToken(original["token"], d, config)
+	transformedToken, err := expandDialogflowCXToolOpenApiSpecAuthenticationBearerTokenConfigToken(tpgresource.GetWriteOnlyValue(d, "open_api_spec.0.authentication.0.bearer_token_config.0.token", original["token"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedToken); val.IsValid() && !tpgresource.IsEmptyValue(val) {
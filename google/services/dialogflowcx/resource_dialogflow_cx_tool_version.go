@@ -294,12 +294,15 @@ E.g., If the API request is "https://example.com/act?X-Api-Key=", "X-Api-Key" wo
 See [RequestLocation](https://cloud.google.com/dialogflow/cx/docs/reference/rest/v3/projects.locations.agents.tools#requestlocation) for valid values.`,
 															},
 															"api_key": {
-																Type:        schema.TypeString,
-																Optional:    true,
-																ForceNew:    true,
-																Description: `Optional. The API key. If the 'secretVersionForApiKey'' field is set, this field will be ignored.`,
-																Sensitive:   true,
+																Type:          schema.TypeString,
+																Optional:      true,
+																ForceNew:      true,
+																Description:   `Optional. The API key. If the 'secretVersionForApiKey'' field is set, this field will be ignored.`,
+																Sensitive:     true,
+																ConflictsWith: []string{"tool.0.open_api_spec.0.authentication.0.api_key_config.0.api_key_wo"},
 															},
+															"api_key_wo":         tpgresource.WriteOnlySchema("tool.0.open_api_spec.0.authentication.0.api_key_config.0.api_key", `Write-only API key. If the 'secretVersionForApiKey' field is set, this field will be ignored.`, false),
+															"api_key_wo_version": tpgresource.WriteOnlyVersionSchema("tool.0.open_api_spec.0.authentication.0.api_key_config.0.api_key", true),
 															"secret_version_for_api_key": {
 																Type:     schema.TypeString,
 																Optional: true,
@@ -333,8 +336,11 @@ Format: projects/{project}/secrets/{secret}/versions/{version}`,
 																ForceNew: true,
 																Description: `Optional. The text token appended to the text Bearer to the request Authorization header.
 [Session parameters reference](https://cloud.google.com/dialogflow/cx/docs/concept/parameter#session-ref) can be used to pass the token dynamically, e.g. '$session.params.parameter-id'.`,
-																Sensitive: true,
+																Sensitive:     true,
+																ConflictsWith: []string{"tool.0.open_api_spec.0.authentication.0.bearer_token_config.0.token_wo"},
 															},
+															"token_wo":         tpgresource.WriteOnlySchema("tool.0.open_api_spec.0.authentication.0.bearer_token_config.0.token", `Write-only text token appended to the text Bearer to the request Authorization header.`, false),
+															"token_wo_version": tpgresource.WriteOnlyVersionSchema("tool.0.open_api_spec.0.authentication.0.bearer_token_config.0.token", true),
 														},
 													},
 												},
@@ -367,12 +373,15 @@ See [OauthGrantType](https://cloud.google.com/dialogflow/cx/docs/reference/rest/
 																Description: `The token endpoint in the OAuth provider to exchange for an access token.`,
 															},
 															"client_secret": {
-																Type:        schema.TypeString,
-																Optional:    true,
-																ForceNew:    true,
-																Description: `Optional. The client secret from the OAuth provider. If the 'secretVersionForClientSecret' field is set, this field will be ignored.`,
-																Sensitive:   true,
+																Type:          schema.TypeString,
+																Optional:      true,
+																ForceNew:      true,
+																Description:   `Optional. The client secret from the OAuth provider. If the 'secretVersionForClientSecret' field is set, this field will be ignored.`,
+																Sensitive:     true,
+																ConflictsWith: []string{"tool.0.open_api_spec.0.authentication.0.oauth_config.0.client_secret_wo"},
 															},
+															"client_secret_wo":         tpgresource.WriteOnlySchema("tool.0.open_api_spec.0.authentication.0.oauth_config.0.client_secret", `Write-only client secret from the OAuth provider. If the 'secretVersionForClientSecret' field is set, this field will be ignored.`, false),
+															"client_secret_wo_version": tpgresource.WriteOnlyVersionSchema("tool.0.open_api_spec.0.authentication.0.oauth_config.0.client_secret", true),
 															"scopes": {
 																Type:        schema.TypeList,
 																Optional:    true,
@@ -937,6 +946,8 @@ func flattenDialogflowCXToolVersionToolOpenApiSpecAuthenticationApiKeyConfig(v i
 		flattenDialogflowCXToolVersionToolOpenApiSpecAuthenticationApiKeyConfigKeyName(original["keyName"], d, config)
 	transformed["api_key"] =
 		flattenDialogflowCXToolVersionToolOpenApiSpecAuthenticationApiKeyConfigApiKey(original["apiKey"], d, config)
+	transformed["api_key_wo_version"] =
+		flattenDialogflowCXToolVersionToolOpenApiSpecAuthenticationApiKeyConfigApiKeyWoVersion(original["apiKeyWoVersion"], d, config)
 	transformed["secret_version_for_api_key"] =
 		flattenDialogflowCXToolVersionToolOpenApiSpecAuthenticationApiKeyConfigSecretVersionForApiKey(original["secretVersionForApiKey"], d, config)
 	transformed["request_location"] =
@@ -951,6 +962,10 @@ func flattenDialogflowCXToolVersionToolOpenApiSpecAuthenticationApiKeyConfigApiK
 	return d.Get("tool.0.open_api_spec.0.authentication.0.api_key_config.0.api_key")
 }
 
+func flattenDialogflowCXToolVersionToolOpenApiSpecAuthenticationApiKeyConfigApiKeyWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("tool.0.open_api_spec.0.authentication.0.api_key_config.0.api_key_wo_version")
+}
+
 func flattenDialogflowCXToolVersionToolOpenApiSpecAuthenticationApiKeyConfigSecretVersionForApiKey(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -974,6 +989,8 @@ func flattenDialogflowCXToolVersionToolOpenApiSpecAuthenticationOauthConfig(v in
 		flattenDialogflowCXToolVersionToolOpenApiSpecAuthenticationOauthConfigClientId(original["clientId"], d, config)
 	transformed["client_secret"] =
 		flattenDialogflowCXToolVersionToolOpenApiSpecAuthenticationOauthConfigClientSecret(original["clientSecret"], d, config)
+	transformed["client_secret_wo_version"] =
+		flattenDialogflowCXToolVersionToolOpenApiSpecAuthenticationOauthConfigClientSecretWoVersion(original["clientSecretWoVersion"], d, config)
 	transformed["secret_version_for_client_secret"] =
 		flattenDialogflowCXToolVersionToolOpenApiSpecAuthenticationOauthConfigSecretVersionForClientSecret(original["secretVersionForClientSecret"], d, config)
 	transformed["token_endpoint"] =
@@ -994,6 +1011,10 @@ func flattenDialogflowCXToolVersionToolOpenApiSpecAuthenticationOauthConfigClien
 	return d.Get("tool.0.open_api_spec.0.authentication.0.oauth_config.0.client_secret")
 }
 
+func flattenDialogflowCXToolVersionToolOpenApiSpecAuthenticationOauthConfigClientSecretWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("tool.0.open_api_spec.0.authentication.0.oauth_config.0.client_secret_wo_version")
+}
+
 func flattenDialogflowCXToolVersionToolOpenApiSpecAuthenticationOauthConfigSecretVersionForClientSecret(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -1034,6 +1055,8 @@ func flattenDialogflowCXToolVersionToolOpenApiSpecAuthenticationBearerTokenConfi
 	transformed := make(map[string]interface{})
 	transformed["token"] =
 		flattenDialogflowCXToolVersionToolOpenApiSpecAuthenticationBearerTokenConfigToken(original["token"], d, config)
+	transformed["token_wo_version"] =
+		flattenDialogflowCXToolVersionToolOpenApiSpecAuthenticationBearerTokenConfigTokenWoVersion(original["tokenWoVersion"], d, config)
 	transformed["secret_version_for_token"] =
 		flattenDialogflowCXToolVersionToolOpenApiSpecAuthenticationBearerTokenConfigSecretVersionForToken(original["secretVersionForToken"], d, config)
 	return []interface{}{transformed}
@@ -1042,6 +1065,10 @@ func flattenDialogflowCXToolVersionToolOpenApiSpecAuthenticationBearerTokenConfi
 	return d.Get("tool.0.open_api_spec.0.authentication.0.bearer_token_config.0.token")
 }
 
+func flattenDialogflowCXToolVersionToolOpenApiSpecAuthenticationBearerTokenConfigTokenWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("tool.0.open_api_spec.0.authentication.0.bearer_token_config.0.token_wo_version")
+}
+
 func flattenDialogflowCXToolVersionToolOpenApiSpecAuthenticationBearerTokenConfigSecretVersionForToken(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -1400,7 +1427,7 @@ func expandDialogflowCXToolVersionToolOpenApiSpecAuthenticationApiKeyConfig(v in
 		transformed["keyName"] = transformedKeyName
 	}
 
-	transformedApiKey, err := expandDialogflowCXToolVersionToolOpenApiSpecAuthenticationApiKeyConfigApiKey(original["api_key"], d, config)
+	transformedApiKey, err := expandDialogflowCXToolVersionToolOpenApiSpecAuthenticationApiKeyConfigApiKey(tpgresource.GetWriteOnlyValue(d, "tool.0.open_api_spec.0.authentication.0.api_key_config.0.api_key", original["api_key"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedApiKey); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -1466,7 +1493,7 @@ func expandDialogflowCXToolVersionToolOpenApiSpecAuthenticationOauthConfig(v int
 		transformed["clientId"] = transformedClientId
 	}
 
-	transformedClientSecret, err := expandDialogflowCXToolVersionToolOpenApiSpecAuthenticationOauthConfigClientSecret(original["client_secret"], d, config)
+	transformedClientSecret, err := expandDialogflowCXToolVersionToolOpenApiSpecAuthenticationOauthConfigClientSecret(tpgresource.GetWriteOnlyValue(d, "tool.0.open_api_spec.0.authentication.0.oauth_config.0.client_secret", original["client_secret"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedClientSecret); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -1559,7 +1586,7 @@ func expandDialogflowCXToolVersionToolOpenApiSpecAuthenticationBearerTokenConfig
 	original := raw.(map[string]interface{})
 	transformed := make(map[string]interface{})
 
-	transformedToken, err := expandDialogflowCXToolVersionToolOpenApiSpecAuthenticationBearerTokenConfigToken(original["token"], d, config)
+	transformedToken, err := expandDialogflowCXToolVersionToolOpenApiSpecAuthenticationBearerTokenConfigToken(tpgresource.GetWriteOnlyValue(d, "tool.0.open_api_spec.0.authentication.0.bearer_token_config.0.token", original["token"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedToken); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -196,7 +196,7 @@ func deleteResourceDiscoveryEngineChatEngine(config *transport_tpg.Config, d *tp
 	}
 
 	// Skip resources that shouldn't be sweeped
-	if !sweeper.IsSweepableTestResource(name) {
+	if !sweeper.ShouldSweep(name, obj) {
 		return nil
 	}
 
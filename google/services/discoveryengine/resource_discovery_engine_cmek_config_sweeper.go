@@ -192,7 +192,7 @@ func deleteResourceDiscoveryEngineCmekConfig(config *transport_tpg.Config, d *tp
 	}
 
 	// Skip resources that shouldn't be sweeped
-	if !sweeper.IsSweepableTestResource(name) {
+	if !sweeper.ShouldSweep(name, obj) {
 		return nil
 	}
 
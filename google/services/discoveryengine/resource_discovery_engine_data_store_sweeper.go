@@ -198,7 +198,7 @@ func deleteResourceDiscoveryEngineDataStore(config *transport_tpg.Config, d *tpg
 	}
 
 	// Skip resources that shouldn't be sweeped
-	if !sweeper.IsSweepableTestResource(name) {
+	if !sweeper.ShouldSweep(name, obj) {
 		return nil
 	}
 
@@ -29,6 +29,8 @@ type DnsChangeWaiter struct {
 	Change      *dns.Change
 	Project     string
 	ManagedZone string
+	// Timeout defaults to 10 minutes when unset
+	Timeout time.Duration
 }
 
 func (w *DnsChangeWaiter) RefreshFunc() retry.StateRefreshFunc {
@@ -48,11 +50,15 @@ func (w *DnsChangeWaiter) RefreshFunc() retry.StateRefreshFunc {
 }
 
 func (w *DnsChangeWaiter) Conf() *retry.StateChangeConf {
+	timeout := w.Timeout
+	if timeout == 0 {
+		timeout = 10 * time.Minute
+	}
 	return &retry.StateChangeConf{
 		Pending:    []string{"pending"},
 		Target:     []string{"done"},
 		Refresh:    w.RefreshFunc(),
-		Timeout:    10 * time.Minute,
+		Timeout:    timeout,
 		MinTimeout: 2 * time.Second,
 	}
 }
@@ -0,0 +1,138 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/services/dns/dns_change_batching.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package dns
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+	"google.golang.org/api/dns/v1"
+)
+
+const batchKeyTmplDnsChanges = "projects/%s/managedZones/%s/changes"
+
+// dnsRecordSetsLockName returns the name of the lock on the record sets of a managed zone.
+// google_dns_managed_zone_records holds it while it replaces the record sets of the zone,
+// and changes to single record sets hold it for reading.
+func dnsRecordSetsLockName(project, zone string) string {
+	return fmt.Sprintf("projects/%s/managedZones/%s/rrsets", project, zone)
+}
+
+// BatchRequestDnsChange submits the additions and deletions of chg as part of a single
+// change per managed zone, combined with the changes of other record sets applied at
+// the same time when DNS batching is enabled, and waits for the change to be done.
+func BatchRequestDnsChange(chg *dns.Change, project, zone, userAgent string, config *transport_tpg.Config, timeout time.Duration) (*dns.Change, error) {
+	lockName := dnsRecordSetsLockName(project, zone)
+	transport_tpg.MutexStore.RLock(lockName)
+	defer transport_tpg.MutexStore.RUnlock(lockName)
+
+	req := &transport_tpg.BatchRequest{
+		ResourceName: fmt.Sprintf("projects/%s/managedZones/%s", project, zone),
+		Body: &dns.Change{
+			Additions: chg.Additions,
+			Deletions: chg.Deletions,
+		},
+		CombineF: combineDnsChangeBatches,
+		SendF:    sendBatchFuncDnsChange(config, userAgent, project, zone, timeout),
+		DebugId:  fmt.Sprintf("DNS change %s for managed zone %q in project %q", dnsChangeDebugId(chg), zone, project),
+	}
+
+	resp, err := config.RequestBatcherDns.SendRequestWithTimeout(
+		fmt.Sprintf(batchKeyTmplDnsChanges, project, zone),
+		req,
+		timeout)
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*dns.Change), nil
+}
+
+func combineDnsChangeBatches(bodyRaw interface{}, toAddRaw interface{}) (interface{}, error) {
+	body, ok := bodyRaw.(*dns.Change)
+	if !ok {
+		return nil, fmt.Errorf("Expected batch body type to be *dns.Change, got %v. This is a provider error.", bodyRaw)
+	}
+	toAdd, ok := toAddRaw.(*dns.Change)
+	if !ok {
+		return nil, fmt.Errorf("Expected new request body type to be *dns.Change, got %v. This is a provider error.", toAddRaw)
+	}
+
+	// A change can only touch a record set once, so a change to a record set
+	// already in the batch is deferred to the next batch.
+	touched := dnsChangeRecordSetKeys(body)
+	for key := range dnsChangeRecordSetKeys(toAdd) {
+		if touched[key] {
+			return nil, fmt.Errorf("record set %s is already changed in this batch: %w", key, transport_tpg.ErrBatchRequestNotCombinable)
+		}
+	}
+
+	return &dns.Change{
+		Additions: append(append([]*dns.ResourceRecordSet{}, body.Additions...), toAdd.Additions...),
+		Deletions: append(append([]*dns.ResourceRecordSet{}, body.Deletions...), toAdd.Deletions...),
+	}, nil
+}
+
+func sendBatchFuncDnsChange(config *transport_tpg.Config, userAgent, project, zone string, timeout time.Duration) transport_tpg.BatcherSendFunc {
+	return func(_ string, chgRaw interface{}) (interface{}, error) {
+		chg, ok := chgRaw.(*dns.Change)
+		if !ok {
+			return nil, fmt.Errorf("Expected batch body type to be *dns.Change, got %v. This is a provider error.", chgRaw)
+		}
+
+		log.Printf("[DEBUG] DNS change request for managed zone %q: %#v", zone, chg)
+		chg, err := NewClient(config, userAgent).Changes.Create(project, zone, chg).Do()
+		if err != nil {
+			return nil, err
+		}
+
+		w := &DnsChangeWaiter{
+			Service:     NewClient(config, userAgent),
+			Change:      chg,
+			Project:     project,
+			ManagedZone: zone,
+			Timeout:     timeout,
+		}
+		if _, err := w.Conf().WaitForState(); err != nil {
+			return nil, fmt.Errorf("Error waiting for Google DNS change: %s", err)
+		}
+		return chg, nil
+	}
+}
+
+// dnsChangeRecordSetKeys returns the name/type keys of the record sets touched by a change
+func dnsChangeRecordSetKeys(chg *dns.Change) map[string]bool {
+	keys := make(map[string]bool)
+	for _, rset := range chg.Additions {
+		keys[rset.Name+"/"+rset.Type] = true
+	}
+	for _, rset := range chg.Deletions {
+		keys[rset.Name+"/"+rset.Type] = true
+	}
+	return keys
+}
+
+func dnsChangeDebugId(chg *dns.Change) string {
+	for _, rset := range chg.Additions {
+		return rset.Name + "/" + rset.Type
+	}
+	for _, rset := range chg.Deletions {
+		return rset.Name + "/" + rset.Type
+	}
+	return ""
+}
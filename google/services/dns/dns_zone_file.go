@@ -0,0 +1,278 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/services/dns/dns_zone_file.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package dns
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/api/dns/v1"
+)
+
+// defaultZoneFileTtl is used for records before the first $TTL directive or explicit TTL
+const defaultZoneFileTtl = 300
+
+// errZoneFileNeedsOrigin is returned when a zone file uses relative names without an origin
+var errZoneFileNeedsOrigin = fmt.Errorf("zone file uses relative names but has no $ORIGIN")
+
+// rdata fields holding domain names, by record type, that are qualified with the origin
+// when they are relative. Other record types are passed through unchanged.
+var zoneFileRdataNameFields = map[string][]int{
+	"CNAME": {0},
+	"DNAME": {0},
+	"NS":    {0},
+	"PTR":   {0},
+	"MX":    {1},
+	"SRV":   {3},
+}
+
+// ParseZoneFile parses the records of an RFC 1035 master file into record sets.
+// origin is the zone's DNS name and may be empty when the file declares $ORIGIN or only
+// uses fully qualified names. Record sets are returned in the order they first appear.
+func ParseZoneFile(zoneFile, origin string) ([]*dns.ResourceRecordSet, error) {
+	lines, err := zoneFileLines(zoneFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var rrsets []*dns.ResourceRecordSet
+	byKey := make(map[string]*dns.ResourceRecordSet)
+	ttl := int64(defaultZoneFileTtl)
+	owner := ""
+
+	for _, line := range lines {
+		tokens := line.tokens
+		if len(tokens) == 0 {
+			continue
+		}
+
+		switch strings.ToUpper(tokens[0]) {
+		case "$ORIGIN":
+			if len(tokens) != 2 {
+				return nil, fmt.Errorf("line %d: $ORIGIN takes a single domain name", line.number)
+			}
+			o, err := qualifyZoneFileName(tokens[1], origin)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", line.number, err)
+			}
+			origin = o
+			continue
+		case "$TTL":
+			if len(tokens) != 2 {
+				return nil, fmt.Errorf("line %d: $TTL takes a single value", line.number)
+			}
+			v, err := parseZoneFileTtl(tokens[1])
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", line.number, err)
+			}
+			ttl = v
+			continue
+		case "$INCLUDE", "$GENERATE":
+			return nil, fmt.Errorf("line %d: %s is not supported", line.number, tokens[0])
+		}
+
+		// A line starting with whitespace belongs to the previous owner
+		if !line.continuesOwner {
+			name, err := qualifyZoneFileName(tokens[0], origin)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", line.number, err)
+			}
+			owner = name
+			tokens = tokens[1:]
+		}
+		if owner == "" {
+			return nil, fmt.Errorf("line %d: record has no owner name", line.number)
+		}
+
+		// TTL and class can appear in either order before the type
+		recordTtl, explicitTtl := ttl, false
+		for len(tokens) > 0 {
+			if v, err := parseZoneFileTtl(tokens[0]); err == nil {
+				recordTtl, explicitTtl = v, true
+				tokens = tokens[1:]
+				continue
+			}
+			if strings.EqualFold(tokens[0], "IN") {
+				tokens = tokens[1:]
+				continue
+			}
+			break
+		}
+		if len(tokens) < 2 {
+			return nil, fmt.Errorf("line %d: record is missing a type or data", line.number)
+		}
+
+		rType := strings.ToUpper(tokens[0])
+		rdata := tokens[1:]
+		for _, i := range zoneFileRdataNameFields[rType] {
+			if i < len(rdata) {
+				name, err := qualifyZoneFileName(rdata[i], origin)
+				if err != nil {
+					return nil, fmt.Errorf("line %d: %w", line.number, err)
+				}
+				rdata[i] = name
+			}
+		}
+
+		key := owner + "/" + rType
+		rset, ok := byKey[key]
+		if !ok {
+			rset = &dns.ResourceRecordSet{
+				Name: owner,
+				Type: rType,
+				Ttl:  recordTtl,
+			}
+			byKey[key] = rset
+			rrsets = append(rrsets, rset)
+		} else if !explicitTtl {
+			// Records without a TTL join the record set of their owner
+			recordTtl = rset.Ttl
+		} else if rset.Ttl != recordTtl {
+			return nil, fmt.Errorf("line %d: records of %s %s have different TTLs", line.number, owner, rType)
+		}
+		rset.Rrdatas = append(rset.Rrdatas, strings.Join(rdata, " "))
+	}
+
+	return rrsets, nil
+}
+
+type zoneFileLine struct {
+	number         int
+	continuesOwner bool
+	tokens         []string
+}
+
+// zoneFileLines splits a zone file into logical lines, removing comments and joining
+// lines grouped with parentheses. Quoted strings are kept as single tokens.
+func zoneFileLines(zoneFile string) ([]zoneFileLine, error) {
+	var lines []zoneFileLine
+	var current *zoneFileLine
+	depth := 0
+
+	for i, raw := range strings.Split(zoneFile, "\n") {
+		raw = strings.TrimRight(raw, "\r")
+		if current == nil {
+			current = &zoneFileLine{
+				number:         i + 1,
+				continuesOwner: raw != "" && (raw[0] == ' ' || raw[0] == '\t'),
+			}
+		}
+
+		var token strings.Builder
+		inToken, quoted := false, false
+		flush := func() {
+			if inToken {
+				current.tokens = append(current.tokens, token.String())
+				token.Reset()
+				inToken = false
+			}
+		}
+	chars:
+		for j := 0; j < len(raw); j++ {
+			c := raw[j]
+			switch {
+			case quoted:
+				token.WriteByte(c)
+				if c == '\\' && j+1 < len(raw) {
+					j++
+					token.WriteByte(raw[j])
+				} else if c == '"' {
+					quoted = false
+				}
+			case c == '"':
+				inToken, quoted = true, true
+				token.WriteByte(c)
+			case c == ';':
+				break chars
+			case c == '(':
+				flush()
+				depth++
+			case c == ')':
+				flush()
+				if depth == 0 {
+					return nil, fmt.Errorf("line %d: unbalanced parentheses", i+1)
+				}
+				depth--
+			case c == ' ' || c == '\t':
+				flush()
+			default:
+				inToken = true
+				token.WriteByte(c)
+			}
+		}
+		if quoted {
+			return nil, fmt.Errorf("line %d: unterminated quoted string", i+1)
+		}
+		flush()
+
+		if depth == 0 {
+			lines = append(lines, *current)
+			current = nil
+		}
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("unbalanced parentheses at end of zone file")
+	}
+	return lines, nil
+}
+
+func qualifyZoneFileName(name, origin string) (string, error) {
+	if name == "@" {
+		if origin == "" {
+			return "", errZoneFileNeedsOrigin
+		}
+		return origin, nil
+	}
+	if strings.HasSuffix(name, ".") {
+		return name, nil
+	}
+	if origin == "" {
+		return "", errZoneFileNeedsOrigin
+	}
+	return name + "." + origin, nil
+}
+
+// parseZoneFileTtl parses a TTL in seconds, optionally using the BIND unit suffixes s, m, h, d and w
+func parseZoneFileTtl(v string) (int64, error) {
+	if v == "" || v[0] < '0' || v[0] > '9' {
+		return 0, fmt.Errorf("invalid TTL %q", v)
+	}
+	units := map[byte]int64{'s': 1, 'm': 60, 'h': 3600, 'd': 86400, 'w': 604800}
+	var total, n int64
+	digits := false
+	for i := 0; i < len(v); i++ {
+		c := v[i]
+		if c >= '0' && c <= '9' {
+			n = n*10 + int64(c-'0')
+			digits = true
+			continue
+		}
+		unit, ok := units[c|0x20]
+		if !ok || !digits {
+			return 0, fmt.Errorf("invalid TTL %q", v)
+		}
+		total += n * unit
+		n, digits = 0, false
+	}
+	if digits {
+		if total != 0 {
+			return 0, fmt.Errorf("invalid TTL %q", v)
+		}
+		total = n
+	}
+	return total, nil
+}
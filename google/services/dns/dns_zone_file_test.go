@@ -0,0 +1,107 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/services/dns/dns_zone_file_test.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package dns
+
+import (
+	"reflect"
+	"testing"
+
+	"google.golang.org/api/dns/v1"
+)
+
+func TestParseZoneFile(t *testing.T) {
+	zoneFile := `
+$TTL 1h
+@       IN SOA ns1.example.com. admin.example.com. (
+            2024010101 ; serial
+            7200 3600 1209600 3600 )
+@          NS    ns1.example.com.
+www     60 IN A     192.0.2.1
+           IN A     192.0.2.2 ; same owner
+mail       MX    10 mx
+txt        TXT   "v=spf1 include:example.net ~all" "second; string"
+$ORIGIN sub.example.com.
+api        CNAME www.example.com.
+`
+
+	got, err := ParseZoneFile(zoneFile, "example.com.")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	want := []*dns.ResourceRecordSet{
+		{Name: "example.com.", Type: "SOA", Ttl: 3600, Rrdatas: []string{"ns1.example.com. admin.example.com. 2024010101 7200 3600 1209600 3600"}},
+		{Name: "example.com.", Type: "NS", Ttl: 3600, Rrdatas: []string{"ns1.example.com."}},
+		{Name: "www.example.com.", Type: "A", Ttl: 60, Rrdatas: []string{"192.0.2.1", "192.0.2.2"}},
+		{Name: "mail.example.com.", Type: "MX", Ttl: 3600, Rrdatas: []string{"10 mx.example.com."}},
+		{Name: "txt.example.com.", Type: "TXT", Ttl: 3600, Rrdatas: []string{`"v=spf1 include:example.net ~all" "second; string"`}},
+		{Name: "api.sub.example.com.", Type: "CNAME", Ttl: 3600, Rrdatas: []string{"www.example.com."}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected record sets:\ngot  %v\nwant %v", got, want)
+	}
+
+	apex := withoutZoneApexRecords(got, "example.com.")
+	if len(apex) != 4 || apex[0].Name != "www.example.com." {
+		t.Errorf("expected SOA and apex NS records to be dropped, got %v", apex)
+	}
+}
+
+func TestParseZoneFile_errors(t *testing.T) {
+	cases := map[string]struct {
+		zoneFile string
+		origin   string
+	}{
+		"relative name without origin":   {zoneFile: "www A 192.0.2.1", origin: ""},
+		"different ttls in a record set": {zoneFile: "www 60 A 192.0.2.1\nwww 120 A 192.0.2.2", origin: "example.com."},
+		"unbalanced parentheses":         {zoneFile: "www A ( 192.0.2.1", origin: "example.com."},
+		"unterminated string":            {zoneFile: `www TXT "abc`, origin: "example.com."},
+		"unsupported directive":          {zoneFile: "$INCLUDE other.zone", origin: "example.com."},
+		"missing data":                   {zoneFile: "www 60 IN A", origin: "example.com."},
+	}
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			if _, err := ParseZoneFile(tc.zoneFile, tc.origin); err == nil {
+				t.Errorf("expected an error")
+			}
+		})
+	}
+}
+
+func TestDiffDnsManagedZoneRecords(t *testing.T) {
+	live := []*dns.ResourceRecordSet{
+		{Name: "same.example.com.", Type: "A", Ttl: 300, Rrdatas: []string{"192.0.2.2", "192.0.2.1"}},
+		{Name: "changed.example.com.", Type: "A", Ttl: 300, Rrdatas: []string{"192.0.2.1"}},
+		{Name: "removed.example.com.", Type: "A", Ttl: 300, Rrdatas: []string{"192.0.2.1"}},
+		{Name: "routed.example.com.", Type: "A", Ttl: 300, RoutingPolicy: &dns.RRSetRoutingPolicy{}},
+		{Name: "replaced.example.com.", Type: "A", Ttl: 300, RoutingPolicy: &dns.RRSetRoutingPolicy{}},
+	}
+	desired := []*dns.ResourceRecordSet{
+		{Name: "same.example.com.", Type: "A", Ttl: 300, Rrdatas: []string{"192.0.2.1", "192.0.2.2"}},
+		{Name: "changed.example.com.", Type: "A", Ttl: 60, Rrdatas: []string{"192.0.2.1"}},
+		{Name: "added.example.com.", Type: "A", Ttl: 300, Rrdatas: []string{"192.0.2.1"}},
+		{Name: "replaced.example.com.", Type: "A", Ttl: 300, Rrdatas: []string{"192.0.2.1"}},
+	}
+
+	chg := diffDnsManagedZoneRecords(live, desired)
+	want := &dns.Change{
+		Additions: []*dns.ResourceRecordSet{desired[1], desired[2], desired[3]},
+		Deletions: []*dns.ResourceRecordSet{live[1], live[4], live[2]},
+	}
+	if !reflect.DeepEqual(chg, want) {
+		t.Errorf("unexpected change:\ngot  %v\nwant %v", chg, want)
+	}
+}
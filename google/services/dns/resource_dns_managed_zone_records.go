@@ -0,0 +1,480 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/services/dns/resource_dns_managed_zone_records.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package dns
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-google/google/registry"
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+	"google.golang.org/api/dns/v1"
+)
+
+func ResourceDnsManagedZoneRecords() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDnsManagedZoneRecordsCreate,
+		Read:   resourceDnsManagedZoneRecordsRead,
+		Update: resourceDnsManagedZoneRecordsUpdate,
+		Delete: resourceDnsManagedZoneRecordsDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDnsManagedZoneRecordsImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		CustomizeDiff: customdiff.All(
+			tpgresource.DefaultProviderDeletionPolicy("DELETE"),
+			tpgresource.DefaultProviderProject,
+			resourceDnsManagedZoneRecordsZoneFileDiff,
+		),
+
+		Schema: map[string]*schema.Schema{
+			"managed_zone": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: tpgresource.CompareSelfLinkOrResourceName,
+				Description:      `The name of the zone in which the records are managed.`,
+			},
+
+			"zone_file": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"zone_file", "record"},
+				Description: `The records of the zone in RFC 1035 master file format. Relative names are
+qualified with the zone's DNS name unless the file declares $ORIGIN. SOA and NS records
+at the zone apex are ignored.`,
+			},
+
+			"record": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"zone_file", "record"},
+				Description: `The record sets of the zone. When zone_file is set this holds the record
+sets parsed from it.`,
+				Elem: dnsManagedZoneRecordsRecordSchema(),
+			},
+
+			"project": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: `The ID of the project in which the resource belongs. If it is not provided, the provider project is used.`,
+			},
+			"deletion_policy": tpgresource.DeletionPolicySchemaEntry("DELETE"),
+		},
+	}
+}
+
+func dnsManagedZoneRecordsRecordSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateRecordNameTrailingDot,
+				Description:  `The DNS name of the record set, including the trailing dot.`,
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `The DNS record set type.`,
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     defaultZoneFileTtl,
+				Description: `The time-to-live of the record set in seconds.`,
+			},
+			"rrdatas": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: `The resource records of the record set.`,
+			},
+		},
+	}
+}
+
+// resourceDnsManagedZoneRecordsZoneFileDiff plans the record sets parsed from zone_file,
+// so that the plan shows each record set being changed.
+func resourceDnsManagedZoneRecordsZoneFileDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	zoneFile, ok := diff.GetOk("zone_file")
+	if !ok || !diff.NewValueKnown("zone_file") {
+		return nil
+	}
+
+	// Relative names and apex records depend on the zone's DNS name, which is
+	// only known once the zone exists.
+	if !diff.NewValueKnown("managed_zone") || !diff.NewValueKnown("project") {
+		return diff.SetNewComputed("record")
+	}
+	config := meta.(*transport_tpg.Config)
+	zone := tpgresource.GetResourceNameFromSelfLink(diff.Get("managed_zone").(string))
+	mz, err := NewClient(config, config.UserAgent).ManagedZones.Get(diff.Get("project").(string), zone).Do()
+	if err != nil {
+		if transport_tpg.IsGoogleApiErrorWithCode(err, 404) {
+			if _, err := ParseZoneFile(zoneFile.(string), "."); err != nil {
+				return fmt.Errorf("Error parsing zone_file: %s", err)
+			}
+			return diff.SetNewComputed("record")
+		}
+		return fmt.Errorf("Error retrieving managed zone %q: %s", zone, err)
+	}
+
+	rrsets, err := ParseZoneFile(zoneFile.(string), mz.DnsName)
+	if err != nil {
+		return fmt.Errorf("Error parsing zone_file: %s", err)
+	}
+	prior := expandDnsManagedZoneRecords(diff.Get("record").(*schema.Set).List())
+	return diff.SetNew("record", flattenDnsManagedZoneRecords(withoutZoneApexRecords(rrsets, mz.DnsName), prior))
+}
+
+func resourceDnsManagedZoneRecordsCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+
+	project, err := tpgresource.GetProject(d, config)
+	if err != nil {
+		return err
+	}
+	zone := tpgresource.GetResourceNameFromSelfLink(d.Get("managed_zone").(string))
+
+	d.SetId(fmt.Sprintf("projects/%s/managedZones/%s", project, zone))
+
+	if err := applyDnsManagedZoneRecords(d, config, d.Timeout(schema.TimeoutCreate)); err != nil {
+		d.SetId("")
+		return err
+	}
+
+	return resourceDnsManagedZoneRecordsRead(d, meta)
+}
+
+func resourceDnsManagedZoneRecordsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := tpgresource.GetProject(d, config)
+	if err != nil {
+		return err
+	}
+	zone := tpgresource.GetResourceNameFromSelfLink(d.Get("managed_zone").(string))
+
+	live, err := listDnsManagedZoneRecords(config, userAgent, project, zone)
+	if err != nil {
+		return transport_tpg.HandleNotFoundError(err, d, fmt.Sprintf("DNS records of managed zone %q", zone))
+	}
+
+	prior := expandDnsManagedZoneRecords(d.Get("record").(*schema.Set).List())
+	if err := d.Set("record", flattenDnsManagedZoneRecords(withoutRoutingPolicyRecords(live), prior)); err != nil {
+		return fmt.Errorf("Error setting record: %s", err)
+	}
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error setting project: %s", err)
+	}
+	if err := tpgresource.DeletionPolicyReadDefault(d, config, "DELETE"); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceDnsManagedZoneRecordsUpdate(d *schema.ResourceData, meta interface{}) error {
+	if tpgresource.DeletionPolicyPreUpdate(d, ResourceDnsManagedZoneRecords) {
+		return resourceDnsManagedZoneRecordsRead(d, meta)
+	}
+
+	config := meta.(*transport_tpg.Config)
+	if err := applyDnsManagedZoneRecords(d, config, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return err
+	}
+
+	return resourceDnsManagedZoneRecordsRead(d, meta)
+}
+
+func resourceDnsManagedZoneRecordsDelete(d *schema.ResourceData, meta interface{}) error {
+	if ok, err := tpgresource.DeletionPolicyPreDelete(d); err != nil {
+		return err
+	} else if ok {
+		return nil
+	}
+
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := tpgresource.GetProject(d, config)
+	if err != nil {
+		return err
+	}
+	zone := tpgresource.GetResourceNameFromSelfLink(d.Get("managed_zone").(string))
+
+	if err := changeDnsManagedZoneRecords(config, userAgent, project, zone, nil, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return transport_tpg.HandleNotFoundError(err, d, fmt.Sprintf("DNS records of managed zone %q", zone))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceDnsManagedZoneRecordsImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*transport_tpg.Config)
+	if err := tpgresource.ParseImportId([]string{
+		"^projects/(?P<project>[^/]+)/managedZones/(?P<managed_zone>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<managed_zone>[^/]+)$",
+		"^(?P<managed_zone>[^/]+)$",
+	}, d, config); err != nil {
+		return nil, err
+	}
+
+	id, err := tpgresource.ReplaceVars(d, config, "projects/{{project}}/managedZones/{{managed_zone}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// applyDnsManagedZoneRecords makes the zone's record sets match the configuration in a single change
+func applyDnsManagedZoneRecords(d *schema.ResourceData, config *transport_tpg.Config, timeout time.Duration) error {
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := tpgresource.GetProject(d, config)
+	if err != nil {
+		return err
+	}
+	zone := tpgresource.GetResourceNameFromSelfLink(d.Get("managed_zone").(string))
+
+	var desired []*dns.ResourceRecordSet
+	if zoneFile, ok := d.GetOk("zone_file"); ok {
+		mz, err := NewClient(config, userAgent).ManagedZones.Get(project, zone).Do()
+		if err != nil {
+			return fmt.Errorf("Error retrieving managed zone %q: %s", zone, err)
+		}
+		rrsets, err := ParseZoneFile(zoneFile.(string), mz.DnsName)
+		if err != nil {
+			return fmt.Errorf("Error parsing zone_file: %s", err)
+		}
+		desired = withoutZoneApexRecords(rrsets, mz.DnsName)
+	} else {
+		desired = expandDnsManagedZoneRecords(d.Get("record").(*schema.Set).List())
+	}
+
+	return changeDnsManagedZoneRecords(config, userAgent, project, zone, desired, timeout)
+}
+
+// changeDnsManagedZoneRecords replaces the record sets of a zone, other than the SOA and NS
+// records at its apex, with desired. Only record sets that differ are changed, and all
+// of them are changed in a single, atomic change.
+func changeDnsManagedZoneRecords(config *transport_tpg.Config, userAgent, project, zone string, desired []*dns.ResourceRecordSet, timeout time.Duration) error {
+	lockName := dnsRecordSetsLockName(project, zone)
+	transport_tpg.MutexStore.Lock(lockName)
+	defer transport_tpg.MutexStore.Unlock(lockName)
+
+	live, err := listDnsManagedZoneRecords(config, userAgent, project, zone)
+	if err != nil {
+		return err
+	}
+
+	chg := diffDnsManagedZoneRecords(live, desired)
+	if len(chg.Additions) == 0 && len(chg.Deletions) == 0 {
+		log.Printf("[DEBUG] DNS records of managed zone %q are up to date", zone)
+		return nil
+	}
+
+	log.Printf("[DEBUG] DNS records change request for managed zone %q: %d additions, %d deletions", zone, len(chg.Additions), len(chg.Deletions))
+	chg, err = NewClient(config, userAgent).Changes.Create(project, zone, chg).Do()
+	if err != nil {
+		return err
+	}
+
+	w := &DnsChangeWaiter{
+		Service:     NewClient(config, userAgent),
+		Change:      chg,
+		Project:     project,
+		ManagedZone: zone,
+		Timeout:     timeout,
+	}
+	if _, err := w.Conf().WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for Google DNS change: %s", err)
+	}
+	return nil
+}
+
+// listDnsManagedZoneRecords returns the record sets of a zone other than the SOA and NS records at its apex
+func listDnsManagedZoneRecords(config *transport_tpg.Config, userAgent, project, zone string) ([]*dns.ResourceRecordSet, error) {
+	client := NewClient(config, userAgent)
+	mz, err := client.ManagedZones.Get(project, zone).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	var rrsets []*dns.ResourceRecordSet
+	err = client.ResourceRecordSets.List(project, zone).Pages(context.Background(), func(resp *dns.ResourceRecordSetsListResponse) error {
+		rrsets = append(rrsets, resp.Rrsets...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return withoutZoneApexRecords(rrsets, mz.DnsName), nil
+}
+
+// withoutZoneApexRecords drops SOA record sets, and NS record sets at the zone apex,
+// which Cloud DNS manages with the zone.
+func withoutZoneApexRecords(rrsets []*dns.ResourceRecordSet, dnsName string) []*dns.ResourceRecordSet {
+	var result []*dns.ResourceRecordSet
+	for _, rset := range rrsets {
+		if rset.Type == "SOA" || (rset.Type == "NS" && rset.Name == dnsName) {
+			continue
+		}
+		result = append(result, rset)
+	}
+	return result
+}
+
+// withoutRoutingPolicyRecords drops record sets with a routing policy, which the record block can't
+// express. They are left in place unless a record set with the same name and type is configured.
+func withoutRoutingPolicyRecords(rrsets []*dns.ResourceRecordSet) []*dns.ResourceRecordSet {
+	var result []*dns.ResourceRecordSet
+	for _, rset := range rrsets {
+		if rset.RoutingPolicy != nil {
+			continue
+		}
+		result = append(result, rset)
+	}
+	return result
+}
+
+// diffDnsManagedZoneRecords returns the change that turns the live record sets into the desired ones.
+// Live record sets with a routing policy are only deleted when a configured record set replaces them.
+func diffDnsManagedZoneRecords(live, desired []*dns.ResourceRecordSet) *dns.Change {
+	liveByKey := make(map[string]*dns.ResourceRecordSet)
+	for _, rset := range live {
+		liveByKey[rset.Name+"/"+rset.Type] = rset
+	}
+
+	chg := &dns.Change{}
+	seen := make(map[string]bool)
+	for _, rset := range desired {
+		key := rset.Name + "/" + rset.Type
+		seen[key] = true
+		existing, ok := liveByKey[key]
+		if ok && dnsRecordSetsEqual(existing, rset) {
+			continue
+		}
+		if ok {
+			chg.Deletions = append(chg.Deletions, existing)
+		}
+		chg.Additions = append(chg.Additions, rset)
+	}
+	for _, rset := range live {
+		if !seen[rset.Name+"/"+rset.Type] && rset.RoutingPolicy == nil {
+			chg.Deletions = append(chg.Deletions, rset)
+		}
+	}
+	return chg
+}
+
+// dnsRecordSetsEqual compares record sets by TTL and records, ignoring record order and the differences
+// between equivalent records that rrdatasDnsDiffSuppress ignores. A record set with a routing policy never
+// equals a configured one, so configuring its name and type replaces it with a plain record set.
+func dnsRecordSetsEqual(a, b *dns.ResourceRecordSet) bool {
+	if a.RoutingPolicy != nil || b.RoutingPolicy != nil {
+		return false
+	}
+	if a.Ttl != b.Ttl || len(a.Rrdatas) != len(b.Rrdatas) {
+		return false
+	}
+	parseFunc := func(record string) string {
+		return normalizeDnsRrdata(strings.ToUpper(a.Type), record)
+	}
+	return RrdatasListDiffSuppress(a.Rrdatas, b.Rrdatas, parseFunc, nil)
+}
+
+// flattenDnsManagedZoneRecords flattens record sets, keeping the records of a prior record set
+// that is equal to the flattened one so that equivalent records don't show a diff
+func flattenDnsManagedZoneRecords(rrsets, prior []*dns.ResourceRecordSet) []interface{} {
+	priorByKey := make(map[string]*dns.ResourceRecordSet, len(prior))
+	for _, rset := range prior {
+		priorByKey[rset.Name+"/"+rset.Type] = rset
+	}
+
+	records := make([]interface{}, 0, len(rrsets))
+	for _, rset := range rrsets {
+		rrs := rset.Rrdatas
+		if p, ok := priorByKey[rset.Name+"/"+rset.Type]; ok && dnsRecordSetsEqual(p, rset) {
+			rrs = p.Rrdatas
+		}
+		rrdatas := make([]interface{}, 0, len(rrs))
+		for _, rr := range rrs {
+			rrdatas = append(rrdatas, rr)
+		}
+		records = append(records, map[string]interface{}{
+			"name":    rset.Name,
+			"type":    rset.Type,
+			"ttl":     int(rset.Ttl),
+			"rrdatas": rrdatas,
+		})
+	}
+	return records
+}
+
+func expandDnsManagedZoneRecords(configured []interface{}) []*dns.ResourceRecordSet {
+	rrsets := make([]*dns.ResourceRecordSet, 0, len(configured))
+	for _, raw := range configured {
+		data := raw.(map[string]interface{})
+		rrsets = append(rrsets, &dns.ResourceRecordSet{
+			Name:    data["name"].(string),
+			Type:    strings.ToUpper(data["type"].(string)),
+			Ttl:     int64(data["ttl"].(int)),
+			Rrdatas: tpgresource.ConvertStringSet(data["rrdatas"].(*schema.Set)),
+		})
+	}
+	return rrsets
+}
+
+func init() {
+	registry.Schema{
+		Name:        "google_dns_managed_zone_records",
+		ProductName: "dns",
+		Type:        registry.SchemaTypeResource,
+		Schema:      ResourceDnsManagedZoneRecords(),
+	}.Register()
+}
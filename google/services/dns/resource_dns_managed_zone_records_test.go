@@ -0,0 +1,135 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/services/dns/resource_dns_managed_zone_records_test.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package dns_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-google/google/acctest"
+)
+
+func TestAccDNSManagedZoneRecords_zoneFile(t *testing.T) {
+	t.Parallel()
+
+	zoneName := fmt.Sprintf("dnszone-test-%s", acctest.RandString(t, 10))
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDnsManagedZoneRecords_zoneFile(zoneName, "192.0.2.1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_dns_managed_zone_records.records", "record.#", "2"),
+				),
+			},
+			{
+				Config: testAccDnsManagedZoneRecords_zoneFile(zoneName, "192.0.2.2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_dns_managed_zone_records.records", "record.#", "2"),
+				),
+			},
+			{
+				ResourceName:            "google_dns_managed_zone_records.records",
+				ImportStateId:           zoneName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"zone_file"},
+			},
+		},
+	})
+}
+
+func TestAccDNSManagedZoneRecords_removesUnmanagedRecords(t *testing.T) {
+	t.Parallel()
+
+	zoneName := fmt.Sprintf("dnszone-test-%s", acctest.RandString(t, 10))
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDnsManagedZoneRecords_withRecordSet(zoneName),
+			},
+			{
+				Config: testAccDnsManagedZoneRecords_records(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_dns_managed_zone_records.records", "record.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDnsManagedZoneRecords_zoneFile(zoneName, addr string) string {
+	return fmt.Sprintf(`
+resource "google_dns_managed_zone" "zone" {
+  name     = "%s"
+  dns_name = "%s.hashicorptest.com."
+}
+
+resource "google_dns_managed_zone_records" "records" {
+  managed_zone = google_dns_managed_zone.zone.name
+  zone_file    = <<-EOT
+    $TTL 300
+    www  IN A   %s
+    txt     TXT "hello world"
+  EOT
+}
+`, zoneName, zoneName, addr)
+}
+
+func testAccDnsManagedZoneRecords_withRecordSet(zoneName string) string {
+	return fmt.Sprintf(`
+resource "google_dns_managed_zone" "zone" {
+  name     = "%s"
+  dns_name = "%s.hashicorptest.com."
+}
+
+resource "google_dns_record_set" "unmanaged" {
+  managed_zone = google_dns_managed_zone.zone.name
+  name         = "unmanaged.%s.hashicorptest.com."
+  type         = "A"
+  ttl          = 300
+  rrdatas      = ["192.0.2.10"]
+  # Removed outside of Terraform by google_dns_managed_zone_records
+  deletion_policy = "ABANDON"
+}
+`, zoneName, zoneName, zoneName)
+}
+
+func testAccDnsManagedZoneRecords_records(zoneName string) string {
+	return fmt.Sprintf(`
+resource "google_dns_managed_zone" "zone" {
+  name     = "%s"
+  dns_name = "%s.hashicorptest.com."
+}
+
+resource "google_dns_managed_zone_records" "records" {
+  managed_zone = google_dns_managed_zone.zone.name
+
+  record {
+    name    = "www.%s.hashicorptest.com."
+    type    = "A"
+    ttl     = 300
+    rrdatas = ["192.0.2.1"]
+  }
+}
+`, zoneName, zoneName, zoneName)
+}
@@ -21,6 +21,7 @@ import (
 	"log"
 
 	"strings"
+	"time"
 
 	"net"
 
@@ -60,22 +61,29 @@ func rrdatasDnsDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
 	oList := tpgresource.ConvertStringArr(o.([]interface{}))
 	nList := tpgresource.ConvertStringArr(n.([]interface{}))
 
+	rrType, _ := d.Get("type").(string)
 	parseFunc := func(record string) string {
-		switch d.Get("type") {
-		case "AAAA":
-			// parse ipv6 to a key from one list
-			return net.ParseIP(record).String()
-		case "MX", "DS":
-			return strings.ToLower(record)
-		case "TXT":
-			return strings.ToLower(strings.Trim(record, `"`))
-		default:
-			return record
-		}
+		return normalizeDnsRrdata(rrType, record)
 	}
 	return RrdatasListDiffSuppress(oList, nList, parseFunc, d)
 }
 
+// normalizeDnsRrdata returns the form of a resource record of the given type
+// that equivalent records share, e.g. "2001:DB8::1" and "2001:db8::1".
+func normalizeDnsRrdata(rrType, record string) string {
+	switch rrType {
+	case "AAAA":
+		// parse ipv6 to a key from one list
+		return net.ParseIP(record).String()
+	case "MX", "DS":
+		return strings.ToLower(record)
+	case "TXT":
+		return strings.ToLower(strings.Trim(record, `"`))
+	default:
+		return record
+	}
+}
+
 // suppress on a list when 1) its items have dups that need to be ignored
 // and 2) string comparison on the items may need a special parse function
 // example of usage can be found ../../../third_party/terraform/services/dns/resource_dns_record_set_test.go.erb
@@ -112,6 +120,12 @@ func ResourceDnsRecordSet() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceDnsRecordSetImportState,
 		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
 		CustomizeDiff: customdiff.All(
 			tpgresource.DefaultProviderDeletionPolicy("DELETE"),
 			tpgresource.DefaultProviderProject,
@@ -448,24 +462,12 @@ func resourceDnsRecordSetCreate(d *schema.ResourceData, meta interface{}) error
 	defer transport_tpg.MutexStore.Unlock(lockName)
 
 	log.Printf("[DEBUG] DNS Record create request: %#v", chg)
-	chg, err = NewClient(config, userAgent).Changes.Create(project, zone, chg).Do()
-	if err != nil {
+	if _, err := BatchRequestDnsChange(chg, project, zone, userAgent, config, d.Timeout(schema.TimeoutCreate)); err != nil {
 		return fmt.Errorf("Error creating DNS RecordSet: %s", err)
 	}
 
 	d.SetId(fmt.Sprintf("projects/%s/managedZones/%s/rrsets/%s/%s", project, zone, name, rType))
 
-	w := &DnsChangeWaiter{
-		Service:     NewClient(config, userAgent),
-		Change:      chg,
-		Project:     project,
-		ManagedZone: zone,
-	}
-	_, err = w.Conf().WaitForState()
-	if err != nil {
-		return fmt.Errorf("Error waiting for Google DNS change: %s", err)
-	}
-
 	return resourceDnsRecordSetRead(d, meta)
 }
 
@@ -613,22 +615,10 @@ func resourceDnsRecordSetDelete(d *schema.ResourceData, meta interface{}) error
 	defer transport_tpg.MutexStore.Unlock(lockName)
 
 	log.Printf("[DEBUG] DNS Record delete request: %#v", chg)
-	chg, err = NewClient(config, userAgent).Changes.Create(project, zone, chg).Do()
-	if err != nil {
+	if _, err := BatchRequestDnsChange(chg, project, zone, userAgent, config, d.Timeout(schema.TimeoutDelete)); err != nil {
 		return transport_tpg.HandleNotFoundError(err, d, "google_dns_record_set")
 	}
 
-	w := &DnsChangeWaiter{
-		Service:     NewClient(config, userAgent),
-		Change:      chg,
-		Project:     project,
-		ManagedZone: zone,
-	}
-	_, err = w.Conf().WaitForState()
-	if err != nil {
-		return fmt.Errorf("Error waiting for Google DNS change: %s", err)
-	}
-
 	d.SetId("")
 	return nil
 }
@@ -699,21 +689,10 @@ func resourceDnsRecordSetUpdate(d *schema.ResourceData, meta interface{}) error
 		chg.Deletions[0].Rrdatas[i] = oldRR.(string)
 	}
 	log.Printf("[DEBUG] DNS Record change request: %#v old: %#v new: %#v", chg, chg.Deletions[0], chg.Additions[0])
-	chg, err = NewClient(config, userAgent).Changes.Create(project, zone, chg).Do()
-	if err != nil {
+	if _, err := BatchRequestDnsChange(chg, project, zone, userAgent, config, d.Timeout(schema.TimeoutUpdate)); err != nil {
 		return fmt.Errorf("Error changing DNS RecordSet: %s", err)
 	}
 
-	w := &DnsChangeWaiter{
-		Service:     NewClient(config, userAgent),
-		Change:      chg,
-		Project:     project,
-		ManagedZone: zone,
-	}
-	if _, err = w.Conf().WaitForState(); err != nil {
-		return fmt.Errorf("Error waiting for Google DNS change: %s", err)
-	}
-
 	d.SetId(fmt.Sprintf("projects/%s/managedZones/%s/rrsets/%s/%s", project, zone, recordName, newType))
 
 	return resourceDnsRecordSetRead(d, meta)
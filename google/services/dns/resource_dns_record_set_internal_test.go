@@ -17,9 +17,12 @@
 package dns
 
 import (
+	"errors"
 	"testing"
 
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
 	"github.com/hashicorp/terraform-provider-google/google/verify"
+	"google.golang.org/api/dns/v1"
 )
 
 func TestValidateRecordNameTrailingDot(t *testing.T) {
@@ -37,3 +40,100 @@ func TestValidateRecordNameTrailingDot(t *testing.T) {
 		t.Errorf("Failed to validate DNS Record name with value: %v", es)
 	}
 }
+
+func TestCombineDnsChangeBatches(t *testing.T) {
+	a := &dns.Change{
+		Additions: []*dns.ResourceRecordSet{{Name: "a.example.com.", Type: "A"}},
+	}
+	b := &dns.Change{
+		Deletions: []*dns.ResourceRecordSet{{Name: "b.example.com.", Type: "A"}},
+		Additions: []*dns.ResourceRecordSet{{Name: "b.example.com.", Type: "A"}},
+	}
+
+	combined, err := combineDnsChangeBatches(a, b)
+	if err != nil {
+		t.Fatalf("unexpected error combining changes: %s", err)
+	}
+	chg := combined.(*dns.Change)
+	if len(chg.Additions) != 2 || len(chg.Deletions) != 1 {
+		t.Errorf("expected 2 additions and 1 deletion, got %#v", chg)
+	}
+	if len(a.Additions) != 1 {
+		t.Errorf("expected combining not to modify the existing batch body")
+	}
+
+	conflict := &dns.Change{
+		Deletions: []*dns.ResourceRecordSet{{Name: "a.example.com.", Type: "A"}},
+	}
+	if _, err := combineDnsChangeBatches(chg, conflict); !errors.Is(err, transport_tpg.ErrBatchRequestNotCombinable) {
+		t.Errorf("expected changes to the same record set not to be combined, got: %v", err)
+	}
+	other := &dns.Change{
+		Additions: []*dns.ResourceRecordSet{{Name: "a.example.com.", Type: "TXT"}},
+	}
+	if _, err := combineDnsChangeBatches(chg, other); err != nil {
+		t.Errorf("unexpected error combining changes to different record types: %s", err)
+	}
+}
+
+func TestDnsRecordSetsEqual(t *testing.T) {
+	cases := []struct {
+		name  string
+		a, b  *dns.ResourceRecordSet
+		equal bool
+	}{
+		{
+			name:  "reordered",
+			a:     &dns.ResourceRecordSet{Type: "A", Ttl: 300, Rrdatas: []string{"10.0.0.1", "10.0.0.2"}},
+			b:     &dns.ResourceRecordSet{Type: "A", Ttl: 300, Rrdatas: []string{"10.0.0.2", "10.0.0.1"}},
+			equal: true,
+		},
+		{
+			name:  "ipv6 forms",
+			a:     &dns.ResourceRecordSet{Type: "AAAA", Ttl: 300, Rrdatas: []string{"2001:DB8:0:0:0:0:0:1"}},
+			b:     &dns.ResourceRecordSet{Type: "AAAA", Ttl: 300, Rrdatas: []string{"2001:db8::1"}},
+			equal: true,
+		},
+		{
+			name:  "quoted txt",
+			a:     &dns.ResourceRecordSet{Type: "TXT", Ttl: 300, Rrdatas: []string{"v=spf1 -all"}},
+			b:     &dns.ResourceRecordSet{Type: "TXT", Ttl: 300, Rrdatas: []string{`"v=spf1 -all"`}},
+			equal: true,
+		},
+		{
+			name: "different ttl",
+			a:    &dns.ResourceRecordSet{Type: "A", Ttl: 300, Rrdatas: []string{"10.0.0.1"}},
+			b:    &dns.ResourceRecordSet{Type: "A", Ttl: 60, Rrdatas: []string{"10.0.0.1"}},
+		},
+		{
+			name: "different records",
+			a:    &dns.ResourceRecordSet{Type: "AAAA", Ttl: 300, Rrdatas: []string{"2001:db8::1"}},
+			b:    &dns.ResourceRecordSet{Type: "AAAA", Ttl: 300, Rrdatas: []string{"2001:db8::2"}},
+		},
+	}
+
+	for _, tc := range cases {
+		if got := dnsRecordSetsEqual(tc.a, tc.b); got != tc.equal {
+			t.Errorf("%s: expected equal to be %t, got %t", tc.name, tc.equal, got)
+		}
+	}
+}
+
+func TestFlattenDnsManagedZoneRecordsKeepsPriorRecords(t *testing.T) {
+	live := []*dns.ResourceRecordSet{
+		{Name: "a.example.com.", Type: "AAAA", Ttl: 300, Rrdatas: []string{"2001:db8::1"}},
+		{Name: "b.example.com.", Type: "AAAA", Ttl: 300, Rrdatas: []string{"2001:db8::2"}},
+	}
+	prior := []*dns.ResourceRecordSet{
+		{Name: "a.example.com.", Type: "AAAA", Ttl: 300, Rrdatas: []string{"2001:DB8::1"}},
+		{Name: "b.example.com.", Type: "AAAA", Ttl: 300, Rrdatas: []string{"2001:DB8::3"}},
+	}
+
+	records := flattenDnsManagedZoneRecords(live, prior)
+	if got := records[0].(map[string]interface{})["rrdatas"].([]interface{})[0]; got != "2001:DB8::1" {
+		t.Errorf("expected the prior form of an equivalent record, got %q", got)
+	}
+	if got := records[1].(map[string]interface{})["rrdatas"].([]interface{})[0]; got != "2001:db8::2" {
+		t.Errorf("expected the live form of a changed record, got %q", got)
+	}
+}
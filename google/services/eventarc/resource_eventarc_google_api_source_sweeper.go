@@ -194,7 +194,7 @@ func deleteResourceEventarcGoogleApiSource(config *transport_tpg.Config, d *tpgr
 	}
 
 	// Skip resources that shouldn't be sweeped
-	if !sweeper.IsSweepableTestResource(name) {
+	if !sweeper.ShouldSweep(name, obj) {
 		return nil
 	}
 
@@ -194,7 +194,7 @@ func deleteResourceEventarcMessageBus(config *transport_tpg.Config, d *tpgresour
 	}
 
 	// Skip resources that shouldn't be sweeped
-	if !sweeper.IsSweepableTestResource(name) {
+	if !sweeper.ShouldSweep(name, obj) {
 		return nil
 	}
 
@@ -197,7 +197,7 @@ func deleteResourceFilestoreInstance(config *transport_tpg.Config, d *tpgresourc
 	name = tpgresource.GetResourceNameFromSelfLink(obj["name"].(string))
 
 	// Skip resources that shouldn't be sweeped
-	if !sweeper.IsSweepableTestResource(name) {
+	if !sweeper.ShouldSweep(name, obj) {
 		return nil
 	}
 
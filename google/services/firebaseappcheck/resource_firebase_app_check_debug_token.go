@@ -157,7 +157,7 @@ or [Android App](https://firebase.google.com/docs/reference/firebase-management/
 			},
 			"token": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 				ForceNew: true,
 				Description: `The secret token itself. Must be provided during creation, and must be a UUID4,
 case insensitive. You may use a method of your choice such as random/random_uuid
@@ -167,8 +167,11 @@ This field is immutable once set, and cannot be updated. You can, however, delet
 this debug token to revoke it.
 
 For security reasons, this field will never be populated in any response.`,
-				Sensitive: true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"token", "token_wo"},
 			},
+			"token_wo":         tpgresource.WriteOnlySchema("token", `Write-only secret token itself. Must be provided during creation, and must be a UUID4, case insensitive.`, true),
+			"token_wo_version": tpgresource.WriteOnlyVersionSchema("token", true),
 			"debug_token_id": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -211,7 +214,7 @@ func resourceFirebaseAppCheckDebugTokenCreate(d *schema.ResourceData, meta inter
 	} else if v, ok := d.GetOkExists("display_name"); !tpgresource.IsEmptyValue(reflect.ValueOf(displayNameProp)) && (ok || !reflect.DeepEqual(v, displayNameProp)) {
 		obj["displayName"] = displayNameProp
 	}
-	tokenProp, err := expandFirebaseAppCheckDebugTokenToken(d.Get("token"), d, config)
+	tokenProp, err := expandFirebaseAppCheckDebugTokenToken(tpgresource.GetWriteOnlyValue(d, "token", d.Get("token")), d, config)
 	if err != nil {
 		return err
 	} else if v, ok := d.GetOkExists("token"); !tpgresource.IsEmptyValue(reflect.ValueOf(tokenProp)) && (ok || !reflect.DeepEqual(v, tokenProp)) {
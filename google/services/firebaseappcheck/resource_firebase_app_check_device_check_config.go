@@ -149,11 +149,14 @@ func ResourceFirebaseAppCheckDeviceCheckConfig() *schema.Resource {
 				Description: `The key identifier of a private key enabled with DeviceCheck, created in your Apple Developer account.`,
 			},
 			"private_key": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: `The contents of the private key (.p8) file associated with the key specified by keyId.`,
-				Sensitive:   true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  `The contents of the private key (.p8) file associated with the key specified by keyId.`,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"private_key", "private_key_wo"},
 			},
+			"private_key_wo":         tpgresource.WriteOnlySchema("private_key", `Write-only contents of the private key (.p8) file associated with the key specified by keyId.`, true),
+			"private_key_wo_version": tpgresource.WriteOnlyVersionSchema("private_key", false),
 			"token_ttl": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -205,7 +208,7 @@ func resourceFirebaseAppCheckDeviceCheckConfigCreate(d *schema.ResourceData, met
 	} else if v, ok := d.GetOkExists("key_id"); !tpgresource.IsEmptyValue(reflect.ValueOf(keyIdProp)) && (ok || !reflect.DeepEqual(v, keyIdProp)) {
 		obj["keyId"] = keyIdProp
 	}
-	privateKeyProp, err := expandFirebaseAppCheckDeviceCheckConfigPrivateKey(d.Get("private_key"), d, config)
+	privateKeyProp, err := expandFirebaseAppCheckDeviceCheckConfigPrivateKey(tpgresource.GetWriteOnlyValue(d, "private_key", d.Get("private_key")), d, config)
 	if err != nil {
 		return err
 	} else if v, ok := d.GetOkExists("private_key"); !tpgresource.IsEmptyValue(reflect.ValueOf(privateKeyProp)) && (ok || !reflect.DeepEqual(v, privateKeyProp)) {
@@ -388,10 +391,10 @@ func resourceFirebaseAppCheckDeviceCheckConfigUpdate(d *schema.ResourceData, met
 	} else if v, ok := d.GetOkExists("key_id"); !tpgresource.IsEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, keyIdProp)) {
 		obj["keyId"] = keyIdProp
 	}
-	privateKeyProp, err := expandFirebaseAppCheckDeviceCheckConfigPrivateKey(d.Get("private_key"), d, config)
+	privateKeyProp, err := expandFirebaseAppCheckDeviceCheckConfigPrivateKey(tpgresource.GetWriteOnlyValue(d, "private_key", d.Get("private_key")), d, config)
 	if err != nil {
 		return err
-	} else if v, ok := d.GetOkExists("private_key"); !tpgresource.IsEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, privateKeyProp)) {
+	} else if v, ok := d.GetOkExists("private_key"); !tpgresource.IsEmptyValue(reflect.ValueOf(privateKeyProp)) && (ok || !reflect.DeepEqual(v, privateKeyProp)) {
 		obj["privateKey"] = privateKeyProp
 	}
 
@@ -412,7 +415,7 @@ func resourceFirebaseAppCheckDeviceCheckConfigUpdate(d *schema.ResourceData, met
 		updateMask = append(updateMask, "keyId")
 	}
 
-	if d.HasChange("private_key") {
+	if tpgresource.WriteOnlyHasChange(d, "private_key") {
 		updateMask = append(updateMask, "privateKey")
 	}
 	// updateMask is a URL parameter but not present in the schema, so ReplaceVars
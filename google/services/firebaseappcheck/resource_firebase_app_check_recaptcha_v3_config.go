@@ -145,11 +145,14 @@ func ResourceFirebaseAppCheckRecaptchaV3Config() *schema.Resource {
 			},
 			"site_secret": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 				Description: `The site secret used to identify your service for reCAPTCHA v3 verification.
 For security reasons, this field will never be populated in any response.`,
-				Sensitive: true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"site_secret", "site_secret_wo"},
 			},
+			"site_secret_wo":         tpgresource.WriteOnlySchema("site_secret", `Write-only site secret used to identify your service for reCAPTCHA v3 verification.`, true),
+			"site_secret_wo_version": tpgresource.WriteOnlyVersionSchema("site_secret", false),
 			"token_ttl": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -194,7 +197,7 @@ func resourceFirebaseAppCheckRecaptchaV3ConfigCreate(d *schema.ResourceData, met
 	} else if v, ok := d.GetOkExists("token_ttl"); !tpgresource.IsEmptyValue(reflect.ValueOf(tokenTtlProp)) && (ok || !reflect.DeepEqual(v, tokenTtlProp)) {
 		obj["tokenTtl"] = tokenTtlProp
 	}
-	siteSecretProp, err := expandFirebaseAppCheckRecaptchaV3ConfigSiteSecret(d.Get("site_secret"), d, config)
+	siteSecretProp, err := expandFirebaseAppCheckRecaptchaV3ConfigSiteSecret(tpgresource.GetWriteOnlyValue(d, "site_secret", d.Get("site_secret")), d, config)
 	if err != nil {
 		return err
 	} else if v, ok := d.GetOkExists("site_secret"); !tpgresource.IsEmptyValue(reflect.ValueOf(siteSecretProp)) && (ok || !reflect.DeepEqual(v, siteSecretProp)) {
@@ -371,10 +374,10 @@ func resourceFirebaseAppCheckRecaptchaV3ConfigUpdate(d *schema.ResourceData, met
 	} else if v, ok := d.GetOkExists("token_ttl"); !tpgresource.IsEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, tokenTtlProp)) {
 		obj["tokenTtl"] = tokenTtlProp
 	}
-	siteSecretProp, err := expandFirebaseAppCheckRecaptchaV3ConfigSiteSecret(d.Get("site_secret"), d, config)
+	siteSecretProp, err := expandFirebaseAppCheckRecaptchaV3ConfigSiteSecret(tpgresource.GetWriteOnlyValue(d, "site_secret", d.Get("site_secret")), d, config)
 	if err != nil {
 		return err
-	} else if v, ok := d.GetOkExists("site_secret"); !tpgresource.IsEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, siteSecretProp)) {
+	} else if v, ok := d.GetOkExists("site_secret"); !tpgresource.IsEmptyValue(reflect.ValueOf(siteSecretProp)) && (ok || !reflect.DeepEqual(v, siteSecretProp)) {
 		obj["siteSecret"] = siteSecretProp
 	}
 
@@ -391,7 +394,7 @@ func resourceFirebaseAppCheckRecaptchaV3ConfigUpdate(d *schema.ResourceData, met
 		updateMask = append(updateMask, "tokenTtl")
 	}
 
-	if d.HasChange("site_secret") {
+	if tpgresource.WriteOnlyHasChange(d, "site_secret") {
 		updateMask = append(updateMask, "siteSecret")
 	}
 	// updateMask is a URL parameter but not present in the schema, so ReplaceVars
@@ -194,7 +194,7 @@ func deleteResourceFirebaseAppHostingBackend(config *transport_tpg.Config, d *tp
 	}
 
 	// Skip resources that shouldn't be sweeped
-	if !sweeper.IsSweepableTestResource(name) {
+	if !sweeper.ShouldSweep(name, obj) {
 		return nil
 	}
 
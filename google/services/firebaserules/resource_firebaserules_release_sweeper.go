@@ -65,5 +65,5 @@ func testSweepFirebaserulesRelease(region string) error {
 }
 
 func isDeletableFirebaserulesRelease(r *Release) bool {
-	return sweeper.IsSweepableTestResource(*r.Name)
+	return sweeper.ShouldSweepTyped(*r.Name, r)
 }
@@ -65,5 +65,5 @@ func testSweepFirebaserulesRuleset(region string) error {
 }
 
 func isDeletableFirebaserulesRuleset(r *Ruleset) bool {
-	return sweeper.IsSweepableTestResource(*r.Name)
+	return sweeper.ShouldSweepTyped(*r.Name, r)
 }
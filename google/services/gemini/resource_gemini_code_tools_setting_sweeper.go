@@ -192,7 +192,7 @@ func deleteResourceGeminiCodeToolsSetting(config *transport_tpg.Config, d *tpgre
 	}
 
 	// Skip resources that shouldn't be sweeped
-	if !sweeper.IsSweepableTestResource(name) {
+	if !sweeper.ShouldSweep(name, obj) {
 		return nil
 	}
 
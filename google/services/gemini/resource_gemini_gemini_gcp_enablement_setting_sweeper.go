@@ -192,7 +192,7 @@ func deleteResourceGeminiGeminiGcpEnablementSetting(config *transport_tpg.Config
 	}
 
 	// Skip resources that shouldn't be sweeped
-	if !sweeper.IsSweepableTestResource(name) {
+	if !sweeper.ShouldSweep(name, obj) {
 		return nil
 	}
 
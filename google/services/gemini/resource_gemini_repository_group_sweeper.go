@@ -177,7 +177,7 @@ func deleteResourceGeminiRepositoryGroup(config *transport_tpg.Config, d *tpgres
 	}
 
 	// Skip resources that shouldn't be sweeped
-	if !sweeper.IsSweepableTestResource(name) {
+	if !sweeper.ShouldSweep(name, obj) {
 		return nil
 	}
 
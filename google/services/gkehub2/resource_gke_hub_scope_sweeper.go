@@ -194,7 +194,7 @@ func deleteResourceGKEHub2Scope(config *transport_tpg.Config, d *tpgresource.Res
 	}
 
 	// Skip resources that shouldn't be sweeped
-	if !sweeper.IsSweepableTestResource(name) {
+	if !sweeper.ShouldSweep(name, obj) {
 		return nil
 	}
 
@@ -0,0 +1,71 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/services/iamcredentials/login_token.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package iamcredentials
+
+import (
+	"fmt"
+	"time"
+
+	iamcredentials "google.golang.org/api/iamcredentials/v1"
+
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+)
+
+// LoginToken is a short-lived access token used as the password of an IAM principal for
+// database authentication.
+type LoginToken struct {
+	// Email of the IAM principal the token was issued to
+	Email       string
+	AccessToken string
+	Expiry      time.Time
+}
+
+// GenerateLoginToken issues an access token with the given scope, such as
+// https://www.googleapis.com/auth/sqlservice.login. When serviceAccount is set, the token is
+// issued to that service account by impersonating it through the delegates, otherwise it's issued
+// to the identity of the provider.
+func GenerateLoginToken(config *transport_tpg.Config, userAgent, scope, serviceAccount string, delegates []string) (*LoginToken, error) {
+	if serviceAccount != "" {
+		tokenRequest := &iamcredentials.GenerateAccessTokenRequest{
+			Delegates: delegates,
+			Scope:     []string{scope},
+		}
+		at, err := NewClient(config, userAgent).Projects.ServiceAccounts.GenerateAccessToken(fmt.Sprintf("projects/-/serviceAccounts/%s", serviceAccount), tokenRequest).Do()
+		if err != nil {
+			return nil, fmt.Errorf("Error calling iamcredentials.GenerateAccessToken: %s", err)
+		}
+		expiry, err := time.Parse(time.RFC3339, at.ExpireTime)
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing token expiry: %s", err)
+		}
+		return &LoginToken{Email: serviceAccount, AccessToken: at.AccessToken, Expiry: expiry}, nil
+	}
+
+	email, err := transport_tpg.GetCurrentUserEmail(config, userAgent)
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving the email of the provider identity: %s", err)
+	}
+	creds, err := config.GetCredentials([]string{scope}, false)
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving credentials: %s", err)
+	}
+	token, err := creds.TokenSource.Token()
+	if err != nil {
+		return nil, fmt.Errorf("Error generating access token: %s", err)
+	}
+	return &LoginToken{Email: email, AccessToken: token.AccessToken, Expiry: token.Expiry}, nil
+}
@@ -247,11 +247,14 @@ can be configured. The possible values are:
 										Description: `OAuth 2.0 client ID used in the OAuth flow to generate an access token. If this field is set, you can skip obtaining the OAuth credentials in this.`,
 									},
 									"client_secret": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										Description: `OAuth secret paired with client ID.`,
-										Sensitive:   true,
+										Type:          schema.TypeString,
+										Optional:      true,
+										Description:   `OAuth secret paired with client ID.`,
+										Sensitive:     true,
+										ConflictsWith: []string{"access_settings.0.oauth_settings.0.client_secret_wo"},
 									},
+									"client_secret_wo":         tpgresource.WriteOnlySchema("access_settings.0.oauth_settings.0.client_secret", `Write-only OAuth secret paired with client ID.`, false),
+									"client_secret_wo_version": tpgresource.WriteOnlyVersionSchema("access_settings.0.oauth_settings.0.client_secret", false),
 									"login_hint": {
 										Type:     schema.TypeString,
 										Optional: true,
@@ -344,8 +347,11 @@ federation OAuth 2.0 Server.`,
 													Optional: true,
 													Description: `Input only. The OAuth 2.0 client secret created while registering
 the client ID.`,
-													Sensitive: true,
+													Sensitive:     true,
+													ConflictsWith: []string{"access_settings.0.workforce_identity_settings.0.oauth2.0.client_secret_wo"},
 												},
+												"client_secret_wo":         tpgresource.WriteOnlySchema("access_settings.0.workforce_identity_settings.0.oauth2.0.client_secret", `Write-only OAuth 2.0 client secret created while registering the client ID.`, false),
+												"client_secret_wo_version": tpgresource.WriteOnlyVersionSchema("access_settings.0.workforce_identity_settings.0.oauth2.0.client_secret", false),
 												"client_secret_sha256": {
 													Type:     schema.TypeString,
 													Computed: true,
@@ -862,6 +868,8 @@ func flattenIapSettingsAccessSettingsOauthSettings(v interface{}, d *schema.Reso
 		flattenIapSettingsAccessSettingsOauthSettingsClientId(original["clientId"], d, config)
 	transformed["client_secret"] =
 		flattenIapSettingsAccessSettingsOauthSettingsClientSecret(original["clientSecret"], d, config)
+	transformed["client_secret_wo_version"] =
+		flattenIapSettingsAccessSettingsOauthSettingsClientSecretWoVersion(original["clientSecretWoVersion"], d, config)
 	transformed["client_secret_sha256"] =
 		flattenIapSettingsAccessSettingsOauthSettingsClientSecretSha256(original["clientSecretSha256"], d, config)
 	transformed["programmatic_clients"] =
@@ -880,6 +888,10 @@ func flattenIapSettingsAccessSettingsOauthSettingsClientSecret(v interface{}, d
 	return d.Get("access_settings.0.oauth_settings.0.client_secret")
 }
 
+func flattenIapSettingsAccessSettingsOauthSettingsClientSecretWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("access_settings.0.oauth_settings.0.client_secret_wo_version")
+}
+
 func flattenIapSettingsAccessSettingsOauthSettingsClientSecretSha256(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -972,6 +984,8 @@ func flattenIapSettingsAccessSettingsWorkforceIdentitySettingsOauth2(v interface
 		flattenIapSettingsAccessSettingsWorkforceIdentitySettingsOauth2ClientId(original["clientId"], d, config)
 	transformed["client_secret"] =
 		flattenIapSettingsAccessSettingsWorkforceIdentitySettingsOauth2ClientSecret(original["clientSecret"], d, config)
+	transformed["client_secret_wo_version"] =
+		flattenIapSettingsAccessSettingsWorkforceIdentitySettingsOauth2ClientSecretWoVersion(original["clientSecretWoVersion"], d, config)
 	transformed["client_secret_sha256"] =
 		flattenIapSettingsAccessSettingsWorkforceIdentitySettingsOauth2ClientSecretSha256(original["clientSecretSha256"], d, config)
 	return []interface{}{transformed}
@@ -984,6 +998,10 @@ func flattenIapSettingsAccessSettingsWorkforceIdentitySettingsOauth2ClientSecret
 	return d.Get("access_settings.0.workforce_identity_settings.0.oauth2.0.client_secret")
 }
 
+func flattenIapSettingsAccessSettingsWorkforceIdentitySettingsOauth2ClientSecretWoVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return d.Get("access_settings.0.workforce_identity_settings.0.oauth2.0.client_secret_wo_version")
+}
+
 func flattenIapSettingsAccessSettingsWorkforceIdentitySettingsOauth2ClientSecretSha256(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -1247,7 +1265,7 @@ func expandIapSettingsAccessSettingsOauthSettings(v interface{}, d tpgresource.T
 		transformed["clientId"] = transformedClientId
 	}
 
-	transformedClientSecret, err := expandIapSettingsAccessSettingsOauthSettingsClientSecret(original["client_secret"], d, config)
+	transformedClientSecret, err := expandIapSettingsAccessSettingsOauthSettingsClientSecret(tpgresource.GetWriteOnlyValue(d, "access_settings.0.oauth_settings.0.client_secret", original["client_secret"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedClientSecret); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -1428,7 +1446,7 @@ func expandIapSettingsAccessSettingsWorkforceIdentitySettingsOauth2(v interface{
 		transformed["clientId"] = transformedClientId
 	}
 
-	transformedClientSecret, err := expandIapSettingsAccessSettingsWorkforceIdentitySettingsOauth2ClientSecret(original["client_secret"], d, config)
+	transformedClientSecret, err := expandIapSettingsAccessSettingsWorkforceIdentitySettingsOauth2ClientSecret(tpgresource.GetWriteOnlyValue(d, "access_settings.0.workforce_identity_settings.0.oauth2.0.client_secret", original["client_secret"]), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedClientSecret); val.IsValid() && !tpgresource.IsEmptyValue(val) {
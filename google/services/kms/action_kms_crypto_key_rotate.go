@@ -0,0 +1,165 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/services/kms/action_kms_crypto_key_rotate.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package kms
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-google/google/registry"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+	"google.golang.org/api/cloudkms/v1"
+)
+
+const kmsCryptoKeyRotateTimeout = 10 * time.Minute
+
+var _ action.ActionWithConfigure = &googleActionKmsCryptoKeyRotate{}
+
+func init() {
+	registry.FrameworkAction{
+		Name:        "google_kms_crypto_key_rotate",
+		ProductName: "kms",
+		Func:        GoogleActionKmsCryptoKeyRotate,
+	}.Register()
+}
+
+func GoogleActionKmsCryptoKeyRotate() action.Action {
+	return &googleActionKmsCryptoKeyRotate{}
+}
+
+type googleActionKmsCryptoKeyRotate struct {
+	providerConfig *transport_tpg.Config
+}
+
+func (a *googleActionKmsCryptoKeyRotate) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kms_crypto_key_rotate"
+}
+
+type actionKmsCryptoKeyRotateModel struct {
+	CryptoKey types.String `tfsdk:"crypto_key"`
+}
+
+func (a *googleActionKmsCryptoKeyRotate) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Rotates a Google Cloud KMS crypto key by creating a new crypto key version. The new version of a symmetric encryption key becomes its primary version.",
+		Attributes: map[string]schema.Attribute{
+			"crypto_key": schema.StringAttribute{
+				Description: "The id of the CryptoKey to rotate, in the format `{projectId}/{locationId}/{keyRingName}/{cryptoKeyName}` or `{locationId}/{keyRingName}/{cryptoKeyName}`.",
+				Required:    true,
+			},
+		},
+	}
+}
+
+func (a *googleActionKmsCryptoKeyRotate) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	pd, ok := req.ProviderData.(*transport_tpg.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *transport_tpg.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.providerConfig = pd
+}
+
+func (a *googleActionKmsCryptoKeyRotate) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data actionKmsCryptoKeyRotateModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config := a.providerConfig
+
+	cryptoKeyId, err := ParseKmsCryptoKeyId(data.CryptoKey.ValueString(), config)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing crypto_key", err.Error())
+		return
+	}
+
+	client := NewClientWithCtx(ctx, config, config.UserAgent)
+	if client == nil {
+		resp.Diagnostics.AddError("Error creating KMS client", "failed to get a KMS client")
+		return
+	}
+	cryptoKeys := client.Projects.Locations.KeyRings.CryptoKeys
+
+	cryptoKey, err := cryptoKeys.Get(cryptoKeyId.CryptoKeyId()).Context(ctx).Do()
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error reading CryptoKey %q", cryptoKeyId.CryptoKeyId()), err.Error())
+		return
+	}
+
+	version, err := cryptoKeys.CryptoKeyVersions.Create(cryptoKeyId.CryptoKeyId(), &cloudkms.CryptoKeyVersion{}).Context(ctx).Do()
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error creating a version of CryptoKey %q", cryptoKeyId.CryptoKeyId()), err.Error())
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Created CryptoKeyVersion %s", version.Name),
+	})
+
+	// Only symmetric encryption keys have a primary version. Versions of
+	// HSM and external keys are generated asynchronously, and can only
+	// become primary once they're enabled.
+	if cryptoKey.Purpose != "ENCRYPT_DECRYPT" {
+		return
+	}
+
+	err = retry.RetryContext(ctx, kmsCryptoKeyRotateTimeout, func() *retry.RetryError {
+		v, err := cryptoKeys.CryptoKeyVersions.Get(version.Name).Context(ctx).Do()
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+		switch v.State {
+		case "ENABLED":
+			return nil
+		case "PENDING_GENERATION":
+			return retry.RetryableError(fmt.Errorf("CryptoKeyVersion %s is %s", v.Name, v.State))
+		default:
+			return retry.NonRetryableError(fmt.Errorf("CryptoKeyVersion %s is %s", v.Name, v.State))
+		}
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error waiting for CryptoKeyVersion %q to be enabled", version.Name), err.Error())
+		return
+	}
+
+	_, err = cryptoKeys.UpdatePrimaryVersion(cryptoKeyId.CryptoKeyId(), &cloudkms.UpdateCryptoKeyPrimaryVersionRequest{
+		CryptoKeyVersionId: version.Name[strings.LastIndex(version.Name, "/")+1:],
+	}).Context(ctx).Do()
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error setting the primary version of CryptoKey %q", cryptoKeyId.CryptoKeyId()), err.Error())
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("CryptoKeyVersion %s is the primary version of %s", version.Name, cryptoKeyId.CryptoKeyId()),
+	})
+}
@@ -0,0 +1,91 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/services/kms/action_kms_crypto_key_rotate_test.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package kms_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-google/google/acctest"
+)
+
+func TestAccActionKmsCryptoKeyRotate_basic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(t, 10),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccActionKmsCryptoKeyRotate_basic(context),
+			},
+			{
+				Config: testAccActionKmsCryptoKeyRotate_basic(context) + testAccActionKmsCryptoKeyRotate_versions(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_kms_crypto_key_versions.versions", "versions.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccActionKmsCryptoKeyRotate_basic(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_kms_key_ring" "key_ring" {
+  name     = "tf-test-key-ring-%{random_suffix}"
+  location = "us-central1"
+}
+
+resource "google_kms_crypto_key" "crypto_key" {
+  name     = "tf-test-crypto-key-%{random_suffix}"
+  key_ring = google_kms_key_ring.key_ring.id
+}
+
+action "google_kms_crypto_key_rotate" "rotate" {
+  config {
+    crypto_key = google_kms_crypto_key.crypto_key.id
+  }
+}
+
+resource "terraform_data" "rotation" {
+  input = google_kms_crypto_key.crypto_key.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.google_kms_crypto_key_rotate.rotate]
+    }
+  }
+}
+`, context)
+}
+
+func testAccActionKmsCryptoKeyRotate_versions() string {
+	return `
+data "google_kms_crypto_key_versions" "versions" {
+  crypto_key = google_kms_crypto_key.crypto_key.id
+}
+`
+}
@@ -32,6 +32,7 @@ var SharedCryptoKey = map[string]string{
 	"ENCRYPT_DECRYPT":    "tftest-shared-key-1",
 	"ASYMMETRIC_SIGN":    "tftest-shared-sign-key-1",
 	"ASYMMETRIC_DECRYPT": "tftest-shared-decrypt-key-1",
+	"MAC":                "tftest-shared-mac-key-1",
 }
 
 type BootstrappedKMS struct {
@@ -112,6 +113,7 @@ func BootstrapKMSKeyWithPurposeInLocationAndName(t *testing.T, purpose, location
 				"ENCRYPT_DECRYPT":    "GOOGLE_SYMMETRIC_ENCRYPTION",
 				"ASYMMETRIC_SIGN":    "RSA_SIGN_PKCS1_4096_SHA256",
 				"ASYMMETRIC_DECRYPT": "RSA_DECRYPT_OAEP_4096_SHA256",
+				"MAC":                "HMAC_SHA256",
 			}
 			template := cloudkms.CryptoKeyVersionTemplate{
 				Algorithm: algos[purpose],
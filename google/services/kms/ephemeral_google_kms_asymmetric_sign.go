@@ -0,0 +1,193 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/services/kms/ephemeral_google_kms_asymmetric_sign.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package kms
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-google/google/registry"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+	"google.golang.org/api/cloudkms/v1"
+)
+
+var _ ephemeral.EphemeralResource = &googleEphemeralKmsAsymmetricSign{}
+
+func init() {
+	registry.FrameworkEphemeralResource{
+		Name:        "google_kms_asymmetric_sign",
+		ProductName: "kms",
+		Func:        GoogleEphemeralKmsAsymmetricSign,
+	}.Register()
+}
+
+func GoogleEphemeralKmsAsymmetricSign() ephemeral.EphemeralResource {
+	return &googleEphemeralKmsAsymmetricSign{}
+}
+
+type googleEphemeralKmsAsymmetricSign struct {
+	providerConfig *transport_tpg.Config
+}
+
+func (p *googleEphemeralKmsAsymmetricSign) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kms_asymmetric_sign"
+}
+
+type ephemeralKmsAsymmetricSignModel struct {
+	CryptoKeyVersion types.String `tfsdk:"crypto_key_version"`
+	Data             types.String `tfsdk:"data"`
+	Digest           types.String `tfsdk:"digest"`
+	DigestAlgorithm  types.String `tfsdk:"digest_algorithm"`
+	Signature        types.String `tfsdk:"signature"`
+}
+
+func (p *googleEphemeralKmsAsymmetricSign) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Signs data with an asymmetric Google Cloud KMS crypto key version.",
+		Attributes: map[string]schema.Attribute{
+			"crypto_key_version": schema.StringAttribute{
+				Description: "The fully qualified name of the CryptoKeyVersion to sign with. Its purpose must be ASYMMETRIC_SIGN.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(cryptoKeyVersionRegexp, "must be a fully qualified KMS crypto key version name"),
+				},
+			},
+			"data": schema.StringAttribute{
+				Description: "The data to sign. KMS computes its digest with the algorithm of the key version.",
+				Optional:    true,
+				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("digest")),
+				},
+			},
+			"digest": schema.StringAttribute{
+				Description: "The digest of the data to sign, encoded in base64. It must be computed with `digest_algorithm`, which matches the algorithm of the key version.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("digest_algorithm")),
+				},
+			},
+			"digest_algorithm": schema.StringAttribute{
+				Description: "The algorithm used to compute `digest`. One of `SHA256`, `SHA384` or `SHA512`.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("SHA256", "SHA384", "SHA512"),
+					stringvalidator.AlsoRequires(path.MatchRoot("digest")),
+				},
+			},
+			"signature": schema.StringAttribute{
+				Description: "The created signature, encoded in base64.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (p *googleEphemeralKmsAsymmetricSign) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	pd, ok := req.ProviderData.(*transport_tpg.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *transport_tpg.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	p.providerConfig = pd
+}
+
+func (p *googleEphemeralKmsAsymmetricSign) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data ephemeralKmsAsymmetricSignModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config := p.providerConfig
+
+	cryptoKeyVersion, err := kmsCryptoKeyVersionName(data.CryptoKeyVersion.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing crypto_key_version", err.Error())
+		return
+	}
+
+	signRequest := &cloudkms.AsymmetricSignRequest{}
+	if !data.Data.IsNull() {
+		signData := []byte(data.Data.ValueString())
+		signRequest.Data = base64.StdEncoding.EncodeToString(signData)
+		signRequest.DataCrc32c = kmsCrc32c(signData)
+	} else {
+		digest, err := base64.StdEncoding.DecodeString(data.Digest.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error decoding digest", err.Error())
+			return
+		}
+		encoded := base64.StdEncoding.EncodeToString(digest)
+		signRequest.Digest = &cloudkms.Digest{}
+		switch data.DigestAlgorithm.ValueString() {
+		case "SHA256":
+			signRequest.Digest.Sha256 = encoded
+		case "SHA384":
+			signRequest.Digest.Sha384 = encoded
+		case "SHA512":
+			signRequest.Digest.Sha512 = encoded
+		}
+		signRequest.DigestCrc32c = kmsCrc32c(digest)
+	}
+
+	client := NewClientWithCtx(ctx, config, config.UserAgent)
+	if client == nil {
+		resp.Diagnostics.AddError("Error creating KMS client", "failed to get a KMS client")
+		return
+	}
+
+	signResponse, err := client.Projects.Locations.KeyRings.CryptoKeys.CryptoKeyVersions.AsymmetricSign(cryptoKeyVersion, signRequest).Do()
+	if err != nil {
+		resp.Diagnostics.AddError("Error signing data", err.Error())
+		return
+	}
+
+	if (signRequest.Digest == nil && !signResponse.VerifiedDataCrc32c) || (signRequest.Digest != nil && !signResponse.VerifiedDigestCrc32c) {
+		resp.Diagnostics.AddError("Error signing data", "asymmetricSign request corrupted in-transit, the checksum was not verified by KMS")
+		return
+	}
+	signature, err := base64.StdEncoding.DecodeString(signResponse.Signature)
+	if err != nil {
+		resp.Diagnostics.AddError("Error decoding base64 response", err.Error())
+		return
+	}
+	if got := kmsCrc32c(signature); got != signResponse.SignatureCrc32c {
+		resp.Diagnostics.AddError("Error signing data", fmt.Sprintf("asymmetricSign response corrupted in-transit, got checksum %x, expected %x", got, signResponse.SignatureCrc32c))
+		return
+	}
+
+	data.Signature = types.StringValue(signResponse.Signature)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, data)...)
+}
@@ -0,0 +1,78 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/services/kms/ephemeral_google_kms_asymmetric_sign_test.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package kms_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-google/google/acctest"
+	"github.com/hashicorp/terraform-provider-google/google/services/kms"
+)
+
+func TestAccEphemeralKmsAsymmetricSign_basic(t *testing.T) {
+	t.Parallel()
+
+	kmsKey := kms.BootstrapKMSKeyWithPurpose(t, "ASYMMETRIC_SIGN")
+	if len(kmsKey.CryptoKeyVersions) == 0 {
+		t.Fatal("no crypto key versions to sign with")
+	}
+
+	context := map[string]interface{}{
+		"crypto_key_version": kmsKey.CryptoKeyVersions[0].Name,
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEphemeralKmsAsymmetricSign_data(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(acctest.EchoResourceName, "data.signature"),
+				),
+			},
+			{
+				Config: testAccEphemeralKmsAsymmetricSign_digest(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(acctest.EchoResourceName, "data.digest_algorithm", "SHA256"),
+					resource.TestCheckResourceAttrSet(acctest.EchoResourceName, "data.signature"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEphemeralKmsAsymmetricSign_data(context map[string]interface{}) string {
+	return acctest.EchoResourceConfig("ephemeral.google_kms_asymmetric_sign.signed") + acctest.Nprintf(`
+ephemeral "google_kms_asymmetric_sign" "signed" {
+  crypto_key_version = "%{crypto_key_version}"
+  data               = "data to sign"
+}
+`, context)
+}
+
+func testAccEphemeralKmsAsymmetricSign_digest(context map[string]interface{}) string {
+	return acctest.EchoResourceConfig("ephemeral.google_kms_asymmetric_sign.signed") + acctest.Nprintf(`
+ephemeral "google_kms_asymmetric_sign" "signed" {
+  crypto_key_version = "%{crypto_key_version}"
+  digest             = base64sha256("data to sign")
+  digest_algorithm   = "SHA256"
+}
+`, context)
+}
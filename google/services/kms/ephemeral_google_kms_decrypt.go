@@ -0,0 +1,164 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/services/kms/ephemeral_google_kms_decrypt.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package kms
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-google/google/registry"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+	"google.golang.org/api/cloudkms/v1"
+)
+
+var _ ephemeral.EphemeralResource = &googleEphemeralKmsDecrypt{}
+
+func init() {
+	registry.FrameworkEphemeralResource{
+		Name:        "google_kms_decrypt",
+		ProductName: "kms",
+		Func:        GoogleEphemeralKmsDecrypt,
+	}.Register()
+}
+
+func GoogleEphemeralKmsDecrypt() ephemeral.EphemeralResource {
+	return &googleEphemeralKmsDecrypt{}
+}
+
+type googleEphemeralKmsDecrypt struct {
+	providerConfig *transport_tpg.Config
+}
+
+func (p *googleEphemeralKmsDecrypt) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kms_decrypt"
+}
+
+type ephemeralKmsDecryptModel struct {
+	CryptoKey                   types.String `tfsdk:"crypto_key"`
+	Ciphertext                  types.String `tfsdk:"ciphertext"`
+	AdditionalAuthenticatedData types.String `tfsdk:"additional_authenticated_data"`
+	Plaintext                   types.String `tfsdk:"plaintext"`
+}
+
+func (p *googleEphemeralKmsDecrypt) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Decrypts a ciphertext that was encrypted with a Google Cloud KMS crypto key.",
+		Attributes: map[string]schema.Attribute{
+			"crypto_key": schema.StringAttribute{
+				Description: "The id of the CryptoKey that was used to encrypt the ciphertext, in the format `{projectId}/{locationId}/{keyRingName}/{cryptoKeyName}` or `{locationId}/{keyRingName}/{cryptoKeyName}`.",
+				Required:    true,
+			},
+			"ciphertext": schema.StringAttribute{
+				Description: "The ciphertext to decrypt, encoded in base64.",
+				Required:    true,
+			},
+			"additional_authenticated_data": schema.StringAttribute{
+				Description: "The additional authenticated data used for integrity checks during encryption and decryption.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"plaintext": schema.StringAttribute{
+				Description: "The decrypted plaintext.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func (p *googleEphemeralKmsDecrypt) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	pd, ok := req.ProviderData.(*transport_tpg.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *transport_tpg.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	p.providerConfig = pd
+}
+
+func (p *googleEphemeralKmsDecrypt) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data ephemeralKmsDecryptModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config := p.providerConfig
+
+	cryptoKeyId, err := ParseKmsCryptoKeyId(data.CryptoKey.ValueString(), config)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing crypto_key", err.Error())
+		return
+	}
+
+	ciphertext, err := base64.StdEncoding.DecodeString(removeWhiteSpaceFromString(data.Ciphertext.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Error decoding ciphertext", err.Error())
+		return
+	}
+
+	decryptRequest := &cloudkms.DecryptRequest{
+		Ciphertext:       base64.StdEncoding.EncodeToString(ciphertext),
+		CiphertextCrc32c: kmsCrc32c(ciphertext),
+	}
+	if aad := data.AdditionalAuthenticatedData.ValueString(); aad != "" {
+		decryptRequest.AdditionalAuthenticatedData = base64.StdEncoding.EncodeToString([]byte(aad))
+		decryptRequest.AdditionalAuthenticatedDataCrc32c = kmsCrc32c([]byte(aad))
+	}
+
+	client := NewClientWithCtx(ctx, config, config.UserAgent)
+	if client == nil {
+		resp.Diagnostics.AddError("Error creating KMS client", "failed to get a KMS client")
+		return
+	}
+
+	decryptCall := client.Projects.Locations.KeyRings.CryptoKeys.Decrypt(cryptoKeyId.CryptoKeyId(), decryptRequest)
+	if config.UserProjectOverride {
+		decryptCall.Header().Set("X-Goog-User-Project", cryptoKeyId.KeyRingId.Project)
+	}
+	decryptResponse, err := decryptCall.Do()
+	if err != nil {
+		resp.Diagnostics.AddError("Error decrypting ciphertext", err.Error())
+		return
+	}
+
+	plaintext, err := base64.StdEncoding.DecodeString(decryptResponse.Plaintext)
+	if err != nil {
+		resp.Diagnostics.AddError("Error decoding base64 response", err.Error())
+		return
+	}
+	if got := kmsCrc32c(plaintext); got != decryptResponse.PlaintextCrc32c {
+		resp.Diagnostics.AddError("Error decrypting ciphertext", fmt.Sprintf("decrypt response corrupted in-transit, got checksum %x, expected %x", got, decryptResponse.PlaintextCrc32c))
+		return
+	}
+
+	data.Plaintext = types.StringValue(string(plaintext))
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, data)...)
+}
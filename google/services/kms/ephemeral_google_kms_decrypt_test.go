@@ -0,0 +1,66 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/services/kms/ephemeral_google_kms_decrypt_test.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package kms_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-google/google/acctest"
+	"github.com/hashicorp/terraform-provider-google/google/services/kms"
+)
+
+func TestAccEphemeralKmsDecrypt_basic(t *testing.T) {
+	t.Parallel()
+
+	kmsKey := kms.BootstrapKMSKey(t)
+
+	context := map[string]interface{}{
+		"crypto_key": kmsKey.CryptoKey.Name,
+		"plaintext":  "my-secret-" + acctest.RandString(t, 10),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEphemeralKmsDecrypt_roundTrip(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(acctest.EchoResourceName, "data.plaintext", context["plaintext"].(string)),
+				),
+			},
+		},
+	})
+}
+
+func testAccEphemeralKmsDecrypt_roundTrip(context map[string]interface{}) string {
+	return acctest.EchoResourceConfig("ephemeral.google_kms_decrypt.decrypted") + acctest.Nprintf(`
+ephemeral "google_kms_encrypt" "encrypted" {
+  crypto_key                    = "%{crypto_key}"
+  plaintext                     = "%{plaintext}"
+  additional_authenticated_data = "aad"
+}
+
+ephemeral "google_kms_decrypt" "decrypted" {
+  crypto_key                    = "%{crypto_key}"
+  ciphertext                    = ephemeral.google_kms_encrypt.encrypted.ciphertext
+  additional_authenticated_data = "aad"
+}
+`, context)
+}
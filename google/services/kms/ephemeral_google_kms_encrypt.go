@@ -0,0 +1,170 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/services/kms/ephemeral_google_kms_encrypt.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package kms
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-google/google/registry"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+	"google.golang.org/api/cloudkms/v1"
+)
+
+var _ ephemeral.EphemeralResource = &googleEphemeralKmsEncrypt{}
+
+func init() {
+	registry.FrameworkEphemeralResource{
+		Name:        "google_kms_encrypt",
+		ProductName: "kms",
+		Func:        GoogleEphemeralKmsEncrypt,
+	}.Register()
+}
+
+func GoogleEphemeralKmsEncrypt() ephemeral.EphemeralResource {
+	return &googleEphemeralKmsEncrypt{}
+}
+
+type googleEphemeralKmsEncrypt struct {
+	providerConfig *transport_tpg.Config
+}
+
+func (p *googleEphemeralKmsEncrypt) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kms_encrypt"
+}
+
+type ephemeralKmsEncryptModel struct {
+	CryptoKey                   types.String `tfsdk:"crypto_key"`
+	Plaintext                   types.String `tfsdk:"plaintext"`
+	AdditionalAuthenticatedData types.String `tfsdk:"additional_authenticated_data"`
+	Ciphertext                  types.String `tfsdk:"ciphertext"`
+	CryptoKeyVersion            types.String `tfsdk:"crypto_key_version"`
+}
+
+func (p *googleEphemeralKmsEncrypt) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Encrypts a plaintext with a Google Cloud KMS crypto key.",
+		Attributes: map[string]schema.Attribute{
+			"crypto_key": schema.StringAttribute{
+				Description: "The id of the CryptoKey to encrypt the plaintext with, in the format `{projectId}/{locationId}/{keyRingName}/{cryptoKeyName}` or `{locationId}/{keyRingName}/{cryptoKeyName}`.",
+				Required:    true,
+			},
+			"plaintext": schema.StringAttribute{
+				Description: "The plaintext to encrypt.",
+				Required:    true,
+				Sensitive:   true,
+			},
+			"additional_authenticated_data": schema.StringAttribute{
+				Description: "The additional authenticated data used for integrity checks during encryption and decryption.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"ciphertext": schema.StringAttribute{
+				Description: "The result of encrypting the plaintext, encoded in base64.",
+				Computed:    true,
+			},
+			"crypto_key_version": schema.StringAttribute{
+				Description: "The resource name of the CryptoKeyVersion used in encryption.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (p *googleEphemeralKmsEncrypt) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	pd, ok := req.ProviderData.(*transport_tpg.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *transport_tpg.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	p.providerConfig = pd
+}
+
+func (p *googleEphemeralKmsEncrypt) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data ephemeralKmsEncryptModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config := p.providerConfig
+
+	cryptoKeyId, err := ParseKmsCryptoKeyId(data.CryptoKey.ValueString(), config)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing crypto_key", err.Error())
+		return
+	}
+
+	plaintext := []byte(data.Plaintext.ValueString())
+	encryptRequest := &cloudkms.EncryptRequest{
+		Plaintext:       base64.StdEncoding.EncodeToString(plaintext),
+		PlaintextCrc32c: kmsCrc32c(plaintext),
+	}
+	aad := data.AdditionalAuthenticatedData.ValueString()
+	if aad != "" {
+		encryptRequest.AdditionalAuthenticatedData = base64.StdEncoding.EncodeToString([]byte(aad))
+		encryptRequest.AdditionalAuthenticatedDataCrc32c = kmsCrc32c([]byte(aad))
+	}
+
+	client := NewClientWithCtx(ctx, config, config.UserAgent)
+	if client == nil {
+		resp.Diagnostics.AddError("Error creating KMS client", "failed to get a KMS client")
+		return
+	}
+
+	encryptCall := client.Projects.Locations.KeyRings.CryptoKeys.Encrypt(cryptoKeyId.CryptoKeyId(), encryptRequest)
+	if config.UserProjectOverride {
+		encryptCall.Header().Set("X-Goog-User-Project", cryptoKeyId.KeyRingId.Project)
+	}
+	encryptResponse, err := encryptCall.Do()
+	if err != nil {
+		resp.Diagnostics.AddError("Error encrypting plaintext", err.Error())
+		return
+	}
+
+	ciphertext, err := base64.StdEncoding.DecodeString(encryptResponse.Ciphertext)
+	if err != nil {
+		resp.Diagnostics.AddError("Error decoding base64 response", err.Error())
+		return
+	}
+	if !encryptResponse.VerifiedPlaintextCrc32c || (aad != "" && !encryptResponse.VerifiedAdditionalAuthenticatedDataCrc32c) {
+		resp.Diagnostics.AddError("Error encrypting plaintext", "encrypt request corrupted in-transit, the checksums were not verified by KMS")
+		return
+	}
+	if got := kmsCrc32c(ciphertext); got != encryptResponse.CiphertextCrc32c {
+		resp.Diagnostics.AddError("Error encrypting plaintext", fmt.Sprintf("encrypt response corrupted in-transit, got checksum %x, expected %x", got, encryptResponse.CiphertextCrc32c))
+		return
+	}
+
+	data.Ciphertext = types.StringValue(encryptResponse.Ciphertext)
+	data.CryptoKeyVersion = types.StringValue(encryptResponse.Name)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, data)...)
+}
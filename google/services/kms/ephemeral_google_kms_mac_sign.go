@@ -0,0 +1,155 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/services/kms/ephemeral_google_kms_mac_sign.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package kms
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-google/google/registry"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+	"google.golang.org/api/cloudkms/v1"
+)
+
+var _ ephemeral.EphemeralResource = &googleEphemeralKmsMacSign{}
+
+func init() {
+	registry.FrameworkEphemeralResource{
+		Name:        "google_kms_mac_sign",
+		ProductName: "kms",
+		Func:        GoogleEphemeralKmsMacSign,
+	}.Register()
+}
+
+func GoogleEphemeralKmsMacSign() ephemeral.EphemeralResource {
+	return &googleEphemeralKmsMacSign{}
+}
+
+type googleEphemeralKmsMacSign struct {
+	providerConfig *transport_tpg.Config
+}
+
+func (p *googleEphemeralKmsMacSign) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kms_mac_sign"
+}
+
+type ephemeralKmsMacSignModel struct {
+	CryptoKeyVersion types.String `tfsdk:"crypto_key_version"`
+	Data             types.String `tfsdk:"data"`
+	Mac              types.String `tfsdk:"mac"`
+}
+
+func (p *googleEphemeralKmsMacSign) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates a MAC tag for data with a Google Cloud KMS crypto key version.",
+		Attributes: map[string]schema.Attribute{
+			"crypto_key_version": schema.StringAttribute{
+				Description: "The fully qualified name of the CryptoKeyVersion to sign with. Its purpose must be MAC.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(cryptoKeyVersionRegexp, "must be a fully qualified KMS crypto key version name"),
+				},
+			},
+			"data": schema.StringAttribute{
+				Description: "The data to create a MAC tag for.",
+				Required:    true,
+				Sensitive:   true,
+			},
+			"mac": schema.StringAttribute{
+				Description: "The created MAC tag, encoded in base64.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func (p *googleEphemeralKmsMacSign) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	pd, ok := req.ProviderData.(*transport_tpg.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *transport_tpg.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	p.providerConfig = pd
+}
+
+func (p *googleEphemeralKmsMacSign) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data ephemeralKmsMacSignModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config := p.providerConfig
+
+	cryptoKeyVersion, err := kmsCryptoKeyVersionName(data.CryptoKeyVersion.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing crypto_key_version", err.Error())
+		return
+	}
+
+	signData := []byte(data.Data.ValueString())
+	signRequest := &cloudkms.MacSignRequest{
+		Data:       base64.StdEncoding.EncodeToString(signData),
+		DataCrc32c: kmsCrc32c(signData),
+	}
+
+	client := NewClientWithCtx(ctx, config, config.UserAgent)
+	if client == nil {
+		resp.Diagnostics.AddError("Error creating KMS client", "failed to get a KMS client")
+		return
+	}
+
+	signResponse, err := client.Projects.Locations.KeyRings.CryptoKeys.CryptoKeyVersions.MacSign(cryptoKeyVersion, signRequest).Do()
+	if err != nil {
+		resp.Diagnostics.AddError("Error signing data", err.Error())
+		return
+	}
+
+	if !signResponse.VerifiedDataCrc32c {
+		resp.Diagnostics.AddError("Error signing data", "macSign request corrupted in-transit, the checksum was not verified by KMS")
+		return
+	}
+	mac, err := base64.StdEncoding.DecodeString(signResponse.Mac)
+	if err != nil {
+		resp.Diagnostics.AddError("Error decoding base64 response", err.Error())
+		return
+	}
+	if got := kmsCrc32c(mac); got != signResponse.MacCrc32c {
+		resp.Diagnostics.AddError("Error signing data", fmt.Sprintf("macSign response corrupted in-transit, got checksum %x, expected %x", got, signResponse.MacCrc32c))
+		return
+	}
+
+	data.Mac = types.StringValue(signResponse.Mac)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, data)...)
+}
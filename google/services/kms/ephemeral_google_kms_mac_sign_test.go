@@ -0,0 +1,61 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/services/kms/ephemeral_google_kms_mac_sign_test.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package kms_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-google/google/acctest"
+	"github.com/hashicorp/terraform-provider-google/google/services/kms"
+)
+
+func TestAccEphemeralKmsMacSign_basic(t *testing.T) {
+	t.Parallel()
+
+	kmsKey := kms.BootstrapKMSKeyWithPurpose(t, "MAC")
+	if len(kmsKey.CryptoKeyVersions) == 0 {
+		t.Fatal("no crypto key versions to sign with")
+	}
+
+	context := map[string]interface{}{
+		"crypto_key_version": kmsKey.CryptoKeyVersions[0].Name,
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEphemeralKmsMacSign_basic(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(acctest.EchoResourceName, "data.mac"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEphemeralKmsMacSign_basic(context map[string]interface{}) string {
+	return acctest.EchoResourceConfig("ephemeral.google_kms_mac_sign.signed") + acctest.Nprintf(`
+ephemeral "google_kms_mac_sign" "signed" {
+  crypto_key_version = "%{crypto_key_version}"
+  data               = "data to sign"
+}
+`, context)
+}
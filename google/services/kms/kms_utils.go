@@ -18,6 +18,7 @@ package kms
 
 import (
 	"fmt"
+	"hash/crc32"
 	"regexp"
 	"strconv"
 	"strings"
@@ -303,3 +304,20 @@ func disableCryptoKeyRotation(cryptoKeyId *KmsCryptoKeyId, userAgent string, con
 
 	return err
 }
+
+// kmsCrc32c returns the CRC32C checksum that KMS uses to verify the integrity of request and
+// response data.
+func kmsCrc32c(data []byte) int64 {
+	return int64(crc32.Checksum(data, crc32.MakeTable(crc32.Castagnoli)))
+}
+
+// kmsCryptoKeyVersionName returns the relative resource name of a crypto key version. The id of
+// `google_kms_crypto_key_version` is prefixed with //cloudkms.googleapis.com/v1, which is an
+// invalid name, so it's accepted and removed.
+func kmsCryptoKeyVersionName(id string) (string, error) {
+	parts := cryptoKeyVersionRegexp.FindStringSubmatch(id)
+	if parts == nil {
+		return "", fmt.Errorf("Invalid CryptoKeyVersion id format, expecting `projects/{projectId}/locations/{locationId}/keyRings/{keyRingName}/cryptoKeys/{cryptoKeyName}/cryptoKeyVersions/{version}`, got id: %s", id)
+	}
+	return parts[len(parts)-1], nil
+}
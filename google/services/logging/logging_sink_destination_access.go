@@ -0,0 +1,197 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/services/logging/logging_sink_destination_access.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package logging
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-google/google/services/bigquery"
+	"github.com/hashicorp/terraform-provider-google/google/services/pubsub"
+	"github.com/hashicorp/terraform-provider-google/google/services/resourcemanager"
+	"github.com/hashicorp/terraform-provider-google/google/services/storage"
+	"github.com/hashicorp/terraform-provider-google/google/tpgiamresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+)
+
+var (
+	loggingSinkStorageDestinationRegex   = regexp.MustCompile(`^storage\.googleapis\.com/([^/]+)$`)
+	loggingSinkBigqueryDestinationRegex  = regexp.MustCompile(`^bigquery\.googleapis\.com/projects/([^/]+)/datasets/([^/]+)$`)
+	loggingSinkPubsubDestinationRegex    = regexp.MustCompile(`^pubsub\.googleapis\.com/projects/([^/]+)/topics/([^/]+)$`)
+	loggingSinkLogBucketDestinationRegex = regexp.MustCompile(`^logging\.googleapis\.com/projects/([^/]+)/locations/[^/]+/buckets/[^/]+$`)
+	loggingSinkProjectDestinationRegex   = regexp.MustCompile(`^logging\.googleapis\.com/projects/([^/]+)$`)
+)
+
+// loggingSinkDestination describes the grant a sink writer identity needs on
+// the resource its logs are routed to.
+type loggingSinkDestination struct {
+	role string
+	// The IAM schema, updater and field values of the resource the role is
+	// granted on.
+	iamSchema  map[string]*schema.Schema
+	newUpdater tpgiamresource.NewResourceIamUpdaterFunc
+	fields     map[string]string
+}
+
+// parseLoggingSinkDestination returns the grant needed to write to a sink
+// destination, or nil if the sink needs no grant, as for log buckets in the
+// sink's own project.
+func parseLoggingSinkDestination(sinkId *LoggingSinkId, destination string) (*loggingSinkDestination, error) {
+	if m := loggingSinkStorageDestinationRegex.FindStringSubmatch(destination); m != nil {
+		return &loggingSinkDestination{
+			role:       "roles/storage.objectCreator",
+			iamSchema:  storage.StorageBucketIamSchema,
+			newUpdater: storage.StorageBucketIamUpdaterProducer,
+			fields:     map[string]string{"bucket": m[1]},
+		}, nil
+	}
+	if m := loggingSinkBigqueryDestinationRegex.FindStringSubmatch(destination); m != nil {
+		return &loggingSinkDestination{
+			role:       "roles/bigquery.dataEditor",
+			iamSchema:  bigquery.IamBigqueryDatasetSchema,
+			newUpdater: bigquery.NewBigqueryDatasetIamUpdater,
+			fields:     map[string]string{"project": m[1], "dataset_id": m[2]},
+		}, nil
+	}
+	if m := loggingSinkPubsubDestinationRegex.FindStringSubmatch(destination); m != nil {
+		return &loggingSinkDestination{
+			role:       "roles/pubsub.publisher",
+			iamSchema:  pubsub.PubsubTopicIamSchema,
+			newUpdater: pubsub.PubsubTopicIamUpdaterProducer,
+			fields:     map[string]string{"project": m[1], "topic": m[2]},
+		}, nil
+	}
+
+	role := ""
+	var m []string
+	if m = loggingSinkLogBucketDestinationRegex.FindStringSubmatch(destination); m != nil {
+		role = "roles/logging.bucketWriter"
+	} else if m = loggingSinkProjectDestinationRegex.FindStringSubmatch(destination); m != nil {
+		role = "roles/logging.logWriter"
+	} else {
+		return nil, fmt.Errorf("cannot grant access to destination %q: grant_destination_access supports Cloud Storage buckets, BigQuery datasets, Pub/Sub topics, log buckets and projects", destination)
+	}
+	if sinkId.resourceType == "projects" && sinkId.resourceId == m[1] {
+		return nil, nil
+	}
+	return &loggingSinkDestination{
+		role:       role,
+		iamSchema:  resourcemanager.IamProjectSchema,
+		newUpdater: resourcemanager.NewProjectIamUpdater,
+		fields:     map[string]string{"project": m[1]},
+	}, nil
+}
+
+func (dest *loggingSinkDestination) updater(config *transport_tpg.Config) (tpgiamresource.ResourceIamUpdater, error) {
+	d := (&schema.Resource{Schema: dest.iamSchema}).Data(nil)
+	for k, v := range dest.fields {
+		if err := d.Set(k, v); err != nil {
+			return nil, fmt.Errorf("Error setting %s: %s", k, err)
+		}
+	}
+	return dest.newUpdater(d, config)
+}
+
+// loggingSinkGrantDestinationAccess grants the writer identity of a sink the
+// role needed to write to its destination. It reports whether the role was
+// granted, as opposed to already held by the writer identity.
+func loggingSinkGrantDestinationAccess(config *transport_tpg.Config, id, destination, writerIdentity string) (bool, error) {
+	sinkId, err := ParseLoggingSinkId(id)
+	if err != nil {
+		return false, err
+	}
+	dest, err := parseLoggingSinkDestination(sinkId, destination)
+	if err != nil || dest == nil || writerIdentity == "" {
+		return false, err
+	}
+	updater, err := dest.updater(config)
+	if err != nil {
+		return false, err
+	}
+	log.Printf("[DEBUG] Granting %s %s on %s for logging sink %s", writerIdentity, dest.role, updater.DescribeResource(), id)
+	granted, err := tpgiamresource.AddIamMember(updater, dest.role, writerIdentity)
+	if err != nil {
+		return false, fmt.Errorf("Error granting %s to the writer identity of logging sink %s: %s", dest.role, id, err)
+	}
+	if !granted {
+		log.Printf("[DEBUG] %s already has %s on %s, it won't be revoked with logging sink %s", writerIdentity, dest.role, updater.DescribeResource(), id)
+	}
+	return granted, nil
+}
+
+// loggingSinkRevokeDestinationAccess revokes the role granted by
+// loggingSinkGrantDestinationAccess. Callers must only revoke a role that was
+// granted, as recorded in destination_access_granted. A destination that no
+// longer exists is not an error.
+func loggingSinkRevokeDestinationAccess(config *transport_tpg.Config, id, destination, writerIdentity string) error {
+	sinkId, err := ParseLoggingSinkId(id)
+	if err != nil {
+		return err
+	}
+	dest, err := parseLoggingSinkDestination(sinkId, destination)
+	if err != nil || dest == nil || writerIdentity == "" {
+		return err
+	}
+	updater, err := dest.updater(config)
+	if err != nil {
+		return err
+	}
+	log.Printf("[DEBUG] Revoking %s %s on %s for logging sink %s", writerIdentity, dest.role, updater.DescribeResource(), id)
+	if err := tpgiamresource.RemoveIamMember(updater, dest.role, writerIdentity); err != nil {
+		if transport_tpg.IsGoogleApiErrorWithCode(err, 404) {
+			return nil
+		}
+		return fmt.Errorf("Error revoking %s from the writer identity of logging sink %s: %s", dest.role, id, err)
+	}
+	return nil
+}
+
+// loggingSinkUpdateDestinationAccess brings the destination grant of a sink in
+// line with its grant_destination_access field after it is created or
+// updated, recording in destination_access_granted whether the grant was
+// added by the sink. It must be called once the sink has been read, so that
+// writer_identity is known.
+func loggingSinkUpdateDestinationAccess(d *schema.ResourceData, config *transport_tpg.Config) error {
+	oldGrant, newGrant := d.GetChange("grant_destination_access")
+	oldDestination, newDestination := d.GetChange("destination")
+	oldWriterIdentity, newWriterIdentity := d.GetChange("writer_identity")
+	if oldGrant == newGrant && oldDestination == newDestination && oldWriterIdentity == newWriterIdentity {
+		return nil
+	}
+
+	granted := d.Get("destination_access_granted").(bool)
+	if granted {
+		if err := loggingSinkRevokeDestinationAccess(config, d.Id(), oldDestination.(string), oldWriterIdentity.(string)); err != nil {
+			return err
+		}
+		granted = false
+	}
+	if newGrant.(bool) {
+		var err error
+		granted, err = loggingSinkGrantDestinationAccess(config, d.Id(), newDestination.(string), newWriterIdentity.(string))
+		if err != nil {
+			return err
+		}
+	}
+	if err := d.Set("destination_access_granted", granted); err != nil {
+		return fmt.Errorf("Error setting destination_access_granted: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/services/logging/logging_sink_destination_access_test.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package logging
+
+import (
+	"testing"
+
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+)
+
+func TestParseLoggingSinkDestination(t *testing.T) {
+	projectSink := &LoggingSinkId{"projects", "my-project", "my-sink"}
+	folderSink := &LoggingSinkId{"folders", "my-folder", "my-sink"}
+
+	tests := []struct {
+		sinkId      *LoggingSinkId
+		destination string
+		role        string
+		resourceId  string
+		errExpected bool
+	}{
+		{projectSink, "storage.googleapis.com/my-bucket", "roles/storage.objectCreator", "b/my-bucket", false},
+		{projectSink, "bigquery.googleapis.com/projects/other-project/datasets/my_dataset", "roles/bigquery.dataEditor", "projects/other-project/datasets/my_dataset", false},
+		{projectSink, "pubsub.googleapis.com/projects/other-project/topics/my-topic", "roles/pubsub.publisher", "projects/other-project/topics/my-topic", false},
+		{projectSink, "logging.googleapis.com/projects/other-project/locations/global/buckets/my-bucket", "roles/logging.bucketWriter", "other-project", false},
+		{projectSink, "logging.googleapis.com/projects/other-project", "roles/logging.logWriter", "other-project", false},
+		{folderSink, "logging.googleapis.com/projects/my-project/locations/global/buckets/my-bucket", "roles/logging.bucketWriter", "my-project", false},
+		// Sinks routing to their own project need no grant.
+		{projectSink, "logging.googleapis.com/projects/my-project/locations/global/buckets/my-bucket", "", "", false},
+		{projectSink, "spanner.googleapis.com/projects/my-project/instances/my-instance", "", "", true},
+	}
+
+	config := &transport_tpg.Config{}
+	for _, test := range tests {
+		dest, err := parseLoggingSinkDestination(test.sinkId, test.destination)
+		if err != nil {
+			if !test.errExpected {
+				t.Errorf("Got error with destination %#v: error = %#v", test.destination, err)
+			}
+			continue
+		}
+		if test.errExpected {
+			t.Errorf("Expected error with destination %#v", test.destination)
+			continue
+		}
+		if dest == nil {
+			if test.role != "" {
+				t.Errorf("Expected role %s for destination %#v but got no grant", test.role, test.destination)
+			}
+			continue
+		}
+		if dest.role != test.role {
+			t.Errorf("Mismatch on destination %#v: expected role %s but got %s", test.destination, test.role, dest.role)
+		}
+		updater, err := dest.updater(config)
+		if err != nil {
+			t.Errorf("Got error creating the updater for destination %#v: error = %#v", test.destination, err)
+			continue
+		}
+		if updater.GetResourceId() != test.resourceId {
+			t.Errorf("Mismatch on destination %#v: expected resource %s but got %s", test.destination, test.resourceId, updater.GetResourceId())
+		}
+	}
+}
@@ -66,7 +66,10 @@ func resourceLoggingBillingAccountSinkCreate(d *schema.ResourceData, meta interf
 	}
 
 	d.SetId(id.canonicalId())
-	return resourceLoggingBillingAccountSinkRead(d, meta)
+	if err := resourceLoggingBillingAccountSinkRead(d, meta); err != nil {
+		return err
+	}
+	return loggingSinkUpdateDestinationAccess(d, config)
 }
 
 func resourceLoggingBillingAccountSinkRead(d *schema.ResourceData, meta interface{}) error {
@@ -113,7 +116,10 @@ func resourceLoggingBillingAccountSinkUpdate(d *schema.ResourceData, meta interf
 		return err
 	}
 
-	return resourceLoggingBillingAccountSinkRead(d, meta)
+	if err := resourceLoggingBillingAccountSinkRead(d, meta); err != nil {
+		return err
+	}
+	return loggingSinkUpdateDestinationAccess(d, config)
 }
 
 func resourceLoggingBillingAccountSinkDelete(d *schema.ResourceData, meta interface{}) error {
@@ -135,6 +141,12 @@ func resourceLoggingBillingAccountSinkDelete(d *schema.ResourceData, meta interf
 		return err
 	}
 
+	if d.Get("destination_access_granted").(bool) {
+		if err := loggingSinkRevokeDestinationAccess(config, d.Id(), d.Get("destination").(string), d.Get("writer_identity").(string)); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -87,7 +87,10 @@ func resourceLoggingFolderSinkCreate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	d.SetId(id.canonicalId())
-	return resourceLoggingFolderSinkRead(d, meta)
+	if err := resourceLoggingFolderSinkRead(d, meta); err != nil {
+		return err
+	}
+	return loggingSinkUpdateDestinationAccess(d, config)
 }
 
 func resourceLoggingFolderSinkRead(d *schema.ResourceData, meta interface{}) error {
@@ -142,7 +145,10 @@ func resourceLoggingFolderSinkUpdate(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 
-	return resourceLoggingFolderSinkRead(d, meta)
+	if err := resourceLoggingFolderSinkRead(d, meta); err != nil {
+		return err
+	}
+	return loggingSinkUpdateDestinationAccess(d, config)
 }
 
 func resourceLoggingFolderSinkDelete(d *schema.ResourceData, meta interface{}) error {
@@ -164,6 +170,12 @@ func resourceLoggingFolderSinkDelete(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 
+	if d.Get("destination_access_granted").(bool) {
+		if err := loggingSinkRevokeDestinationAccess(config, d.Id(), d.Get("destination").(string), d.Get("writer_identity").(string)); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
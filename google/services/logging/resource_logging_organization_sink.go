@@ -86,7 +86,10 @@ func resourceLoggingOrganizationSinkCreate(d *schema.ResourceData, meta interfac
 	}
 
 	d.SetId(id.canonicalId())
-	return resourceLoggingOrganizationSinkRead(d, meta)
+	if err := resourceLoggingOrganizationSinkRead(d, meta); err != nil {
+		return err
+	}
+	return loggingSinkUpdateDestinationAccess(d, config)
 }
 
 func resourceLoggingOrganizationSinkRead(d *schema.ResourceData, meta interface{}) error {
@@ -141,7 +144,10 @@ func resourceLoggingOrganizationSinkUpdate(d *schema.ResourceData, meta interfac
 		return err
 	}
 
-	return resourceLoggingOrganizationSinkRead(d, meta)
+	if err := resourceLoggingOrganizationSinkRead(d, meta); err != nil {
+		return err
+	}
+	return loggingSinkUpdateDestinationAccess(d, config)
 }
 
 func resourceLoggingOrganizationSinkDelete(d *schema.ResourceData, meta interface{}) error {
@@ -163,6 +169,12 @@ func resourceLoggingOrganizationSinkDelete(d *schema.ResourceData, meta interfac
 		return err
 	}
 
+	if d.Get("destination_access_granted").(bool) {
+		if err := loggingSinkRevokeDestinationAccess(config, d.Id(), d.Get("destination").(string), d.Get("writer_identity").(string)); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -106,7 +106,10 @@ func resourceLoggingProjectSinkAcquireOrCreate(d *schema.ResourceData, meta inte
 		}
 
 		d.SetId(id.canonicalId())
-		return resourceLoggingProjectSinkRead(d, meta)
+		if err := resourceLoggingProjectSinkRead(d, meta); err != nil {
+			return err
+		}
+		return loggingSinkUpdateDestinationAccess(d, config)
 	}
 	d.SetId(id.canonicalId())
 
@@ -231,7 +234,10 @@ func resourceLoggingProjectSinkUpdate(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
-	return resourceLoggingProjectSinkRead(d, meta)
+	if err := resourceLoggingProjectSinkRead(d, meta); err != nil {
+		return err
+	}
+	return loggingSinkUpdateDestinationAccess(d, config)
 }
 
 func resourceLoggingProjectSinkDelete(d *schema.ResourceData, meta interface{}) error {
@@ -261,6 +267,12 @@ func resourceLoggingProjectSinkDelete(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
+	if d.Get("destination_access_granted").(bool) {
+		if err := loggingSinkRevokeDestinationAccess(config, d.Id(), d.Get("destination").(string), d.Get("writer_identity").(string)); err != nil {
+			return err
+		}
+	}
+
 	d.SetId("")
 	return nil
 }
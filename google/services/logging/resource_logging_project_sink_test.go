@@ -18,6 +18,8 @@ package logging_test
 
 import (
 	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -369,6 +371,47 @@ func TestAccLoggingProjectSink_disabled_update(t *testing.T) {
 	})
 }
 
+func TestAccLoggingProjectSink_grantDestinationAccess(t *testing.T) {
+	t.Parallel()
+
+	sinkName := "tf-test-sink-" + acctest.RandString(t, 10)
+	bucketName := "tf-test-sink-bucket-" + acctest.RandString(t, 10)
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckLoggingProjectSinkDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLoggingProjectSink_grantDestinationAccess(sinkName, bucketName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_logging_project_sink.grant", "destination_access_granted", "true"),
+					resource.TestMatchResourceAttr("data.google_storage_bucket_iam_policy.policy", "policy_data", regexp.MustCompile("roles/storage.objectCreator")),
+				),
+			},
+			{
+				ResourceName:            "google_logging_project_sink.grant",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"grant_destination_access", "destination_access_granted"},
+			},
+			{
+				Config: testAccLoggingProjectSink_grantDestinationAccess(sinkName, bucketName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_logging_project_sink.grant", "grant_destination_access", "false"),
+					resource.TestCheckResourceAttr("google_logging_project_sink.grant", "destination_access_granted", "false"),
+					resource.TestCheckResourceAttrWith("data.google_storage_bucket_iam_policy.policy", "policy_data", func(policy string) error {
+						if strings.Contains(policy, "roles/storage.objectCreator") {
+							return fmt.Errorf("expected roles/storage.objectCreator to be revoked, got policy %s", policy)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckLoggingProjectSinkDestroyProducer(t *testing.T) func(s *terraform.State) error {
 	return func(s *terraform.State) error {
 		config := acctest.GoogleProviderConfig(t)
@@ -736,3 +779,27 @@ resource "google_logging_project_sink" "loggingbucket" {
 
 `, name, project, project)
 }
+
+func testAccLoggingProjectSink_grantDestinationAccess(name, bucketName string, grant bool) string {
+	return fmt.Sprintf(`
+resource "google_storage_bucket" "gcs-bucket" {
+  name     = "%s"
+  location = "US"
+  uniform_bucket_level_access = true
+}
+
+resource "google_logging_project_sink" "grant" {
+  name        = "%s"
+  destination = "storage.googleapis.com/${google_storage_bucket.gcs-bucket.name}"
+  filter      = "severity>=ERROR"
+
+  grant_destination_access = %t
+}
+
+data "google_storage_bucket_iam_policy" "policy" {
+  bucket = google_storage_bucket.gcs-bucket.name
+
+  depends_on = [google_logging_project_sink.grant]
+}
+`, bucketName, name, grant)
+}
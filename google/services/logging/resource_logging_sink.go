@@ -119,6 +119,19 @@ func resourceLoggingSinkSchema() map[string]*schema.Schema {
 			},
 		},
 
+		"grant_destination_access": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: `If set to true, the minimal role needed to write to the destination is granted to writer_identity on the destination, and revoked when the sink is deleted or its destination changes. Supports Cloud Storage buckets, BigQuery datasets, Pub/Sub topics, log buckets and projects.`,
+		},
+
+		"destination_access_granted": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: `Whether the role requested by grant_destination_access was granted by this resource. It is false when writer_identity already had the role, in which case the role is not revoked.`,
+		},
+
 		//UDP schema start
 		"deletion_policy": tpgresource.DeletionPolicySchemaEntry("DELETE"),
 		//UDP schema end
@@ -169,6 +182,11 @@ func flattenResourceLoggingSink(d *schema.ResourceData, sink *logging.LogSink) e
 	if err := d.Set("bigquery_options", flattenLoggingSinkBigqueryOptions(sink.BigqueryOptions)); err != nil {
 		return fmt.Errorf("Error setting bigquery_options: %s", err)
 	}
+	if _, ok := d.GetOkExists("grant_destination_access"); !ok {
+		if err := d.Set("grant_destination_access", false); err != nil {
+			return fmt.Errorf("Error setting grant_destination_access: %s", err)
+		}
+	}
 
 	return nil
 }
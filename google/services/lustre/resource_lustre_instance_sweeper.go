@@ -194,7 +194,7 @@ func deleteResourceLustreInstance(config *transport_tpg.Config, d *tpgresource.R
 	}
 
 	// Skip resources that shouldn't be sweeped
-	if !sweeper.IsSweepableTestResource(name) {
+	if !sweeper.ShouldSweep(name, obj) {
 		return nil
 	}
 
@@ -194,7 +194,7 @@ func deleteResourceManagedKafkaConnectCluster(config *transport_tpg.Config, d *t
 	}
 
 	// Skip resources that shouldn't be sweeped
-	if !sweeper.IsSweepableTestResource(name) {
+	if !sweeper.ShouldSweep(name, obj) {
 		return nil
 	}
 
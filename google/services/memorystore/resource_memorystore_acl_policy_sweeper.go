@@ -194,7 +194,7 @@ func deleteResourceMemorystoreAclPolicy(config *transport_tpg.Config, d *tpgreso
 	}
 
 	// Skip resources that shouldn't be sweeped
-	if !sweeper.IsSweepableTestResource(name) {
+	if !sweeper.ShouldSweep(name, obj) {
 		return nil
 	}
 
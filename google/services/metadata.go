@@ -0,0 +1,35 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/services/metadata.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package services
+
+import (
+	"embed"
+	"sync"
+
+	"github.com/hashicorp/terraform-provider-google/google/cai"
+)
+
+// metadataFS holds the resource metadata files of every service package.
+//
+//go:embed */resource_*_meta.yaml
+var metadataFS embed.FS
+
+// Metadata returns the resource metadata embedded in the provider, keyed by
+// resource type. It is loaded on first use.
+var Metadata = sync.OnceValues(func() (map[string]cai.Metadata, error) {
+	return cai.LoadMetadataFS(metadataFS)
+})
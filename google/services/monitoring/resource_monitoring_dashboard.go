@@ -17,8 +17,11 @@
 package monitoring
 
 import (
+	"context"
 	"fmt"
+	"log"
 	"reflect"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-provider-google/google/registry"
@@ -93,12 +96,15 @@ func ResourceMonitoringDashboard() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			tpgresource.DefaultProviderProject,
 			tpgresource.DefaultProviderDeletionPolicy("DELETE"),
+			resourceMonitoringDashboardTypedCustomizeDiff,
 		),
 
-		Schema: map[string]*schema.Schema{
+		Schema: tpgresource.MergeSchemas(map[string]*schema.Schema{
 			"dashboard_json": {
 				Type:             schema.TypeString,
-				Required:         true,
+				Optional:         true,
+				Computed:         true,
+				ExactlyOneOf:     []string{"dashboard_json", "display_name"},
 				ValidateFunc:     validation.StringIsJSON,
 				DiffSuppressFunc: monitoringDashboardDiffSuppress,
 				StateFunc: func(v interface{}) string {
@@ -117,11 +123,43 @@ func ResourceMonitoringDashboard() *schema.Resource {
 			//UDP schema start
 			"deletion_policy": tpgresource.DeletionPolicySchemaEntry("DELETE"),
 			//UDP schema end
-		},
+		}, monitoringDashboardTypedSchema()),
 		UseJSONNumber: true,
 	}
 }
 
+// resourceMonitoringDashboardTypedCustomizeDiff plans dashboard_json from the
+// typed form, so the API calls only deal with the JSON form.
+func resourceMonitoringDashboardTypedCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if _, ok := d.GetOk("display_name"); !ok {
+		return nil
+	}
+	typed := map[string]interface{}{}
+	changed := false
+	for _, k := range append([]string{"display_name"}, monitoringDashboardLayouts...) {
+		if !d.NewValueKnown(k) {
+			return d.SetNewComputed("dashboard_json")
+		}
+		typed[k] = d.Get(k)
+		changed = changed || d.HasChange(k)
+	}
+	obj, err := ExpandMonitoringDashboardTyped(typed)
+	if err != nil {
+		return err
+	}
+	str, err := structure.FlattenJsonToString(obj)
+	if err != nil {
+		return err
+	}
+	// DiffSuppressFunc isn't applied to values set in CustomizeDiff, so keep
+	// the old value when the dashboards are equivalent. Zero values are
+	// omitted from the JSON, so a change of the typed form is always planned.
+	if old, ok := d.Get("dashboard_json").(string); ok && old != "" && !changed && monitoringDashboardDiffSuppress("dashboard_json", old, str, nil) {
+		return nil
+	}
+	return d.SetNew("dashboard_json", str)
+}
+
 func resourceMonitoringDashboardCreate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*transport_tpg.Config)
 	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
@@ -204,6 +242,18 @@ func resourceMonitoringDashboardRead(d *schema.ResourceData, meta interface{}) e
 		return fmt.Errorf("Error reading Dashboard: %s", err)
 	}
 
+	if _, ok := d.GetOk("display_name"); ok {
+		typed, unsupported := FlattenMonitoringDashboardTyped(res)
+		if len(unsupported) > 0 {
+			log.Printf("[WARN] Dashboard %q has fields that the typed form doesn't support, they are dropped: %s", d.Id(), strings.Join(unsupported, ", "))
+		}
+		for _, k := range append([]string{"display_name"}, monitoringDashboardLayouts...) {
+			if err := d.Set(k, typed[k]); err != nil {
+				return fmt.Errorf("Error reading Dashboard: %s", err)
+			}
+		}
+	}
+
 	if err := tpgresource.DeletionPolicyReadDefault(d, config, "DELETE"); err != nil {
 		return err
 	}
@@ -18,6 +18,7 @@ package monitoring_test
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -147,6 +148,57 @@ func TestAccMonitoringDashboard_update(t *testing.T) {
 	})
 }
 
+func TestAccMonitoringDashboard_typed(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(t, 10),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckMonitoringDashboardDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMonitoringDashboard_typed(context, 6),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_monitoring_dashboard.dashboard", "mosaic_layout.0.tiles.#", "3"),
+					resource.TestCheckResourceAttrSet("google_monitoring_dashboard.dashboard", "dashboard_json"),
+				),
+			},
+			{
+				ResourceName:            "google_monitoring_dashboard.dashboard",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"project", "display_name", "mosaic_layout"},
+			},
+			{
+				Config: testAccMonitoringDashboard_typed(context, 12),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_monitoring_dashboard.dashboard", "mosaic_layout.0.tiles.0.width", "12"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMonitoringDashboard_typedInvalid(t *testing.T) {
+	t.Parallel()
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckMonitoringDashboardDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccMonitoringDashboard_typedInvalid(),
+				ExpectError: regexp.MustCompile("exactly one of time_series_filter, time_series_query_language, prometheus_query must be set"),
+			},
+		},
+	})
+}
+
 func testAccCheckMonitoringDashboardDestroyProducer(t *testing.T) func(s *terraform.State) error {
 	return func(s *terraform.State) error {
 		for name, rs := range s.RootModule().Resources {
@@ -353,3 +405,106 @@ EOF
 }
 `)
 }
+
+func testAccMonitoringDashboard_typed(context map[string]interface{}, width int) string {
+	context["width"] = width
+	return acctest.Nprintf(`
+resource "google_monitoring_dashboard" "dashboard" {
+  display_name = "tf-test-typed-%{random_suffix}"
+
+  mosaic_layout {
+    columns = 12
+
+    tiles {
+      width  = %{width}
+      height = 4
+
+      widget {
+        title = "Accepted connections"
+
+        xy_chart {
+          data_sets {
+            plot_type = "LINE"
+
+            time_series_query {
+              time_series_filter {
+                filter = "metric.type=\"agent.googleapis.com/nginx/connections/accepted_count\" resource.type=\"gce_instance\""
+
+                aggregation {
+                  alignment_period   = "60s"
+                  per_series_aligner = "ALIGN_RATE"
+                }
+              }
+            }
+          }
+
+          y_axis {
+            label = "y1Axis"
+            scale = "LINEAR"
+          }
+        }
+      }
+    }
+
+    tiles {
+      y_pos  = 4
+      width  = 6
+      height = 4
+
+      widget {
+        title = "CPU"
+
+        scorecard {
+          time_series_query {
+            prometheus_query = "avg(rate(compute_googleapis_com:instance_cpu_usage_time[5m]))"
+          }
+
+          gauge_view {
+            upper_bound = 1
+          }
+
+          thresholds {
+            value     = 0.8
+            color     = "RED"
+            direction = "ABOVE"
+          }
+        }
+      }
+    }
+
+    tiles {
+      x_pos  = 6
+      y_pos  = 4
+      width  = 6
+      height = 4
+
+      widget {
+        text {
+          content = "Managed by Terraform"
+          format  = "MARKDOWN"
+        }
+      }
+    }
+  }
+}
+`, context)
+}
+
+func testAccMonitoringDashboard_typedInvalid() string {
+	return `
+resource "google_monitoring_dashboard" "dashboard" {
+  display_name = "tf-test-typed-invalid"
+
+  grid_layout {
+    widgets {
+      scorecard {
+        time_series_query {
+          prometheus_query           = "up"
+          time_series_query_language = "fetch gce_instance"
+        }
+      }
+    }
+  }
+}
+`
+}
@@ -0,0 +1,481 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/services/monitoring/resource_monitoring_dashboard_typed.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package monitoring
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-google/google/verify"
+)
+
+// The typed form of a dashboard is described once by the specs below, which
+// are used to build the Terraform schema and to convert between the typed
+// form and the API representation.
+
+type monitoringDashboardFieldKind int
+
+const (
+	monitoringDashboardString monitoringDashboardFieldKind = iota
+	// monitoringDashboardInt is an int32 field, encoded as a JSON number.
+	monitoringDashboardInt
+	// monitoringDashboardInt64 is an int64 field, encoded as a JSON string.
+	monitoringDashboardInt64
+	monitoringDashboardFloat
+	monitoringDashboardStrings
+	// monitoringDashboardObject is a nested message, a block with a single
+	// element in Terraform.
+	monitoringDashboardObject
+	monitoringDashboardObjects
+)
+
+type monitoringDashboardField struct {
+	tf, api     string
+	kind        monitoringDashboardFieldKind
+	required    bool
+	validate    schema.SchemaValidateFunc
+	description string
+	object      *monitoringDashboardSpec
+}
+
+type monitoringDashboardSpec struct {
+	fields []monitoringDashboardField
+	// oneOf lists fields of which exactly one must be set.
+	oneOf []string
+}
+
+var monitoringDashboardAggregationSpec = &monitoringDashboardSpec{
+	fields: []monitoringDashboardField{
+		{tf: "alignment_period", api: "alignmentPeriod", validate: verify.ValidateDuration(), description: `The alignment period for per-time series alignment, such as "60s".`},
+		{tf: "per_series_aligner", api: "perSeriesAligner", validate: validation.StringMatch(monitoringDashboardEnumRegexp("ALIGN_"), "must be an aligner such as ALIGN_RATE"), description: `The approach used to align individual time series, such as ALIGN_RATE.`},
+		{tf: "cross_series_reducer", api: "crossSeriesReducer", validate: validation.StringMatch(monitoringDashboardEnumRegexp("REDUCE_"), "must be a reducer such as REDUCE_SUM"), description: `The approach used to combine time series, such as REDUCE_SUM.`},
+		{tf: "group_by_fields", api: "groupByFields", kind: monitoringDashboardStrings, description: `The fields preserved when cross_series_reducer is applied.`},
+	},
+}
+
+var monitoringDashboardTimeSeriesQuerySpec = &monitoringDashboardSpec{
+	fields: []monitoringDashboardField{
+		{tf: "time_series_filter", api: "timeSeriesFilter", kind: monitoringDashboardObject, description: `A monitoring filter that identifies the time series to chart.`, object: &monitoringDashboardSpec{
+			fields: []monitoringDashboardField{
+				{tf: "filter", api: "filter", required: true, description: `The monitoring filter that identifies the time series.`},
+				{tf: "aggregation", api: "aggregation", kind: monitoringDashboardObject, description: `How the time series are aligned and combined.`, object: monitoringDashboardAggregationSpec},
+			},
+		}},
+		{tf: "time_series_query_language", api: "timeSeriesQueryLanguage", description: `A query in the Monitoring Query Language (MQL).`},
+		{tf: "prometheus_query", api: "prometheusQuery", description: `A query in the Prometheus Query Language (PromQL).`},
+		{tf: "unit_override", api: "unitOverride", description: `The unit of the data in the query result.`},
+	},
+	oneOf: []string{"time_series_filter", "time_series_query_language", "prometheus_query"},
+}
+
+var monitoringDashboardThresholdsField = monitoringDashboardField{tf: "thresholds", api: "thresholds", kind: monitoringDashboardObjects, description: `Thresholds drawn on the chart.`, object: &monitoringDashboardSpec{
+	fields: []monitoringDashboardField{
+		{tf: "value", api: "value", kind: monitoringDashboardFloat, description: `The value of the threshold.`},
+		{tf: "label", api: "label", description: `The label of the threshold.`},
+		{tf: "color", api: "color", validate: validation.StringInSlice([]string{"GREY", "BLUE", "GREEN", "YELLOW", "ORANGE", "RED"}, false), description: `The color of the threshold.`},
+		{tf: "direction", api: "direction", validate: validation.StringInSlice([]string{"ABOVE", "BELOW"}, false), description: `Whether the threshold is crossed above or below its value.`},
+	},
+}}
+
+var monitoringDashboardWidgetSpec = &monitoringDashboardSpec{
+	fields: []monitoringDashboardField{
+		{tf: "title", api: "title", description: `The title of the widget.`},
+		{tf: "xy_chart", api: "xyChart", kind: monitoringDashboardObject, description: `A chart of time series data.`, object: &monitoringDashboardSpec{
+			fields: []monitoringDashboardField{
+				{tf: "data_sets", api: "dataSets", kind: monitoringDashboardObjects, required: true, description: `The data displayed on the chart.`, object: &monitoringDashboardSpec{
+					fields: []monitoringDashboardField{
+						{tf: "time_series_query", api: "timeSeriesQuery", kind: monitoringDashboardObject, required: true, description: `The query for the data set.`, object: monitoringDashboardTimeSeriesQuerySpec},
+						{tf: "plot_type", api: "plotType", validate: validation.StringInSlice([]string{"LINE", "STACKED_AREA", "STACKED_BAR", "HEATMAP"}, false), description: `How the data set is drawn.`},
+						{tf: "legend_template", api: "legendTemplate", description: `A template for the legend of each time series.`},
+						{tf: "min_alignment_period", api: "minAlignmentPeriod", validate: verify.ValidateDuration(), description: `The lower bound on the alignment period of the data set.`},
+						{tf: "target_axis", api: "targetAxis", validate: validation.StringInSlice([]string{"Y1", "Y2"}, false), description: `The Y axis the data set is drawn against.`},
+					},
+				}},
+				{tf: "y_axis", api: "yAxis", kind: monitoringDashboardObject, description: `The properties of the Y axis.`, object: &monitoringDashboardSpec{
+					fields: []monitoringDashboardField{
+						{tf: "label", api: "label", description: `The label of the axis.`},
+						{tf: "scale", api: "scale", validate: validation.StringInSlice([]string{"LINEAR", "LOG10"}, false), description: `The scale of the axis.`},
+					},
+				}},
+				monitoringDashboardThresholdsField,
+			},
+		}},
+		{tf: "scorecard", api: "scorecard", kind: monitoringDashboardObject, description: `A scorecard showing the latest value of a time series.`, object: &monitoringDashboardSpec{
+			fields: []monitoringDashboardField{
+				{tf: "time_series_query", api: "timeSeriesQuery", kind: monitoringDashboardObject, required: true, description: `The query for the scorecard.`, object: monitoringDashboardTimeSeriesQuerySpec},
+				{tf: "gauge_view", api: "gaugeView", kind: monitoringDashboardObject, description: `Shows the value as a gauge.`, object: &monitoringDashboardSpec{
+					fields: []monitoringDashboardField{
+						{tf: "lower_bound", api: "lowerBound", kind: monitoringDashboardFloat, description: `The lower bound of the gauge.`},
+						{tf: "upper_bound", api: "upperBound", kind: monitoringDashboardFloat, description: `The upper bound of the gauge.`},
+					},
+				}},
+				{tf: "spark_chart_view", api: "sparkChartView", kind: monitoringDashboardObject, description: `Shows the recent values as a spark chart.`, object: &monitoringDashboardSpec{
+					fields: []monitoringDashboardField{
+						{tf: "spark_chart_type", api: "sparkChartType", required: true, validate: validation.StringInSlice([]string{"SPARK_LINE", "SPARK_BAR"}, false), description: `The type of the spark chart.`},
+						{tf: "min_alignment_period", api: "minAlignmentPeriod", validate: verify.ValidateDuration(), description: `The lower bound on the alignment period of the spark chart.`},
+					},
+				}},
+				monitoringDashboardThresholdsField,
+			},
+		}},
+		{tf: "text", api: "text", kind: monitoringDashboardObject, description: `A block of static text.`, object: &monitoringDashboardSpec{
+			fields: []monitoringDashboardField{
+				{tf: "content", api: "content", description: `The text content.`},
+				{tf: "format", api: "format", validate: validation.StringInSlice([]string{"MARKDOWN", "RAW"}, false), description: `How the text content is formatted.`},
+			},
+		}},
+	},
+	oneOf: []string{"xy_chart", "scorecard", "text"},
+}
+
+var monitoringDashboardWidgetsField = monitoringDashboardField{tf: "widgets", api: "widgets", kind: monitoringDashboardObjects, description: `The widgets of the layout.`, object: monitoringDashboardWidgetSpec}
+
+var monitoringDashboardTypedSpec = &monitoringDashboardSpec{
+	fields: []monitoringDashboardField{
+		{tf: "display_name", api: "displayName", required: true, description: `The name of the dashboard.`},
+		{tf: "grid_layout", api: "gridLayout", kind: monitoringDashboardObject, description: `A layout that arranges widgets in a grid.`, object: &monitoringDashboardSpec{
+			fields: []monitoringDashboardField{
+				{tf: "columns", api: "columns", kind: monitoringDashboardInt64, validate: validation.IntAtLeast(1), description: `The number of columns of the grid.`},
+				monitoringDashboardWidgetsField,
+			},
+		}},
+		{tf: "mosaic_layout", api: "mosaicLayout", kind: monitoringDashboardObject, description: `A layout that places tiles on a grid of columns.`, object: &monitoringDashboardSpec{
+			fields: []monitoringDashboardField{
+				{tf: "columns", api: "columns", kind: monitoringDashboardInt, validate: validation.IntAtLeast(1), description: `The number of columns of the mosaic grid.`},
+				{tf: "tiles", api: "tiles", kind: monitoringDashboardObjects, description: `The tiles of the layout.`, object: &monitoringDashboardSpec{
+					fields: []monitoringDashboardField{
+						{tf: "x_pos", api: "xPos", kind: monitoringDashboardInt, validate: validation.IntAtLeast(0), description: `The zero-indexed column of the upper left corner of the tile.`},
+						{tf: "y_pos", api: "yPos", kind: monitoringDashboardInt, validate: validation.IntAtLeast(0), description: `The zero-indexed row of the upper left corner of the tile.`},
+						{tf: "width", api: "width", kind: monitoringDashboardInt, validate: validation.IntAtLeast(1), description: `The width of the tile, in grid blocks.`},
+						{tf: "height", api: "height", kind: monitoringDashboardInt, validate: validation.IntAtLeast(1), description: `The height of the tile, in grid blocks.`},
+						{tf: "widget", api: "widget", kind: monitoringDashboardObject, required: true, description: `The widget of the tile.`, object: monitoringDashboardWidgetSpec},
+					},
+				}},
+			},
+		}},
+		{tf: "column_layout", api: "columnLayout", kind: monitoringDashboardObject, description: `A layout that arranges widgets in columns.`, object: &monitoringDashboardSpec{
+			fields: []monitoringDashboardField{
+				{tf: "columns", api: "columns", kind: monitoringDashboardObjects, description: `The columns of the layout.`, object: &monitoringDashboardSpec{
+					fields: []monitoringDashboardField{
+						{tf: "weight", api: "weight", kind: monitoringDashboardInt64, validate: validation.IntAtLeast(1), description: `The relative width of the column.`},
+						monitoringDashboardWidgetsField,
+					},
+				}},
+			},
+		}},
+	},
+}
+
+// monitoringDashboardLayouts are the mutually exclusive layouts of a dashboard.
+var monitoringDashboardLayouts = []string{"grid_layout", "mosaic_layout", "column_layout"}
+
+func monitoringDashboardEnumRegexp(prefix string) *regexp.Regexp {
+	return regexp.MustCompile("^" + prefix + "[A-Z0-9_]+$")
+}
+
+// monitoringDashboardFieldSchema builds the Terraform schema of a field of
+// the typed form.
+func monitoringDashboardFieldSchema(f monitoringDashboardField) *schema.Schema {
+	s := &schema.Schema{
+		Required:     f.required,
+		Optional:     !f.required,
+		ValidateFunc: f.validate,
+		Description:  f.description,
+	}
+	// The API omits zero values and fills in defaults, so optional scalar
+	// fields keep the value read from the API when they aren't configured.
+	switch f.kind {
+	case monitoringDashboardString, monitoringDashboardInt, monitoringDashboardInt64, monitoringDashboardFloat:
+		s.Computed = !f.required
+	}
+	switch f.kind {
+	case monitoringDashboardString:
+		s.Type = schema.TypeString
+	case monitoringDashboardInt, monitoringDashboardInt64:
+		s.Type = schema.TypeInt
+	case monitoringDashboardFloat:
+		s.Type = schema.TypeFloat
+	case monitoringDashboardStrings:
+		s.Type = schema.TypeList
+		s.Elem = &schema.Schema{Type: schema.TypeString}
+	case monitoringDashboardObject, monitoringDashboardObjects:
+		s.Type = schema.TypeList
+		if f.kind == monitoringDashboardObject {
+			s.MaxItems = 1
+		}
+		s.Elem = &schema.Resource{Schema: monitoringDashboardSpecSchema(f.object)}
+	}
+	return s
+}
+
+func monitoringDashboardSpecSchema(spec *monitoringDashboardSpec) map[string]*schema.Schema {
+	s := make(map[string]*schema.Schema, len(spec.fields))
+	for _, f := range spec.fields {
+		s[f.tf] = monitoringDashboardFieldSchema(f)
+	}
+	return s
+}
+
+// monitoringDashboardTypedSchema returns the top-level fields of the typed
+// form, which are mutually exclusive with dashboard_json.
+func monitoringDashboardTypedSchema() map[string]*schema.Schema {
+	s := monitoringDashboardSpecSchema(monitoringDashboardTypedSpec)
+	s["display_name"].Required = false
+	s["display_name"].Optional = true
+	s["display_name"].Computed = false
+	s["display_name"].ExactlyOneOf = []string{"dashboard_json", "display_name"}
+	for _, layout := range monitoringDashboardLayouts {
+		s[layout].ConflictsWith = []string{"dashboard_json"}
+		for _, other := range monitoringDashboardLayouts {
+			if other != layout {
+				s[layout].ConflictsWith = append(s[layout].ConflictsWith, other)
+			}
+		}
+		s[layout].RequiredWith = []string{"display_name"}
+	}
+	return s
+}
+
+// ExpandMonitoringDashboardTyped converts the typed form of a dashboard to
+// its API representation. Nested messages may be given either as a single
+// object or as a list with a single element, as they are stored by Terraform.
+func ExpandMonitoringDashboardTyped(typed map[string]interface{}) (map[string]interface{}, error) {
+	layouts := 0
+	for _, layout := range monitoringDashboardLayouts {
+		if monitoringDashboardBlock(typed[layout]) != nil {
+			layouts++
+		}
+	}
+	if layouts > 1 {
+		return nil, fmt.Errorf("only one of %s can be set", strings.Join(monitoringDashboardLayouts, ", "))
+	}
+	return expandMonitoringDashboardSpec(monitoringDashboardTypedSpec, typed, "")
+}
+
+func expandMonitoringDashboardSpec(spec *monitoringDashboardSpec, typed map[string]interface{}, path string) (map[string]interface{}, error) {
+	obj := make(map[string]interface{})
+	set := make(map[string]bool)
+	for _, f := range spec.fields {
+		v := typed[f.tf]
+		switch f.kind {
+		case monitoringDashboardString:
+			if s, _ := v.(string); s != "" {
+				obj[f.api] = s
+			}
+		case monitoringDashboardInt:
+			if i := monitoringDashboardToInt(v); i != 0 {
+				obj[f.api] = i
+			}
+		case monitoringDashboardInt64:
+			if i := monitoringDashboardToInt(v); i != 0 {
+				obj[f.api] = strconv.Itoa(i)
+			}
+		case monitoringDashboardFloat:
+			if n := monitoringDashboardToFloat(v); n != 0 {
+				obj[f.api] = n
+			}
+		case monitoringDashboardStrings:
+			if l, _ := v.([]interface{}); len(l) > 0 {
+				obj[f.api] = l
+			}
+		case monitoringDashboardObject:
+			if block := monitoringDashboardBlock(v); block != nil {
+				nested, err := expandMonitoringDashboardSpec(f.object, block, path+f.tf+".0.")
+				if err != nil {
+					return nil, err
+				}
+				obj[f.api] = nested
+			}
+		case monitoringDashboardObjects:
+			l, _ := v.([]interface{})
+			items := make([]interface{}, 0, len(l))
+			for i, raw := range l {
+				block, _ := raw.(map[string]interface{})
+				if block == nil {
+					block = map[string]interface{}{}
+				}
+				nested, err := expandMonitoringDashboardSpec(f.object, block, fmt.Sprintf("%s%s.%d.", path, f.tf, i))
+				if err != nil {
+					return nil, err
+				}
+				items = append(items, nested)
+			}
+			if len(items) > 0 {
+				obj[f.api] = items
+			}
+		}
+		if _, ok := obj[f.api]; ok {
+			set[f.tf] = true
+		} else if f.required {
+			return nil, fmt.Errorf("%s%s is required", path, f.tf)
+		}
+	}
+
+	if len(spec.oneOf) > 0 {
+		count := 0
+		for _, k := range spec.oneOf {
+			if set[k] {
+				count++
+			}
+		}
+		if count != 1 {
+			return nil, fmt.Errorf("%sexactly one of %s must be set", monitoringDashboardPathPrefix(path), strings.Join(spec.oneOf, ", "))
+		}
+	}
+	return obj, nil
+}
+
+func monitoringDashboardPathPrefix(path string) string {
+	if path == "" {
+		return ""
+	}
+	return strings.TrimSuffix(path, ".") + ": "
+}
+
+// FlattenMonitoringDashboardTyped converts the API representation of a
+// dashboard to its typed form. It also returns the paths of the fields that
+// the typed form doesn't cover, which are dropped from it, sorted. The output
+// only name and etag fields are not reported.
+func FlattenMonitoringDashboardTyped(obj map[string]interface{}) (map[string]interface{}, []string) {
+	var unsupported []string
+	typed := flattenMonitoringDashboardSpec(monitoringDashboardTypedSpec, obj, "", &unsupported)
+	unsupported = slices.DeleteFunc(unsupported, func(path string) bool {
+		return path == "name" || path == "etag"
+	})
+	sort.Strings(unsupported)
+	return typed, unsupported
+}
+
+func flattenMonitoringDashboardSpec(spec *monitoringDashboardSpec, obj map[string]interface{}, path string, unsupported *[]string) map[string]interface{} {
+	typed := make(map[string]interface{})
+	covered := make(map[string]bool, len(spec.fields))
+	for _, f := range spec.fields {
+		covered[f.api] = true
+		v, ok := obj[f.api]
+		if !ok || v == nil {
+			continue
+		}
+		fieldPath := path + f.api
+		switch f.kind {
+		case monitoringDashboardString:
+			if s, ok := v.(string); ok {
+				typed[f.tf] = s
+			} else {
+				*unsupported = append(*unsupported, fieldPath)
+			}
+		case monitoringDashboardInt, monitoringDashboardInt64:
+			typed[f.tf] = monitoringDashboardToInt(v)
+		case monitoringDashboardFloat:
+			typed[f.tf] = monitoringDashboardToFloat(v)
+		case monitoringDashboardStrings:
+			if l, ok := v.([]interface{}); ok {
+				typed[f.tf] = l
+			} else {
+				*unsupported = append(*unsupported, fieldPath)
+			}
+		case monitoringDashboardObject:
+			if m, ok := v.(map[string]interface{}); ok {
+				typed[f.tf] = []interface{}{flattenMonitoringDashboardSpec(f.object, m, fieldPath+".", unsupported)}
+			} else {
+				*unsupported = append(*unsupported, fieldPath)
+			}
+		case monitoringDashboardObjects:
+			if l, ok := v.([]interface{}); ok {
+				items := make([]interface{}, 0, len(l))
+				for i, raw := range l {
+					if m, ok := raw.(map[string]interface{}); ok {
+						items = append(items, flattenMonitoringDashboardSpec(f.object, m, fmt.Sprintf("%s[%d].", fieldPath, i), unsupported))
+					} else {
+						*unsupported = append(*unsupported, fmt.Sprintf("%s[%d]", fieldPath, i))
+					}
+				}
+				typed[f.tf] = items
+			} else {
+				*unsupported = append(*unsupported, fieldPath)
+			}
+		}
+	}
+	for k := range obj {
+		if !covered[k] {
+			*unsupported = append(*unsupported, path+k)
+		}
+	}
+	return typed
+}
+
+// monitoringDashboardBlock returns the content of a nested message, given
+// either as an object or as a list with a single element.
+func monitoringDashboardBlock(v interface{}) map[string]interface{} {
+	switch block := v.(type) {
+	case map[string]interface{}:
+		return block
+	case []interface{}:
+		if len(block) == 0 {
+			return nil
+		}
+		if m, ok := block[0].(map[string]interface{}); ok {
+			return m
+		}
+		// An empty block is stored as a nil element.
+		return map[string]interface{}{}
+	}
+	return nil
+}
+
+func monitoringDashboardToInt(v interface{}) int {
+	switch n := v.(type) {
+	case int:
+		return n
+	case int64:
+		return int(n)
+	case float64:
+		return int(n)
+	case json.Number:
+		i, _ := strconv.ParseFloat(string(n), 64)
+		return int(i)
+	case string:
+		i, _ := strconv.Atoi(n)
+		return i
+	}
+	return 0
+}
+
+func monitoringDashboardToFloat(v interface{}) float64 {
+	switch n := v.(type) {
+	case int:
+		return float64(n)
+	case int64:
+		return float64(n)
+	case float64:
+		return n
+	case json.Number:
+		f, _ := n.Float64()
+		return f
+	case string:
+		f, _ := strconv.ParseFloat(n, 64)
+		return f
+	}
+	return 0
+}
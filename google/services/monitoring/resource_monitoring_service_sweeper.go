@@ -194,7 +194,7 @@ func deleteResourceMonitoringGenericService(config *transport_tpg.Config, d *tpg
 	}
 
 	// Skip resources that shouldn't be sweeped
-	if !sweeper.IsSweepableTestResource(name) {
+	if !sweeper.ShouldSweep(name, obj) {
 		return nil
 	}
 
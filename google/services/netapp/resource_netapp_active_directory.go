@@ -172,11 +172,14 @@ A five-character random ID is generated automatically, for example, -6f9a, and a
 '\\NetBIOS_PREFIX-ABCD.DOMAIN_NAME\SHARE_NAME'`,
 			},
 			"password": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: `Password for specified username. Note - Manual changes done to the password will not be detected. Terraform will not re-apply the password, unless you use a new password in Terraform.`,
-				Sensitive:   true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  `Password for specified username. Note - Manual changes done to the password will not be detected. Terraform will not re-apply the password, unless you use a new password in Terraform.`,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"password", "password_wo"},
 			},
+			"password_wo":         tpgresource.WriteOnlySchema("password", `Write-only password of the Active Directory domain administrator to join domain.`, true),
+			"password_wo_version": tpgresource.WriteOnlyVersionSchema("password", false),
 			"username": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -367,7 +370,7 @@ func resourceNetappActiveDirectoryCreate(d *schema.ResourceData, meta interface{
 	} else if v, ok := d.GetOkExists("username"); !tpgresource.IsEmptyValue(reflect.ValueOf(usernameProp)) && (ok || !reflect.DeepEqual(v, usernameProp)) {
 		obj["username"] = usernameProp
 	}
-	passwordProp, err := expandNetappActiveDirectoryPassword(d.Get("password"), d, config)
+	passwordProp, err := expandNetappActiveDirectoryPassword(tpgresource.GetWriteOnlyValue(d, "password", d.Get("password")), d, config)
 	if err != nil {
 		return err
 	} else if v, ok := d.GetOkExists("password"); !tpgresource.IsEmptyValue(reflect.ValueOf(passwordProp)) && (ok || !reflect.DeepEqual(v, passwordProp)) {
@@ -691,10 +694,10 @@ func resourceNetappActiveDirectoryUpdate(d *schema.ResourceData, meta interface{
 	} else if v, ok := d.GetOkExists("username"); !tpgresource.IsEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, usernameProp)) {
 		obj["username"] = usernameProp
 	}
-	passwordProp, err := expandNetappActiveDirectoryPassword(d.Get("password"), d, config)
+	passwordProp, err := expandNetappActiveDirectoryPassword(tpgresource.GetWriteOnlyValue(d, "password", d.Get("password")), d, config)
 	if err != nil {
 		return err
-	} else if v, ok := d.GetOkExists("password"); !tpgresource.IsEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, passwordProp)) {
+	} else if v, ok := d.GetOkExists("password"); !tpgresource.IsEmptyValue(reflect.ValueOf(passwordProp)) && (ok || !reflect.DeepEqual(v, passwordProp)) {
 		obj["password"] = passwordProp
 	}
 	backupOperatorsProp, err := expandNetappActiveDirectoryBackupOperators(d.Get("backup_operators"), d, config)
@@ -795,7 +798,7 @@ func resourceNetappActiveDirectoryUpdate(d *schema.ResourceData, meta interface{
 		updateMask = append(updateMask, "username")
 	}
 
-	if d.HasChange("password") {
+	if tpgresource.WriteOnlyHasChange(d, "password") {
 		updateMask = append(updateMask, "password")
 	}
 
@@ -201,7 +201,7 @@ func deleteResourceNetappVolume(config *transport_tpg.Config, d *tpgresource.Res
 	name = tpgresource.GetResourceNameFromSelfLink(obj["name"].(string))
 
 	// Skip resources that shouldn't be sweeped
-	if !sweeper.IsSweepableTestResource(name) {
+	if !sweeper.ShouldSweep(name, obj) {
 		return nil
 	}
 
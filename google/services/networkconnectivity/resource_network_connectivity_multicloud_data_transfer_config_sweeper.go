@@ -195,7 +195,7 @@ func deleteResourceNetworkConnectivityMulticloudDataTransferConfig(config *trans
 	name = tpgresource.GetResourceNameFromSelfLink(obj["name"].(string))
 
 	// Skip resources that shouldn't be sweeped
-	if !sweeper.IsSweepableTestResource(name) {
+	if !sweeper.ShouldSweep(name, obj) {
 		return nil
 	}
 
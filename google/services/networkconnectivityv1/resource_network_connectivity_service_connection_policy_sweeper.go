@@ -196,7 +196,7 @@ func deleteResourceNetworkConnectivityv1ServiceConnectionPolicy(config *transpor
 	prefixes := []string{
 		"gcp-memorystore",
 	}
-	if !sweeper.IsSweepableTestResource(name) && !sweeper.HasAnyPrefix(name, prefixes) {
+	if !sweeper.ShouldSweep(name, obj, prefixes...) {
 		return nil
 	}
 
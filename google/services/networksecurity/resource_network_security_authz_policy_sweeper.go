@@ -189,7 +189,7 @@ func deleteResourceNetworkSecurityAuthzPolicy(config *transport_tpg.Config, d *t
 	name = tpgresource.GetResourceNameFromSelfLink(obj["name"].(string))
 
 	// Skip resources that shouldn't be sweeped
-	if !sweeper.IsSweepableTestResource(name) {
+	if !sweeper.ShouldSweep(name, obj) {
 		return nil
 	}
 
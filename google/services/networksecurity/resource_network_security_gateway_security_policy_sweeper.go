@@ -201,7 +201,7 @@ func deleteResourceNetworkSecurityGatewaySecurityPolicy(config *transport_tpg.Co
 	name = tpgresource.GetResourceNameFromSelfLink(obj["name"].(string))
 
 	// Skip resources that shouldn't be sweeped
-	if !sweeper.IsSweepableTestResource(name) {
+	if !sweeper.ShouldSweep(name, obj) {
 		return nil
 	}
 
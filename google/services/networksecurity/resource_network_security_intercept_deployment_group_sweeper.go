@@ -196,7 +196,7 @@ func deleteResourceNetworkSecurityInterceptDeploymentGroup(config *transport_tpg
 	}
 
 	// Skip resources that shouldn't be sweeped
-	if !sweeper.IsSweepableTestResource(name) {
+	if !sweeper.ShouldSweep(name, obj) {
 		return nil
 	}
 
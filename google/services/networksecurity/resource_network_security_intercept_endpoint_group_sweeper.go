@@ -196,7 +196,7 @@ func deleteResourceNetworkSecurityInterceptEndpointGroup(config *transport_tpg.C
 	}
 
 	// Skip resources that shouldn't be sweeped
-	if !sweeper.IsSweepableTestResource(name) {
+	if !sweeper.ShouldSweep(name, obj) {
 		return nil
 	}
 
@@ -192,7 +192,7 @@ func deleteResourceNetworkSecurityMirroringDeployment(config *transport_tpg.Conf
 	}
 
 	// Skip resources that shouldn't be sweeped
-	if !sweeper.IsSweepableTestResource(name) {
+	if !sweeper.ShouldSweep(name, obj) {
 		return nil
 	}
 
@@ -194,7 +194,7 @@ func deleteResourceNetworkSecurityUllMirroringCollector(config *transport_tpg.Co
 	}
 
 	// Skip resources that shouldn't be sweeped
-	if !sweeper.IsSweepableTestResource(name) {
+	if !sweeper.ShouldSweep(name, obj) {
 		return nil
 	}
 
@@ -194,7 +194,7 @@ func deleteResourceNetworkSecurityUllMirroringEngine(config *transport_tpg.Confi
 	}
 
 	// Skip resources that shouldn't be sweeped
-	if !sweeper.IsSweepableTestResource(name) {
+	if !sweeper.ShouldSweep(name, obj) {
 		return nil
 	}
 
@@ -192,7 +192,7 @@ func deleteResourceNetworkServicesMulticastDomainGroup(config *transport_tpg.Con
 	}
 
 	// Skip resources that shouldn't be sweeped
-	if !sweeper.IsSweepableTestResource(name) {
+	if !sweeper.ShouldSweep(name, obj) {
 		return nil
 	}
 
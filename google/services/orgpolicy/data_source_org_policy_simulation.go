@@ -0,0 +1,495 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/services/orgpolicy/data_source_org_policy_simulation.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package orgpolicy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-google/google/registry"
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+)
+
+// policySimulatorProduct is the Policy Simulator API, which previews the
+// violations of organization policies. It has no resources of its own, so it
+// isn't registered as a product.
+var policySimulatorProduct = registry.Product{
+	Name:    "policysimulator",
+	BaseUrl: "https://policysimulator.googleapis.com/v1/",
+}
+
+// orgPolicySimulationMaxViolations caps the number of violations read, as
+// a preview can find many thousands of them.
+const orgPolicySimulationMaxViolations = 1000
+
+func DataSourceOrgPolicySimulation() *schema.Resource {
+	policySchema := ResourceOrgPolicyPolicy().Schema
+	constraintSchema := ResourceOrgPolicyCustomConstraint().Schema
+
+	return &schema.Resource{
+		ReadContext: dataSourceOrgPolicySimulationRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"organization": {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: tpgresource.CompareSelfLinkOrResourceName,
+				Description:      `The organization to simulate the policies in, as organizations/{organization_id} or {organization_id}.`,
+			},
+			"policy": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				AtLeastOneOf: []string{"policy", "custom_constraint"},
+				Description:  `Proposed organization policies, replacing the current policies of the same name.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							Description: `The name of the policy, as {parent}/policies/{constraint}, where {parent} is
+projects/{project_number}, folders/{folder_id} or organizations/{organization_id}.`,
+						},
+						"spec": orgPolicySimulationInputSchema(policySchema["spec"]),
+					},
+				},
+			},
+			"custom_constraint": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				AtLeastOneOf: []string{"policy", "custom_constraint"},
+				Description:  `Proposed custom constraints of the organization, replacing the current constraints of the same name.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name":           constraintSchema["name"],
+						"action_type":    constraintSchema["action_type"],
+						"condition":      constraintSchema["condition"],
+						"method_types":   constraintSchema["method_types"],
+						"resource_types": constraintSchema["resource_types"],
+						"display_name":   constraintSchema["display_name"],
+						"description":    constraintSchema["description"],
+					},
+				},
+			},
+			"warn_on_violations": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: `Whether to return a warning, shown during plan, when the proposed policies have violations.`,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The name of the violations preview.`,
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The state of the violations preview.`,
+			},
+			"violations_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: `The number of violations found.`,
+			},
+			"resource_counts": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: `The number of resources in each state of the preview.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"scanned": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: `The number of resources checked for compliance.`,
+						},
+						"noncompliant": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: `The number of scanned resources with at least one violation.`,
+						},
+						"compliant": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: `The number of scanned resources without violations.`,
+						},
+						"unenforced": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: `The number of resources where the constraint isn't enforced.`,
+						},
+						"errors": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: `The number of resources that couldn't be checked.`,
+						},
+					},
+				},
+			},
+			"violations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Description: fmt.Sprintf(`The violations found, that is the existing resources that don't comply with the
+proposed policies. At most %d violations are returned.`, orgPolicySimulationMaxViolations),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The full resource name of the violating resource.`,
+						},
+						"asset_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The asset type of the violating resource.`,
+						},
+						"ancestors": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: `The ancestors of the violating resource, from its parent up to the organization.`,
+						},
+						"custom_constraint": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The name of the custom constraint that the resource violates.`,
+						},
+						"error": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The error encountered while checking the resource, if any.`,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// orgPolicySimulationInputSchema clears the diff suppression functions of a
+// google_org_policy_policy field reused as a data source input, as they read
+// the field at its path in the resource.
+func orgPolicySimulationInputSchema(s *schema.Schema) *schema.Schema {
+	s.DiffSuppressFunc = nil
+	if r, ok := s.Elem.(*schema.Resource); ok {
+		for _, v := range r.Schema {
+			orgPolicySimulationInputSchema(v)
+		}
+	}
+	return s
+}
+
+func dataSourceOrgPolicySimulationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	organization := "organizations/" + tpgresource.GetResourceNameFromSelfLink(d.Get("organization").(string))
+	overlay, err := expandOrgPolicySimulationOverlay(d, config, organization)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	billingProject := ""
+	// err == nil indicates that the billing_project value was found
+	if bp, err := tpgresource.GetBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	preview, violations, err := runOrgPolicySimulation(config, userAgent, billingProject, organization, overlay, d.Timeout(schema.TimeoutRead))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name, _ := preview["name"].(string)
+	d.SetId(name)
+	if err := d.Set("name", name); err != nil {
+		return diag.Errorf("Error setting name: %s", err)
+	}
+	if err := d.Set("state", preview["state"]); err != nil {
+		return diag.Errorf("Error setting state: %s", err)
+	}
+	violationsCount := flattenOrgPolicySimulationCount(preview["violationsCount"])
+	if err := d.Set("violations_count", violationsCount); err != nil {
+		return diag.Errorf("Error setting violations_count: %s", err)
+	}
+	if err := d.Set("resource_counts", flattenOrgPolicySimulationResourceCounts(preview["resourceCounts"])); err != nil {
+		return diag.Errorf("Error setting resource_counts: %s", err)
+	}
+	if err := d.Set("violations", violations); err != nil {
+		return diag.Errorf("Error setting violations: %s", err)
+	}
+
+	if violationsCount > 0 && d.Get("warn_on_violations").(bool) {
+		return diag.Diagnostics{orgPolicySimulationWarning(violationsCount, violations)}
+	}
+	return nil
+}
+
+// expandOrgPolicySimulationOverlay builds the policies and custom constraints
+// to simulate in place of the current ones.
+func expandOrgPolicySimulationOverlay(d *schema.ResourceData, config *transport_tpg.Config, organization string) (map[string]interface{}, error) {
+	var policies []interface{}
+	for _, raw := range d.Get("policy").([]interface{}) {
+		p := raw.(map[string]interface{})
+		name := p["name"].(string)
+		i := strings.Index(name, "/policies/")
+		if i <= 0 {
+			return nil, fmt.Errorf("invalid policy name %q, expected {parent}/policies/{constraint}", name)
+		}
+		spec, err := expandOrgPolicyPolicySpec(p["spec"], d, config)
+		if err != nil {
+			return nil, err
+		}
+		policy := map[string]interface{}{"name": name}
+		if spec != nil {
+			policy["spec"] = spec
+		}
+		policies = append(policies, map[string]interface{}{
+			"policyParent": name[:i],
+			"policy":       policy,
+		})
+	}
+
+	var constraints []interface{}
+	for _, raw := range d.Get("custom_constraint").([]interface{}) {
+		c := raw.(map[string]interface{})
+		constraint := map[string]interface{}{
+			"name":          fmt.Sprintf("%s/customConstraints/%s", organization, c["name"]),
+			"actionType":    c["action_type"],
+			"condition":     c["condition"],
+			"methodTypes":   c["method_types"],
+			"resourceTypes": c["resource_types"],
+		}
+		if v := c["display_name"].(string); v != "" {
+			constraint["displayName"] = v
+		}
+		if v := c["description"].(string); v != "" {
+			constraint["description"] = v
+		}
+		constraints = append(constraints, map[string]interface{}{
+			"customConstraintParent": organization,
+			"customConstraint":       constraint,
+		})
+	}
+
+	overlay := make(map[string]interface{})
+	if len(policies) > 0 {
+		overlay["policies"] = policies
+	}
+	if len(constraints) > 0 {
+		overlay["customConstraints"] = constraints
+	}
+	return overlay, nil
+}
+
+// runOrgPolicySimulation previews the violations of overlay in organization, and
+// returns the preview with up to orgPolicySimulationMaxViolations of its violations.
+func runOrgPolicySimulation(config *transport_tpg.Config, userAgent, billingProject, organization string, overlay map[string]interface{}, timeout time.Duration) (map[string]interface{}, []interface{}, error) {
+	url := fmt.Sprintf("%s%s/locations/global/orgPolicyViolationsPreviews", transport_tpg.BaseUrl(policySimulatorProduct, config), organization)
+	op, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+		Config:    config,
+		Method:    "POST",
+		Project:   billingProject,
+		RawURL:    url,
+		UserAgent: userAgent,
+		Body:      map[string]interface{}{"overlay": overlay},
+		Timeout:   timeout,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("Error creating org policy violations preview in %s: %s", organization, err)
+	}
+
+	var preview map[string]interface{}
+	w := &policySimulatorOperationWaiter{
+		Config:    config,
+		UserAgent: userAgent,
+		Project:   billingProject,
+	}
+	if err := w.CommonOperationWaiter.SetOp(op); err != nil {
+		return nil, nil, err
+	}
+	if err := tpgresource.OperationWait(w, "Simulating org policies", timeout, config.PollInterval); err != nil {
+		return nil, nil, err
+	}
+	if err := json.Unmarshal([]byte(w.CommonOperationWaiter.Op.Response), &preview); err != nil {
+		return nil, nil, fmt.Errorf("Error reading org policy violations preview: %s", err)
+	}
+
+	name, _ := preview["name"].(string)
+	violations, err := listOrgPolicySimulationViolations(config, name, billingProject, userAgent, timeout)
+	if err != nil {
+		return nil, nil, err
+	}
+	return preview, violations, nil
+}
+
+// listOrgPolicySimulationViolations lists the violations of a preview, up to
+// orgPolicySimulationMaxViolations.
+func listOrgPolicySimulationViolations(config *transport_tpg.Config, preview, billingProject, userAgent string, timeout time.Duration) ([]interface{}, error) {
+	url := fmt.Sprintf("%s%s/orgPolicyViolations", transport_tpg.BaseUrl(policySimulatorProduct, config), preview)
+
+	violations := make([]interface{}, 0)
+	params := map[string]string{"pageSize": "1000"}
+	for len(violations) < orgPolicySimulationMaxViolations {
+		pageUrl, err := transport_tpg.AddQueryParams(url, params)
+		if err != nil {
+			return nil, err
+		}
+		res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+			Config:    config,
+			Method:    "GET",
+			Project:   billingProject,
+			RawURL:    pageUrl,
+			UserAgent: userAgent,
+			Timeout:   timeout,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("Error listing the violations of %s: %s", preview, err)
+		}
+		raw, _ := res["orgPolicyViolations"].([]interface{})
+		for _, v := range raw {
+			if len(violations) == orgPolicySimulationMaxViolations {
+				break
+			}
+			if violation, ok := v.(map[string]interface{}); ok {
+				violations = append(violations, flattenOrgPolicySimulationViolation(violation))
+			}
+		}
+		token, _ := res["nextPageToken"].(string)
+		if token == "" {
+			break
+		}
+		params["pageToken"] = token
+	}
+	return violations, nil
+}
+
+func flattenOrgPolicySimulationViolation(v map[string]interface{}) map[string]interface{} {
+	transformed := make(map[string]interface{})
+	if resource, ok := v["resource"].(map[string]interface{}); ok {
+		transformed["resource"] = resource["resource"]
+		transformed["asset_type"] = resource["assetType"]
+		transformed["ancestors"] = resource["ancestors"]
+	}
+	if constraint, ok := v["customConstraint"].(map[string]interface{}); ok {
+		transformed["custom_constraint"] = constraint["name"]
+	}
+	if status, ok := v["error"].(map[string]interface{}); ok {
+		transformed["error"] = status["message"]
+	}
+	return transformed
+}
+
+func flattenOrgPolicySimulationResourceCounts(v interface{}) []interface{} {
+	counts, ok := v.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	for _, k := range []string{"scanned", "noncompliant", "compliant", "unenforced", "errors"} {
+		transformed[k] = flattenOrgPolicySimulationCount(counts[k])
+	}
+	return []interface{}{transformed}
+}
+
+// flattenOrgPolicySimulationCount reads an int64 count, which the API returns
+// as a string.
+func flattenOrgPolicySimulationCount(v interface{}) int64 {
+	switch v := v.(type) {
+	case string:
+		if i, err := tpgresource.StringToFixed64(v); err == nil {
+			return i
+		}
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+	case float64:
+		return int64(v)
+	}
+	return 0
+}
+
+// orgPolicySimulationWarning summarizes the violations of a preview, naming the
+// first few violating resources.
+func orgPolicySimulationWarning(count int64, violations []interface{}) diag.Diagnostic {
+	const maxListed = 10
+	var resources []string
+	for _, raw := range violations {
+		if len(resources) == maxListed {
+			break
+		}
+		if resource, _ := raw.(map[string]interface{})["resource"].(string); resource != "" {
+			resources = append(resources, "  - "+resource)
+		}
+	}
+	detail := fmt.Sprintf("The proposed organization policies would be violated %d time(s) by existing resources", count)
+	if len(resources) > 0 {
+		detail += ", including:\n" + strings.Join(resources, "\n")
+	}
+	if int64(len(resources)) < count {
+		detail += "\nSee the violations attribute for more."
+	}
+	return diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  "Organization policy changes affect existing resources",
+		Detail:   detail,
+	}
+}
+
+type policySimulatorOperationWaiter struct {
+	Config    *transport_tpg.Config
+	UserAgent string
+	Project   string
+	tpgresource.CommonOperationWaiter
+}
+
+func (w *policySimulatorOperationWaiter) QueryOp() (interface{}, error) {
+	if w == nil {
+		return nil, fmt.Errorf("Cannot query operation, it's unset or nil.")
+	}
+	url := transport_tpg.BaseUrl(policySimulatorProduct, w.Config) + w.CommonOperationWaiter.Op.Name
+	return transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+		Config:    w.Config,
+		Method:    "GET",
+		Project:   w.Project,
+		RawURL:    url,
+		UserAgent: w.UserAgent,
+	})
+}
+
+func init() {
+	registry.Schema{
+		Name:        "google_org_policy_simulation",
+		ProductName: "orgpolicy",
+		Type:        registry.SchemaTypeDataSource,
+		Schema:      DataSourceOrgPolicySimulation(),
+	}.Register()
+}
@@ -0,0 +1,125 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/services/orgpolicy/data_source_org_policy_simulation_internal_test.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package orgpolicy
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+)
+
+func TestExpandOrgPolicySimulationOverlay(t *testing.T) {
+	t.Parallel()
+
+	d := schema.TestResourceDataRaw(t, DataSourceOrgPolicySimulation().Schema, map[string]interface{}{
+		"organization": "123456789",
+		"policy": []interface{}{
+			map[string]interface{}{
+				"name": "projects/my-project/policies/custom.disableGkeAutoUpgrade",
+				"spec": []interface{}{
+					map[string]interface{}{
+						"rules": []interface{}{
+							map[string]interface{}{"enforce": "TRUE"},
+						},
+					},
+				},
+			},
+		},
+		"custom_constraint": []interface{}{
+			map[string]interface{}{
+				"name":           "custom.disableGkeAutoUpgrade",
+				"action_type":    "ALLOW",
+				"condition":      "resource.management.autoUpgrade == false",
+				"method_types":   []interface{}{"CREATE"},
+				"resource_types": []interface{}{"container.googleapis.com/NodePool"},
+			},
+		},
+	})
+
+	overlay, err := expandOrgPolicySimulationOverlay(d, &transport_tpg.Config{}, "organizations/123456789")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	policies := overlay["policies"].([]interface{})
+	if len(policies) != 1 {
+		t.Fatalf("expected 1 policy, got %d", len(policies))
+	}
+	policy := policies[0].(map[string]interface{})
+	if got := policy["policyParent"]; got != "projects/my-project" {
+		t.Errorf("expected policyParent projects/my-project, got %v", got)
+	}
+	spec := policy["policy"].(map[string]interface{})["spec"].(map[string]interface{})
+	if rules := spec["rules"].([]interface{}); len(rules) != 1 || rules[0].(map[string]interface{})["enforce"] != true {
+		t.Errorf("expected one enforced rule, got %v", spec["rules"])
+	}
+
+	expected := []interface{}{
+		map[string]interface{}{
+			"customConstraintParent": "organizations/123456789",
+			"customConstraint": map[string]interface{}{
+				"name":          "organizations/123456789/customConstraints/custom.disableGkeAutoUpgrade",
+				"actionType":    "ALLOW",
+				"condition":     "resource.management.autoUpgrade == false",
+				"methodTypes":   []interface{}{"CREATE"},
+				"resourceTypes": []interface{}{"container.googleapis.com/NodePool"},
+			},
+		},
+	}
+	if got := overlay["customConstraints"]; !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected custom constraints %v, got %v", expected, got)
+	}
+}
+
+func TestExpandOrgPolicySimulationOverlay_invalidPolicyName(t *testing.T) {
+	t.Parallel()
+
+	d := schema.TestResourceDataRaw(t, DataSourceOrgPolicySimulation().Schema, map[string]interface{}{
+		"organization": "123456789",
+		"policy": []interface{}{
+			map[string]interface{}{"name": "custom.disableGkeAutoUpgrade"},
+		},
+	})
+
+	if _, err := expandOrgPolicySimulationOverlay(d, &transport_tpg.Config{}, "organizations/123456789"); err == nil {
+		t.Fatalf("expected an error for a policy name without a parent")
+	}
+}
+
+func TestOrgPolicySimulationWarning(t *testing.T) {
+	t.Parallel()
+
+	violations := []interface{}{
+		map[string]interface{}{"resource": "//container.googleapis.com/projects/p/locations/l/clusters/c/nodePools/a"},
+		map[string]interface{}{"resource": "//container.googleapis.com/projects/p/locations/l/clusters/c/nodePools/b"},
+	}
+
+	warning := orgPolicySimulationWarning(3, violations)
+	if warning.Severity != diag.Warning {
+		t.Errorf("expected a warning, got severity %v", warning.Severity)
+	}
+	for _, want := range []string{"violated 3 time(s)", "nodePools/a", "nodePools/b", "See the violations attribute"} {
+		if !strings.Contains(warning.Detail, want) {
+			t.Errorf("expected the detail to contain %q, got %q", want, warning.Detail)
+		}
+	}
+}
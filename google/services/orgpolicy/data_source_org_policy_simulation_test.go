@@ -0,0 +1,79 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/services/orgpolicy/data_source_org_policy_simulation_test.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package orgpolicy_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-google/google/acctest"
+	"github.com/hashicorp/terraform-provider-google/google/envvar"
+)
+
+func TestAccDataSourceOrgPolicySimulation_customConstraint(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"org_id":        envvar.GetTestOrgTargetFromEnv(t),
+		"random_suffix": acctest.RandString(t, 10),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceOrgPolicySimulation_customConstraint(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.google_org_policy_simulation.simulation", "name"),
+					resource.TestCheckResourceAttr("data.google_org_policy_simulation.simulation", "state", "SUCCEEDED"),
+					resource.TestCheckResourceAttrSet("data.google_org_policy_simulation.simulation", "violations_count"),
+					resource.TestCheckResourceAttr("data.google_org_policy_simulation.simulation", "resource_counts.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceOrgPolicySimulation_customConstraint(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+data "google_org_policy_simulation" "simulation" {
+  organization = "organizations/%{org_id}"
+
+  custom_constraint {
+    name           = "custom.tfTestSimulation%{random_suffix}"
+    action_type    = "ALLOW"
+    condition      = "resource.management.autoUpgrade == false"
+    method_types   = ["CREATE", "UPDATE"]
+    resource_types = ["container.googleapis.com/NodePool"]
+  }
+
+  policy {
+    name = "organizations/%{org_id}/policies/custom.tfTestSimulation%{random_suffix}"
+
+    spec {
+      rules {
+        enforce = "TRUE"
+      }
+    }
+  }
+
+  warn_on_violations = false
+}
+`, context)
+}
@@ -194,6 +194,8 @@ func ResourceOrgPolicyPolicy() *schema.Resource {
 			MutableIdentity: true,
 		},
 
+		CustomizeDiff: resourceOrgPolicyPolicySimulationCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:             schema.TypeString,
@@ -453,6 +455,18 @@ func ResourceOrgPolicyPolicy() *schema.Resource {
 				Description: `Optional. An opaque tag indicating the current state of the policy, used for concurrency control. This 'etag' is computed by the server based on the value of other fields, and may be sent on update and delete requests to ensure the client has an up-to-date value before proceeding.`,
 			},
 
+			"simulation_organization": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: tpgresource.CompareSelfLinkOrResourceName,
+				Description: `The organization, as organizations/{organization_id} or {organization_id}, to preview the planned spec in with the Policy Simulator.
+When set, changes to spec are previewed at plan time, and the number of existing resources that would violate the planned spec is shown in the plan as simulated_violations_count.`,
+			},
+			"simulated_violations_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: `The number of violations of existing resources found by the last preview of spec. Only set when simulation_organization is set.`,
+			},
 			"deletion_policy": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -642,7 +656,7 @@ func resourceOrgPolicyPolicyRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceOrgPolicyPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
-	clientSideFields := map[string]bool{"deletion_policy": true}
+	clientSideFields := map[string]bool{"deletion_policy": true, "simulation_organization": true, "simulated_violations_count": true}
 	clientSideOnly := true
 	for field := range ResourceOrgPolicyPolicy().Schema {
 		if d.HasChange(field) && !clientSideFields[field] {
@@ -0,0 +1,91 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/services/orgpolicy/resource_org_policy_policy_simulation.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package orgpolicy
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+)
+
+// orgPolicyPolicySimulationTimeout bounds the violations preview run at plan
+// time, as plans have no timeouts of their own.
+const orgPolicyPolicySimulationTimeout = 20 * time.Minute
+
+// resourceOrgPolicyPolicySimulationCustomizeDiff previews, when
+// simulation_organization is set, the violations of existing resources with
+// the planned spec, and shows their number in the plan.
+func resourceOrgPolicyPolicySimulationCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	org, ok := diff.GetOk("simulation_organization")
+	if !ok || !diff.HasChange("spec") {
+		return nil
+	}
+	if !diff.NewValueKnown("spec") || !diff.NewValueKnown("name") || !diff.NewValueKnown("parent") {
+		return diff.SetNewComputed("simulated_violations_count")
+	}
+
+	config := meta.(*transport_tpg.Config)
+	spec, err := expandOrgPolicyPolicySpec(diff.Get("spec"), nil, config)
+	if err != nil {
+		return err
+	}
+	overlay := expandOrgPolicyPolicySimulationOverlay(diff.Get("parent").(string), diff.Get("name").(string), spec)
+
+	organization := "organizations/" + tpgresource.GetResourceNameFromSelfLink(org.(string))
+	preview, violations, err := runOrgPolicySimulation(config, config.UserAgent, config.BillingProject, organization, overlay, orgPolicyPolicySimulationTimeout)
+	if err != nil {
+		return err
+	}
+
+	count := flattenOrgPolicySimulationCount(preview["violationsCount"])
+	if count > 0 {
+		var resources []string
+		for _, raw := range violations {
+			if resource, _ := raw.(map[string]interface{})["resource"].(string); resource != "" {
+				resources = append(resources, resource)
+			}
+		}
+		log.Printf("[WARN] The planned spec of policy %s would be violated %d time(s) by existing resources, including: %s", diff.Get("name"), count, strings.Join(resources, ", "))
+	}
+	return diff.SetNew("simulated_violations_count", int(count))
+}
+
+// expandOrgPolicyPolicySimulationOverlay builds the overlay replacing the
+// policy named name under parent with one holding spec.
+func expandOrgPolicyPolicySimulationOverlay(parent, name string, spec interface{}) map[string]interface{} {
+	policy := map[string]interface{}{
+		"name": fmt.Sprintf("%s/policies/%s", parent, tpgresource.GetResourceNameFromSelfLink(name)),
+	}
+	if spec != nil {
+		policy["spec"] = spec
+	}
+	return map[string]interface{}{
+		"policies": []interface{}{
+			map[string]interface{}{
+				"policyParent": parent,
+				"policy":       policy,
+			},
+		},
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/services/orgpolicy/resource_org_policy_policy_simulation_internal_test.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package orgpolicy
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExpandOrgPolicyPolicySimulationOverlay(t *testing.T) {
+	t.Parallel()
+
+	spec := map[string]interface{}{
+		"rules": []interface{}{
+			map[string]interface{}{"enforce": true},
+		},
+	}
+	expected := map[string]interface{}{
+		"policies": []interface{}{
+			map[string]interface{}{
+				"policyParent": "projects/123",
+				"policy": map[string]interface{}{
+					"name": "projects/123/policies/custom.disableGkeAutoUpgrade",
+					"spec": spec,
+				},
+			},
+		},
+	}
+
+	for _, name := range []string{"custom.disableGkeAutoUpgrade", "projects/123/policies/custom.disableGkeAutoUpgrade"} {
+		if got := expandOrgPolicyPolicySimulationOverlay("projects/123", name, spec); !reflect.DeepEqual(got, expected) {
+			t.Errorf("%s: expected overlay %v, got %v", name, expected, got)
+		}
+	}
+}
@@ -117,7 +117,7 @@ func testSweepCertificateAuthority(region string) error {
 			// Increment count and skip if resource is not sweepable.
 			nameParts := strings.Split(caName, "/")
 			id := nameParts[len(nameParts)-1]
-			if !sweeper.IsSweepableTestResource(id) {
+			if !sweeper.ShouldSweep(id, obj) {
 				nonPrefixCount++
 				continue
 			}
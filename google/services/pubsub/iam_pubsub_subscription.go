@@ -175,6 +175,8 @@ func NewPubsubSubscriptionIamMemberListResource() list.ListResource {
 			ParentResourceField: "subscription",
 			EnableRoleFilter:    true,
 			EnableMemberFilter:  true,
+			AssetType:           "pubsub.googleapis.com/Subscription",
+			AssetNameFormat:     "//pubsub.googleapis.com/projects/{{project}}/subscriptions/{{subscription}}",
 		},
 	)
 }
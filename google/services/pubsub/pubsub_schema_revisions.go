@@ -0,0 +1,479 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/services/pubsub/pubsub_schema_revisions.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package pubsub
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+)
+
+// resourcePubsubSchemaRevisionCustomizeDiff checks that a new definition is
+// compatible with the current revision, and plans the revision it commits.
+func resourcePubsubSchemaRevisionCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("definition") {
+		return nil
+	}
+	if !d.NewValueKnown("definition") {
+		return d.SetNewComputed("revision_id")
+	}
+	oldDefinition, newDefinition := d.GetChange("definition")
+	if d.Get("compatibility").(string) == "BACKWARD" && !d.HasChange("type") && oldDefinition.(string) != "" {
+		if err := checkPubsubSchemaCompatibility(d.Get("type").(string), oldDefinition.(string), newDefinition.(string)); err != nil {
+			return fmt.Errorf("the new definition is not backward compatible with revision %s: %s. Set compatibility = \"NONE\" to commit it anyway", d.Get("revision_id"), err)
+		}
+	}
+	if err := d.SetNewComputed("revision_id"); err != nil {
+		return err
+	}
+	return d.SetNewComputed("revisions")
+}
+
+// listPubsubSchemaRevisions returns the revisions of a schema, newest first.
+func listPubsubSchemaRevisions(config *transport_tpg.Config, d *schema.ResourceData, billingProject, userAgent string) ([]interface{}, error) {
+	url, err := tpgresource.ReplaceVars(d, config, transport_tpg.BaseUrl(Product, config)+"projects/{{project}}/schemas/{{name}}:listRevisions")
+	if err != nil {
+		return nil, err
+	}
+
+	var revisions []interface{}
+	params := map[string]string{"view": "BASIC"}
+	for {
+		pageUrl, err := transport_tpg.AddQueryParams(url, params)
+		if err != nil {
+			return nil, err
+		}
+		res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+			Config:    config,
+			Method:    "GET",
+			Project:   billingProject,
+			RawURL:    pageUrl,
+			UserAgent: userAgent,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("Error listing revisions of Schema %q: %s", d.Id(), err)
+		}
+		for _, raw := range pubsubSchemaList(res["schemas"]) {
+			revision, ok := raw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			revisions = append(revisions, map[string]interface{}{
+				"revision_id":          revision["revisionId"],
+				"revision_create_time": revision["revisionCreateTime"],
+			})
+		}
+		token, _ := res["nextPageToken"].(string)
+		if token == "" {
+			break
+		}
+		params["pageToken"] = token
+	}
+	return revisions, nil
+}
+
+// pubsubSchemaLatestRevisionId returns the ID of the newest of revisions, or
+// "" if there are none.
+func pubsubSchemaLatestRevisionId(revisions []interface{}) string {
+	if len(revisions) == 0 {
+		return ""
+	}
+	revision, ok := revisions[0].(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	id, _ := revision["revision_id"].(string)
+	return id
+}
+
+// checkPubsubSchemaCompatibility is a best-effort check that messages
+// written with the old definition of a schema can be read with the new one.
+func checkPubsubSchemaCompatibility(schemaType, oldDefinition, newDefinition string) error {
+	switch schemaType {
+	case "AVRO":
+		return checkPubsubAvroCompatibility(oldDefinition, newDefinition)
+	case "PROTOCOL_BUFFER":
+		return checkPubsubProtobufCompatibility(oldDefinition, newDefinition)
+	}
+	return nil
+}
+
+var pubsubAvroPrimitives = map[string]bool{
+	"null": true, "boolean": true, "int": true, "long": true, "float": true, "double": true, "bytes": true, "string": true,
+}
+
+// pubsubAvroPromotions lists the writer types that can be read by a reader
+// type, following the Avro schema resolution rules.
+var pubsubAvroPromotions = map[string][]string{
+	"long":   {"int"},
+	"float":  {"int", "long"},
+	"double": {"int", "long", "float"},
+	"string": {"bytes"},
+	"bytes":  {"string"},
+}
+
+type pubsubAvroResolver struct {
+	readerNames map[string]interface{}
+	writerNames map[string]interface{}
+	visited     map[string]bool
+}
+
+func checkPubsubAvroCompatibility(oldDefinition, newDefinition string) error {
+	var writer, reader interface{}
+	if err := json.Unmarshal([]byte(oldDefinition), &writer); err != nil {
+		// The old definition can't be checked against.
+		return nil
+	}
+	if err := json.Unmarshal([]byte(newDefinition), &reader); err != nil {
+		return fmt.Errorf("the definition is not a valid Avro schema: %s", err)
+	}
+	r := &pubsubAvroResolver{
+		readerNames: map[string]interface{}{},
+		writerNames: map[string]interface{}{},
+		visited:     map[string]bool{},
+	}
+	collectPubsubAvroNames(reader, r.readerNames)
+	collectPubsubAvroNames(writer, r.writerNames)
+	return r.canRead(reader, writer, "")
+}
+
+func collectPubsubAvroNames(s interface{}, names map[string]interface{}) {
+	switch s := s.(type) {
+	case []interface{}:
+		for _, branch := range s {
+			collectPubsubAvroNames(branch, names)
+		}
+	case map[string]interface{}:
+		if name, ok := s["name"].(string); ok {
+			if t, _ := s["type"].(string); t == "record" || t == "enum" || t == "fixed" {
+				names[name] = s
+			}
+		}
+		if fields, ok := s["fields"].([]interface{}); ok {
+			for _, f := range fields {
+				if field, ok := f.(map[string]interface{}); ok {
+					collectPubsubAvroNames(field["type"], names)
+				}
+			}
+		}
+		collectPubsubAvroNames(s["items"], names)
+		collectPubsubAvroNames(s["values"], names)
+	}
+}
+
+// resolve returns a schema with references to named types replaced by their
+// definition, and its type name.
+func (r *pubsubAvroResolver) resolve(s interface{}, names map[string]interface{}) (interface{}, string) {
+	switch s := s.(type) {
+	case string:
+		if pubsubAvroPrimitives[s] {
+			return s, s
+		}
+		if named, ok := names[s]; ok {
+			return r.resolve(named, names)
+		}
+		return s, s
+	case []interface{}:
+		return s, "union"
+	case map[string]interface{}:
+		t, _ := s["type"].(string)
+		if pubsubAvroPrimitives[t] {
+			// A primitive type with attributes, such as a logical type.
+			return t, t
+		}
+		return s, t
+	}
+	return s, ""
+}
+
+func (r *pubsubAvroResolver) canRead(reader, writer interface{}, path string) error {
+	reader, readerType := r.resolve(reader, r.readerNames)
+	writer, writerType := r.resolve(writer, r.writerNames)
+
+	if writerType == "union" {
+		for _, branch := range writer.([]interface{}) {
+			if err := r.canRead(reader, branch, path); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if readerType == "union" {
+		for _, branch := range reader.([]interface{}) {
+			if err := r.canRead(branch, writer, path); err == nil {
+				return nil
+			}
+		}
+		return fmt.Errorf("%s: no branch of the union can read %s", pubsubAvroPath(path), writerType)
+	}
+
+	if readerType != writerType {
+		for _, promoted := range pubsubAvroPromotions[readerType] {
+			if promoted == writerType {
+				return nil
+			}
+		}
+		return fmt.Errorf("%s: type changed from %s to %s", pubsubAvroPath(path), writerType, readerType)
+	}
+
+	switch readerType {
+	case "record":
+		readerRecord := reader.(map[string]interface{})
+		writerRecord := writer.(map[string]interface{})
+		// Recursive records are only checked once.
+		key := fmt.Sprintf("%v/%v", readerRecord["name"], writerRecord["name"])
+		if r.visited[key] {
+			return nil
+		}
+		r.visited[key] = true
+		if err := r.canReadRecord(readerRecord, writerRecord, path); err != nil {
+			delete(r.visited, key)
+			return err
+		}
+	case "enum":
+		readerEnum := reader.(map[string]interface{})
+		if _, hasDefault := readerEnum["default"]; hasDefault {
+			return nil
+		}
+		symbols := map[string]bool{}
+		for _, s := range pubsubSchemaList(readerEnum["symbols"]) {
+			symbols[fmt.Sprint(s)] = true
+		}
+		for _, s := range pubsubSchemaList(writer.(map[string]interface{})["symbols"]) {
+			if !symbols[fmt.Sprint(s)] {
+				return fmt.Errorf("%s: enum symbol %v removed", pubsubAvroPath(path), s)
+			}
+		}
+	case "array":
+		return r.canRead(reader.(map[string]interface{})["items"], writer.(map[string]interface{})["items"], path+"[]")
+	case "map":
+		return r.canRead(reader.(map[string]interface{})["values"], writer.(map[string]interface{})["values"], path+"{}")
+	case "fixed":
+		readerSize := reader.(map[string]interface{})["size"]
+		writerSize := writer.(map[string]interface{})["size"]
+		if fmt.Sprint(readerSize) != fmt.Sprint(writerSize) {
+			return fmt.Errorf("%s: fixed size changed from %v to %v", pubsubAvroPath(path), writerSize, readerSize)
+		}
+	default:
+		// References to named types that couldn't be resolved are compared
+		// by name.
+		if fmt.Sprint(reader) != fmt.Sprint(writer) {
+			return fmt.Errorf("%s: type changed from %v to %v", pubsubAvroPath(path), writer, reader)
+		}
+	}
+	return nil
+}
+
+func (r *pubsubAvroResolver) canReadRecord(readerRecord, writerRecord map[string]interface{}, path string) error {
+	writerFields := map[string]map[string]interface{}{}
+	for _, f := range pubsubSchemaList(writerRecord["fields"]) {
+		if field, ok := f.(map[string]interface{}); ok {
+			name, _ := field["name"].(string)
+			writerFields[name] = field
+		}
+	}
+	for _, f := range pubsubSchemaList(readerRecord["fields"]) {
+		field, ok := f.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _ := field["name"].(string)
+		fieldPath := strings.TrimPrefix(path+"."+name, ".")
+		writerField, ok := writerFields[name]
+		if !ok {
+			for _, alias := range pubsubSchemaList(field["aliases"]) {
+				if aliasName, _ := alias.(string); writerFields[aliasName] != nil {
+					writerField, ok = writerFields[aliasName], true
+					break
+				}
+			}
+		}
+		if !ok {
+			if _, hasDefault := field["default"]; !hasDefault {
+				return fmt.Errorf("%s: field added without a default value", fieldPath)
+			}
+			continue
+		}
+		if err := r.canRead(field["type"], writerField["type"], fieldPath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func pubsubSchemaList(v interface{}) []interface{} {
+	l, _ := v.([]interface{})
+	return l
+}
+
+func pubsubAvroPath(path string) string {
+	if path == "" {
+		return "schema"
+	}
+	return path
+}
+
+type pubsubProtobufField struct {
+	label, typ, name string
+}
+
+var (
+	pubsubProtobufCommentRegexp = regexp.MustCompile(`(?s)/\*.*?\*/|//[^\n]*`)
+	pubsubProtobufBlockRegexp   = regexp.MustCompile(`^\s*(message|enum|oneof|extend|service)\s+([A-Za-z_][\w.]*)\s*\{`)
+	pubsubProtobufFieldRegexp   = regexp.MustCompile(`^\s*(optional|required|repeated)?\s*(map\s*<[^>]+>|[A-Za-z_.][\w.]*)\s+([A-Za-z_]\w*)\s*=\s*(\d+)`)
+)
+
+func checkPubsubProtobufCompatibility(oldDefinition, newDefinition string) error {
+	oldMessages := parsePubsubProtobufMessages(oldDefinition)
+	newMessages := parsePubsubProtobufMessages(newDefinition)
+
+	for message, newFields := range newMessages {
+		oldFields, ok := oldMessages[message]
+		if !ok {
+			continue
+		}
+		for number, oldField := range oldFields {
+			newField, ok := newFields[number]
+			if !ok {
+				if oldField.label == "required" {
+					return fmt.Errorf("%s: required field %s removed", message, oldField.name)
+				}
+				continue
+			}
+			if newField.name != oldField.name {
+				return fmt.Errorf("%s: field %s renamed to %s", message, oldField.name, newField.name)
+			}
+			if (newField.label == "repeated") != (oldField.label == "repeated") {
+				return fmt.Errorf("%s: field %s changed between repeated and singular", message, newField.name)
+			}
+			if !pubsubProtobufTypesCompatible(oldField.typ, newField.typ) {
+				return fmt.Errorf("%s: field %s type changed from %s to %s", message, newField.name, oldField.typ, newField.typ)
+			}
+		}
+		for number, newField := range newFields {
+			if _, ok := oldFields[number]; !ok && newField.label == "required" {
+				return fmt.Errorf("%s: required field %s added", message, newField.name)
+			}
+		}
+	}
+	return nil
+}
+
+// pubsubProtobufCompatibleTypes groups the scalar types that share a wire
+// encoding.
+var pubsubProtobufCompatibleTypes = [][]string{
+	{"int32", "uint32", "int64", "uint64", "bool"},
+	{"sint32", "sint64"},
+	{"fixed32", "sfixed32"},
+	{"fixed64", "sfixed64"},
+	{"string", "bytes"},
+}
+
+func pubsubProtobufTypesCompatible(oldType, newType string) bool {
+	oldType = strings.Join(strings.Fields(oldType), "")
+	newType = strings.Join(strings.Fields(newType), "")
+	if oldType == newType {
+		return true
+	}
+	for _, group := range pubsubProtobufCompatibleTypes {
+		oldOk, newOk := false, false
+		for _, t := range group {
+			oldOk = oldOk || t == oldType
+			newOk = newOk || t == newType
+		}
+		if oldOk && newOk {
+			return true
+		}
+	}
+	return false
+}
+
+// parsePubsubProtobufMessages returns the fields of each message of a
+// Protobuf definition, by qualified message name and field number.
+func parsePubsubProtobufMessages(definition string) map[string]map[string]pubsubProtobufField {
+	definition = pubsubProtobufCommentRegexp.ReplaceAllString(definition, "")
+	// Put every statement and brace on its own line.
+	definition = strings.NewReplacer("{", "{\n", "}", "\n}\n", ";", ";\n").Replace(definition)
+
+	messages := map[string]map[string]pubsubProtobufField{}
+	// The enclosing blocks, with their kind and name.
+	var stack [][2]string
+	for _, line := range strings.Split(definition, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		if m := pubsubProtobufBlockRegexp.FindStringSubmatch(line); m != nil {
+			stack = append(stack, [2]string{m[1], m[2]})
+			if m[1] == "message" {
+				messages[pubsubProtobufMessageName(stack)] = map[string]pubsubProtobufField{}
+			}
+			continue
+		}
+		if strings.HasSuffix(line, "{") {
+			// Options and other blocks.
+			stack = append(stack, [2]string{"", ""})
+			continue
+		}
+		if line == "}" {
+			if len(stack) > 0 {
+				stack = stack[:len(stack)-1]
+			}
+			continue
+		}
+		message := pubsubProtobufMessageName(stack)
+		if message == "" || !pubsubProtobufInMessage(stack) {
+			continue
+		}
+		if m := pubsubProtobufFieldRegexp.FindStringSubmatch(line); m != nil {
+			if m[2] == "option" || m[2] == "reserved" || m[2] == "extensions" {
+				continue
+			}
+			messages[message][m[4]] = pubsubProtobufField{label: m[1], typ: m[2], name: m[3]}
+		}
+	}
+	return messages
+}
+
+// pubsubProtobufMessageName returns the qualified name of the innermost
+// message of the stack.
+func pubsubProtobufMessageName(stack [][2]string) string {
+	var names []string
+	for _, block := range stack {
+		if block[0] == "message" {
+			names = append(names, block[1])
+		}
+	}
+	return strings.Join(names, ".")
+}
+
+// pubsubProtobufInMessage reports whether fields at the top of the stack
+// belong to a message, directly or through a oneof.
+func pubsubProtobufInMessage(stack [][2]string) bool {
+	if len(stack) == 0 {
+		return false
+	}
+	kind := stack[len(stack)-1][0]
+	return kind == "message" || kind == "oneof"
+}
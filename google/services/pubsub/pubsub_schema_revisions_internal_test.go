@@ -0,0 +1,164 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/services/pubsub/pubsub_schema_revisions_internal_test.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package pubsub
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCheckPubsubSchemaCompatibility(t *testing.T) {
+	t.Parallel()
+
+	avro := `{"type": "record", "name": "Avro", "fields": [
+  {"name": "id", "type": "int"},
+  {"name": "tags", "type": {"type": "array", "items": "string"}},
+  {"name": "kind", "type": {"type": "enum", "name": "Kind", "symbols": ["A", "B"]}}
+]}`
+	proto := `syntax = "proto3";
+// Results of a request.
+message Results {
+  string message_request = 1;
+  repeated int32 codes = 2;
+  Inner inner = 3;
+  message Inner {
+    int64 value = 1;
+  }
+  oneof payload {
+    string text = 4;
+    bytes data = 5;
+  }
+}`
+
+	cases := map[string]struct {
+		schemaType    string
+		oldDefinition string
+		newDefinition string
+		// ExpectError is a substring of the expected error, if any.
+		ExpectError string
+	}{
+		"avro unchanged": {
+			schemaType:    "AVRO",
+			oldDefinition: avro,
+			newDefinition: avro,
+		},
+		"avro field added with a default": {
+			schemaType:    "AVRO",
+			oldDefinition: avro,
+			newDefinition: strings.Replace(avro, `{"name": "id", "type": "int"}`, `{"name": "id", "type": "int"}, {"name": "note", "type": ["null", "string"], "default": null}`, 1),
+		},
+		"avro field added without a default": {
+			schemaType:    "AVRO",
+			oldDefinition: avro,
+			newDefinition: strings.Replace(avro, `{"name": "id", "type": "int"}`, `{"name": "id", "type": "int"}, {"name": "note", "type": "string"}`, 1),
+			ExpectError:   "note: field added without a default value",
+		},
+		"avro field removed": {
+			schemaType:    "AVRO",
+			oldDefinition: avro,
+			newDefinition: strings.Replace(avro, `{"name": "id", "type": "int"},`, ``, 1),
+		},
+		"avro type promoted": {
+			schemaType:    "AVRO",
+			oldDefinition: avro,
+			newDefinition: strings.Replace(avro, `"type": "int"`, `"type": "long"`, 1),
+		},
+		"avro type narrowed": {
+			schemaType:    "AVRO",
+			oldDefinition: strings.Replace(avro, `"type": "int"`, `"type": "long"`, 1),
+			newDefinition: avro,
+			ExpectError:   "id: type changed from long to int",
+		},
+		"avro type made nullable": {
+			schemaType:    "AVRO",
+			oldDefinition: avro,
+			newDefinition: strings.Replace(avro, `"type": "int"`, `"type": ["null", "int"]`, 1),
+		},
+		"avro array items changed": {
+			schemaType:    "AVRO",
+			oldDefinition: avro,
+			newDefinition: strings.Replace(avro, `"items": "string"`, `"items": "int"`, 1),
+			ExpectError:   "tags[]: type changed from string to int",
+		},
+		"avro enum symbol removed": {
+			schemaType:    "AVRO",
+			oldDefinition: avro,
+			newDefinition: strings.Replace(avro, `["A", "B"]`, `["A"]`, 1),
+			ExpectError:   "kind: enum symbol B removed",
+		},
+		"avro invalid definition": {
+			schemaType:    "AVRO",
+			oldDefinition: avro,
+			newDefinition: `{"type": `,
+			ExpectError:   "not a valid Avro schema",
+		},
+		"protobuf unchanged": {
+			schemaType:    "PROTOCOL_BUFFER",
+			oldDefinition: proto,
+			newDefinition: proto,
+		},
+		"protobuf field added": {
+			schemaType:    "PROTOCOL_BUFFER",
+			oldDefinition: proto,
+			newDefinition: strings.Replace(proto, "Inner inner = 3;", "Inner inner = 3;\n  string timestamp_request = 6;", 1),
+		},
+		"protobuf field removed": {
+			schemaType:    "PROTOCOL_BUFFER",
+			oldDefinition: proto,
+			newDefinition: strings.Replace(proto, "string message_request = 1;", "reserved 1;", 1),
+		},
+		"protobuf compatible type change": {
+			schemaType:    "PROTOCOL_BUFFER",
+			oldDefinition: proto,
+			newDefinition: strings.Replace(proto, "int64 value = 1;", "uint64 value = 1;", 1),
+		},
+		"protobuf nested type change": {
+			schemaType:    "PROTOCOL_BUFFER",
+			oldDefinition: proto,
+			newDefinition: strings.Replace(proto, "int64 value = 1;", "string value = 1;", 1),
+			ExpectError:   "Results.Inner: field value type changed from int64 to string",
+		},
+		"protobuf oneof field renamed": {
+			schemaType:    "PROTOCOL_BUFFER",
+			oldDefinition: proto,
+			newDefinition: strings.Replace(proto, "bytes data = 5;", "bytes blob = 5;", 1),
+			ExpectError:   "Results: field data renamed to blob",
+		},
+		"protobuf field made singular": {
+			schemaType:    "PROTOCOL_BUFFER",
+			oldDefinition: proto,
+			newDefinition: strings.Replace(proto, "repeated int32 codes = 2;", "int32 codes = 2;", 1),
+			ExpectError:   "Results: field codes changed between repeated and singular",
+		},
+		"protobuf required field added": {
+			schemaType:    "PROTOCOL_BUFFER",
+			oldDefinition: `syntax = "proto2"; message Results { optional string a = 1; }`,
+			newDefinition: `syntax = "proto2"; message Results { optional string a = 1; required string b = 2; }`,
+			ExpectError:   "Results: required field b added",
+		},
+	}
+
+	for tn, tc := range cases {
+		err := checkPubsubSchemaCompatibility(tc.schemaType, tc.oldDefinition, tc.newDefinition)
+		if tc.ExpectError == "" && err != nil {
+			t.Errorf("%s: unexpected error: %s", tn, err)
+		}
+		if tc.ExpectError != "" && (err == nil || !strings.Contains(err.Error(), tc.ExpectError)) {
+			t.Errorf("%s: expected error containing %q, got %v", tn, tc.ExpectError, err)
+		}
+	}
+}
@@ -115,6 +115,7 @@ func ResourcePubsubSchema() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			tpgresource.DefaultProviderProject,
 			tpgresource.DefaultProviderDeletionPolicy("DELETE"),
+			resourcePubsubSchemaRevisionCustomizeDiff,
 		),
 
 		Identity: &schema.ResourceIdentity{
@@ -162,11 +163,39 @@ error indicating that the limit has been reached require manually
 				Description:  `The type of the schema definition Default value: "TYPE_UNSPECIFIED" Possible values: ["TYPE_UNSPECIFIED", "PROTOCOL_BUFFER", "AVRO"]`,
 				Default:      "TYPE_UNSPECIFIED",
 			},
+			"compatibility": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidateEnum([]string{"BACKWARD", "NONE"}),
+				Description: `The compatibility check applied at plan time when the definition changes.
+With BACKWARD, the new definition must be able to read messages written
+with the current revision. With NONE, any definition is committed. Default value: "NONE" Possible values: ["BACKWARD", "NONE"]`,
+				Default: "NONE",
+			},
 			"revision_id": {
 				Type:        schema.TypeString,
 				Computed:    true,
 				Description: `Output only. The revision ID of the schema.`,
 			},
+			"revisions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: `The revisions of the schema, newest first. They are only refreshed when revision_id changes.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"revision_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The revision ID.`,
+						},
+						"revision_create_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The time the revision was committed.`,
+						},
+					},
+				},
+			},
 			"project": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -373,6 +402,11 @@ func resourcePubsubSchemaRead(d *schema.ResourceData, meta interface{}) error {
 			}
 		}
 	}
+	if _, ok := d.GetOkExists("compatibility"); !ok {
+		if err := d.Set("compatibility", "NONE"); err != nil {
+			return fmt.Errorf("Error setting compatibility: %s", err)
+		}
+	}
 	if err := d.Set("project", project); err != nil {
 		return fmt.Errorf("Error reading Schema: %s", err)
 	}
@@ -382,6 +416,18 @@ func resourcePubsubSchemaRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	// Revisions are only committed along with a new revision ID, so they are
+	// only listed when it changes, saving an API call on most reads.
+	if pubsubSchemaLatestRevisionId(d.Get("revisions").([]interface{})) != d.Get("revision_id").(string) {
+		revisions, err := listPubsubSchemaRevisions(config, d, billingProject, userAgent)
+		if err != nil {
+			return err
+		}
+		if err := d.Set("revisions", revisions); err != nil {
+			return fmt.Errorf("Error reading Schema: %s", err)
+		}
+	}
+
 	identity, err := d.Identity()
 	if err == nil && identity != nil {
 		if v, ok := identity.GetOk("name"); !ok && v == "" {
@@ -404,7 +450,7 @@ func resourcePubsubSchemaRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourcePubsubSchemaUpdate(d *schema.ResourceData, meta interface{}) error {
-	clientSideFields := map[string]bool{"deletion_policy": true}
+	clientSideFields := map[string]bool{"deletion_policy": true, "compatibility": true}
 	clientSideOnly := true
 	for field := range ResourcePubsubSchema().Schema {
 		if d.HasChange(field) && !clientSideFields[field] {
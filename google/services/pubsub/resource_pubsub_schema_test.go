@@ -18,6 +18,7 @@ package pubsub_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -58,6 +59,44 @@ func TestAccPubsubSchema_update(t *testing.T) {
 	})
 }
 
+func TestAccPubsubSchema_compatibility(t *testing.T) {
+	t.Parallel()
+
+	schema := fmt.Sprintf("tf-test-schema-%s", acctest.RandString(t, 10))
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckPubsubSchemaDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPubsubSchema_basic(schema),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_pubsub_schema.foo", "compatibility", "NONE"),
+					resource.TestCheckResourceAttr("google_pubsub_schema.foo", "revisions.#", "1"),
+				),
+			},
+			{
+				Config:      testAccPubsubSchema_incompatible(schema, "BACKWARD"),
+				ExpectError: regexp.MustCompile("not backward compatible"),
+			},
+			{
+				Config: testAccPubsubSchema_incompatible(schema, "NONE"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_pubsub_schema.foo", "revisions.#", "2"),
+					resource.TestCheckResourceAttrPair("google_pubsub_schema.foo", "revisions.0.revision_id", "google_pubsub_schema.foo", "revision_id"),
+				),
+			},
+			{
+				ResourceName:            "google_pubsub_schema.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"compatibility"},
+			},
+		},
+	})
+}
+
 func testAccPubsubSchema_basic(schema string) string {
 	return fmt.Sprintf(`
 	resource "google_pubsub_schema" "foo" {
@@ -77,3 +116,14 @@ func testAccPubsubSchema_updated(schema string) string {
 	}
 `, schema)
 }
+
+func testAccPubsubSchema_incompatible(schema, compatibility string) string {
+	return fmt.Sprintf(`
+	resource "google_pubsub_schema" "foo" {
+		name = "%s"
+		type = "PROTOCOL_BUFFER"
+		definition = "syntax = \"proto3\";\nmessage Results {\nstring message_request = 1;\nint64 message_response = 2;\n}"
+		compatibility = "%s"
+	}
+`, schema, compatibility)
+}
@@ -0,0 +1,272 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/services/pubsub/resource_pubsub_topic_schema_revision_window.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package pubsub
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-google/google/registry"
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourcePubsubTopicSchemaRevisionWindow() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePubsubTopicSchemaRevisionWindowCreate,
+		Read:   resourcePubsubTopicSchemaRevisionWindowRead,
+		Update: resourcePubsubTopicSchemaRevisionWindowUpdate,
+		Delete: resourcePubsubTopicSchemaRevisionWindowDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourcePubsubTopicSchemaRevisionWindowImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		CustomizeDiff: customdiff.All(
+			tpgresource.DefaultProviderProject,
+		),
+
+		Schema: map[string]*schema.Schema{
+			"topic": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: tpgresource.CompareSelfLinkOrResourceName,
+				Description: `The topic to pin the schema revisions of. The topic must already have schema_settings.
+Changing this forces a new resource to be created.`,
+			},
+
+			"first_revision_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"first_revision_id", "last_revision_id"},
+				Description:  `The minimum (inclusive) revision of the schema allowed for validating messages.`,
+			},
+
+			"last_revision_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"first_revision_id", "last_revision_id"},
+				Description:  `The maximum (inclusive) revision of the schema allowed for validating messages.`,
+			},
+
+			"project": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: `The ID of the project in which the resource belongs. If it is not provided, the provider project is used.`,
+			},
+		},
+		UseJSONNumber: true,
+	}
+}
+
+func resourcePubsubTopicSchemaRevisionWindowCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	project, err := tpgresource.GetProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	if err := pubsubSetTopicSchemaRevisionWindow(d, config, d.Get("first_revision_id").(string), d.Get("last_revision_id").(string), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	d.SetId(GetComputedTopicName(project, d.Get("topic").(string)))
+
+	return resourcePubsubTopicSchemaRevisionWindowRead(d, meta)
+}
+
+func resourcePubsubTopicSchemaRevisionWindowRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	project, err := tpgresource.GetProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	topic, err := pubsubGetTopicForSchemaRevisionWindow(d, config, d.Timeout(schema.TimeoutRead))
+	if err != nil {
+		return transport_tpg.HandleNotFoundError(err, d, fmt.Sprintf("PubsubTopicSchemaRevisionWindow %q", d.Id()))
+	}
+
+	settings, ok := topic["schemaSettings"].(map[string]interface{})
+	if !ok {
+		log.Printf("[WARN] Removing PubsubTopicSchemaRevisionWindow %q because the topic has no schema_settings", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error setting project: %s", err)
+	}
+	if err := d.Set("first_revision_id", settings["firstRevisionId"]); err != nil {
+		return fmt.Errorf("Error setting first_revision_id: %s", err)
+	}
+	if err := d.Set("last_revision_id", settings["lastRevisionId"]); err != nil {
+		return fmt.Errorf("Error setting last_revision_id: %s", err)
+	}
+	return nil
+}
+
+func resourcePubsubTopicSchemaRevisionWindowUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+
+	if err := pubsubSetTopicSchemaRevisionWindow(d, config, d.Get("first_revision_id").(string), d.Get("last_revision_id").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return err
+	}
+
+	return resourcePubsubTopicSchemaRevisionWindowRead(d, meta)
+}
+
+func resourcePubsubTopicSchemaRevisionWindowDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+
+	if _, err := pubsubGetTopicForSchemaRevisionWindow(d, config, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return transport_tpg.HandleNotFoundError(err, d, fmt.Sprintf("PubsubTopicSchemaRevisionWindow %q", d.Id()))
+	}
+
+	// Clearing the window lets messages be validated against any revision again.
+	return pubsubSetTopicSchemaRevisionWindow(d, config, "", "", d.Timeout(schema.TimeoutDelete))
+}
+
+func resourcePubsubTopicSchemaRevisionWindowImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*transport_tpg.Config)
+	if err := tpgresource.ParseImportId([]string{
+		"^projects/(?P<project>[^/]+)/topics/(?P<topic>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<topic>[^/]+)$",
+	}, d, config); err != nil {
+		return nil, err
+	}
+
+	id, err := tpgresource.ReplaceVars(d, config, "projects/{{project}}/topics/{{topic}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// pubsubGetTopicForSchemaRevisionWindow reads the topic the window belongs to.
+func pubsubGetTopicForSchemaRevisionWindow(d *schema.ResourceData, config *transport_tpg.Config, timeout time.Duration) (map[string]interface{}, error) {
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return nil, err
+	}
+	project, err := tpgresource.GetProject(d, config)
+	if err != nil {
+		return nil, err
+	}
+	billingProject := project
+	if bp, err := tpgresource.GetBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	return transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+		Config:               config,
+		Method:               "GET",
+		Project:              billingProject,
+		RawURL:               transport_tpg.BaseUrl(Product, config) + GetComputedTopicName(project, d.Get("topic").(string)),
+		UserAgent:            userAgent,
+		Timeout:              timeout,
+		ErrorRetryPredicates: []transport_tpg.RetryErrorPredicateFunc{transport_tpg.PubsubTopicProjectNotReady},
+	})
+}
+
+// pubsubSetTopicSchemaRevisionWindow updates the revision window of a topic,
+// keeping the rest of its schema settings.
+func pubsubSetTopicSchemaRevisionWindow(d *schema.ResourceData, config *transport_tpg.Config, firstRevisionId, lastRevisionId string, timeout time.Duration) error {
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+	project, err := tpgresource.GetProject(d, config)
+	if err != nil {
+		return err
+	}
+	billingProject := project
+	if bp, err := tpgresource.GetBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+	topicName := GetComputedTopicName(project, d.Get("topic").(string))
+
+	topic, err := pubsubGetTopicForSchemaRevisionWindow(d, config, timeout)
+	if err != nil {
+		return fmt.Errorf("Error reading Topic %q: %s", topicName, err)
+	}
+	settings, ok := topic["schemaSettings"].(map[string]interface{})
+	if !ok {
+		if firstRevisionId == "" && lastRevisionId == "" {
+			return nil
+		}
+		return fmt.Errorf("Error, topic %s has no schema_settings to pin the revisions of", topicName)
+	}
+
+	delete(settings, "firstRevisionId")
+	delete(settings, "lastRevisionId")
+	if firstRevisionId != "" {
+		settings["firstRevisionId"] = firstRevisionId
+	}
+	if lastRevisionId != "" {
+		settings["lastRevisionId"] = lastRevisionId
+	}
+
+	url, err := transport_tpg.AddQueryParams(transport_tpg.BaseUrl(Product, config)+topicName, map[string]string{"updateMask": "schemaSettings"})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Setting the schema revision window of Topic %q to [%q, %q]", topicName, firstRevisionId, lastRevisionId)
+	_, err = transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+		Config:    config,
+		Method:    "PATCH",
+		Project:   billingProject,
+		RawURL:    url,
+		UserAgent: userAgent,
+		Body: map[string]interface{}{
+			"topic": map[string]interface{}{
+				"schemaSettings": settings,
+			},
+		},
+		Timeout:              timeout,
+		ErrorRetryPredicates: []transport_tpg.RetryErrorPredicateFunc{transport_tpg.PubsubTopicProjectNotReady},
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating the schema revision window of Topic %q: %s", topicName, err)
+	}
+	return nil
+}
+
+func init() {
+	registry.Schema{
+		Name:        "google_pubsub_topic_schema_revision_window",
+		ProductName: "pubsub",
+		Type:        registry.SchemaTypeResource,
+		Schema:      ResourcePubsubTopicSchemaRevisionWindow(),
+	}.Register()
+}
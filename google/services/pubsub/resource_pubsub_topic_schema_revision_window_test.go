@@ -0,0 +1,116 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/services/pubsub/resource_pubsub_topic_schema_revision_window_test.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package pubsub_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-google/google/acctest"
+)
+
+func TestAccPubsubTopicSchemaRevisionWindow_basic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(t, 10),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckPubsubTopicDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPubsubTopicSchemaRevisionWindow_first(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("google_pubsub_topic_schema_revision_window.window", "first_revision_id", "google_pubsub_schema.schema", "revision_id"),
+					resource.TestCheckResourceAttr("google_pubsub_topic_schema_revision_window.window", "last_revision_id", ""),
+				),
+			},
+			{
+				ResourceName:      "google_pubsub_topic_schema_revision_window.window",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPubsubTopicSchemaRevisionWindow_last(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_pubsub_topic_schema_revision_window.window", "first_revision_id", ""),
+					resource.TestCheckResourceAttrPair("google_pubsub_topic_schema_revision_window.window", "last_revision_id", "google_pubsub_schema.schema", "revision_id"),
+				),
+			},
+			{
+				ResourceName:      "google_pubsub_topic_schema_revision_window.window",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// The topic keeps its schema settings once the window is removed.
+				Config: testAccPubsubTopicSchemaRevisionWindow_topic(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_pubsub_topic.topic", "schema_settings.0.first_revision_id", ""),
+					resource.TestCheckResourceAttr("google_pubsub_topic.topic", "schema_settings.0.last_revision_id", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccPubsubTopicSchemaRevisionWindow_topic(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_pubsub_schema" "schema" {
+  name       = "tf-test-schema-%{random_suffix}"
+  type       = "PROTOCOL_BUFFER"
+  definition = "syntax = \"proto3\";\nmessage Results {\nstring message_request = 1;\nstring message_response = 2;\n}"
+}
+
+resource "google_pubsub_topic" "topic" {
+  name = "tf-test-topic-%{random_suffix}"
+
+  schema_settings {
+    schema   = google_pubsub_schema.schema.id
+    encoding = "JSON"
+  }
+
+  lifecycle {
+    ignore_changes = [
+      schema_settings[0].first_revision_id,
+      schema_settings[0].last_revision_id,
+    ]
+  }
+}
+`, context)
+}
+
+func testAccPubsubTopicSchemaRevisionWindow_first(context map[string]interface{}) string {
+	return testAccPubsubTopicSchemaRevisionWindow_topic(context) + `
+resource "google_pubsub_topic_schema_revision_window" "window" {
+  topic             = google_pubsub_topic.topic.name
+  first_revision_id = google_pubsub_schema.schema.revision_id
+}
+`
+}
+
+func testAccPubsubTopicSchemaRevisionWindow_last(context map[string]interface{}) string {
+	return testAccPubsubTopicSchemaRevisionWindow_topic(context) + `
+resource "google_pubsub_topic_schema_revision_window" "window" {
+  topic            = google_pubsub_topic.topic.name
+  last_revision_id = google_pubsub_schema.schema.revision_id
+}
+`
+}
@@ -65,5 +65,5 @@ func testSweepRecaptchaEnterpriseKey(region string) error {
 }
 
 func isDeletableRecaptchaEnterpriseKey(r *Key) bool {
-	return sweeper.IsSweepableTestResource(*r.Name)
+	return sweeper.ShouldSweepTyped(*r.Name, r)
 }
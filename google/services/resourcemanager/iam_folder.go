@@ -189,6 +189,8 @@ func NewFolderIamMemberListResource() list.ListResource {
 			ParentResourceField: "folder",
 			EnableRoleFilter:    true,
 			EnableMemberFilter:  true,
+			AssetType:           "cloudresourcemanager.googleapis.com/Folder",
+			AssetNameFormat:     "//cloudresourcemanager.googleapis.com/{{folder}}",
 		},
 	)
 }
@@ -94,6 +94,8 @@ func NewProjectIamMemberListResource() list.ListResource {
 			ParentResourceField: "project",
 			EnableRoleFilter:    true,
 			EnableMemberFilter:  true,
+			AssetType:           "cloudresourcemanager.googleapis.com/Project",
+			AssetNameFormat:     "//cloudresourcemanager.googleapis.com/projects/{{project}}",
 		},
 	)
 }
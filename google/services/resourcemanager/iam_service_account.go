@@ -167,6 +167,8 @@ func NewServiceAccountIamMemberListResource() list.ListResource {
 			ParentResourceField: "service_account_id",
 			EnableRoleFilter:    true,
 			EnableMemberFilter:  true,
+			AssetType:           "iam.googleapis.com/ServiceAccount",
+			AssetNameFormat:     "//iam.googleapis.com/{{service_account_id}}",
 		},
 	)
 }
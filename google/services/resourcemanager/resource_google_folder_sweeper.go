@@ -79,7 +79,7 @@ func testSweepFolder(region string) error {
 		}
 
 		for _, folder := range found.Folders {
-			if !strings.HasPrefix(folder.DisplayName, TestPrefix) {
+			if !strings.HasPrefix(folder.DisplayName, TestPrefix) || sweeper.SkipForDryRun(folder.Name) {
 				continue
 			}
 			log.Printf("[INFO][SWEEPER_LOG] Sweeping Folder id: %s, name: %s", folder.Name, folder.DisplayName)
@@ -345,7 +345,7 @@ func resourceGoogleProjectServiceDelete(d *schema.ResourceData, meta interface{}
 
 	service := d.Get("service").(string)
 	disableDependencies := d.Get("disable_dependent_services").(bool)
-	err = disableServiceUsageProjectService(service, project, d, config, disableDependencies)
+	err = disableServiceUsageProjectService(service, project, d, config, disableDependencies, "")
 	if err != nil {
 		return transport_tpg.HandleNotFoundError(err, d, fmt.Sprintf("Project Service %s", d.Id()))
 	}
@@ -373,8 +373,9 @@ func resourceGoogleProjectServiceUpdate(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-// Disables a project service.
-func disableServiceUsageProjectService(service, project string, d *schema.ResourceData, config *transport_tpg.Config, disableDependentServices bool) error {
+// Disables a project service. checkIfServiceHasUsage is passed to the API as
+// is, and an empty value uses the API's default (SKIP).
+func disableServiceUsageProjectService(service, project string, d *schema.ResourceData, config *transport_tpg.Config, disableDependentServices bool, checkIfServiceHasUsage string) error {
 	err := transport_tpg.Retry(transport_tpg.RetryOptions{
 		RetryFunc: func() error {
 			billingProject := project
@@ -385,6 +386,7 @@ func disableServiceUsageProjectService(service, project string, d *schema.Resour
 			name := fmt.Sprintf("projects/%s/services/%s", project, service)
 			servicesDisableCall := tpgserviceusage.NewClient(config, userAgent).Services.Disable(name, &serviceusage.DisableServiceRequest{
 				DisableDependentServices: disableDependentServices,
+				CheckIfServiceHasUsage:   checkIfServiceHasUsage,
 			})
 			if config.UserProjectOverride {
 				// err == nil indicates that the billing_project value was found
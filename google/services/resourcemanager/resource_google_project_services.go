@@ -0,0 +1,493 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/services/resourcemanager/resource_google_project_services.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package resourcemanager
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-google/google/registry"
+	tpgserviceusage "github.com/hashicorp/terraform-provider-google/google/services/serviceusage"
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+	"github.com/hashicorp/terraform-provider-google/google/verify"
+)
+
+func ResourceGoogleProjectServices() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGoogleProjectServicesCreate,
+		Read:   resourceGoogleProjectServicesRead,
+		Update: resourceGoogleProjectServicesUpdate,
+		Delete: resourceGoogleProjectServicesDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceGoogleProjectServicesImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		CustomizeDiff: customdiff.All(
+			tpgresource.DefaultProviderDeletionPolicy("DELETE"),
+			tpgresource.DefaultProviderProject,
+			resourceGoogleProjectServicesCustomizeDiff,
+		),
+
+		Schema: map[string]*schema.Schema{
+			"services": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateProjectServiceService,
+				},
+				Description: `The services to enable in the project. Enabled services that aren't listed, and aren't
+a dependency of a listed service, are disabled.`,
+			},
+			"project": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: tpgresource.CompareResourceNames,
+				Description:      `The ID of the project in which the resource belongs. If it is not provided, the provider project is used.`,
+			},
+			"check_if_service_has_usage": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "CHECK",
+				ValidateFunc: verify.ValidateEnum([]string{"CHECK", "SKIP"}),
+				Description: `Whether to refuse to disable a service that, or whose dependents, had usage in the last
+30 days, for example because resources in the project still use it. Default value: "CHECK" Possible values: ["CHECK", "SKIP"]`,
+			},
+			"disable_on_destroy": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: `Whether to disable the services and their dependencies when the resource is destroyed.`,
+			},
+			"dependency_services": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Description: `The services that aren't listed in services, but that listed services depend on,
+directly or transitively. They are kept enabled.`,
+			},
+			//UDP schema start
+			"deletion_policy": tpgresource.DeletionPolicySchemaEntry("DELETE"),
+			//UDP schema end
+		},
+		UseJSONNumber: true,
+	}
+}
+
+// resourceGoogleProjectServicesCustomizeDiff plans the dependencies of the
+// listed services, so that the plan shows the ones that are disabled along
+// with the services removed from the list.
+func resourceGoogleProjectServicesCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && !d.HasChange("services") {
+		return nil
+	}
+	if !d.NewValueKnown("services") || !d.NewValueKnown("project") {
+		return d.SetNewComputed("dependency_services")
+	}
+
+	config := meta.(*transport_tpg.Config)
+	project, err := tpgresource.GetProjectFromDiff(d, config)
+	if err != nil {
+		return err
+	}
+	project = tpgresource.GetResourceNameFromSelfLink(project)
+	billingProject := project
+	if config.BillingProject != "" {
+		billingProject = config.BillingProject
+	}
+
+	services := tpgresource.ConvertStringSet(d.Get("services").(*schema.Set))
+	deps, err := readProjectServicesDependencies(services, project, billingProject, config.UserAgent, config, transport_tpg.DefaultRequestTimeout)
+	if err != nil {
+		if d.Id() == "" {
+			// The project may be created in the same apply.
+			log.Printf("[DEBUG] Couldn't read the dependencies of the services of project %s, they're known after apply: %s", project, err)
+			return d.SetNewComputed("dependency_services")
+		}
+		return err
+	}
+	return d.SetNew("dependency_services", projectServicesDependencySet(services, deps))
+}
+
+func resourceGoogleProjectServicesCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+
+	project, err := tpgresource.GetProject(d, config)
+	if err != nil {
+		return err
+	}
+	project = tpgresource.GetResourceNameFromSelfLink(project)
+
+	if err := applyGoogleProjectServices(project, d, config, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	d.SetId(project)
+	return resourceGoogleProjectServicesRead(d, meta)
+}
+
+func resourceGoogleProjectServicesRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+
+	project, err := tpgresource.GetProject(d, config)
+	if err != nil {
+		return err
+	}
+	project = tpgresource.GetResourceNameFromSelfLink(project)
+
+	servicesRaw, err := BatchRequestReadServices(project, d, config)
+	if err != nil {
+		return transport_tpg.HandleNotFoundError(err, d, fmt.Sprintf("Project Services %s", d.Id()))
+	}
+	enabled := servicesRaw.(map[string]struct{})
+
+	// Every enabled service is either listed, or a dependency of a listed
+	// service, so that enabled services outside of both show up as a diff.
+	knownDeps := make(map[string]struct{})
+	for _, s := range tpgresource.ConvertStringSet(d.Get("dependency_services").(*schema.Set)) {
+		knownDeps[s] = struct{}{}
+	}
+	var services, deps []string
+	for s := range enabled {
+		if _, ok := knownDeps[s]; ok {
+			deps = append(deps, s)
+		} else {
+			services = append(services, s)
+		}
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error setting project: %s", err)
+	}
+	if err := d.Set("services", services); err != nil {
+		return fmt.Errorf("Error setting services: %s", err)
+	}
+	if err := d.Set("dependency_services", deps); err != nil {
+		return fmt.Errorf("Error setting dependency_services: %s", err)
+	}
+	if _, ok := d.GetOk("check_if_service_has_usage"); !ok {
+		if err := d.Set("check_if_service_has_usage", "CHECK"); err != nil {
+			return fmt.Errorf("Error setting check_if_service_has_usage: %s", err)
+		}
+	}
+	if err := tpgresource.DeletionPolicyReadDefault(d, config, "DELETE"); err != nil {
+		return err
+	}
+	return nil
+}
+
+func resourceGoogleProjectServicesUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+
+	if d.HasChange("services") {
+		project, err := tpgresource.GetProject(d, config)
+		if err != nil {
+			return err
+		}
+		project = tpgresource.GetResourceNameFromSelfLink(project)
+
+		if err := applyGoogleProjectServices(project, d, config, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceGoogleProjectServicesRead(d, meta)
+}
+
+func resourceGoogleProjectServicesDelete(d *schema.ResourceData, meta interface{}) error {
+	if ok, err := tpgresource.DeletionPolicyPreDelete(d); err != nil {
+		return err
+	} else if ok {
+		return nil
+	}
+
+	if !d.Get("disable_on_destroy").(bool) {
+		log.Printf("[WARN] Project services %q disable_on_destroy is false, skip disabling services", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	config := meta.(*transport_tpg.Config)
+	project, err := tpgresource.GetProject(d, config)
+	if err != nil {
+		return err
+	}
+	project = tpgresource.GetResourceNameFromSelfLink(project)
+
+	managed := tpgresource.ConvertStringSet(d.Get("services").(*schema.Set))
+	managed = append(managed, tpgresource.ConvertStringSet(d.Get("dependency_services").(*schema.Set))...)
+	if err := disableGoogleProjectServices(managed, project, d, config, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return transport_tpg.HandleNotFoundError(err, d, fmt.Sprintf("Project Services %s", d.Id()))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceGoogleProjectServicesImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*transport_tpg.Config)
+	if err := tpgresource.ParseImportId([]string{
+		"^projects/(?P<project>[^/]+)$",
+		"^(?P<project>[^/]+)$",
+	}, d, config); err != nil {
+		return nil, err
+	}
+	d.SetId(d.Get("project").(string))
+	return []*schema.ResourceData{d}, nil
+}
+
+// applyGoogleProjectServices makes the enabled services of the project match
+// the listed services and their dependencies. It enables missing services
+// before their dependents, and disables the other services before their
+// dependencies.
+func applyGoogleProjectServices(project string, d *schema.ResourceData, config *transport_tpg.Config, timeout time.Duration) error {
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+	billingProject := project
+	// err == nil indicates that the billing_project value was found
+	if bp, err := tpgresource.GetBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	services := tpgresource.ConvertStringSet(d.Get("services").(*schema.Set))
+	deps, err := readProjectServicesDependencies(services, project, billingProject, userAgent, config, timeout)
+	if err != nil {
+		return err
+	}
+	wanted := make(map[string]struct{})
+	for _, s := range services {
+		wanted[s] = struct{}{}
+	}
+	for _, s := range projectServicesDependencySet(services, deps) {
+		wanted[s] = struct{}{}
+	}
+
+	servicesRaw, err := BatchRequestReadServices(project, d, config)
+	if err != nil {
+		return err
+	}
+	enabled := servicesRaw.(map[string]struct{})
+
+	var toEnable, toDisable []string
+	for s := range wanted {
+		if _, ok := enabled[s]; !ok {
+			toEnable = append(toEnable, s)
+		}
+	}
+	for s := range enabled {
+		if _, ok := wanted[s]; !ok {
+			toDisable = append(toDisable, s)
+		}
+	}
+
+	if len(toEnable) > 0 {
+		enableDeps, err := readProjectServicesDependencies(toEnable, project, billingProject, userAgent, config, timeout)
+		if err != nil {
+			return err
+		}
+		for _, layer := range projectServicesLayers(toEnable, enableDeps) {
+			log.Printf("[DEBUG] Enabling services %q in project %s", layer, project)
+			if err := BatchRequestEnableServices(layer, project, d, config, timeout); err != nil {
+				return err
+			}
+		}
+	}
+
+	return disableGoogleProjectServices(toDisable, project, d, config, timeout)
+}
+
+// disableGoogleProjectServices disables the given services, each before the
+// services it depends on, so that no service is disabled while an enabled
+// service still depends on it.
+func disableGoogleProjectServices(services []string, project string, d *schema.ResourceData, config *transport_tpg.Config, timeout time.Duration) error {
+	if len(services) == 0 {
+		return nil
+	}
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+	billingProject := project
+	// err == nil indicates that the billing_project value was found
+	if bp, err := tpgresource.GetBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	deps, err := readProjectServicesDependencies(services, project, billingProject, userAgent, config, timeout)
+	if err != nil {
+		return err
+	}
+	layers := projectServicesLayers(services, deps)
+	checkUsage := d.Get("check_if_service_has_usage").(string)
+	for i := len(layers) - 1; i >= 0; i-- {
+		for _, service := range layers[i] {
+			log.Printf("[DEBUG] Disabling service %s in project %s", service, project)
+			if err := disableServiceUsageProjectService(service, project, d, config, false, checkUsage); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// readProjectServicesDependencies returns the services that each of the given
+// services depends on, directly or transitively.
+func readProjectServicesDependencies(services []string, project, billingProject, userAgent string, config *transport_tpg.Config, timeout time.Duration) (map[string][]string, error) {
+	deps := make(map[string][]string, len(services))
+	for _, service := range services {
+		d, err := listProjectServiceDependencies(service, project, billingProject, userAgent, config, timeout)
+		if err != nil {
+			return nil, err
+		}
+		deps[service] = d
+	}
+	return deps, nil
+}
+
+// listProjectServiceDependencies lists the descendants of the dependencies
+// group of a service in the Service Usage dependency graph.
+func listProjectServiceDependencies(service, project, billingProject, userAgent string, config *transport_tpg.Config, timeout time.Duration) ([]string, error) {
+	// The dependency graph is only available in the v2beta API.
+	url := fmt.Sprintf("%sv2beta/projects/%s/services/%s/groups/dependencies/descendantServices", transport_tpg.RemoveBasePathVersion(transport_tpg.BaseUrl(tpgserviceusage.Product, config)), project, service)
+
+	var deps []string
+	params := map[string]string{"pageSize": "1000"}
+	for {
+		pageUrl, err := transport_tpg.AddQueryParams(url, params)
+		if err != nil {
+			return nil, err
+		}
+		res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+			Config:    config,
+			Method:    "GET",
+			Project:   billingProject,
+			RawURL:    pageUrl,
+			UserAgent: userAgent,
+			Timeout:   timeout,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("Error listing the dependencies of service %q: %s", service, err)
+		}
+		if raw, ok := res["services"].([]interface{}); ok {
+			for _, v := range raw {
+				dep, ok := v.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				// services are returned as "services/{{name}}"
+				if name, ok := dep["serviceName"].(string); ok && name != "" {
+					deps = append(deps, tpgresource.GetResourceNameFromSelfLink(name))
+				}
+			}
+		}
+		token, _ := res["nextPageToken"].(string)
+		if token == "" {
+			break
+		}
+		params["pageToken"] = token
+	}
+	return deps, nil
+}
+
+// projectServicesDependencySet returns the dependencies of the given services
+// that aren't in services themselves, sorted.
+func projectServicesDependencySet(services []string, deps map[string][]string) []string {
+	listed := make(map[string]struct{}, len(services))
+	for _, s := range services {
+		listed[s] = struct{}{}
+	}
+	set := make(map[string]struct{})
+	for _, s := range services {
+		for _, dep := range deps[s] {
+			if _, ok := listed[dep]; !ok {
+				set[dep] = struct{}{}
+			}
+		}
+	}
+	result := make([]string, 0, len(set))
+	for s := range set {
+		result = append(result, s)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// projectServicesLayers orders services so that each service comes in a later
+// layer than the services it depends on among them. Services in the same layer
+// don't depend on each other. Services in a dependency cycle share the last
+// layer.
+func projectServicesLayers(services []string, deps map[string][]string) [][]string {
+	remaining := make(map[string]struct{}, len(services))
+	for _, s := range services {
+		remaining[s] = struct{}{}
+	}
+
+	var layers [][]string
+	for len(remaining) > 0 {
+		var layer []string
+		for s := range remaining {
+			ready := true
+			for _, dep := range deps[s] {
+				if _, ok := remaining[dep]; ok && dep != s {
+					ready = false
+					break
+				}
+			}
+			if ready {
+				layer = append(layer, s)
+			}
+		}
+		if len(layer) == 0 {
+			for s := range remaining {
+				layer = append(layer, s)
+			}
+		}
+		sort.Strings(layer)
+		for _, s := range layer {
+			delete(remaining, s)
+		}
+		layers = append(layers, layer)
+	}
+	return layers
+}
+
+func init() {
+	registry.Schema{
+		Name:        "google_project_services",
+		ProductName: "resourcemanager",
+		Type:        registry.SchemaTypeResource,
+		Schema:      ResourceGoogleProjectServices(),
+	}.Register()
+}
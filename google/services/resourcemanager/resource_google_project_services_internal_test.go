@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/services/resourcemanager/resource_google_project_services_internal_test.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package resourcemanager
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestProjectServicesLayers(t *testing.T) {
+	cases := map[string]struct {
+		services []string
+		deps     map[string][]string
+		expected [][]string
+	}{
+		"independent": {
+			services: []string{"b.googleapis.com", "a.googleapis.com"},
+			expected: [][]string{{"a.googleapis.com", "b.googleapis.com"}},
+		},
+		"chain": {
+			services: []string{"a.googleapis.com", "b.googleapis.com", "c.googleapis.com"},
+			deps: map[string][]string{
+				"a.googleapis.com": {"b.googleapis.com", "c.googleapis.com"},
+				"b.googleapis.com": {"c.googleapis.com"},
+			},
+			expected: [][]string{{"c.googleapis.com"}, {"b.googleapis.com"}, {"a.googleapis.com"}},
+		},
+		"dependencies outside the services": {
+			services: []string{"a.googleapis.com", "b.googleapis.com"},
+			deps: map[string][]string{
+				"a.googleapis.com": {"x.googleapis.com"},
+				"b.googleapis.com": {"a.googleapis.com", "x.googleapis.com"},
+			},
+			expected: [][]string{{"a.googleapis.com"}, {"b.googleapis.com"}},
+		},
+		"cycle": {
+			services: []string{"a.googleapis.com", "b.googleapis.com", "c.googleapis.com"},
+			deps: map[string][]string{
+				"a.googleapis.com": {"b.googleapis.com", "c.googleapis.com"},
+				"b.googleapis.com": {"a.googleapis.com", "c.googleapis.com"},
+			},
+			expected: [][]string{{"c.googleapis.com"}, {"a.googleapis.com", "b.googleapis.com"}},
+		},
+	}
+
+	for tn, tc := range cases {
+		if got := projectServicesLayers(tc.services, tc.deps); !reflect.DeepEqual(got, tc.expected) {
+			t.Errorf("bad: %s, expected layers %q, got %q", tn, tc.expected, got)
+		}
+	}
+}
+
+func TestProjectServicesDependencySet(t *testing.T) {
+	services := []string{"bigquery.googleapis.com", "bigquerystorage.googleapis.com", "pubsub.googleapis.com"}
+	deps := map[string][]string{
+		"bigquery.googleapis.com": {"bigqueryconnection.googleapis.com", "bigquerystorage.googleapis.com"},
+		"pubsub.googleapis.com":   {"bigqueryconnection.googleapis.com"},
+	}
+	expected := []string{"bigqueryconnection.googleapis.com"}
+	if got := projectServicesDependencySet(services, deps); !reflect.DeepEqual(got, expected) {
+		t.Errorf("bad: expected %q, got %q", expected, got)
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/services/resourcemanager/resource_google_project_services_test.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package resourcemanager_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-google/google/acctest"
+	"github.com/hashicorp/terraform-provider-google/google/envvar"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// Test that the enabled services of a project follow the list, along with their dependencies
+func TestAccProjectServices_basic(t *testing.T) {
+	t.Parallel()
+	// Enables and disables services in a new project
+	acctest.SkipIfVcr(t)
+
+	org := envvar.GetTestOrgFromEnv(t)
+	billingId := envvar.GetTestBillingAccountFromEnv(t)
+	pid := fmt.Sprintf("tf-test-%d", acctest.RandInt(t))
+	base := []string{"serviceusage.googleapis.com", "cloudresourcemanager.googleapis.com"}
+	withBigQuery := append([]string{"bigquery.googleapis.com"}, base...)
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectServices_basic(withBigQuery, pid, org, billingId),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProjectService(t, withBigQuery, pid, true),
+					// BigQuery depends on the BigQuery Storage API.
+					resource.TestCheckTypeSetElemAttr("google_project_services.test", "dependency_services.*", "bigquerystorage.googleapis.com"),
+					testAccCheckProjectService(t, []string{"bigquerystorage.googleapis.com"}, pid, true),
+				),
+			},
+			{
+				ResourceName:            "google_project_services.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"disable_on_destroy", "dependency_services", "services"},
+			},
+			{
+				Config: testAccProjectServices_basic(base, pid, org, billingId),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProjectService(t, base, pid, true),
+					// The dependency is disabled along with the service.
+					testAccCheckProjectService(t, []string{"bigquery.googleapis.com", "bigquerystorage.googleapis.com"}, pid, false),
+					resource.TestCheckResourceAttr("google_project_services.test", "dependency_services.#", "0"),
+				),
+			},
+			{
+				ResourceName:            "google_project_services.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"disable_on_destroy"},
+			},
+		},
+	})
+}
+
+func testAccProjectServices_basic(services []string, pid, org, billing string) string {
+	return fmt.Sprintf(`
+resource "google_project" "acceptance" {
+  project_id      = "%s"
+  name            = "%s"
+  org_id          = "%s"
+  billing_account = "%s"
+  deletion_policy = "DELETE"
+}
+
+resource "google_project_services" "test" {
+  project  = google_project.acceptance.project_id
+  services = ["%s"]
+}
+`, pid, pid, org, billing, strings.Join(services, `", "`))
+}
@@ -79,6 +79,9 @@ func testSweepProject(region string) error {
 
 		fSvc := resourcemanagerv3.NewClient(config, config.UserAgent)
 		for _, project := range found.Projects {
+			if sweeper.SkipForDryRun("projects/" + project.ProjectId) {
+				continue
+			}
 			log.Printf("[INFO][SWEEPER_LOG] Sweeping Project id: %s", project.ProjectId)
 			cleanupLiens(fSvc, "projects/"+project.ProjectId, config)
 
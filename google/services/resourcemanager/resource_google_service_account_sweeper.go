@@ -78,7 +78,7 @@ func testSweepServiceAccount(region string) error {
 
 		id := tpgresource.GetResourceNameFromSelfLink(obj["name"].(string))
 		// Increment count and skip if resource is not sweepable.
-		if !sweeper.IsSweepableTestResource(id) {
+		if !sweeper.ShouldSweep(id, obj) {
 			nonPrefixCount++
 			continue
 		}
@@ -67,6 +67,50 @@ func BatchRequestEnableService(service string, project string, d *schema.Resourc
 	return err
 }
 
+// BatchRequestEnableServices batches a request to enable several services
+// with the requests of other resource nodes, such as google_project_service(s)
+// resources of the same project.
+func BatchRequestEnableServices(services []string, project string, d *schema.ResourceData, config *transport_tpg.Config, timeout time.Duration) error {
+	var toBatch []string
+	for _, service := range services {
+		if altName, ok := renamedServicesByOldAndNewServiceNames[service]; ok {
+			if err := tryEnableRenamedService(service, altName, project, d, config); err != nil {
+				return err
+			}
+			continue
+		}
+		toBatch = append(toBatch, service)
+	}
+	if len(toBatch) == 0 {
+		return nil
+	}
+
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	billingProject := project
+	// err == nil indicates that the billing_project value was found
+	if bp, err := tpgresource.GetBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	req := &transport_tpg.BatchRequest{
+		ResourceName: project,
+		Body:         toBatch,
+		CombineF:     combineServiceUsageServicesBatches,
+		SendF:        sendBatchFuncEnableServices(config, userAgent, billingProject, timeout),
+		DebugId:      fmt.Sprintf("Enable Project Services %q for project %q", toBatch, project),
+	}
+
+	_, err = config.RequestBatcherServiceUsage.SendRequestWithTimeout(
+		fmt.Sprintf(batchKeyTmplServiceUsageEnableServices, project),
+		req,
+		timeout)
+	return err
+}
+
 func tryEnableRenamedService(service, altName string, project string, d *schema.ResourceData, config *transport_tpg.Config) error {
 	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
 	if err != nil {
@@ -148,12 +148,15 @@ func ResourceSecretManagerRegionalRegionalSecretVersion() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"secret_data": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: `The secret data. Must be no larger than 64KiB.`,
-				Sensitive:   true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  `The secret data. Must be no larger than 64KiB.`,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"secret_data", "secret_data_wo"},
 			},
+			"secret_data_wo":         tpgresource.WriteOnlySchema("secret_data", `Write-only secret data. Must be no larger than 64KiB.`, true),
+			"secret_data_wo_version": tpgresource.WriteOnlyVersionSchema("secret_data", true),
 
 			"secret": {
 				Type:             schema.TypeString,
@@ -587,6 +590,11 @@ func flattenSecretManagerRegionalRegionalSecretVersionEnabled(v interface{}, d *
 func flattenSecretManagerRegionalRegionalSecretVersionPayload(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	transformed := make(map[string]interface{})
 
+	// write-only: the secret data isn't kept in state
+	if _, ok := d.GetOkExists("secret_data_wo_version"); ok {
+		return []interface{}{transformed}
+	}
+
 	// if this secret version is disabled, the api will return an error, as the value cannot be accessed, return what we have
 	if d.Get("enabled").(bool) == false {
 		transformed["secret_data"] = d.Get("secret_data")
@@ -635,7 +643,7 @@ func expandSecretManagerRegionalRegionalSecretVersionEnabled(_ interface{}, _ tp
 
 func expandSecretManagerRegionalRegionalSecretVersionPayload(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
 	transformed := make(map[string]interface{})
-	transformedSecretData, err := expandSecretManagerRegionalRegionalSecretVersionPayloadSecretData(d.Get("secret_data"), d, config)
+	transformedSecretData, err := expandSecretManagerRegionalRegionalSecretVersionPayloadSecretData(tpgresource.GetWriteOnlyValue(d, "secret_data", d.Get("secret_data")), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedSecretData); val.IsValid() && !tpgresource.IsEmptyValue(val) {
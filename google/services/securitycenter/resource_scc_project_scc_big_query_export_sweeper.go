@@ -194,7 +194,7 @@ func deleteResourceSecurityCenterProjectSccBigQueryExport(config *transport_tpg.
 	}
 
 	// Skip resources that shouldn't be sweeped
-	if !sweeper.IsSweepableTestResource(name) {
+	if !sweeper.ShouldSweep(name, obj) {
 		return nil
 	}
 
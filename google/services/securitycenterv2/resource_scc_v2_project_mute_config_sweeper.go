@@ -192,7 +192,7 @@ func deleteResourceSecurityCenterV2ProjectMuteConfig(config *transport_tpg.Confi
 	}
 
 	// Skip resources that shouldn't be sweeped
-	if !sweeper.IsSweepableTestResource(name) {
+	if !sweeper.ShouldSweep(name, obj) {
 		return nil
 	}
 
@@ -189,7 +189,7 @@ func deleteResourceSecurityCenterV2ProjectNotificationConfig(config *transport_t
 	name = tpgresource.GetResourceNameFromSelfLink(obj["name"].(string))
 
 	// Skip resources that shouldn't be sweeped
-	if !sweeper.IsSweepableTestResource(name) {
+	if !sweeper.ShouldSweep(name, obj) {
 		return nil
 	}
 
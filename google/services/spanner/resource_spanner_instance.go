@@ -47,6 +47,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-google/google/registry"
+	"github.com/hashicorp/terraform-provider-google/google/services/tags"
 	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
 	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
 	"github.com/hashicorp/terraform-provider-google/google/verify"
@@ -154,10 +155,10 @@ func init() {
 
 func ResourceSpannerInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceSpannerInstanceCreate,
-		Read:   resourceSpannerInstanceRead,
-		Update: resourceSpannerInstanceUpdate,
-		Delete: resourceSpannerInstanceDelete,
+		CreateContext: tags.CreateWithTagBindings(resourceSpannerInstanceCreate, "google_spanner_instance"),
+		Read:          resourceSpannerInstanceRead,
+		Update:        resourceSpannerInstanceUpdate,
+		Delete:        resourceSpannerInstanceDelete,
 
 		Importer: &schema.ResourceImporter{
 			State: resourceSpannerInstanceImport,
@@ -507,6 +508,7 @@ This must be set to true if you created a backup manually in the console.`,
 				Computed: true,
 				ForceNew: true,
 			},
+			"tags": tags.TagBindingsSchema(),
 			"deletion_policy": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -797,11 +799,20 @@ func resourceSpannerInstanceRead(d *schema.ResourceData, meta interface{}) error
 		log.Printf("[DEBUG] (Read) identity not set: %s", err)
 	}
 
+	if err := tags.ReadTagBindings(d, config, "google_spanner_instance"); err != nil {
+		return err
+	}
+
 	return nil
 }
 
 func resourceSpannerInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
-	clientSideFields := map[string]bool{"deletion_policy": true}
+	if d.HasChange("tags") {
+		if err := tags.UpdateTagBindings(d, meta.(*transport_tpg.Config), "google_spanner_instance"); err != nil {
+			return err
+		}
+	}
+	clientSideFields := map[string]bool{"deletion_policy": true, "tags": true}
 	clientSideOnly := true
 	for field := range ResourceSpannerInstance().Schema {
 		if d.HasChange(field) && !clientSideFields[field] {
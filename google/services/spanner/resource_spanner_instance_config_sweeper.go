@@ -102,7 +102,7 @@ func testSweepSpannerInstanceConfig(region string) error {
 
 		name := tpgresource.GetResourceNameFromSelfLink(obj["name"].(string))
 		// Skip resources that shouldn't be sweeped
-		if !sweeper.IsSweepableTestResource(strings.ReplaceAll(name, "custom-", "")) {
+		if !sweeper.ShouldSweep(strings.ReplaceAll(name, "custom-", ""), obj) {
 			nonPrefixCount++
 			continue
 		}
@@ -82,7 +82,7 @@ func testSweepSpannerInstance(region string) error {
 		shortName := name[strings.LastIndex(name, "/")+1:]
 
 		// Increment count and skip if resource is not sweepable.
-		if !sweeper.IsSweepableTestResource(shortName) {
+		if !sweeper.ShouldSweep(shortName, obj) {
 			nonPrefixCount++
 			continue
 		}
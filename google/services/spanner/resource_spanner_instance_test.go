@@ -25,6 +25,7 @@ import (
 	"github.com/hashicorp/terraform-provider-google/google/envvar"
 	_ "github.com/hashicorp/terraform-provider-google/google/services/resourcemanager"
 	_ "github.com/hashicorp/terraform-provider-google/google/services/spanner"
+	_ "github.com/hashicorp/terraform-provider-google/google/services/tags"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
@@ -600,6 +601,43 @@ func TestAccSpannerInstance_autoscalingWithTotalCPUUtilizationPercent(t *testing
 	})
 }
 
+func TestAccSpannerInstance_tags(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"project":       envvar.GetTestProjectFromEnv(),
+		"random_suffix": acctest.RandString(t, 10),
+	}
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckSpannerInstanceDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpannerInstance_tags(context, "prod"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_spanner_instance.basic", "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:            "google_spanner_instance.basic",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"tags"},
+			},
+			{
+				Config: testAccSpannerInstance_tags(context, "dev"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("google_spanner_instance.basic", fmt.Sprintf("tags.%s/tf-test-key-%s", context["project"], context["random_suffix"]), "google_tags_tag_value.dev", "namespaced_name"),
+				),
+			},
+			{
+				Config: testAccSpannerInstance_basicTagKey(context),
+			},
+		},
+	})
+}
+
 func testAccSpannerInstance_basic(name string) string {
 	return fmt.Sprintf(`
 resource "google_spanner_instance" "basic" {
@@ -1114,3 +1152,66 @@ resource "google_spanner_instance" "test" {
   edition = "ENTERPRISE_PLUS"
 }`, name, name)
 }
+
+func testAccSpannerInstance_basicTagKey(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_tags_tag_key" "key" {
+  parent     = "projects/%{project}"
+  short_name = "tf-test-key-%{random_suffix}"
+}
+
+resource "google_tags_tag_value" "prod" {
+  parent     = google_tags_tag_key.key.id
+  short_name = "prod"
+}
+
+resource "google_tags_tag_value" "dev" {
+  parent     = google_tags_tag_key.key.id
+  short_name = "dev"
+}
+
+resource "google_spanner_instance" "basic" {
+  name         = "tf-test-%{random_suffix}"
+  config       = "regional-us-central1"
+  display_name = "tf-test-%{random_suffix}-dname"
+
+  processing_units             = 100
+  edition                      = "ENTERPRISE"
+  default_backup_schedule_type = "NONE"
+}
+`, context)
+}
+
+func testAccSpannerInstance_tags(context map[string]interface{}, value string) string {
+	context["value"] = value
+	return acctest.Nprintf(`
+resource "google_tags_tag_key" "key" {
+  parent     = "projects/%{project}"
+  short_name = "tf-test-key-%{random_suffix}"
+}
+
+resource "google_tags_tag_value" "prod" {
+  parent     = google_tags_tag_key.key.id
+  short_name = "prod"
+}
+
+resource "google_tags_tag_value" "dev" {
+  parent     = google_tags_tag_key.key.id
+  short_name = "dev"
+}
+
+resource "google_spanner_instance" "basic" {
+  name         = "tf-test-%{random_suffix}"
+  config       = "regional-us-central1"
+  display_name = "tf-test-%{random_suffix}-dname"
+
+  processing_units             = 100
+  edition                      = "ENTERPRISE"
+  default_backup_schedule_type = "NONE"
+
+  tags = {
+    (google_tags_tag_key.key.namespaced_name) = google_tags_tag_value.%{value}.namespaced_name
+  }
+}
+`, context)
+}
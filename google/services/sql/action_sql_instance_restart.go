@@ -0,0 +1,139 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/services/sql/action_sql_instance_restart.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package sql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-google/google/fwresource"
+	"github.com/hashicorp/terraform-provider-google/google/registry"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+	sqladmin "google.golang.org/api/sqladmin/v1beta4"
+)
+
+const sqlInstanceRestartTimeout = 30 * time.Minute
+
+var _ action.ActionWithConfigure = &googleActionSqlInstanceRestart{}
+
+func init() {
+	registry.FrameworkAction{
+		Name:        "google_sql_instance_restart",
+		ProductName: "sql",
+		Func:        GoogleActionSqlInstanceRestart,
+	}.Register()
+}
+
+func GoogleActionSqlInstanceRestart() action.Action {
+	return &googleActionSqlInstanceRestart{}
+}
+
+type googleActionSqlInstanceRestart struct {
+	providerConfig *transport_tpg.Config
+}
+
+func (a *googleActionSqlInstanceRestart) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sql_instance_restart"
+}
+
+type actionSqlInstanceRestartModel struct {
+	Instance types.String `tfsdk:"instance"`
+	Project  types.String `tfsdk:"project"`
+}
+
+func (a *googleActionSqlInstanceRestart) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Restarts a Cloud SQL instance.",
+		Attributes: map[string]schema.Attribute{
+			"instance": schema.StringAttribute{
+				Description: "The name of the Cloud SQL instance to restart.",
+				Required:    true,
+			},
+			"project": schema.StringAttribute{
+				Description: "The ID of the project in which the instance belongs. If it is not provided, the provider project is used.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (a *googleActionSqlInstanceRestart) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	pd, ok := req.ProviderData.(*transport_tpg.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *transport_tpg.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.providerConfig = pd
+}
+
+func (a *googleActionSqlInstanceRestart) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data actionSqlInstanceRestartModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config := a.providerConfig
+
+	project := fwresource.GetProjectFramework(data.Project, types.StringValue(config.Project), &resp.Diagnostics).ValueString()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	instance := data.Instance.ValueString()
+
+	transport_tpg.MutexStore.Lock(instanceMutexKey(project, instance))
+	defer transport_tpg.MutexStore.Unlock(instanceMutexKey(project, instance))
+
+	var op *sqladmin.Operation
+	err := transport_tpg.Retry(transport_tpg.RetryOptions{
+		RetryFunc: func() (rerr error) {
+			op, rerr = NewClient(config, config.UserAgent).Instances.Restart(project, instance).Context(ctx).Do()
+			return rerr
+		},
+		Timeout:              sqlInstanceRestartTimeout,
+		ErrorRetryPredicates: []transport_tpg.RetryErrorPredicateFunc{transport_tpg.IsSqlOperationInProgressError},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error restarting SQL Database Instance %q", instance), err.Error())
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Restarting SQL Database Instance %s", instance),
+	})
+
+	err = SqlAdminOperationWaitTime(config, op, project, "Restart Instance", config.UserAgent, sqlInstanceRestartTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error waiting for SQL Database Instance %q to restart", instance), err.Error())
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("SQL Database Instance %s has been restarted", instance),
+	})
+}
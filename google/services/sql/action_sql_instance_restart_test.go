@@ -0,0 +1,85 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/services/sql/action_sql_instance_restart_test.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package sql_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-google/google/acctest"
+)
+
+func TestAccActionSqlInstanceRestart_basic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"instance_name": "tf-test-" + acctest.RandString(t, 10),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccSqlDatabaseInstanceDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccActionSqlInstanceRestart_basic(context),
+			},
+			{
+				Config: testAccActionSqlInstanceRestart_basic(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_sql_database_instance.instance", "settings.0.activation_policy", "ALWAYS"),
+				),
+			},
+		},
+	})
+}
+
+func testAccActionSqlInstanceRestart_basic(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_sql_database_instance" "instance" {
+  name                = "%{instance_name}"
+  region              = "us-central1"
+  database_version    = "MYSQL_8_0"
+  deletion_protection = false
+
+  settings {
+    tier = "db-f1-micro"
+  }
+}
+
+action "google_sql_instance_restart" "restart" {
+  config {
+    instance = google_sql_database_instance.instance.name
+  }
+}
+
+resource "terraform_data" "restart" {
+  input = google_sql_database_instance.instance.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.google_sql_instance_restart.restart]
+    }
+  }
+}
+`, context)
+}
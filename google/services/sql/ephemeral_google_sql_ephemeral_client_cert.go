@@ -0,0 +1,212 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/services/sql/ephemeral_google_sql_ephemeral_client_cert.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package sql
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-google/google/fwvalidators"
+	"github.com/hashicorp/terraform-provider-google/google/registry"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+	sqladmin "google.golang.org/api/sqladmin/v1beta4"
+)
+
+var _ ephemeral.EphemeralResource = &googleEphemeralSqlEphemeralClientCert{}
+
+func init() {
+	registry.FrameworkEphemeralResource{
+		Name:        "google_sql_ephemeral_client_cert",
+		ProductName: "sql",
+		Func:        GoogleEphemeralSqlEphemeralClientCert,
+	}.Register()
+}
+
+func GoogleEphemeralSqlEphemeralClientCert() ephemeral.EphemeralResource {
+	return &googleEphemeralSqlEphemeralClientCert{}
+}
+
+type googleEphemeralSqlEphemeralClientCert struct {
+	providerConfig *transport_tpg.Config
+}
+
+func (p *googleEphemeralSqlEphemeralClientCert) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sql_ephemeral_client_cert"
+}
+
+type ephemeralSqlEphemeralClientCertModel struct {
+	Project           types.String `tfsdk:"project"`
+	Instance          types.String `tfsdk:"instance"`
+	PublicKey         types.String `tfsdk:"public_key"`
+	ValidDuration     types.String `tfsdk:"valid_duration"`
+	IamAuthentication types.Bool   `tfsdk:"iam_authentication"`
+	Cert              types.String `tfsdk:"cert"`
+	CertSerialNumber  types.String `tfsdk:"cert_serial_number"`
+	ExpirationTime    types.String `tfsdk:"expiration_time"`
+	PrivateKey        types.String `tfsdk:"private_key"`
+}
+
+func (p *googleEphemeralSqlEphemeralClientCert) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Generates a short-lived client certificate to connect to a Cloud SQL instance.",
+		Attributes: map[string]schema.Attribute{
+			"project": schema.StringAttribute{
+				Description: "The project of the instance. If it is not provided, the provider project is used.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"instance": schema.StringAttribute{
+				Description: "The name of the Cloud SQL instance to generate the certificate for.",
+				Required:    true,
+			},
+			"public_key": schema.StringAttribute{
+				Description: "The PEM encoded RSA public key to sign. If it is not provided, a key pair is generated and its private key is exported as `private_key`.",
+				Optional:    true,
+			},
+			"valid_duration": schema.StringAttribute{
+				Description: "The duration the certificate is valid for, up to one hour, in seconds with up to nine fractional digits, terminated by 's'. Example: \"3600s\".",
+				Optional:    true,
+				Validators: []validator.String{
+					fwvalidators.BoundedDuration{
+						MinDuration: time.Second,
+						MaxDuration: time.Hour,
+					},
+				},
+			},
+			"iam_authentication": schema.BoolAttribute{
+				Description: "If true, an access token of the provider identity is embedded in the certificate so that it can be used for IAM database authentication. The certificate then expires with the access token.",
+				Optional:    true,
+			},
+			"cert": schema.StringAttribute{
+				Description: "The PEM encoded client certificate.",
+				Computed:    true,
+			},
+			"cert_serial_number": schema.StringAttribute{
+				Description: "The serial number of the certificate.",
+				Computed:    true,
+			},
+			"expiration_time": schema.StringAttribute{
+				Description: "The time at which the certificate expires, in RFC3339 format.",
+				Computed:    true,
+			},
+			"private_key": schema.StringAttribute{
+				Description: "The PEM encoded private key of the certificate, if `public_key` is not provided.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func (p *googleEphemeralSqlEphemeralClientCert) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	pd, ok := req.ProviderData.(*transport_tpg.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *transport_tpg.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	p.providerConfig = pd
+}
+
+func (p *googleEphemeralSqlEphemeralClientCert) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data ephemeralSqlEphemeralClientCertModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config := p.providerConfig
+	userAgent := config.UserAgent
+
+	project := data.Project.ValueString()
+	if project == "" {
+		project = config.Project
+	}
+
+	publicKey := data.PublicKey.ValueString()
+	if publicKey == "" {
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		if err != nil {
+			resp.Diagnostics.AddError("Error generating private key", err.Error())
+			return
+		}
+		pub, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+		if err != nil {
+			resp.Diagnostics.AddError("Error encoding public key", err.Error())
+			return
+		}
+		priv, err := x509.MarshalPKCS8PrivateKey(key)
+		if err != nil {
+			resp.Diagnostics.AddError("Error encoding private key", err.Error())
+			return
+		}
+		publicKey = string(pem.EncodeToMemory(&pem.Block{Type: "RSA PUBLIC KEY", Bytes: pub}))
+		data.PrivateKey = types.StringValue(string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: priv})))
+	}
+
+	certRequest := &sqladmin.GenerateEphemeralCertRequest{
+		PublicKey:     publicKey,
+		ValidDuration: data.ValidDuration.ValueString(),
+	}
+	if data.IamAuthentication.ValueBool() {
+		creds, err := config.GetCredentials([]string{sqlLoginScope}, false)
+		if err != nil {
+			resp.Diagnostics.AddError("Error retrieving credentials", err.Error())
+			return
+		}
+		token, err := creds.TokenSource.Token()
+		if err != nil {
+			resp.Diagnostics.AddError("Error generating access token", err.Error())
+			return
+		}
+		certRequest.AccessToken = token.AccessToken
+	}
+
+	certResponse, err := NewClient(config, userAgent).Connect.GenerateEphemeralCert(project, data.Instance.ValueString(), certRequest).Do()
+	if err != nil {
+		resp.Diagnostics.AddError("Error generating ephemeral certificate", err.Error())
+		return
+	}
+	if certResponse.EphemeralCert == nil {
+		resp.Diagnostics.AddError("Error generating ephemeral certificate", "the response doesn't contain a certificate")
+		return
+	}
+
+	data.Project = types.StringValue(project)
+	data.Cert = types.StringValue(certResponse.EphemeralCert.Cert)
+	data.CertSerialNumber = types.StringValue(certResponse.EphemeralCert.CertSerialNumber)
+	data.ExpirationTime = types.StringValue(certResponse.EphemeralCert.ExpirationTime)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, data)...)
+}
@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/services/sql/ephemeral_google_sql_ephemeral_client_cert_test.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package sql_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-google/google/acctest"
+)
+
+func TestAccEphemeralSqlEphemeralClientCert_basic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"instance": "tf-test-" + acctest.RandString(t, 10),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+		CheckDestroy:             testAccSqlDatabaseInstanceDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSqlEphemeralClientCert_instance(context),
+			},
+			{
+				Config: testAccSqlEphemeralClientCert_basic(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(acctest.EchoResourceName, "data.cert"),
+					resource.TestCheckResourceAttrSet(acctest.EchoResourceName, "data.cert_serial_number"),
+					resource.TestCheckResourceAttrSet(acctest.EchoResourceName, "data.expiration_time"),
+					resource.TestCheckResourceAttrSet(acctest.EchoResourceName, "data.private_key"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSqlEphemeralClientCert_instance(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_sql_database_instance" "instance" {
+  name                = "%{instance}"
+  region              = "us-central1"
+  database_version    = "POSTGRES_15"
+  deletion_protection = false
+
+  settings {
+    tier = "db-f1-micro"
+  }
+}
+`, context)
+}
+
+func testAccSqlEphemeralClientCert_basic(context map[string]interface{}) string {
+	return testAccSqlEphemeralClientCert_instance(context) + acctest.EchoResourceConfig("ephemeral.google_sql_ephemeral_client_cert.cert") + acctest.Nprintf(`
+ephemeral "google_sql_ephemeral_client_cert" "cert" {
+  instance       = "%{instance}"
+  valid_duration = "600s"
+}
+`, context)
+}
@@ -0,0 +1,193 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/services/sql/ephemeral_google_sql_generated_login.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package sql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-google/google/fwutils"
+	"github.com/hashicorp/terraform-provider-google/google/fwvalidators"
+	"github.com/hashicorp/terraform-provider-google/google/registry"
+	iamcredentials_tpg "github.com/hashicorp/terraform-provider-google/google/services/iamcredentials"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+)
+
+// sqlLoginScope is the OAuth scope of the access tokens used as passwords for IAM database
+// authentication.
+const sqlLoginScope = "https://www.googleapis.com/auth/sqlservice.login"
+
+var _ ephemeral.EphemeralResource = &googleEphemeralSqlGeneratedLogin{}
+
+func init() {
+	registry.FrameworkEphemeralResource{
+		Name:        "google_sql_generated_login",
+		ProductName: "sql",
+		Func:        GoogleEphemeralSqlGeneratedLogin,
+	}.Register()
+}
+
+func GoogleEphemeralSqlGeneratedLogin() ephemeral.EphemeralResource {
+	return &googleEphemeralSqlGeneratedLogin{}
+}
+
+type googleEphemeralSqlGeneratedLogin struct {
+	providerConfig *transport_tpg.Config
+}
+
+func (p *googleEphemeralSqlGeneratedLogin) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sql_generated_login"
+}
+
+type ephemeralSqlGeneratedLoginModel struct {
+	Project        types.String `tfsdk:"project"`
+	Instance       types.String `tfsdk:"instance"`
+	ServiceAccount types.String `tfsdk:"service_account"`
+	Delegates      types.Set    `tfsdk:"delegates"`
+	Username       types.String `tfsdk:"username"`
+	Password       types.String `tfsdk:"password"`
+	ExpireTime     types.String `tfsdk:"expire_time"`
+}
+
+func (p *googleEphemeralSqlGeneratedLogin) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Generates a short-lived login for IAM database authentication to a Cloud SQL instance.",
+		Attributes: map[string]schema.Attribute{
+			"project": schema.StringAttribute{
+				Description: "The project of the instance. If it is not provided, the provider project is used.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"instance": schema.StringAttribute{
+				Description: "The name of the Cloud SQL instance to log in to. It is used to format `username` for its database engine.",
+				Required:    true,
+			},
+			"service_account": schema.StringAttribute{
+				Description: "The email of a service account to log in as. If it is not provided, the identity of the provider is used.",
+				Optional:    true,
+				Validators: []validator.String{
+					fwvalidators.ServiceAccountEmailValidator{},
+				},
+			},
+			"delegates": schema.SetAttribute{
+				Description: "Delegate chain of approvals needed to impersonate `service_account`.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(fwvalidators.ServiceAccountEmailValidator{}),
+					setvalidator.AlsoRequires(path.MatchRoot("service_account")),
+				},
+			},
+			"username": schema.StringAttribute{
+				Description: "The database user name of the IAM principal.",
+				Computed:    true,
+			},
+			"password": schema.StringAttribute{
+				Description: "The OAuth 2.0 access token to use as the password of `username`.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"expire_time": schema.StringAttribute{
+				Description: "The time at which `password` expires, in RFC3339 format.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (p *googleEphemeralSqlGeneratedLogin) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	pd, ok := req.ProviderData.(*transport_tpg.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *transport_tpg.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	p.providerConfig = pd
+}
+
+func (p *googleEphemeralSqlGeneratedLogin) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data ephemeralSqlGeneratedLoginModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config := p.providerConfig
+	userAgent := config.UserAgent
+
+	project := data.Project.ValueString()
+	if project == "" {
+		project = config.Project
+	}
+
+	instance, err := NewClient(config, userAgent).Instances.Get(project, data.Instance.ValueString()).Do()
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving instance", err.Error())
+		return
+	}
+
+	login, err := iamcredentials_tpg.GenerateLoginToken(config, userAgent, sqlLoginScope, data.ServiceAccount.ValueString(), fwutils.StringSet(data.Delegates))
+	if err != nil {
+		resp.Diagnostics.AddError("Error generating login", err.Error())
+		return
+	}
+
+	username, err := sqlIamDatabaseUsername(instance.DatabaseVersion, login.Email)
+	if err != nil {
+		resp.Diagnostics.AddError("Error generating login", err.Error())
+		return
+	}
+
+	data.Project = types.StringValue(project)
+	data.Username = types.StringValue(username)
+	data.Password = types.StringValue(login.AccessToken)
+	if !login.Expiry.IsZero() {
+		data.ExpireTime = types.StringValue(login.Expiry.UTC().Format(time.RFC3339))
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, data)...)
+}
+
+// sqlIamDatabaseUsername returns the name of the database user of an IAM principal, which
+// depends on the database engine.
+// See https://cloud.google.com/sql/docs/postgres/iam-logins
+func sqlIamDatabaseUsername(databaseVersion, email string) (string, error) {
+	switch {
+	case strings.HasPrefix(databaseVersion, "POSTGRES"):
+		return strings.TrimSuffix(email, ".gserviceaccount.com"), nil
+	case strings.HasPrefix(databaseVersion, "MYSQL"):
+		return strings.SplitN(email, "@", 2)[0], nil
+	default:
+		return "", fmt.Errorf("IAM database authentication isn't supported for database version %q", databaseVersion)
+	}
+}
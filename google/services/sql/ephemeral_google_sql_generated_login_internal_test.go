@@ -0,0 +1,71 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/services/sql/ephemeral_google_sql_generated_login_internal_test.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package sql
+
+import (
+	"testing"
+)
+
+func TestSqlIamDatabaseUsername(t *testing.T) {
+	cases := map[string]struct {
+		DatabaseVersion, Email string
+		Expected               string
+		ExpectError            bool
+	}{
+		"postgres service account": {
+			DatabaseVersion: "POSTGRES_15",
+			Email:           "sa@my-project.iam.gserviceaccount.com",
+			Expected:        "sa@my-project.iam",
+		},
+		"postgres user": {
+			DatabaseVersion: "POSTGRES_15",
+			Email:           "user@example.com",
+			Expected:        "user@example.com",
+		},
+		"mysql service account": {
+			DatabaseVersion: "MYSQL_8_0",
+			Email:           "sa@my-project.iam.gserviceaccount.com",
+			Expected:        "sa",
+		},
+		"mysql user": {
+			DatabaseVersion: "MYSQL_8_0",
+			Email:           "user@example.com",
+			Expected:        "user",
+		},
+		"sql server": {
+			DatabaseVersion: "SQLSERVER_2019_STANDARD",
+			Email:           "user@example.com",
+			ExpectError:     true,
+		},
+	}
+
+	for tn, tc := range cases {
+		username, err := sqlIamDatabaseUsername(tc.DatabaseVersion, tc.Email)
+		if tc.ExpectError {
+			if err == nil {
+				t.Errorf("bad: %s, expected an error", tn)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("bad: %s, unexpected error: %s", tn, err)
+		}
+		if username != tc.Expected {
+			t.Errorf("bad: %s, %q => %q, expected %q", tn, tc.Email, username, tc.Expected)
+		}
+	}
+}
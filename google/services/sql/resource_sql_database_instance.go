@@ -236,6 +236,7 @@ func ResourceSqlDatabaseInstance() *schema.Resource {
 
 		CustomizeDiff: customdiff.All(
 			tpgresource.DefaultProviderProject,
+			switchedOverRolesCustomizeDiff,
 			diskSizeCutomizeDiff,
 			customdiff.ForceNewIf("master_instance_name", func(_ context.Context, d *schema.ResourceDiff, meta interface{}) bool {
 				// If we set master but this is not the new master of a switchover, require replacement and warn user.
@@ -472,7 +473,7 @@ API (for read pools, effective_availability_type may differ from availability_ty
 										Type:             schema.TypeBool,
 										Optional:         true,
 										AtLeastOneOf:     backupConfigurationKeys,
-										DiffSuppressFunc: EnhancedBackupManagerDiffSuppressFunc,
+										DiffSuppressFunc: backupEnabledDiffSuppressFunc,
 										Description:      `True if backup configuration is enabled.`,
 									},
 									"start_time": {
@@ -3098,6 +3099,15 @@ func EnhancedBackupManagerDiffSuppressFunc(k, old, new string, d *schema.Resourc
 	return false
 }
 
+// backupEnabledDiffSuppressFunc also keeps the backups that a switchover enabled on the new
+// primary instance while its configuration still describes a replica.
+func backupEnabledDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	if EnhancedBackupManagerDiffSuppressFunc(k, old, new, d) {
+		return true
+	}
+	return old == "true" && new == "false" && isSwitchedOverToConfiguredReplica(d)
+}
+
 func databaseVersionDiffSuppress(_, oldVersion, newVersion string, _ *schema.ResourceData) bool {
 	// Suppress diff when newVersion is MYSQL_8_0 and oldVersion is >= MYSQL_8_0_35 for MySQL version auto-upgrade cases.
 	if newVersion == "MYSQL_8_0" && strings.HasPrefix(oldVersion, "MYSQL_8_0_") {
@@ -3949,6 +3959,66 @@ func isSwitchoverFromOldPrimarySide(d *schema.ResourceDiff) bool {
 		isCascadableReplica)
 }
 
+// switchedOverRolesCustomizeDiff keeps the configured replication roles of a DR pair after a
+// switchover that wasn't made through this resource, e.g. with google_sql_instance_switchover.
+// The refreshed roles are kept instead of planning to replace the new primary instance or to
+// promote the former one.
+func switchedOverRolesCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	var keys []string
+	switch {
+	case isSwitchedOverToConfiguredReplica(d):
+		keys = []string{"instance_type", "master_instance_name"}
+	case isSwitchedOverFromConfiguredPrimary(d):
+		keys = []string{"instance_type", "replication_cluster"}
+	default:
+		return nil
+	}
+
+	log.Printf("[DEBUG] SQL Database Instance %q was switched over, keeping its current replication role", d.Get("name").(string))
+	for _, k := range keys {
+		if err := d.Clear(k); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isSwitchedOverToConfiguredReplica reports whether an instance configured as the replica of
+// another instance became its primary instance. Unlike the primary side of a switchover made
+// through this resource, the configured master instance is still one of its replicas.
+func isSwitchedOverToConfiguredReplica(d tpgresource.TerraformResourceDataChange) bool {
+	oldInstanceType, newInstanceType := d.GetChange("instance_type")
+	oldMasterInstanceName, newMasterInstanceName := d.GetChange("master_instance_name")
+	oldReplicaNames, newReplicaNames := d.GetChange("replica_names")
+
+	master := newMasterInstanceName.(string)
+	return oldInstanceType.(string) == "CLOUD_SQL_INSTANCE" && newInstanceType.(string) == "READ_REPLICA_INSTANCE" &&
+		oldMasterInstanceName.(string) == "" && master != "" &&
+		slices.Contains(oldReplicaNames.([]interface{}), interface{}(master)) &&
+		slices.Contains(newReplicaNames.([]interface{}), interface{}(master))
+}
+
+// isSwitchedOverFromConfiguredPrimary reports whether an instance configured as a primary
+// instance became the replica of its configured DR replica. Unlike a promotion, or the
+// replica side of a switchover made through this resource, its master instance is still the
+// configured DR replica and isn't listed in its replicas.
+func isSwitchedOverFromConfiguredPrimary(d tpgresource.TerraformResourceDataChange) bool {
+	oldInstanceType, newInstanceType := d.GetChange("instance_type")
+	oldMasterInstanceName, _ := d.GetChange("master_instance_name")
+	_, newReplicaNames := d.GetChange("replica_names")
+	_, newDrReplicaName := d.GetChange("replication_cluster.0.failover_dr_replica_name")
+
+	master := oldMasterInstanceName.(string)
+	drReplica, _ := newDrReplicaName.(string)
+	return oldInstanceType.(string) == "READ_REPLICA_INSTANCE" && newInstanceType.(string) == "CLOUD_SQL_INSTANCE" &&
+		master != "" && drReplica[strings.LastIndex(drReplica, ":")+1:] == master &&
+		!slices.Contains(newReplicaNames.([]interface{}), interface{}(master))
+}
+
 func checkPromoteConfigurations(d *schema.ResourceData) error {
 	masterInstanceName := d.GetRawConfig().GetAttr("master_instance_name")
 	replicaConfiguration := d.GetRawConfig().GetAttr("replica_configuration").AsValueSlice()
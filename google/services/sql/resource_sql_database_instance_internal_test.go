@@ -20,6 +20,8 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
 )
 
 func TestMaintenanceVersionDiffSuppress(t *testing.T) {
@@ -239,3 +241,91 @@ func TestDatabaseVersionDiffSuppress(t *testing.T) {
 		})
 	}
 }
+
+func TestSwitchedOverRoles(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		before, after map[string]interface{}
+		toReplica     bool
+		fromPrimary   bool
+	}{
+		"configured replica is the new primary": {
+			before: map[string]interface{}{
+				"instance_type":        "CLOUD_SQL_INSTANCE",
+				"master_instance_name": "",
+				"replica_names":        []interface{}{"original-primary"},
+			},
+			after: map[string]interface{}{
+				"instance_type":        "READ_REPLICA_INSTANCE",
+				"master_instance_name": "original-primary",
+				"replica_names":        []interface{}{"original-primary"},
+			},
+			toReplica: true,
+		},
+		"primary updated to the replica of the new primary": {
+			before: map[string]interface{}{
+				"instance_type":        "CLOUD_SQL_INSTANCE",
+				"master_instance_name": "",
+				"replica_names":        []interface{}{"original-replica"},
+			},
+			after: map[string]interface{}{
+				"instance_type":        "READ_REPLICA_INSTANCE",
+				"master_instance_name": "original-replica",
+				"replica_names":        []interface{}{},
+			},
+		},
+		"configured primary is the new replica": {
+			before: map[string]interface{}{
+				"instance_type":        "READ_REPLICA_INSTANCE",
+				"master_instance_name": "original-replica",
+				"replica_names":        []interface{}{},
+			},
+			after: map[string]interface{}{
+				"instance_type":        "CLOUD_SQL_INSTANCE",
+				"master_instance_name": "original-replica",
+				"replica_names":        []interface{}{},
+				"replication_cluster.0.failover_dr_replica_name": "my-project:original-replica",
+			},
+			fromPrimary: true,
+		},
+		"replica switched over to the new primary": {
+			before: map[string]interface{}{
+				"instance_type":        "READ_REPLICA_INSTANCE",
+				"master_instance_name": "original-primary",
+				"replica_names":        []interface{}{},
+			},
+			after: map[string]interface{}{
+				"instance_type":        "CLOUD_SQL_INSTANCE",
+				"master_instance_name": "original-primary",
+				"replica_names":        []interface{}{"original-primary"},
+				"replication_cluster.0.failover_dr_replica_name": "my-project:original-primary",
+			},
+		},
+		"replica promoted": {
+			before: map[string]interface{}{
+				"instance_type":        "READ_REPLICA_INSTANCE",
+				"master_instance_name": "original-primary",
+				"replica_names":        []interface{}{},
+			},
+			after: map[string]interface{}{
+				"instance_type":        "CLOUD_SQL_INSTANCE",
+				"master_instance_name": "original-primary",
+				"replica_names":        []interface{}{},
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+			d := &tpgresource.ResourceDiffMock{Before: tc.before, After: tc.after}
+			if got := isSwitchedOverToConfiguredReplica(d); got != tc.toReplica {
+				t.Errorf("isSwitchedOverToConfiguredReplica() = %t, want %t", got, tc.toReplica)
+			}
+			if got := isSwitchedOverFromConfiguredPrimary(d); got != tc.fromPrimary {
+				t.Errorf("isSwitchedOverFromConfiguredPrimary() = %t, want %t", got, tc.fromPrimary)
+			}
+		})
+	}
+}
@@ -55,10 +55,6 @@ func testSweepSQLDatabaseInstance(region string) error {
 	running := map[string]struct{}{}
 
 	for _, d := range found.Items {
-		if !sweeper.IsSweepableTestResource(d.Name) {
-			continue
-		}
-
 		if d.State != "RUNNABLE" {
 			continue
 		}
@@ -66,7 +62,7 @@ func testSweepSQLDatabaseInstance(region string) error {
 	}
 
 	for _, d := range found.Items {
-		if !sweeper.IsSweepableTestResource(d.Name) {
+		if !sweeper.ShouldSweepTyped(d.Name, d) {
 			continue
 		}
 
@@ -0,0 +1,195 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/services/sql/resource_sql_instance_switchover.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package sql
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-google/google/registry"
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+	"github.com/hashicorp/terraform-provider-google/google/verify"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sqladmin "google.golang.org/api/sqladmin/v1beta4"
+)
+
+func ResourceSqlInstanceSwitchover() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSqlInstanceSwitchoverCreate,
+		Read:   resourceSqlInstanceSwitchoverRead,
+		Delete: resourceSqlInstanceSwitchoverDelete,
+		CustomizeDiff: customdiff.All(
+			tpgresource.DefaultProviderProject,
+		),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				Description: `The name of the DR replica to switch over to. It becomes the primary instance, and the
+				current primary instance becomes its DR replica. Changing this forces a new switchover.`,
+			},
+
+			"db_timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidateDuration(),
+				Description: `The maximum duration to wait for the replica to catch up with the primary instance
+				before switching over, for SQL Server instances. A duration in seconds with up to nine fractional
+				digits, ending with 's'. Example: "3.5s".`,
+			},
+
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Description: `Arbitrary values that, when changed, run the switchover again. Switching back to the
+				former primary instance is done with another google_sql_instance_switchover resource.`,
+			},
+
+			"project": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: `The ID of the project in which the resource belongs. If it is not provided, the provider project is used.`,
+			},
+
+			"previous_primary_instance": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The name of the instance that was the primary instance before the switchover.`,
+			},
+		},
+		UseJSONNumber: true,
+	}
+}
+
+func resourceSqlInstanceSwitchoverCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := tpgresource.GetProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	instance := d.Get("instance").(string)
+
+	transport_tpg.MutexStore.Lock(instanceMutexKey(project, instance))
+	defer transport_tpg.MutexStore.Unlock(instanceMutexKey(project, instance))
+
+	replica, err := sqlGetReplicaInstance(config, userAgent, project, instance, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+	primary := replica.MasterInstanceName
+
+	log.Printf("[INFO] switching over from primary instance %s to replica %s", primary, instance)
+
+	var op *sqladmin.Operation
+	err = transport_tpg.Retry(transport_tpg.RetryOptions{
+		RetryFunc: func() (rerr error) {
+			call := NewClient(config, userAgent).Instances.Switchover(project, instance)
+			if v, ok := d.GetOk("db_timeout"); ok {
+				call = call.DbTimeout(v.(string))
+			}
+			op, rerr = call.Do()
+			return rerr
+		},
+		Timeout:              d.Timeout(schema.TimeoutCreate),
+		ErrorRetryPredicates: []transport_tpg.RetryErrorPredicateFunc{transport_tpg.IsSqlOperationInProgressError},
+	})
+	if err != nil {
+		return fmt.Errorf("Error, failed to switch over to replica %s: %s", instance, err)
+	}
+	err = SqlAdminOperationWaitTime(config, op, project, "Switchover Instance", userAgent, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+
+	// Check that the roles of the instances were swapped, so that google_sql_database_instance
+	// resources read them after the switchover.
+	newPrimary, err := NewClient(config, userAgent).Instances.Get(project, instance).Do()
+	if err != nil {
+		return fmt.Errorf("Error reading SQL Database Instance %q after the switchover: %s", instance, err)
+	}
+	if newPrimary.InstanceType != "CLOUD_SQL_INSTANCE" {
+		return fmt.Errorf("Error, instance %s is a %s after the switchover", instance, newPrimary.InstanceType)
+	}
+	log.Printf("[INFO] instance %s is the primary instance, and %s is its replica", instance, primary)
+
+	d.SetId(fmt.Sprintf("projects/%s/instances/%s/switchovers/%d", project, instance, time.Now().UnixNano()))
+	if err := d.Set("previous_primary_instance", primary); err != nil {
+		return fmt.Errorf("Error setting previous_primary_instance: %s", err)
+	}
+	return nil
+}
+
+func resourceSqlInstanceSwitchoverRead(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func resourceSqlInstanceSwitchoverDelete(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+// sqlGetReplicaInstance returns the given instance, or an error if it isn't a replica.
+func sqlGetReplicaInstance(config *transport_tpg.Config, userAgent, project, instance string, timeout time.Duration) (*sqladmin.DatabaseInstance, error) {
+	var replica *sqladmin.DatabaseInstance
+	err := transport_tpg.Retry(transport_tpg.RetryOptions{
+		RetryFunc: func() (rerr error) {
+			replica, rerr = NewClient(config, userAgent).Instances.Get(project, instance).Do()
+			return rerr
+		},
+		Timeout:              timeout,
+		ErrorRetryPredicates: []transport_tpg.RetryErrorPredicateFunc{transport_tpg.IsSqlOperationInProgressError},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error reading SQL Database Instance %q: %s", instance, err)
+	}
+	if replica.MasterInstanceName == "" || replica.InstanceType != "READ_REPLICA_INSTANCE" {
+		return nil, fmt.Errorf("Error, instance %s isn't a replica", instance)
+	}
+	// The primary instance name may be prefixed with its project.
+	replica.MasterInstanceName = replica.MasterInstanceName[strings.LastIndex(replica.MasterInstanceName, ":")+1:]
+	return replica, nil
+}
+
+func init() {
+	registry.Schema{
+		Name:        "google_sql_instance_switchover",
+		ProductName: "sql",
+		Type:        registry.SchemaTypeResource,
+		Schema:      ResourceSqlInstanceSwitchover(),
+	}.Register()
+}
@@ -0,0 +1,161 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/services/sql/resource_sql_replica_promotion.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package sql
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-google/google/registry"
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sqladmin "google.golang.org/api/sqladmin/v1beta4"
+)
+
+func ResourceSqlReplicaPromotion() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSqlReplicaPromotionCreate,
+		Read:   resourceSqlReplicaPromotionRead,
+		Delete: resourceSqlReplicaPromotionDelete,
+		CustomizeDiff: customdiff.All(
+			tpgresource.DefaultProviderProject,
+		),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				Description: `The name of the read replica to promote to a stand-alone primary instance.
+				Changing this forces a new promotion.`,
+			},
+
+			"failover": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Description: `Whether the promotion is a replica failover to the DR replica of a replication cluster.
+				The former primary instance becomes a DR replica of the promoted instance once it's available again.`,
+			},
+
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: `Arbitrary values that, when changed, run the promotion again.`,
+			},
+
+			"project": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: `The ID of the project in which the resource belongs. If it is not provided, the provider project is used.`,
+			},
+
+			"previous_primary_instance": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The name of the instance the replica replicated from before the promotion.`,
+			},
+		},
+		UseJSONNumber: true,
+	}
+}
+
+func resourceSqlReplicaPromotionCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := tpgresource.GetProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	instance := d.Get("instance").(string)
+
+	transport_tpg.MutexStore.Lock(instanceMutexKey(project, instance))
+	defer transport_tpg.MutexStore.Unlock(instanceMutexKey(project, instance))
+
+	replica, err := sqlGetReplicaInstance(config, userAgent, project, instance, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] promoting replica %s of instance %s", instance, replica.MasterInstanceName)
+
+	var op *sqladmin.Operation
+	err = transport_tpg.Retry(transport_tpg.RetryOptions{
+		RetryFunc: func() (rerr error) {
+			op, rerr = NewClient(config, userAgent).Instances.PromoteReplica(project, instance).Failover(d.Get("failover").(bool)).Do()
+			return rerr
+		},
+		Timeout:              d.Timeout(schema.TimeoutCreate),
+		ErrorRetryPredicates: []transport_tpg.RetryErrorPredicateFunc{transport_tpg.IsSqlOperationInProgressError},
+	})
+	if err != nil {
+		return fmt.Errorf("Error, failed to promote read replica instance as primary stand-alone %s: %s", instance, err)
+	}
+	err = SqlAdminOperationWaitTime(config, op, project, "Promote Instance", userAgent, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+
+	promoted, err := NewClient(config, userAgent).Instances.Get(project, instance).Do()
+	if err != nil {
+		return fmt.Errorf("Error reading SQL Database Instance %q after the promotion: %s", instance, err)
+	}
+	if promoted.InstanceType != "CLOUD_SQL_INSTANCE" {
+		return fmt.Errorf("Error, instance %s is a %s after the promotion", instance, promoted.InstanceType)
+	}
+	log.Printf("[INFO] replica %s has been promoted", instance)
+
+	d.SetId(fmt.Sprintf("projects/%s/instances/%s/promotions/%d", project, instance, time.Now().UnixNano()))
+	if err := d.Set("previous_primary_instance", replica.MasterInstanceName); err != nil {
+		return fmt.Errorf("Error setting previous_primary_instance: %s", err)
+	}
+	return nil
+}
+
+func resourceSqlReplicaPromotionRead(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func resourceSqlReplicaPromotionDelete(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func init() {
+	registry.Schema{
+		Name:        "google_sql_replica_promotion",
+		ProductName: "sql",
+		Type:        registry.SchemaTypeResource,
+		Schema:      ResourceSqlReplicaPromotion(),
+	}.Register()
+}
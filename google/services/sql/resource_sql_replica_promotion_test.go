@@ -0,0 +1,91 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/services/sql/resource_sql_replica_promotion_test.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package sql_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-google/google/acctest"
+)
+
+func TestAccSqlReplicaPromotion_mysql(t *testing.T) {
+	t.Parallel()
+	primaryName := "tf-test-sql-instance-" + acctest.RandString(t, 10)
+	replicaName := "tf-test-sql-instance-replica-" + acctest.RandString(t, 10)
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccSqlDatabaseInstanceDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSqlReplicaPromotion_mysql(primaryName, replicaName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_sql_replica_promotion.promotion", "previous_primary_instance", primaryName),
+				),
+			},
+			{
+				Config: testAccSqlReplicaPromotion_mysql(primaryName, replicaName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_sql_database_instance.replica", "instance_type", "CLOUD_SQL_INSTANCE"),
+					resource.TestCheckResourceAttr("google_sql_database_instance.replica", "master_instance_name", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccSqlReplicaPromotion_mysql(primaryName, replicaName string) string {
+	return fmt.Sprintf(`
+resource "google_sql_database_instance" "primary" {
+  name                = "%s"
+  region              = "us-central1"
+  database_version    = "MYSQL_8_0"
+  deletion_protection = false
+
+  settings {
+    tier = "db-n1-standard-1"
+
+    backup_configuration {
+      binary_log_enabled = true
+      enabled            = true
+    }
+  }
+}
+
+resource "google_sql_database_instance" "replica" {
+  name                 = "%s"
+  region               = "us-central1"
+  database_version     = "MYSQL_8_0"
+  master_instance_name = google_sql_database_instance.primary.name
+  deletion_protection  = false
+
+  settings {
+    tier = "db-n1-standard-1"
+  }
+
+  lifecycle {
+    ignore_changes = [master_instance_name, replica_configuration, instance_type]
+  }
+}
+
+resource "google_sql_replica_promotion" "promotion" {
+  instance = google_sql_database_instance.replica.name
+}
+`, primaryName, replicaName)
+}
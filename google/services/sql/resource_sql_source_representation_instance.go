@@ -179,12 +179,15 @@ func ResourceSQLSourceRepresentationInstance() *schema.Resource {
 				Description: `A file in the bucket that contains the data from the external server.`,
 			},
 			"password": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				ForceNew:    true,
-				Description: `The password for the replication user account.`,
-				Sensitive:   true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Description:   `The password for the replication user account.`,
+				Sensitive:     true,
+				ConflictsWith: []string{"password_wo"},
 			},
+			"password_wo":         tpgresource.WriteOnlySchema("password", `Write-only password for the replication user account.`, false),
+			"password_wo_version": tpgresource.WriteOnlyVersionSchema("password", true),
 			"port": {
 				Type:         schema.TypeInt,
 				Optional:     true,
@@ -643,7 +646,7 @@ func expandSQLSourceRepresentationInstanceOnPremisesConfiguration(v interface{},
 		transformed["username"] = transformedUsername
 	}
 
-	transformedPassword, err := expandSQLSourceRepresentationInstanceOnPremisesConfigurationPassword(d.Get("password"), d, config)
+	transformedPassword, err := expandSQLSourceRepresentationInstanceOnPremisesConfigurationPassword(tpgresource.GetWriteOnlyValue(d, "password", d.Get("password")), d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedPassword); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -0,0 +1,141 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/services/storage/list_google_storage_bucket_iam_member_test.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package storage_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	"github.com/hashicorp/terraform-provider-google/google/acctest"
+	"github.com/hashicorp/terraform-provider-google/google/envvar"
+)
+
+func TestAccStorageBucketIamMemberList_queryIdentityWithFilter(t *testing.T) {
+	t.Parallel()
+
+	bucket := fmt.Sprintf("tf-test-%d", acctest.RandInt(t))
+	role := "roles/storage.objectViewer"
+	member := "user:admin@hashicorptest.com"
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageBucketIamMemberListCreate(bucket, role, member),
+			},
+			{
+				Query:  true,
+				Config: testAccStorageBucketIamMemberListQuery(fmt.Sprintf("bucket = %q", bucket), role, member),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("google_storage_bucket_iam_member.test", 1),
+					querycheck.ExpectIdentity("google_storage_bucket_iam_member.test", map[string]knownvalue.Check{
+						"bucket":          knownvalue.StringExact("b/" + bucket),
+						"role":            knownvalue.StringExact(role),
+						"member":          knownvalue.StringExact(member),
+						"condition_title": knownvalue.Null(),
+					}),
+				},
+			},
+		},
+	})
+}
+
+// Lists the same binding through Cloud Asset, which can take a couple of
+// minutes to index a new bucket policy.
+func TestAccStorageBucketIamMemberList_scope(t *testing.T) {
+	t.Parallel()
+
+	project := envvar.GetTestProjectFromEnv()
+	bucket := fmt.Sprintf("tf-test-%d", acctest.RandInt(t))
+	role := "roles/storage.objectViewer"
+	member := "user:admin@hashicorptest.com"
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"time": {},
+		},
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageBucketIamMemberListCreate(bucket, role, member) + `
+resource "time_sleep" "wait_for_asset_index" {
+  create_duration = "180s"
+
+  depends_on = [google_storage_bucket_iam_member.test]
+}
+`,
+			},
+			{
+				Query:  true,
+				Config: testAccStorageBucketIamMemberListQuery(fmt.Sprintf("scope = \"projects/%s\"", project), role, member),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectIdentity("google_storage_bucket_iam_member.test", map[string]knownvalue.Check{
+						"bucket":          knownvalue.StringExact("b/" + bucket),
+						"role":            knownvalue.StringExact(role),
+						"member":          knownvalue.StringExact(member),
+						"condition_title": knownvalue.Null(),
+					}),
+				},
+			},
+		},
+	})
+}
+
+func testAccStorageBucketIamMemberListCreate(bucket, role, member string) string {
+	return fmt.Sprintf(`
+resource "google_storage_bucket" "test" {
+  name                        = "%s"
+  location                    = "US"
+  uniform_bucket_level_access = true
+}
+
+resource "google_storage_bucket_iam_member" "test" {
+  bucket = google_storage_bucket.test.name
+  role   = "%s"
+  member = "%s"
+}
+`, bucket, role, member)
+}
+
+func testAccStorageBucketIamMemberListQuery(target, role, member string) string {
+	return fmt.Sprintf(`
+list "google_storage_bucket_iam_member" "test" {
+  provider         = google
+  include_resource = true
+  limit            = 1000
+
+  config {
+    %s
+    role   = %q
+    member = %q
+  }
+}
+`, target, role, member)
+}
@@ -0,0 +1,695 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/services/storage/resource_storage_bucket_objects_sync.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package storage
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"io"
+	"io/fs"
+	"log"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-google/google/registry"
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/storage/v1"
+)
+
+// Files larger than this are uploaded with resumable uploads, in chunks of this size
+const objectsSyncChunkSize = 16 * 1024 * 1024
+
+// Object hashes are stored as "<algorithm>:<base64 hash>", using MD5 unless Cloud Storage
+// has no MD5 for the object, as is the case for composite objects.
+const (
+	objectsSyncHashMd5    = "md5:"
+	objectsSyncHashCrc32c = "crc32c:"
+)
+
+func ResourceStorageBucketObjectsSync() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceStorageBucketObjectsSyncCreate,
+		Read:   resourceStorageBucketObjectsSyncRead,
+		Update: resourceStorageBucketObjectsSyncUpdate,
+		Delete: resourceStorageBucketObjectsSyncDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		CustomizeDiff: customdiff.All(
+			tpgresource.DefaultProviderDeletionPolicy("DELETE"),
+			resourceStorageBucketObjectsSyncCustomizeDiff,
+		),
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The name of the containing bucket.`,
+			},
+
+			"source_dir": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `The local directory whose files are uploaded.`,
+			},
+
+			"destination_prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `The prefix prepended to the relative path of each file to form its object name, for example "site/". A "/" is added when the prefix doesn't end with one.`,
+			},
+
+			"include": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: `Globs of the files to upload, relative to source_dir. "**" matches any number of directories. Defaults to all files.`,
+			},
+
+			"exclude": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: `Globs of the files not to upload, relative to source_dir. Exclusions take precedence over inclusions.`,
+			},
+
+			"delete_extraneous": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: `Whether objects under destination_prefix that weren't synced from a file are deleted.`,
+			},
+
+			"parallelism": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      8,
+				ValidateFunc: validation.IntBetween(1, 64),
+				Description:  `The number of objects uploaded or deleted at the same time.`,
+			},
+
+			"object_config": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `Settings for the objects whose files match a glob. When several blocks match a file, settings of later blocks take precedence.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"glob": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: `Glob of the files the settings apply to, relative to source_dir.`,
+						},
+						"content_type": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `Content-Type of the objects. Defaults to the type registered for the file extension.`,
+						},
+						"cache_control": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `Cache-Control directive of the objects.`,
+						},
+						"content_disposition": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `Content-Disposition of the objects.`,
+						},
+						"content_encoding": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `Content-Encoding of the objects.`,
+						},
+						"content_language": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `Content-Language of the objects.`,
+						},
+						"metadata": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: `User-provided metadata of the objects, in key/value pairs.`,
+						},
+					},
+				},
+			},
+
+			"objects": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: `The synced objects, mapping each object name to the hash of its content.`,
+			},
+
+			"deletion_policy": tpgresource.DeletionPolicySchemaEntry("DELETE"),
+		},
+	}
+}
+
+// objectsSyncFile is a local file and the object it is uploaded to
+type objectsSyncFile struct {
+	path   string
+	rel    string
+	object string
+}
+
+// resourceStorageBucketObjectsSyncCustomizeDiff plans the hashes of the local files, so
+// that added, changed and removed files show up in the plan.
+func resourceStorageBucketObjectsSyncCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for _, k := range []string{"source_dir", "destination_prefix", "include", "exclude", "delete_extraneous"} {
+		if !d.NewValueKnown(k) {
+			return d.SetNewComputed("objects")
+		}
+	}
+
+	files, err := objectsSyncLocalFiles(d)
+	if err != nil {
+		return err
+	}
+
+	old := tpgresource.ConvertStringMap(d.Get("objects").(map[string]interface{}))
+	desired := make(map[string]interface{}, len(files))
+	for _, f := range files {
+		// Objects stored without an MD5 are compared by CRC32C
+		h, err := objectsSyncFileHash(f.path, strings.HasPrefix(old[f.object], objectsSyncHashCrc32c))
+		if err != nil {
+			return err
+		}
+		desired[f.object] = h
+	}
+
+	return d.SetNew("objects", desired)
+}
+
+func resourceStorageBucketObjectsSyncCreate(d *schema.ResourceData, meta interface{}) error {
+	d.SetId(fmt.Sprintf("%s/%s", d.Get("bucket").(string), objectsSyncPrefix(d)))
+
+	if err := syncStorageBucketObjects(d, meta, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	return resourceStorageBucketObjectsSyncRead(d, meta)
+}
+
+func resourceStorageBucketObjectsSyncRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	bucket := d.Get("bucket").(string)
+	remote, err := listObjectsSyncRemote(config, userAgent, bucket, objectsSyncPrefix(d))
+	if err != nil {
+		return transport_tpg.HandleNotFoundError(err, d, fmt.Sprintf("Storage Bucket %q", bucket))
+	}
+
+	// Track the objects synced from local files, and any others under the prefix
+	// when they would be deleted.
+	managed := make(map[string]bool)
+	for object := range d.Get("objects").(map[string]interface{}) {
+		managed[object] = true
+	}
+	if files, err := objectsSyncLocalFiles(d); err == nil {
+		for _, f := range files {
+			managed[f.object] = true
+		}
+	} else {
+		log.Printf("[WARN] Unable to read local files of %q, only previously synced objects are refreshed: %s", d.Id(), err)
+	}
+
+	objects := make(map[string]interface{})
+	for name, obj := range remote {
+		if managed[name] || d.Get("delete_extraneous").(bool) {
+			objects[name] = objectsSyncObjectHash(obj)
+		}
+	}
+	if err := d.Set("objects", objects); err != nil {
+		return fmt.Errorf("Error setting objects: %s", err)
+	}
+
+	if err := tpgresource.DeletionPolicyReadDefault(d, config, "DELETE"); err != nil {
+		return err
+	}
+	return nil
+}
+
+func resourceStorageBucketObjectsSyncUpdate(d *schema.ResourceData, meta interface{}) error {
+	if tpgresource.DeletionPolicyPreUpdate(d, ResourceStorageBucketObjectsSync) {
+		return resourceStorageBucketObjectsSyncRead(d, meta)
+	}
+
+	if err := syncStorageBucketObjects(d, meta, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return err
+	}
+
+	return resourceStorageBucketObjectsSyncRead(d, meta)
+}
+
+func resourceStorageBucketObjectsSyncDelete(d *schema.ResourceData, meta interface{}) error {
+	if ok, err := tpgresource.DeletionPolicyPreDelete(d); err != nil {
+		return err
+	} else if ok {
+		return nil
+	}
+
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	var names []string
+	for object := range d.Get("objects").(map[string]interface{}) {
+		names = append(names, object)
+	}
+	sort.Strings(names)
+
+	objectsService := storage.NewObjectsService(NewClientWithTimeoutOverride(config, userAgent, d.Timeout(schema.TimeoutDelete)))
+	bucket := d.Get("bucket").(string)
+	err = runObjectsSyncTasks(d.Get("parallelism").(int), names, func(name string) error {
+		if err := objectsService.Delete(bucket, name).Do(); err != nil && !transport_tpg.IsGoogleApiErrorWithCode(err, 404) {
+			return fmt.Errorf("Error deleting object %s: %s", name, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// syncStorageBucketObjects uploads local files whose content or object_config settings differ
+// from their object, and deletes the objects of removed files and, when requested, extraneous
+// objects.
+func syncStorageBucketObjects(d *schema.ResourceData, meta interface{}, timeout time.Duration) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	bucket := d.Get("bucket").(string)
+	prefix := objectsSyncPrefix(d)
+
+	files, err := objectsSyncLocalFiles(d)
+	if err != nil {
+		return err
+	}
+	remote, err := listObjectsSyncRemote(config, userAgent, bucket, prefix)
+	if err != nil {
+		return fmt.Errorf("Error listing objects of bucket %s: %s", bucket, err)
+	}
+	objectConfigs, err := expandObjectsSyncObjectConfigs(d.Get("object_config").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	uploads := make(map[string]objectsSyncFile)
+	var uploadNames []string
+	local := make(map[string]bool)
+	for _, f := range files {
+		local[f.object] = true
+		if obj, ok := remote[f.object]; ok {
+			h, err := objectsSyncFileHash(f.path, obj.Md5Hash == "")
+			if err != nil {
+				return err
+			}
+			// Unchanged files are still uploaded again when their settings changed, since
+			// an upload replaces all of the object's metadata.
+			desired, _ := objectsSyncObject(bucket, f, objectConfigs)
+			if h == objectsSyncObjectHash(obj) && objectsSyncMetadataMatches(obj, desired) {
+				continue
+			}
+		}
+		uploads[f.object] = f
+		uploadNames = append(uploadNames, f.object)
+	}
+
+	// Objects previously synced from files that were removed are always deleted, other
+	// objects only when requested.
+	previous, _ := d.GetChange("objects")
+	var deleteNames []string
+	for name := range remote {
+		if local[name] {
+			continue
+		}
+		if _, ok := previous.(map[string]interface{})[name]; ok || d.Get("delete_extraneous").(bool) {
+			deleteNames = append(deleteNames, name)
+		}
+	}
+	sort.Strings(deleteNames)
+
+	log.Printf("[DEBUG] Syncing %d files to gs://%s/%s: %d uploads, %d deletions", len(files), bucket, prefix, len(uploadNames), len(deleteNames))
+
+	objectsService := storage.NewObjectsService(NewClientWithTimeoutOverride(config, userAgent, timeout))
+	parallelism := d.Get("parallelism").(int)
+
+	err = runObjectsSyncTasks(parallelism, uploadNames, func(name string) error {
+		f := uploads[name]
+		media, err := os.Open(f.path)
+		if err != nil {
+			return err
+		}
+		defer media.Close()
+
+		object, contentType := objectsSyncObject(bucket, f, objectConfigs)
+		options := []googleapi.MediaOption{googleapi.ChunkSize(objectsSyncChunkSize)}
+		if contentType != "" {
+			options = append(options, googleapi.ContentType(contentType))
+		}
+		if _, err := objectsService.Insert(bucket, object).Name(name).Media(media, options...).Do(); err != nil {
+			return fmt.Errorf("Error uploading object %s: %s", name, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return runObjectsSyncTasks(parallelism, deleteNames, func(name string) error {
+		if err := objectsService.Delete(bucket, name).Do(); err != nil && !transport_tpg.IsGoogleApiErrorWithCode(err, 404) {
+			return fmt.Errorf("Error deleting object %s: %s", name, err)
+		}
+		return nil
+	})
+}
+
+// runObjectsSyncTasks calls task for each name with at most parallelism calls running at a
+// time, and returns the errors of all failed calls.
+func runObjectsSyncTasks(parallelism int, names []string, task func(name string) error) error {
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var errs []error
+	sem := make(chan struct{}, parallelism)
+
+	for _, name := range names {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(name string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			if err := task(name); err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+			}
+		}(name)
+	}
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
+
+// objectsSyncLocalFiles walks source_dir and returns the files selected by include and exclude
+func objectsSyncLocalFiles(d interface{ Get(string) interface{} }) ([]objectsSyncFile, error) {
+	sourceDir := d.Get("source_dir").(string)
+	prefix := objectsSyncPrefix(d)
+
+	include, err := compileObjectsSyncGlobs(tpgresource.ConvertStringArr(d.Get("include").([]interface{})))
+	if err != nil {
+		return nil, err
+	}
+	exclude, err := compileObjectsSyncGlobs(tpgresource.ConvertStringArr(d.Get("exclude").([]interface{})))
+	if err != nil {
+		return nil, err
+	}
+
+	var files []objectsSyncFile
+	err = filepath.WalkDir(sourceDir, func(p string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(sourceDir, p)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if (len(include) > 0 && !matchesAnyGlob(include, rel)) || matchesAnyGlob(exclude, rel) {
+			return nil
+		}
+		files = append(files, objectsSyncFile{path: p, rel: rel, object: prefix + rel})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error reading source_dir %q: %s", sourceDir, err)
+	}
+	return files, nil
+}
+
+func listObjectsSyncRemote(config *transport_tpg.Config, userAgent, bucket, prefix string) (map[string]*storage.Object, error) {
+	objects := make(map[string]*storage.Object)
+	objectsService := storage.NewObjectsService(NewClient(config, userAgent))
+	call := objectsService.List(bucket).Prefix(prefix).Fields("nextPageToken", "items(name,md5Hash,crc32c,contentType,cacheControl,contentDisposition,contentEncoding,contentLanguage,metadata)")
+	err := call.Pages(context.Background(), func(res *storage.Objects) error {
+		for _, obj := range res.Items {
+			objects[obj.Name] = obj
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return objects, nil
+}
+
+// objectsSyncPrefix returns destination_prefix as a directory, so that object names never
+// run into the file names and listing the prefix doesn't match sibling prefixes.
+func objectsSyncPrefix(d interface{ Get(string) interface{} }) string {
+	prefix := d.Get("destination_prefix").(string)
+	if prefix != "" && !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+	return prefix
+}
+
+// objectsSyncMetadataMatches reports whether obj has the settings of desired. A content type
+// that wasn't set is left to Cloud Storage, so it only has to match when one was chosen.
+func objectsSyncMetadataMatches(obj, desired *storage.Object) bool {
+	if desired.ContentType != "" && obj.ContentType != desired.ContentType {
+		return false
+	}
+	if obj.CacheControl != desired.CacheControl ||
+		obj.ContentDisposition != desired.ContentDisposition ||
+		obj.ContentEncoding != desired.ContentEncoding ||
+		obj.ContentLanguage != desired.ContentLanguage {
+		return false
+	}
+	if len(obj.Metadata) != len(desired.Metadata) {
+		return false
+	}
+	for k, v := range desired.Metadata {
+		if got, ok := obj.Metadata[k]; !ok || got != v {
+			return false
+		}
+	}
+	return true
+}
+
+func objectsSyncObjectHash(obj *storage.Object) string {
+	if obj.Md5Hash != "" {
+		return objectsSyncHashMd5 + obj.Md5Hash
+	}
+	return objectsSyncHashCrc32c + obj.Crc32c
+}
+
+func objectsSyncFileHash(p string, useCrc32c bool) (string, error) {
+	f, err := os.Open(p)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	var h hash.Hash = md5.New()
+	prefix := objectsSyncHashMd5
+	if useCrc32c {
+		h = crc32.New(crc32.MakeTable(crc32.Castagnoli))
+		prefix = objectsSyncHashCrc32c
+	}
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+
+	// Cloud Storage encodes both hashes as base64 of their big-endian bytes
+	return prefix + base64.StdEncoding.EncodeToString(h.Sum(nil)), nil
+}
+
+type objectsSyncObjectConfig struct {
+	glob   *regexp.Regexp
+	object storage.Object
+}
+
+func expandObjectsSyncObjectConfigs(configured []interface{}) ([]objectsSyncObjectConfig, error) {
+	var configs []objectsSyncObjectConfig
+	for _, raw := range configured {
+		data := raw.(map[string]interface{})
+		glob, err := compileObjectsSyncGlob(data["glob"].(string))
+		if err != nil {
+			return nil, err
+		}
+		configs = append(configs, objectsSyncObjectConfig{
+			glob: glob,
+			object: storage.Object{
+				ContentType:        data["content_type"].(string),
+				CacheControl:       data["cache_control"].(string),
+				ContentDisposition: data["content_disposition"].(string),
+				ContentEncoding:    data["content_encoding"].(string),
+				ContentLanguage:    data["content_language"].(string),
+				Metadata:           tpgresource.ConvertStringMap(data["metadata"].(map[string]interface{})),
+			},
+		})
+	}
+	return configs, nil
+}
+
+// objectsSyncObject builds the object metadata for a file from the matching object_config
+// blocks, and returns the content type to upload it with.
+func objectsSyncObject(bucket string, f objectsSyncFile, configs []objectsSyncObjectConfig) (*storage.Object, string) {
+	object := &storage.Object{Bucket: bucket}
+	contentType := mime.TypeByExtension(path.Ext(f.object))
+
+	for _, c := range configs {
+		if !c.glob.MatchString(f.rel) {
+			continue
+		}
+		if c.object.ContentType != "" {
+			contentType = c.object.ContentType
+		}
+		if c.object.CacheControl != "" {
+			object.CacheControl = c.object.CacheControl
+		}
+		if c.object.ContentDisposition != "" {
+			object.ContentDisposition = c.object.ContentDisposition
+		}
+		if c.object.ContentEncoding != "" {
+			object.ContentEncoding = c.object.ContentEncoding
+		}
+		if c.object.ContentLanguage != "" {
+			object.ContentLanguage = c.object.ContentLanguage
+		}
+		for k, v := range c.object.Metadata {
+			if object.Metadata == nil {
+				object.Metadata = make(map[string]string)
+			}
+			object.Metadata[k] = v
+		}
+	}
+	object.ContentType = contentType
+	return object, contentType
+}
+
+func compileObjectsSyncGlobs(globs []string) ([]*regexp.Regexp, error) {
+	var compiled []*regexp.Regexp
+	for _, g := range globs {
+		re, err := compileObjectsSyncGlob(g)
+		if err != nil {
+			return nil, err
+		}
+		compiled = append(compiled, re)
+	}
+	return compiled, nil
+}
+
+// compileObjectsSyncGlob converts a glob to a regular expression. "*" and "?" don't match
+// "/", "**/" matches any number of directories and a trailing "**" matches everything.
+func compileObjectsSyncGlob(glob string) (*regexp.Regexp, error) {
+	var re strings.Builder
+	re.WriteString("^")
+	for i := 0; i < len(glob); i++ {
+		c := glob[i]
+		switch {
+		case strings.HasPrefix(glob[i:], "**/"):
+			re.WriteString("(?:.*/)?")
+			i += 2
+		case strings.HasPrefix(glob[i:], "**"):
+			re.WriteString(".*")
+			i++
+		case c == '*':
+			re.WriteString("[^/]*")
+		case c == '?':
+			re.WriteString("[^/]")
+		case c == '[':
+			end := strings.IndexByte(glob[i:], ']')
+			if end < 0 {
+				return nil, fmt.Errorf("invalid glob %q: unterminated character class", glob)
+			}
+			class := glob[i+1 : i+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			re.WriteString("[" + class + "]")
+			i += end
+		default:
+			re.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	re.WriteString("$")
+
+	compiled, err := regexp.Compile(re.String())
+	if err != nil {
+		return nil, fmt.Errorf("invalid glob %q: %s", glob, err)
+	}
+	return compiled, nil
+}
+
+func matchesAnyGlob(globs []*regexp.Regexp, p string) bool {
+	for _, g := range globs {
+		if g.MatchString(p) {
+			return true
+		}
+	}
+	return false
+}
+
+func init() {
+	registry.Schema{
+		Name:        "google_storage_bucket_objects_sync",
+		ProductName: "storage",
+		Type:        registry.SchemaTypeResource,
+		Schema:      ResourceStorageBucketObjectsSync(),
+	}.Register()
+}
@@ -0,0 +1,216 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/services/storage/resource_storage_bucket_objects_sync_internal_test.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package storage
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"google.golang.org/api/storage/v1"
+)
+
+func TestCompileObjectsSyncGlob(t *testing.T) {
+	cases := map[string]struct {
+		Glob    string
+		Match   []string
+		NoMatch []string
+	}{
+		"star": {
+			Glob:    "*.html",
+			Match:   []string{"index.html"},
+			NoMatch: []string{"blog/index.html", "index.htm"},
+		},
+		"double star directory": {
+			Glob:    "**/*.css",
+			Match:   []string{"site.css", "assets/site.css", "a/b/c/site.css"},
+			NoMatch: []string{"site.cssx"},
+		},
+		"trailing double star": {
+			Glob:    "drafts/**",
+			Match:   []string{"drafts/a.md", "drafts/2024/b.md"},
+			NoMatch: []string{"drafts", "published/drafts/a.md"},
+		},
+		"question mark and class": {
+			Glob:    "img?.[jp]ng",
+			Match:   []string{"img1.png", "img2.jng"},
+			NoMatch: []string{"img10.png", "img1.gif", "img/.png"},
+		},
+		"negated class": {
+			Glob:    "[!.]*",
+			Match:   []string{"index.html"},
+			NoMatch: []string{".DS_Store"},
+		},
+		"literal characters": {
+			Glob:    "a+b(1).txt",
+			Match:   []string{"a+b(1).txt"},
+			NoMatch: []string{"aab1.txt"},
+		},
+	}
+
+	for tn, tc := range cases {
+		re, err := compileObjectsSyncGlob(tc.Glob)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", tn, err)
+		}
+		for _, p := range tc.Match {
+			if !re.MatchString(p) {
+				t.Errorf("%s: expected %q to match %q", tn, tc.Glob, p)
+			}
+		}
+		for _, p := range tc.NoMatch {
+			if re.MatchString(p) {
+				t.Errorf("%s: expected %q not to match %q", tn, tc.Glob, p)
+			}
+		}
+	}
+
+	if _, err := compileObjectsSyncGlob("[abc"); err == nil {
+		t.Errorf("expected an error for an unterminated character class")
+	}
+}
+
+func TestObjectsSyncFileHash(t *testing.T) {
+	p := filepath.Join(t.TempDir(), "file.txt")
+	if err := os.WriteFile(p, []byte("hello world"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := map[string]struct {
+		UseCrc32c bool
+		Expected  string
+	}{
+		"md5":    {UseCrc32c: false, Expected: "md5:XrY7u+Ae7tCTyyK7j1rNww=="},
+		"crc32c": {UseCrc32c: true, Expected: "crc32c:yZRlqg=="},
+	}
+	for tn, tc := range cases {
+		h, err := objectsSyncFileHash(p, tc.UseCrc32c)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", tn, err)
+		}
+		if h != tc.Expected {
+			t.Errorf("%s: expected hash %q, got %q", tn, tc.Expected, h)
+		}
+	}
+}
+
+func TestObjectsSyncObject(t *testing.T) {
+	configs, err := expandObjectsSyncObjectConfigs([]interface{}{
+		map[string]interface{}{
+			"glob":                "**",
+			"content_type":        "",
+			"cache_control":       "public, max-age=300",
+			"content_disposition": "",
+			"content_encoding":    "",
+			"content_language":    "en",
+			"metadata":            map[string]interface{}{"team": "web"},
+		},
+		map[string]interface{}{
+			"glob":                "assets/**",
+			"content_type":        "application/x-custom",
+			"cache_control":       "public, max-age=31536000",
+			"content_disposition": "",
+			"content_encoding":    "gzip",
+			"content_language":    "",
+			"metadata":            map[string]interface{}{"immutable": "true"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	object, contentType := objectsSyncObject("bucket", objectsSyncFile{rel: "index.html", object: "site/index.html"}, configs)
+	if contentType != "text/html; charset=utf-8" || object.CacheControl != "public, max-age=300" || object.ContentLanguage != "en" {
+		t.Errorf("unexpected object for index.html: content type %q, %#v", contentType, object)
+	}
+	if !reflect.DeepEqual(object.Metadata, map[string]string{"team": "web"}) {
+		t.Errorf("unexpected metadata for index.html: %v", object.Metadata)
+	}
+
+	object, contentType = objectsSyncObject("bucket", objectsSyncFile{rel: "assets/app.js", object: "site/assets/app.js"}, configs)
+	if contentType != "application/x-custom" || object.CacheControl != "public, max-age=31536000" || object.ContentEncoding != "gzip" || object.ContentLanguage != "en" {
+		t.Errorf("unexpected object for assets/app.js: content type %q, %#v", contentType, object)
+	}
+	if !reflect.DeepEqual(object.Metadata, map[string]string{"team": "web", "immutable": "true"}) {
+		t.Errorf("unexpected metadata for assets/app.js: %v", object.Metadata)
+	}
+}
+
+func TestObjectsSyncPrefix(t *testing.T) {
+	cases := map[string]string{
+		"":      "",
+		"site":  "site/",
+		"site/": "site/",
+		"a/b":   "a/b/",
+	}
+	for prefix, expected := range cases {
+		d := schema.TestResourceDataRaw(t, ResourceStorageBucketObjectsSync().Schema, map[string]interface{}{
+			"bucket":             "bucket",
+			"source_dir":         "dir",
+			"destination_prefix": prefix,
+		})
+		if got := objectsSyncPrefix(d); got != expected {
+			t.Errorf("destination_prefix %q: expected %q, got %q", prefix, expected, got)
+		}
+	}
+}
+
+func TestObjectsSyncMetadataMatches(t *testing.T) {
+	desired := &storage.Object{
+		ContentType:  "text/html",
+		CacheControl: "no-cache",
+		Metadata:     map[string]string{"team": "web"},
+	}
+	cases := map[string]struct {
+		Remote   *storage.Object
+		Desired  *storage.Object
+		Expected bool
+	}{
+		"same settings": {
+			Remote:   &storage.Object{ContentType: "text/html", CacheControl: "no-cache", Metadata: map[string]string{"team": "web"}},
+			Desired:  desired,
+			Expected: true,
+		},
+		"content type left to Cloud Storage": {
+			Remote:   &storage.Object{ContentType: "application/octet-stream"},
+			Desired:  &storage.Object{},
+			Expected: true,
+		},
+		"changed cache control": {
+			Remote:   &storage.Object{ContentType: "text/html", CacheControl: "public", Metadata: map[string]string{"team": "web"}},
+			Desired:  desired,
+			Expected: false,
+		},
+		"changed metadata value": {
+			Remote:   &storage.Object{ContentType: "text/html", CacheControl: "no-cache", Metadata: map[string]string{"team": "data"}},
+			Desired:  desired,
+			Expected: false,
+		},
+		"removed metadata key": {
+			Remote:   &storage.Object{ContentType: "text/html", CacheControl: "no-cache", Metadata: map[string]string{"team": "web", "old": "x"}},
+			Desired:  desired,
+			Expected: false,
+		},
+	}
+	for tn, tc := range cases {
+		if got := objectsSyncMetadataMatches(tc.Remote, tc.Desired); got != tc.Expected {
+			t.Errorf("%s: expected %t, got %t", tn, tc.Expected, got)
+		}
+	}
+}
@@ -0,0 +1,173 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/services/storage/resource_storage_bucket_objects_sync_test.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package storage_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/hashicorp/terraform-provider-google/google/acctest"
+	storage_tpg "github.com/hashicorp/terraform-provider-google/google/services/storage"
+
+	"google.golang.org/api/storage/v1"
+)
+
+func TestAccStorageBucketObjectsSync_basic(t *testing.T) {
+	t.Parallel()
+
+	bucketName := acctest.TestBucketName(t)
+	sourceDir := t.TempDir()
+	writeObjectsSyncTestFile(t, sourceDir, "index.html", "<h1>hello</h1>")
+	writeObjectsSyncTestFile(t, sourceDir, "assets/app.js", "console.log(1)")
+	writeObjectsSyncTestFile(t, sourceDir, "drafts/post.md", "draft")
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccStorageBucketObjectsSyncDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageBucketObjectsSync(bucketName, sourceDir, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_storage_bucket_objects_sync.site", "objects.%", "2"),
+					resource.TestCheckResourceAttrSet("google_storage_bucket_objects_sync.site", "objects.site/index.html"),
+					resource.TestCheckResourceAttrSet("google_storage_bucket_objects_sync.site", "objects.site/assets/app.js"),
+					testAccCheckStorageBucketObjectsSyncObject(t, bucketName, "site/assets/app.js", "text/javascript; charset=utf-8", "public, max-age=31536000"),
+					testAccCheckStorageBucketObjectsSyncObject(t, bucketName, "site/index.html", "text/html; charset=utf-8", "public, max-age=300"),
+				),
+			},
+			{
+				PreConfig: func() {
+					writeObjectsSyncTestFile(t, sourceDir, "index.html", "<h1>hello again</h1>")
+					if err := os.Remove(filepath.Join(sourceDir, "assets", "app.js")); err != nil {
+						t.Fatal(err)
+					}
+					// An object that wasn't synced from a file is only deleted with delete_extraneous
+					config := acctest.GoogleProviderConfig(t)
+					objectsService := storage.NewObjectsService(storage_tpg.NewClient(config, config.UserAgent))
+					if _, err := objectsService.Insert(bucketName, &storage.Object{Name: "site/extra.txt"}).Media(strings.NewReader("not synced")).Do(); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccStorageBucketObjectsSync(bucketName, sourceDir, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_storage_bucket_objects_sync.site", "objects.%", "1"),
+					resource.TestCheckResourceAttrSet("google_storage_bucket_objects_sync.site", "objects.site/index.html"),
+					testAccCheckStorageBucketObjectsSyncObjectDeleted(t, bucketName, "site/extra.txt"),
+					testAccCheckStorageBucketObjectsSyncObjectDeleted(t, bucketName, "site/assets/app.js"),
+				),
+			},
+		},
+	})
+}
+
+func writeObjectsSyncTestFile(t *testing.T, dir, name, content string) {
+	p := filepath.Join(dir, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(p, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testAccCheckStorageBucketObjectsSyncObject(t *testing.T, bucket, name, contentType, cacheControl string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := acctest.GoogleProviderConfig(t)
+
+		obj, err := storage.NewObjectsService(storage_tpg.NewClient(config, config.UserAgent)).Get(bucket, name).Do()
+		if err != nil {
+			return fmt.Errorf("Error retrieving object %s: %s", name, err)
+		}
+		if obj.ContentType != contentType {
+			return fmt.Errorf("Expected object %s to have content type %q, got %q", name, contentType, obj.ContentType)
+		}
+		if obj.CacheControl != cacheControl {
+			return fmt.Errorf("Expected object %s to have cache control %q, got %q", name, cacheControl, obj.CacheControl)
+		}
+		return nil
+	}
+}
+
+func testAccCheckStorageBucketObjectsSyncObjectDeleted(t *testing.T, bucket, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := acctest.GoogleProviderConfig(t)
+
+		if _, err := storage.NewObjectsService(storage_tpg.NewClient(config, config.UserAgent)).Get(bucket, name).Do(); err == nil {
+			return fmt.Errorf("Object %s still exists", name)
+		}
+		return nil
+	}
+}
+
+func testAccStorageBucketObjectsSyncDestroyProducer(t *testing.T) func(s *terraform.State) error {
+	return func(s *terraform.State) error {
+		config := acctest.GoogleProviderConfig(t)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "google_storage_bucket_objects_sync" {
+				continue
+			}
+
+			bucket := rs.Primary.Attributes["bucket"]
+			objectsService := storage.NewObjectsService(storage_tpg.NewClient(config, config.UserAgent))
+			res, err := objectsService.List(bucket).Prefix(rs.Primary.Attributes["destination_prefix"]).Do()
+			if err != nil {
+				continue
+			}
+			if len(res.Items) > 0 {
+				return fmt.Errorf("Object %s still exists", res.Items[0].Name)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccStorageBucketObjectsSync(bucketName, sourceDir string, deleteExtraneous bool) string {
+	return fmt.Sprintf(`
+resource "google_storage_bucket" "bucket" {
+  name          = "%s"
+  location      = "US"
+  force_destroy = true
+}
+
+resource "google_storage_bucket_objects_sync" "site" {
+  bucket             = google_storage_bucket.bucket.name
+  source_dir         = "%s"
+  destination_prefix = "site/"
+  exclude            = ["drafts/**"]
+  delete_extraneous  = %t
+
+  object_config {
+    glob          = "**"
+    cache_control = "public, max-age=300"
+  }
+
+  object_config {
+    glob          = "assets/**"
+    cache_control = "public, max-age=31536000"
+  }
+}
+`, bucketName, filepath.ToSlash(sourceDir), deleteExtraneous)
+}
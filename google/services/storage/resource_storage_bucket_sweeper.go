@@ -181,7 +181,7 @@ func testSweepStorageBucket(region string) error {
 
 		id := obj["name"].(string)
 		// Increment count and skip if resource is not sweepable.
-		if !sweeper.IsSweepableTestResource(id) {
+		if !sweeper.ShouldSweep(id, obj) {
 			nonPrefixCount++
 			continue
 		}
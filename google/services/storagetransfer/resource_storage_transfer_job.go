@@ -783,11 +783,14 @@ func awsS3DataSchema() *schema.Resource {
 							Description: `AWS Key ID.`,
 						},
 						"secret_access_key": {
-							Type:        schema.TypeString,
-							Required:    true,
-							Sensitive:   true,
-							Description: `AWS Secret Access Key.`,
+							Type:         schema.TypeString,
+							Optional:     true,
+							Sensitive:    true,
+							ExactlyOneOf: tpgresource.WriteOnlyPair("transfer_spec.0.aws_s3_data_source.0.aws_access_key.0.secret_access_key"),
+							Description:  `AWS Secret Access Key.`,
 						},
+						"secret_access_key_wo":         tpgresource.WriteOnlySchema("transfer_spec.0.aws_s3_data_source.0.aws_access_key.0.secret_access_key", `Write-only AWS Secret Access Key.`, true),
+						"secret_access_key_wo_version": tpgresource.WriteOnlyVersionSchema("transfer_spec.0.aws_s3_data_source.0.aws_access_key.0.secret_access_key", false),
 					},
 				},
 				ExactlyOneOf: awsS3AuthKeys,
@@ -895,11 +898,14 @@ func azureBlobStorageDataSchema() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"sas_token": {
-							Type:        schema.TypeString,
-							Required:    true,
-							Sensitive:   true,
-							Description: `Azure shared access signature.`,
+							Type:         schema.TypeString,
+							Optional:     true,
+							Sensitive:    true,
+							ExactlyOneOf: tpgresource.WriteOnlyPair("transfer_spec.0.azure_blob_storage_data_source.0.azure_credentials.0.sas_token"),
+							Description:  `Azure shared access signature.`,
 						},
+						"sas_token_wo":         tpgresource.WriteOnlySchema("transfer_spec.0.azure_blob_storage_data_source.0.azure_credentials.0.sas_token", `Write-only Azure shared access signature.`, true),
+						"sas_token_wo_version": tpgresource.WriteOnlyVersionSchema("transfer_spec.0.azure_blob_storage_data_source.0.azure_credentials.0.sas_token", false),
 					},
 				},
 				Description: ` Credentials used to authenticate API requests to Azure.`,
@@ -1028,7 +1034,7 @@ func resourceStorageTransferJobCreate(d *schema.ResourceData, meta interface{})
 		Status:             d.Get("status").(string),
 		Schedule:           expandTransferSchedules(d.Get("schedule").([]interface{})),
 		EventStream:        expandEventStream(d.Get("event_stream").([]interface{})),
-		TransferSpec:       expandTransferSpecs(d.Get("transfer_spec").([]interface{})),
+		TransferSpec:       expandTransferSpecs(d, d.Get("transfer_spec").([]interface{})),
 		ReplicationSpec:    expandReplicationSpecs(d.Get("replication_spec").([]interface{})),
 		LoggingConfig:      expandTransferJobLoggingConfig(d.Get("logging_config").([]interface{})),
 		NotificationConfig: expandTransferJobNotificationConfig(d.Get("notification_config").([]interface{})),
@@ -1214,7 +1220,7 @@ func resourceStorageTransferJobUpdate(d *schema.ResourceData, meta interface{})
 	if d.HasChange("transfer_spec") {
 		fieldMask = append(fieldMask, "transfer_spec")
 		if v, ok := d.GetOk("transfer_spec"); ok {
-			transferJob.TransferSpec = expandTransferSpecs(v.([]interface{}))
+			transferJob.TransferSpec = expandTransferSpecs(d, v.([]interface{}))
 		}
 	}
 
@@ -1551,7 +1557,7 @@ func flattenGcsData(gcsData *storagetransfer.GcsData) []map[string]interface{} {
 	return []map[string]interface{}{data}
 }
 
-func expandAwsAccessKeys(awsAccessKeys []interface{}) *storagetransfer.AwsAccessKey {
+func expandAwsAccessKeys(d tpgresource.TerraformResourceData, awsAccessKeys []interface{}) *storagetransfer.AwsAccessKey {
 	if len(awsAccessKeys) == 0 || awsAccessKeys[0] == nil {
 		return nil
 	}
@@ -1559,20 +1565,21 @@ func expandAwsAccessKeys(awsAccessKeys []interface{}) *storagetransfer.AwsAccess
 	awsAccessKey := awsAccessKeys[0].(map[string]interface{})
 	return &storagetransfer.AwsAccessKey{
 		AccessKeyId:     awsAccessKey["access_key_id"].(string),
-		SecretAccessKey: awsAccessKey["secret_access_key"].(string),
+		SecretAccessKey: tpgresource.GetWriteOnlyValue(d, "transfer_spec.0.aws_s3_data_source.0.aws_access_key.0.secret_access_key", awsAccessKey["secret_access_key"]).(string),
 	}
 }
 
 func flattenAwsAccessKeys(d *schema.ResourceData) []map[string]interface{} {
 	data := map[string]interface{}{
-		"access_key_id":     d.Get("transfer_spec.0.aws_s3_data_source.0.aws_access_key.0.access_key_id"),
-		"secret_access_key": d.Get("transfer_spec.0.aws_s3_data_source.0.aws_access_key.0.secret_access_key"),
+		"access_key_id":                d.Get("transfer_spec.0.aws_s3_data_source.0.aws_access_key.0.access_key_id"),
+		"secret_access_key":            d.Get("transfer_spec.0.aws_s3_data_source.0.aws_access_key.0.secret_access_key"),
+		"secret_access_key_wo_version": d.Get("transfer_spec.0.aws_s3_data_source.0.aws_access_key.0.secret_access_key_wo_version"),
 	}
 
 	return []map[string]interface{}{data}
 }
 
-func expandAwsS3Data(awsS3Datas []interface{}) *storagetransfer.AwsS3Data {
+func expandAwsS3Data(d tpgresource.TerraformResourceData, awsS3Datas []interface{}) *storagetransfer.AwsS3Data {
 	if len(awsS3Datas) == 0 || awsS3Datas[0] == nil {
 		return nil
 	}
@@ -1580,7 +1587,7 @@ func expandAwsS3Data(awsS3Datas []interface{}) *storagetransfer.AwsS3Data {
 	awsS3Data := awsS3Datas[0].(map[string]interface{})
 	result := &storagetransfer.AwsS3Data{
 		BucketName:        awsS3Data["bucket_name"].(string),
-		AwsAccessKey:      expandAwsAccessKeys(awsS3Data["aws_access_key"].([]interface{})),
+		AwsAccessKey:      expandAwsAccessKeys(d, awsS3Data["aws_access_key"].([]interface{})),
 		RoleArn:           awsS3Data["role_arn"].(string),
 		CredentialsSecret: awsS3Data["credentials_secret"].(string),
 		Path:              awsS3Data["path"].(string),
@@ -1766,30 +1773,31 @@ func flattenS3MetaData(s3MetaData *storagetransfer.S3CompatibleMetadata, d *sche
 	return []map[string]interface{}{s3Metadata}
 }
 
-func expandAzureCredentials(azureCredentials []interface{}) *storagetransfer.AzureCredentials {
+func expandAzureCredentials(d tpgresource.TerraformResourceData, azureCredentials []interface{}) *storagetransfer.AzureCredentials {
 	if len(azureCredentials) == 0 || azureCredentials[0] == nil {
 		return nil
 	}
 
 	azureCredential := azureCredentials[0].(map[string]interface{})
 	return &storagetransfer.AzureCredentials{
-		SasToken: azureCredential["sas_token"].(string),
+		SasToken: tpgresource.GetWriteOnlyValue(d, "transfer_spec.0.azure_blob_storage_data_source.0.azure_credentials.0.sas_token", azureCredential["sas_token"]).(string),
 	}
 }
 
 func flattenAzureCredentials(d *schema.ResourceData) []map[string]interface{} {
-	if d.Get("transfer_spec.0.azure_blob_storage_data_source.0.azure_credentials.0.sas_token") == "" {
+	if d.Get("transfer_spec.0.azure_blob_storage_data_source.0.azure_credentials.0.sas_token") == "" && d.Get("transfer_spec.0.azure_blob_storage_data_source.0.azure_credentials.0.sas_token_wo_version") == "" {
 		return []map[string]interface{}{}
 	}
 
 	data := map[string]interface{}{
-		"sas_token": d.Get("transfer_spec.0.azure_blob_storage_data_source.0.azure_credentials.0.sas_token"),
+		"sas_token":            d.Get("transfer_spec.0.azure_blob_storage_data_source.0.azure_credentials.0.sas_token"),
+		"sas_token_wo_version": d.Get("transfer_spec.0.azure_blob_storage_data_source.0.azure_credentials.0.sas_token_wo_version"),
 	}
 
 	return []map[string]interface{}{data}
 }
 
-func expandAzureBlobStorageData(azureBlobStorageDatas []interface{}) *storagetransfer.AzureBlobStorageData {
+func expandAzureBlobStorageData(d tpgresource.TerraformResourceData, azureBlobStorageDatas []interface{}) *storagetransfer.AzureBlobStorageData {
 	if len(azureBlobStorageDatas) == 0 || azureBlobStorageDatas[0] == nil {
 		return nil
 	}
@@ -1800,7 +1808,7 @@ func expandAzureBlobStorageData(azureBlobStorageDatas []interface{}) *storagetra
 		Container:               azureBlobStorageData["container"].(string),
 		Path:                    azureBlobStorageData["path"].(string),
 		StorageAccount:          azureBlobStorageData["storage_account"].(string),
-		AzureCredentials:        expandAzureCredentials(azureBlobStorageData["azure_credentials"].([]interface{})),
+		AzureCredentials:        expandAzureCredentials(d, azureBlobStorageData["azure_credentials"].([]interface{})),
 		CredentialsSecret:       azureBlobStorageData["credentials_secret"].(string),
 		FederatedIdentityConfig: expandAzureFederatedIdentifyConfig(azureBlobStorageData["federated_identity_config"].([]interface{})),
 		PrivateNetworkService:   azureBlobStorageData["private_network_service"].(string),
@@ -1913,7 +1921,7 @@ func flattenMetadataOptions(options *storagetransfer.MetadataOptions) []map[stri
 	return []map[string]interface{}{data}
 }
 
-func expandTransferSpecs(transferSpecs []interface{}) *storagetransfer.TransferSpec {
+func expandTransferSpecs(d tpgresource.TerraformResourceData, transferSpecs []interface{}) *storagetransfer.TransferSpec {
 	if len(transferSpecs) == 0 || transferSpecs[0] == nil {
 		return nil
 	}
@@ -1927,9 +1935,9 @@ func expandTransferSpecs(transferSpecs []interface{}) *storagetransfer.TransferS
 		ObjectConditions:           expandObjectConditions(transferSpec["object_conditions"].([]interface{})),
 		TransferOptions:            expandTransferOptions(transferSpec["transfer_options"].([]interface{})),
 		GcsDataSource:              expandGcsData(transferSpec["gcs_data_source"].([]interface{})),
-		AwsS3DataSource:            expandAwsS3Data(transferSpec["aws_s3_data_source"].([]interface{})),
+		AwsS3DataSource:            expandAwsS3Data(d, transferSpec["aws_s3_data_source"].([]interface{})),
 		HttpDataSource:             expandHttpData(transferSpec["http_data_source"].([]interface{})),
-		AzureBlobStorageDataSource: expandAzureBlobStorageData(transferSpec["azure_blob_storage_data_source"].([]interface{})),
+		AzureBlobStorageDataSource: expandAzureBlobStorageData(d, transferSpec["azure_blob_storage_data_source"].([]interface{})),
 		PosixDataSource:            expandPosixData(transferSpec["posix_data_source"].([]interface{})),
 		HdfsDataSource:             expandHdfsData(transferSpec["hdfs_data_source"].([]interface{})),
 		AwsS3CompatibleDataSource:  expandAwsS3CompatibleData(transferSpec["aws_s3_compatible_data_source"].([]interface{})),
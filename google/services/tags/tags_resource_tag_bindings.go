@@ -0,0 +1,393 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/services/tags/tags_resource_tag_bindings.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package tags
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-google/google/cai"
+	"github.com/hashicorp/terraform-provider-google/google/services"
+	"github.com/hashicorp/terraform-provider-google/google/services/tagslocation"
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+)
+
+// TagBindingsResourceTypes are the resource types with a tags field whose tags
+// are managed through tag bindings. A resource type can only be added when its
+// metadata has cai_asset_name_formats, which name the resource tags are bound
+// to, and its API supports resource manager tags. Resources that set tags
+// through their own API, such as resource_manager_tags on GKE clusters, are
+// not listed.
+var TagBindingsResourceTypes = []string{
+	"google_cloud_run_service",
+	"google_spanner_instance",
+}
+
+// tagBindingsLocationParams are the name format parameters holding the
+// location of regional and zonal resources, whose tags are bound through the
+// endpoint of their location.
+var tagBindingsLocationParams = []string{"location", "region", "zone"}
+
+// TagBindingsSchema returns the schema of the tags field of resources whose
+// tags are managed through tag bindings.
+func TagBindingsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeMap,
+		Optional: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+		Description: `A map of resource manager tags bound to the resource. Keys can be either the tag key ID (tagKeys/123)
+or the namespaced name (project/tag-key). Values can be the tag value ID (tagValues/456) or the namespaced value
+(project/tag-key/tag-value). Only the tag keys in the map are managed, tags bound by other means are left as is.`,
+	}
+}
+
+// CreateWithTagBindings wraps the create function of a resource whose tags are
+// managed through tag bindings, binding its tags once it is created. Tags that
+// fail to bind are reported as a warning rather than an error, which would
+// taint the created resource, and are left out of state so that the next plan
+// binds them again.
+func CreateWithTagBindings(create schema.CreateFunc, resourceType string) schema.CreateContextFunc {
+	return func(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		config := meta.(*transport_tpg.Config)
+		configured := d.Get("tags")
+		if err := create(d, meta); err != nil {
+			return diag.FromErr(err)
+		}
+		if d.Id() == "" {
+			return nil
+		}
+
+		// Reading the created resource drops the tags that aren't bound yet.
+		if err := d.Set("tags", configured); err != nil {
+			return diag.Errorf("Error setting tags: %s", err)
+		}
+		err := UpdateTagBindings(d, config, resourceType)
+		if err == nil {
+			return nil
+		}
+		if rerr := ReadTagBindings(d, config, resourceType); rerr != nil {
+			log.Printf("[WARN] Error reading the tags bound to %s %q: %s", resourceType, d.Id(), rerr)
+			if serr := d.Set("tags", map[string]interface{}{}); serr != nil {
+				return diag.Errorf("Error setting tags: %s", serr)
+			}
+		}
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("%s %q was created, but its tags could not be bound", resourceType, d.Id()),
+			Detail:   fmt.Sprintf("%s\n\nThe tags that aren't bound are bound again on the next apply.", err),
+		}}
+	}
+}
+
+// UpdateTagBindings binds the tags of the tags field to a resource, and
+// unbinds the tags removed from it.
+func UpdateTagBindings(d *schema.ResourceData, config *transport_tpg.Config, resourceType string) error {
+	target, err := tagBindingsTargetOf(d, config, resourceType)
+	if err != nil {
+		return err
+	}
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	lockName := fmt.Sprintf("tagBindings/%s", target.parent)
+	transport_tpg.MutexStore.Lock(lockName)
+	defer transport_tpg.MutexStore.Unlock(lockName)
+
+	effective, err := listDirectEffectiveTags(d, config, target, userAgent)
+	if err != nil {
+		return err
+	}
+
+	o, n := d.GetChange("tags")
+	oldTags, newTags := o.(map[string]interface{}), n.(map[string]interface{})
+
+	for _, key := range sortedTagKeys(oldTags) {
+		if v, ok := newTags[key]; ok && v == oldTags[key] {
+			continue
+		}
+		tag := findEffectiveTag(effective, key)
+		if tag == nil {
+			continue
+		}
+		if err := deleteTagBinding(d, config, target, tag["tagValue"].(string), userAgent); err != nil {
+			return err
+		}
+	}
+	for _, key := range sortedTagKeys(newTags) {
+		value := newTags[key].(string)
+		if tag := findEffectiveTag(effective, key); tag != nil && tagValueMatches(tag, value) {
+			continue
+		}
+		if err := createTagBinding(d, config, target, value, userAgent); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReadTagBindings refreshes the tags field of a resource from the tags bound
+// to it.
+func ReadTagBindings(d *schema.ResourceData, config *transport_tpg.Config, resourceType string) error {
+	configured := d.Get("tags").(map[string]interface{})
+	if len(configured) == 0 {
+		return nil
+	}
+	target, err := tagBindingsTargetOf(d, config, resourceType)
+	if err != nil {
+		return err
+	}
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+	effective, err := listDirectEffectiveTags(d, config, target, userAgent)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("tags", flattenTagBindings(configured, effective)); err != nil {
+		return fmt.Errorf("Error setting tags: %s", err)
+	}
+	return nil
+}
+
+// tagBindingsTarget is a resource tags are bound to.
+type tagBindingsTarget struct {
+	// parent is the full resource name of the resource.
+	parent string
+	// location is the location of regional resources, empty for global ones.
+	location string
+	// basePath is the endpoint the tags of the resource are bound through.
+	basePath string
+}
+
+// tagBindingsTargetOf returns the resource tags are bound to, named after the
+// first cai_asset_name_formats entry of the metadata of its resource type.
+func tagBindingsTargetOf(d *schema.ResourceData, config *transport_tpg.Config, resourceType string) (*tagBindingsTarget, error) {
+	if !slices.Contains(TagBindingsResourceTypes, resourceType) {
+		return nil, fmt.Errorf("tags are not supported on %s", resourceType)
+	}
+	metadata, err := services.Metadata()
+	m, ok := metadata[resourceType]
+	if !ok || len(m.CaiAssetNameFormats) == 0 {
+		if err != nil {
+			return nil, fmt.Errorf("tags are not supported on %s: %s", resourceType, err)
+		}
+		return nil, fmt.Errorf("tags are not supported on %s: no cai_asset_name_formats in its metadata", resourceType)
+	}
+	nameFormat := m.CaiAssetNameFormats[0]
+
+	target := &tagBindingsTarget{basePath: transport_tpg.BaseUrl(Product, config)}
+	attributes := make(map[string]string)
+	for _, param := range cai.ExtractIdentifiers(nameFormat) {
+		v, err := tagBindingsParam(d, config, param)
+		if err != nil {
+			return nil, err
+		}
+		if v == "" {
+			return nil, fmt.Errorf("cannot determine the full resource name of %s: %s is not set", resourceType, param)
+		}
+		attributes[param] = v
+		if slices.Contains(tagBindingsLocationParams, param) {
+			target.location = v
+			target.basePath = strings.Replace(transport_tpg.BaseUrl(tagslocation.Product, config), "{{location}}", v, 1)
+		}
+	}
+	target.parent = cai.FormatAssetName(nameFormat, attributes)
+	return target, nil
+}
+
+func tagBindingsParam(d *schema.ResourceData, config *transport_tpg.Config, param string) (string, error) {
+	switch param {
+	case "project":
+		return tpgresource.GetProject(d, config)
+	case "region":
+		return tpgresource.GetRegion(d, config)
+	case "zone":
+		return tpgresource.GetZone(d, config)
+	}
+	v, _ := d.Get(param).(string)
+	return tpgresource.GetResourceNameFromSelfLink(v), nil
+}
+
+// wait waits for a tag binding operation on the endpoint of the target.
+func (t *tagBindingsTarget) wait(config *transport_tpg.Config, op map[string]interface{}, activity, userAgent string, timeout time.Duration) error {
+	if t.location != "" {
+		return TagsLocationOperationWaitTime(config, op, activity, userAgent, timeout)
+	}
+	return TagsOperationWaitTime(config, op, activity, userAgent, timeout)
+}
+
+// listDirectEffectiveTags returns the tags bound to a resource, excluding the
+// tags it inherits from its ancestors.
+func listDirectEffectiveTags(d *schema.ResourceData, config *transport_tpg.Config, target *tagBindingsTarget, userAgent string) ([]map[string]interface{}, error) {
+	billingProject := ""
+	if bp, err := tpgresource.GetBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	var result []map[string]interface{}
+	params := map[string]string{"parent": target.parent, "pageSize": "300"}
+	for {
+		u, err := transport_tpg.AddQueryParams(target.basePath+"effectiveTags", params)
+		if err != nil {
+			return nil, err
+		}
+		res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+			Config:    config,
+			Method:    "GET",
+			Project:   billingProject,
+			RawURL:    u,
+			UserAgent: userAgent,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("Error reading the tags of %s: %s", target.parent, err)
+		}
+		tags, _ := res["effectiveTags"].([]interface{})
+		for _, raw := range tags {
+			tag, ok := raw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if inherited, _ := tag["inherited"].(bool); inherited {
+				continue
+			}
+			result = append(result, tag)
+		}
+		token, _ := res["nextPageToken"].(string)
+		if token == "" {
+			return result, nil
+		}
+		params["pageToken"] = token
+	}
+}
+
+func createTagBinding(d *schema.ResourceData, config *transport_tpg.Config, target *tagBindingsTarget, value, userAgent string) error {
+	obj := map[string]interface{}{"parent": target.parent}
+	// The tag value is either in id format "tagValues/{id}" or namespaced format "{parent}/{key}/{val}"
+	if strings.HasPrefix(value, "tagValues/") {
+		obj["tagValue"] = value
+	} else {
+		obj["tagValueNamespacedName"] = value
+	}
+
+	billingProject := ""
+	if bp, err := tpgresource.GetBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	log.Printf("[DEBUG] Binding tag value %s to %s", value, target.parent)
+	res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+		Config:    config,
+		Method:    "POST",
+		Project:   billingProject,
+		RawURL:    target.basePath + "tagBindings",
+		UserAgent: userAgent,
+		Body:      obj,
+		Timeout:   d.Timeout(schema.TimeoutUpdate),
+	})
+	if err != nil {
+		return fmt.Errorf("Error binding tag value %s to %s: %s", value, target.parent, err)
+	}
+	if err := target.wait(config, res, "Creating TagBinding", userAgent, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("Error waiting to bind tag value %s to %s: %s", value, target.parent, err)
+	}
+	return nil
+}
+
+func deleteTagBinding(d *schema.ResourceData, config *transport_tpg.Config, target *tagBindingsTarget, value, userAgent string) error {
+	billingProject := ""
+	if bp, err := tpgresource.GetBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	log.Printf("[DEBUG] Unbinding tag value %s from %s", value, target.parent)
+	res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+		Config:    config,
+		Method:    "DELETE",
+		Project:   billingProject,
+		RawURL:    fmt.Sprintf("%stagBindings/%s/%s", target.basePath, url.QueryEscape(target.parent), value),
+		UserAgent: userAgent,
+		Timeout:   d.Timeout(schema.TimeoutUpdate),
+	})
+	if err != nil {
+		if transport_tpg.IsGoogleApiErrorWithCode(err, 404) {
+			return nil
+		}
+		return fmt.Errorf("Error unbinding tag value %s from %s: %s", value, target.parent, err)
+	}
+	if err := target.wait(config, res, "Deleting TagBinding", userAgent, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("Error waiting to unbind tag value %s from %s: %s", value, target.parent, err)
+	}
+	return nil
+}
+
+// flattenTagBindings returns the configured tags as bound to the resource,
+// keeping the id or namespaced format of each configured key and value.
+// Configured keys without a binding are left out.
+func flattenTagBindings(configured map[string]interface{}, effective []map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{})
+	for key, v := range configured {
+		tag := findEffectiveTag(effective, key)
+		if tag == nil {
+			continue
+		}
+		value, _ := v.(string)
+		switch {
+		case tagValueMatches(tag, value):
+			result[key] = value
+		case strings.HasPrefix(value, "tagValues/"):
+			result[key] = tag["tagValue"]
+		default:
+			result[key] = tag["namespacedTagValue"]
+		}
+	}
+	return result
+}
+
+func findEffectiveTag(effective []map[string]interface{}, key string) map[string]interface{} {
+	for _, tag := range effective {
+		if tag["tagKey"] == key || tag["namespacedTagKey"] == key {
+			return tag
+		}
+	}
+	return nil
+}
+
+func tagValueMatches(tag map[string]interface{}, value string) bool {
+	return tag["tagValue"] == value || tag["namespacedTagValue"] == value
+}
+
+func sortedTagKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
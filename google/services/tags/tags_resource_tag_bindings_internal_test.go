@@ -0,0 +1,173 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/services/tags/tags_resource_tag_bindings_internal_test.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package tags
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-google/google/services"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+)
+
+// The full resource names tags are bound to follow the CAI asset name formats
+// in the metadata of each resource.
+func TestTagBindingsTargetOf(t *testing.T) {
+	t.Parallel()
+
+	resourceSchema := map[string]*schema.Schema{
+		"project":  {Type: schema.TypeString, Optional: true},
+		"name":     {Type: schema.TypeString, Optional: true},
+		"location": {Type: schema.TypeString, Optional: true},
+	}
+	config := &transport_tpg.Config{Project: "my-project"}
+
+	cases := map[string]struct {
+		resourceType string
+		raw          map[string]interface{}
+		wantParent   string
+		wantLocation string
+		wantErr      bool
+	}{
+		"global": {
+			resourceType: "google_spanner_instance",
+			raw:          map[string]interface{}{"name": "my-instance"},
+			wantParent:   "//spanner.googleapis.com/projects/my-project/instances/my-instance",
+		},
+		"regional": {
+			resourceType: "google_cloud_run_service",
+			raw:          map[string]interface{}{"project": "other-project", "name": "my-service", "location": "us-central1"},
+			wantParent:   "//run.googleapis.com/projects/other-project/locations/us-central1/services/my-service",
+			wantLocation: "us-central1",
+		},
+		"missing parameter": {
+			resourceType: "google_cloud_run_service",
+			raw:          map[string]interface{}{"name": "my-service"},
+			wantErr:      true,
+		},
+		"unsupported resource": {
+			resourceType: "google_spanner_database",
+			raw:          map[string]interface{}{"name": "my-database"},
+			wantErr:      true,
+		},
+		"no metadata": {
+			resourceType: "google_not_a_resource",
+			raw:          map[string]interface{}{"name": "my-instance"},
+			wantErr:      true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceSchema, tc.raw)
+			target, err := tagBindingsTargetOf(d, config, tc.resourceType)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got target %#v", target)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if target.parent != tc.wantParent {
+				t.Errorf("got parent %q, want %q", target.parent, tc.wantParent)
+			}
+			if target.location != tc.wantLocation {
+				t.Errorf("got location %q, want %q", target.location, tc.wantLocation)
+			}
+			if tc.wantLocation != "" && !strings.Contains(target.basePath, tc.wantLocation+"-") {
+				t.Errorf("got base path %q, want the %s endpoint", target.basePath, tc.wantLocation)
+			}
+		})
+	}
+}
+
+// Every resource type with a tags field needs a CAI asset name format to name
+// the resource its tags are bound to.
+func TestTagBindingsResourceTypes(t *testing.T) {
+	t.Parallel()
+
+	metadata, err := services.Metadata()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, resourceType := range TagBindingsResourceTypes {
+		if len(metadata[resourceType].CaiAssetNameFormats) == 0 {
+			t.Errorf("%s has no cai_asset_name_formats in its metadata", resourceType)
+		}
+	}
+}
+
+func TestFlattenTagBindings(t *testing.T) {
+	t.Parallel()
+
+	effective := []map[string]interface{}{
+		{
+			"tagKey":             "tagKeys/1",
+			"namespacedTagKey":   "my-project/env",
+			"tagValue":           "tagValues/11",
+			"namespacedTagValue": "my-project/env/prod",
+		},
+		{
+			"tagKey":             "tagKeys/2",
+			"namespacedTagKey":   "my-project/team",
+			"tagValue":           "tagValues/21",
+			"namespacedTagValue": "my-project/team/data",
+		},
+	}
+
+	cases := map[string]struct {
+		configured map[string]interface{}
+		want       map[string]interface{}
+	}{
+		"ids": {
+			configured: map[string]interface{}{"tagKeys/1": "tagValues/11"},
+			want:       map[string]interface{}{"tagKeys/1": "tagValues/11"},
+		},
+		"namespaced names": {
+			configured: map[string]interface{}{"my-project/team": "my-project/team/data"},
+			want:       map[string]interface{}{"my-project/team": "my-project/team/data"},
+		},
+		"value drift keeps the configured format": {
+			configured: map[string]interface{}{
+				"tagKeys/1":       "tagValues/12",
+				"my-project/team": "my-project/team/ml",
+			},
+			want: map[string]interface{}{
+				"tagKeys/1":       "tagValues/11",
+				"my-project/team": "my-project/team/data",
+			},
+		},
+		"unbound key": {
+			configured: map[string]interface{}{"tagKeys/3": "tagValues/31"},
+			want:       map[string]interface{}{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := flattenTagBindings(tc.configured, effective)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %#v, want %#v", got, tc.want)
+			}
+		})
+	}
+}
@@ -195,7 +195,7 @@ func deleteResourceVertexAIEndpoint(config *transport_tpg.Config, d *tpgresource
 		"tf-test-",
 		"mg-endpoint-",
 	}
-	if !sweeper.IsSweepableTestResource(name) && !sweeper.HasAnyPrefix(name, prefixes) {
+	if !sweeper.ShouldSweep(name, obj, prefixes...) {
 		return nil
 	}
 
@@ -190,7 +190,7 @@ func deleteResourceVertexAIIndexEndpoint(config *transport_tpg.Config, d *tpgres
 	name = obj["displayName"].(string)
 
 	// Skip resources that shouldn't be sweeped
-	if !sweeper.IsSweepableTestResource(name) {
+	if !sweeper.ShouldSweep(name, obj) {
 		return nil
 	}
 
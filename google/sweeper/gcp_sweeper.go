@@ -19,7 +19,9 @@ package sweeper
 import (
 	"fmt"
 	"log"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-provider-google/google/envvar"
 	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
@@ -64,8 +66,112 @@ func SharedConfigForRegion(region string) (*transport_tpg.Config, error) {
 	return conf, nil
 }
 
+// Settings from the -sweep-dry-run and -sweep-min-age flags, set by ExecuteSweepers
+// and consulted whenever a sweeper decides whether to delete a resource.
+var (
+	sweepDryRun bool
+	sweepMinAge time.Duration
+)
+
+// Keys that list responses use for a resource's creation time
+var creationTimeKeys = []string{
+	"createTime",        // most APIs
+	"creationTimestamp", // compute
+	"timeCreated",       // storage
+	"creationTime",      // bigquery, as milliseconds since the epoch
+}
+
+// IsSweepableTestResource reports whether a resource should be swept based on its name.
+// Resources can't be age or label filtered by name alone, so when -sweep-min-age,
+// -sweep-run-id or -sweep-run-ttl is set they are never swept; sweepers that have the
+// listed resource should use ShouldSweep or ShouldSweepTyped.
 func IsSweepableTestResource(resourceName string) bool {
-	return hasAnyPrefix(resourceName, testResourcePrefixes)
+	if !hasAnyPrefix(resourceName, testResourcePrefixes) {
+		return false
+	}
+
+	if labelSelection() != nil {
+		log.Printf("[INFO][SWEEPER_LOG] Skipping %s: labels unknown and selecting by test run", resourceName)
+		return false
+	}
+
+	if sweepMinAge > 0 {
+		log.Printf("[INFO][SWEEPER_LOG] Skipping %s: creation time unknown and minimum age %s set", resourceName, sweepMinAge)
+		return false
+	}
+
+	return !SkipForDryRun(resourceName)
+}
+
+// ShouldSweep reports whether a resource returned by a list call should be swept.
+// Resources are selected by name prefix, including any extraPrefixes specific to
+// the sweeper. With -sweep-any-test-run, resources with the ownership labels
+// stamped by acceptance tests are selected too, whatever their name; when
+// -sweep-run-id or -sweep-run-ttl is set only the labels are considered.
+// Resources created less than -sweep-min-age ago are kept.
+func ShouldSweep(resourceName string, obj map[string]interface{}, extraPrefixes ...string) bool {
+	labels := ResourceLabels(obj)
+	if filter := labelSelection(); filter != nil {
+		if !filter(labels) {
+			return false
+		}
+	} else if !hasAnyPrefix(resourceName, testResourcePrefixes) && !hasAnyPrefix(resourceName, extraPrefixes) && !(sweepAnyTestRun && HasTestOwnershipLabels(labels)) {
+		return false
+	}
+
+	if sweepMinAge > 0 {
+		created, ok := ResourceCreationTime(obj)
+		if !ok {
+			log.Printf("[INFO][SWEEPER_LOG] Skipping %s: creation time unknown and minimum age %s set", resourceName, sweepMinAge)
+			return false
+		}
+		if age := time.Since(created); age < sweepMinAge {
+			log.Printf("[INFO][SWEEPER_LOG] Skipping %s: created %s ago, minimum age is %s", resourceName, age.Round(time.Second), sweepMinAge)
+			return false
+		}
+	}
+
+	return !SkipForDryRun(resourceName)
+}
+
+// ShouldSweepTyped is ShouldSweep for resources listed through a typed API client,
+// whose labels and creation time are read from the JSON form of the resource.
+func ShouldSweepTyped(resourceName string, resource interface{}, extraPrefixes ...string) bool {
+	obj, err := tpgresource.ConvertToMap(resource)
+	if err != nil {
+		log.Printf("[INFO][SWEEPER_LOG] Skipping %s: %s", resourceName, err)
+		return false
+	}
+	return ShouldSweep(resourceName, obj, extraPrefixes...)
+}
+
+// SkipForDryRun prints the resource that would be deleted and reports true
+// when running with -sweep-dry-run. Sweepers that select resources without
+// IsSweepableTestResource or ShouldSweep call it before every delete.
+func SkipForDryRun(resourceName string) bool {
+	if !sweepDryRun {
+		return false
+	}
+	log.Printf("[INFO][SWEEPER_LOG][DRY_RUN] Would delete %s", resourceName)
+	return true
+}
+
+// ResourceCreationTime returns the creation time of a resource from a list response,
+// and whether one was found.
+func ResourceCreationTime(obj map[string]interface{}) (time.Time, bool) {
+	for _, key := range creationTimeKeys {
+		v, ok := obj[key].(string)
+		if !ok || v == "" {
+			continue
+		}
+		if t, err := time.Parse(time.RFC3339Nano, v); err == nil {
+			return t, true
+		}
+		if ms, err := strconv.ParseInt(v, 10, 64); err == nil {
+			return time.UnixMilli(ms), true
+		}
+	}
+	return time.Time{}, false
 }
 
 // hasAnyPrefix checks if the input string begins with any prefix from the given slice.
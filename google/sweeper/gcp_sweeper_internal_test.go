@@ -0,0 +1,202 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/sweeper/gcp_sweeper_internal_test.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package sweeper
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-google/google/envvar"
+)
+
+func TestShouldSweep(t *testing.T) {
+	old := time.Now().Add(-48 * time.Hour)
+	recent := time.Now().Add(-10 * time.Minute)
+
+	cases := map[string]struct {
+		name   string
+		obj    map[string]interface{}
+		dryRun bool
+		minAge time.Duration
+		want   bool
+	}{
+		"prefixed": {
+			name: "tf-test-foo",
+			obj:  map[string]interface{}{},
+			want: true,
+		},
+		"not prefixed": {
+			name: "prod-foo",
+			obj:  map[string]interface{}{},
+			want: false,
+		},
+		"dry run": {
+			name:   "tf-test-foo",
+			obj:    map[string]interface{}{},
+			dryRun: true,
+			want:   false,
+		},
+		"older than min age": {
+			name:   "tf-test-foo",
+			obj:    map[string]interface{}{"createTime": old.Format(time.RFC3339)},
+			minAge: 24 * time.Hour,
+			want:   true,
+		},
+		"younger than min age": {
+			name:   "tf-test-foo",
+			obj:    map[string]interface{}{"creationTimestamp": recent.Format(time.RFC3339Nano)},
+			minAge: 24 * time.Hour,
+			want:   false,
+		},
+		"millisecond creation time": {
+			name:   "tf_test_foo",
+			obj:    map[string]interface{}{"creationTime": fmt.Sprint(old.UnixMilli())},
+			minAge: 24 * time.Hour,
+			want:   true,
+		},
+		"unknown creation time with min age": {
+			name:   "tf-test-foo",
+			obj:    map[string]interface{}{},
+			minAge: time.Hour,
+			want:   false,
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			sweepDryRun, sweepMinAge = tc.dryRun, tc.minAge
+			defer func() { sweepDryRun, sweepMinAge = false, 0 }()
+
+			if got := ShouldSweep(tc.name, tc.obj); got != tc.want {
+				t.Errorf("ShouldSweep(%q) = %v, want %v", tc.name, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestShouldSweep_extraPrefixes(t *testing.T) {
+	if !ShouldSweep("test-net-foo", map[string]interface{}{}, "test-net-") {
+		t.Error("expected a resource matching an extra prefix to be swept")
+	}
+	if ShouldSweep("prod-net-foo", map[string]interface{}{}, "test-net-") {
+		t.Error("expected a resource matching no prefix not to be swept")
+	}
+}
+
+func TestShouldSweepTyped(t *testing.T) {
+	type resource struct {
+		Name       string `json:"name,omitempty"`
+		CreateTime string `json:"createTime,omitempty"`
+	}
+
+	sweepMinAge = time.Hour
+	defer func() { sweepMinAge = 0 }()
+
+	old := &resource{Name: "tf-test-old", CreateTime: time.Now().Add(-48 * time.Hour).Format(time.RFC3339)}
+	if !ShouldSweepTyped(old.Name, old) {
+		t.Error("expected a typed resource older than the minimum age to be swept")
+	}
+	recent := &resource{Name: "tf-test-new", CreateTime: time.Now().Format(time.RFC3339)}
+	if ShouldSweepTyped(recent.Name, recent) {
+		t.Error("expected a typed resource younger than the minimum age not to be swept")
+	}
+}
+
+func TestIsSweepableTestResource_minAge(t *testing.T) {
+	sweepMinAge = time.Hour
+	defer func() { sweepMinAge = 0 }()
+
+	if IsSweepableTestResource("tf-test-foo") {
+		t.Error("expected resources without a known creation time not to be swept when a minimum age is set")
+	}
+}
+
+func TestShouldSweep_labels(t *testing.T) {
+	oldRun := envvar.TestRunTimeLabelValue(time.Now().Add(-48 * time.Hour))
+	newRun := envvar.TestRunTimeLabelValue(time.Now().Add(-10 * time.Minute))
+	labelled := func(runId, runTime string) map[string]interface{} {
+		return map[string]interface{}{
+			"labels": map[string]interface{}{
+				envvar.TestRunIdLabel:   runId,
+				envvar.TestRunTimeLabel: runTime,
+			},
+		}
+	}
+
+	cases := map[string]struct {
+		name       string
+		obj        map[string]interface{}
+		runId      string
+		runTtl     time.Duration
+		anyTestRun bool
+		want       bool
+	}{
+		"labelled without prefix": {
+			name: "custom-name",
+			obj:  labelled("run-1", oldRun),
+			want: false,
+		},
+		"labelled without prefix sweeping any test run": {
+			name:       "custom-name",
+			obj:        labelled("run-1", oldRun),
+			anyTestRun: true,
+			want:       true,
+		},
+		"matching run id": {
+			name:  "custom-name",
+			obj:   labelled("run-1", newRun),
+			runId: "run-1",
+			want:  true,
+		},
+		"other run id": {
+			name:  "tf-test-foo",
+			obj:   labelled("run-2", newRun),
+			runId: "run-1",
+			want:  false,
+		},
+		"prefixed but unlabelled when selecting by run": {
+			name:  "tf-test-foo",
+			obj:   map[string]interface{}{},
+			runId: "run-1",
+			want:  false,
+		},
+		"run older than ttl": {
+			name:   "custom-name",
+			obj:    labelled("run-1", oldRun),
+			runTtl: 24 * time.Hour,
+			want:   true,
+		},
+		"run newer than ttl": {
+			name:   "tf-test-foo",
+			obj:    labelled("run-1", newRun),
+			runTtl: 24 * time.Hour,
+			want:   false,
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			sweepRunId, sweepRunTtl, sweepAnyTestRun = tc.runId, tc.runTtl, tc.anyTestRun
+			defer func() { sweepRunId, sweepRunTtl, sweepAnyTestRun = "", 0, false }()
+
+			if got := ShouldSweep(tc.name, tc.obj); got != tc.want {
+				t.Errorf("ShouldSweep(%q) = %v, want %v", tc.name, got, tc.want)
+			}
+		})
+	}
+}
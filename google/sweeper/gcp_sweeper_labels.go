@@ -0,0 +1,109 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/sweeper/gcp_sweeper_labels.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package sweeper
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-google/google/envvar"
+)
+
+// Settings from the -sweep-run-id, -sweep-run-ttl and -sweep-any-test-run flags, set
+// by ExecuteSweepers. When -sweep-run-id or -sweep-run-ttl is set, resources are
+// selected by their ownership labels instead of by name prefix. When
+// -sweep-any-test-run is set, resources labelled by any test run are swept along
+// with the prefixed ones, whatever their name.
+var (
+	sweepRunId      string
+	sweepRunTtl     time.Duration
+	sweepAnyTestRun bool
+)
+
+// LabelFilter reports whether a listed resource with the given labels should be acted on
+type LabelFilter func(labels map[string]string) bool
+
+// ResourceLabels returns the labels of a resource from a list response
+func ResourceLabels(obj map[string]interface{}) map[string]string {
+	labels := make(map[string]string)
+	raw, ok := obj["labels"].(map[string]interface{})
+	if !ok {
+		return labels
+	}
+	for k, v := range raw {
+		if s, ok := v.(string); ok {
+			labels[k] = s
+		}
+	}
+	return labels
+}
+
+// HasTestOwnershipLabels reports whether a resource was labelled by a test run
+func HasTestOwnershipLabels(labels map[string]string) bool {
+	_, ok := labels[envvar.TestRunIdLabel]
+	return ok
+}
+
+// TestRunFilter selects resources created by the given test run
+func TestRunFilter(runId string) LabelFilter {
+	return func(labels map[string]string) bool {
+		return labels[envvar.TestRunIdLabel] == runId
+	}
+}
+
+// TestRunOlderThanFilter selects resources created by test runs that started more than ttl ago
+func TestRunOlderThanFilter(ttl time.Duration) LabelFilter {
+	return func(labels map[string]string) bool {
+		started, ok := testRunTime(labels)
+		return ok && time.Since(started) > ttl
+	}
+}
+
+// labelSelection returns the filter built from the -sweep-run-id and -sweep-run-ttl
+// flags, or nil when resources are selected by name.
+func labelSelection() LabelFilter {
+	var filters []LabelFilter
+	if sweepRunId != "" {
+		filters = append(filters, TestRunFilter(sweepRunId))
+	}
+	if sweepRunTtl > 0 {
+		filters = append(filters, TestRunOlderThanFilter(sweepRunTtl))
+	}
+	if len(filters) == 0 {
+		return nil
+	}
+	return func(labels map[string]string) bool {
+		for _, f := range filters {
+			if !f(labels) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+func testRunTime(labels map[string]string) (time.Time, bool) {
+	v, ok := labels[envvar.TestRunTimeLabel]
+	if !ok {
+		return time.Time{}, false
+	}
+	secs, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return time.Unix(secs, 0), true
+}
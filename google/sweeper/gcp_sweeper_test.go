@@ -17,6 +17,7 @@
 package sweeper_test
 
 import (
+	"flag"
 	_ "github.com/hashicorp/terraform-provider-google/google/services/accessapproval"
 	_ "github.com/hashicorp/terraform-provider-google/google/services/accesscontextmanager"
 	_ "github.com/hashicorp/terraform-provider-google/google/services/activedirectory"
@@ -201,6 +202,16 @@ import (
 	"github.com/hashicorp/terraform-provider-google/google/sweeper"
 )
 
+// Flags for our own sweeper options, read by name in sweeper.ExecuteSweepers
+var (
+	_ = flag.Bool("sweep-dry-run", false, "log the resources sweepers would delete without deleting them")
+	_ = flag.Duration("sweep-min-age", 0, "only sweep resources created at least this long ago, e.g. 3h")
+	_ = flag.Int("sweep-parallelism", 1, "number of sweepers to run at once; sweepers still wait for their dependencies")
+	_ = flag.String("sweep-run-id", "", "only sweep resources labelled with this acceptance test run id")
+	_ = flag.Duration("sweep-run-ttl", 0, "only sweep resources labelled by acceptance test runs that started at least this long ago")
+	_ = flag.Bool("sweep-any-test-run", false, "also sweep resources labelled by any acceptance test run, whatever their name")
+)
+
 func TestAccExecuteSweepers(t *testing.T) {
 	sweeper.ExecuteSweepers(t)
 }
@@ -21,7 +21,9 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
 	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
@@ -52,6 +54,12 @@ var (
 	flagSweep              *string
 	flagSweepAllowFailures *bool
 	flagSweepRun           *string
+	flagSweepDryRun        *bool
+	flagSweepMinAge        *time.Duration
+	flagSweepParallelism   *int
+	flagSweepRunId         *string
+	flagSweepRunTtl        *time.Duration
+	flagSweepAnyTestRun    *bool
 	sweeperInventory       map[string]*Sweeper
 )
 
@@ -89,6 +97,57 @@ func registerFlags() {
 		flagSweepAllowFailures = &fsafDefault
 		flagSweepRun = &fsrDefault
 	}
+
+	// These flags are our own and are declared by the test binary running the sweepers,
+	// so that they are not added to every binary that links in a sweeper.
+	fsdrDefault := false
+	fsmaDefault := time.Duration(0)
+	fspDefault := 1
+	flagSweepDryRun = &fsdrDefault
+	flagSweepMinAge = &fsmaDefault
+	flagSweepParallelism = &fspDefault
+	fsriDefault := ""
+	fsrtDefault := time.Duration(0)
+	flagSweepRunId = &fsriDefault
+	flagSweepRunTtl = &fsrtDefault
+	fsatrDefault := false
+	flagSweepAnyTestRun = &fsatrDefault
+	if f := flag.Lookup("sweep-dry-run"); f != nil {
+		if getter, ok := f.Value.(flag.Getter); ok {
+			vb := getter.Get().(bool)
+			flagSweepDryRun = &vb
+		}
+	}
+	if f := flag.Lookup("sweep-min-age"); f != nil {
+		if getter, ok := f.Value.(flag.Getter); ok {
+			vd := getter.Get().(time.Duration)
+			flagSweepMinAge = &vd
+		}
+	}
+	if f := flag.Lookup("sweep-parallelism"); f != nil {
+		if getter, ok := f.Value.(flag.Getter); ok {
+			vi := getter.Get().(int)
+			flagSweepParallelism = &vi
+		}
+	}
+	if f := flag.Lookup("sweep-run-id"); f != nil {
+		if getter, ok := f.Value.(flag.Getter); ok {
+			vs := getter.Get().(string)
+			flagSweepRunId = &vs
+		}
+	}
+	if f := flag.Lookup("sweep-run-ttl"); f != nil {
+		if getter, ok := f.Value.(flag.Getter); ok {
+			vd := getter.Get().(time.Duration)
+			flagSweepRunTtl = &vd
+		}
+	}
+	if f := flag.Lookup("sweep-any-test-run"); f != nil {
+		if getter, ok := f.Value.(flag.Getter); ok {
+			vb := getter.Get().(bool)
+			flagSweepAnyTestRun = &vb
+		}
+	}
 }
 
 // AddTestSweepers function adds a sweeper configuration to the inventory
@@ -129,7 +188,16 @@ func ExecuteSweepers(t *testing.T) {
 		// get filtered list of sweepers to run based on sweep-run flag
 		sweepers := filterSweepers(*flagSweepRun, sweeperInventory)
 
-		if err := runSweepers(t, regions, sweepers, *flagSweepAllowFailures); err != nil {
+		sweepDryRun = *flagSweepDryRun
+		sweepMinAge = *flagSweepMinAge
+		sweepRunId = *flagSweepRunId
+		sweepRunTtl = *flagSweepRunTtl
+		sweepAnyTestRun = *flagSweepAnyTestRun
+		if sweepDryRun {
+			log.Printf("[INFO][SWEEPER_LOG] Dry run: resources that would be deleted are logged and left in place")
+		}
+
+		if err := runSweepers(t, regions, sweepers, *flagSweepAllowFailures, *flagSweepParallelism); err != nil {
 			t.Errorf("error running sweepers: %v", err)
 		}
 	} else {
@@ -137,7 +205,7 @@ func ExecuteSweepers(t *testing.T) {
 	}
 }
 
-func runSweepers(t *testing.T, regions []string, sweepers map[string]*Sweeper, allowFailures bool) error {
+func runSweepers(t *testing.T, regions []string, sweepers map[string]*Sweeper, allowFailures bool, parallelism int) error {
 	// First validate that parent sweepers have ListAndAction
 	if err := validateParentSweepers(sweepers); err != nil {
 		return fmt.Errorf("parent validation failed: %v", err)
@@ -149,28 +217,75 @@ func runSweepers(t *testing.T, regions []string, sweepers map[string]*Sweeper, a
 		return fmt.Errorf("failed to sort sweepers: %v", err)
 	}
 
+	if parallelism > 1 {
+		runSweepersInParallel(t, regions, sorted, allowFailures, parallelism)
+		return nil
+	}
+
 	// Run each sweeper in dependency order
 	for _, sweeper := range sorted {
 		sweeper := sweeper // capture for closure
 		t.Run(sweeper.Name, func(t *testing.T) {
-			for _, region := range regions {
-				region := strings.TrimSpace(region)
-				err := sweeper.DeleteFunction(region)
-
-				if err != nil {
-					if allowFailures {
-						t.Errorf("failed in region %s: %s", region, err)
-					} else {
-						t.Fatalf("failed in region %s: %s", region, err)
-					}
-				}
-			}
+			runSweeper(t, sweeper, regions, allowFailures)
 		})
 	}
 
 	return nil
 }
 
+// runSweepersInParallel runs up to parallelism sweepers at once. Each sweeper starts
+// once every sweeper it depends on has finished, so the sweepers must be ordered such
+// that dependencies come first, as returned by topologicalSort.
+func runSweepersInParallel(t *testing.T, regions []string, sorted []*Sweeper, allowFailures bool, parallelism int) {
+	done := make(map[string]chan struct{}, len(sorted))
+	for _, sweeper := range sorted {
+		done[sweeper.Name] = make(chan struct{})
+	}
+
+	sem := make(chan struct{}, parallelism)
+	var wg sync.WaitGroup
+	for _, sweeper := range sorted {
+		sweeper := sweeper // capture for closure
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer close(done[sweeper.Name])
+
+			// Wait for dependencies before taking a slot, so waiting sweepers
+			// can't starve the ones they are waiting on.
+			for _, dep := range sweeper.Dependencies {
+				if ch, ok := done[dep]; ok {
+					<-ch
+				}
+			}
+
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			// t.Run blocks until the subtest finishes and may be called from multiple goroutines.
+			t.Run(sweeper.Name, func(t *testing.T) {
+				runSweeper(t, sweeper, regions, allowFailures)
+			})
+		}()
+	}
+	wg.Wait()
+}
+
+func runSweeper(t *testing.T, sweeper *Sweeper, regions []string, allowFailures bool) {
+	for _, region := range regions {
+		region := strings.TrimSpace(region)
+		err := sweeper.DeleteFunction(region)
+
+		if err != nil {
+			if allowFailures {
+				t.Errorf("failed in region %s: %s", region, err)
+			} else {
+				t.Fatalf("failed in region %s: %s", region, err)
+			}
+		}
+	}
+}
+
 // filterSweepers takes a comma separated string listing the sweepers to run
 func filterSweepers(f string, source map[string]*Sweeper) map[string]*Sweeper {
 	filterSlice := strings.Split(strings.ToLower(f), ",")
@@ -17,9 +17,12 @@
 package sweeper
 
 import (
+	"fmt"
 	"reflect"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 )
 
 // TestUnifyRelationships verifies that parent relationships are correctly
@@ -358,3 +361,53 @@ func TestFilterSweepers(t *testing.T) {
 		t.Error("Filtering for resource_b should not include unrelated resource_c")
 	}
 }
+
+// TestRunSweepersInParallel verifies that sweepers run in parallel never start
+// before the sweepers they depend on have finished
+func TestRunSweepersInParallel(t *testing.T) {
+	var mu sync.Mutex
+	finished := make(map[string]bool)
+	var violations []string
+
+	newSweeper := func(name string, deps ...string) *Sweeper {
+		return &Sweeper{
+			Name:         name,
+			Dependencies: deps,
+			DeleteFunction: func(region string) error {
+				mu.Lock()
+				for _, dep := range deps {
+					if !finished[dep] {
+						violations = append(violations, fmt.Sprintf("%s started before %s finished", name, dep))
+					}
+				}
+				mu.Unlock()
+
+				time.Sleep(10 * time.Millisecond)
+
+				mu.Lock()
+				finished[name] = true
+				mu.Unlock()
+				return nil
+			},
+		}
+	}
+
+	sweepers := map[string]*Sweeper{
+		"A": newSweeper("A"),
+		"B": newSweeper("B", "A"),
+		"C": newSweeper("C", "A"),
+		"D": newSweeper("D", "B", "C"),
+		"E": newSweeper("E"),
+	}
+
+	if err := runSweepers(t, []string{"us-central1"}, sweepers, true, 3); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(finished) != len(sweepers) {
+		t.Errorf("expected %d sweepers to run, got %d", len(sweepers), len(finished))
+	}
+	for _, v := range violations {
+		t.Error(v)
+	}
+}
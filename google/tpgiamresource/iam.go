@@ -205,6 +205,42 @@ func iamPolicyReadModifyWrite(updater ResourceIamUpdater, modify iamPolicyModify
 	return nil
 }
 
+// AddIamMember grants role to member in the IAM policy of the resource of
+// updater, for resources that manage a grant as part of their own lifecycle.
+// It reports whether the grant was added, as opposed to already present, so
+// that callers only revoke grants they added.
+func AddIamMember(updater ResourceIamUpdater, role, member string) (bool, error) {
+	added := false
+	err := iamPolicyReadModifyWrite(updater, func(ep *cloudresourcemanager.Policy) error {
+		_, present := createIamBindingsMap(ep.Bindings)[iamBindingKey{Role: role}][tpgresource.NormalizeIamPrincipalCasing(member)]
+		added = !present
+		if present {
+			return nil
+		}
+		ep.Bindings = MergeBindings(append(ep.Bindings, &cloudresourcemanager.Binding{
+			Role:    role,
+			Members: []string{member},
+		}))
+		ep.Version = IamPolicyVersion
+		return nil
+	})
+	return added, err
+}
+
+// RemoveIamMember revokes role from member in the IAM policy of the resource
+// of updater. It is the counterpart of AddIamMember, and should only be called
+// for grants that AddIamMember reported as added, as the member may otherwise
+// hold the role for other reasons.
+func RemoveIamMember(updater ResourceIamUpdater, role, member string) error {
+	return iamPolicyReadModifyWrite(updater, func(ep *cloudresourcemanager.Policy) error {
+		ep.Bindings = subtractFromBindings(ep.Bindings, &cloudresourcemanager.Binding{
+			Role:    role,
+			Members: []string{member},
+		})
+		return nil
+	})
+}
+
 // Flattens a list of Bindings so each role+condition has a single Binding with combined members
 func MergeBindings(bindings []*cloudresourcemanager.Binding) []*cloudresourcemanager.Binding {
 	bm := createIamBindingsMap(bindings)
@@ -23,6 +23,10 @@
 // When IamMemberListCallConfig.ListUrlFunc is set, List() uses transport.ListCall to
 // discover multiple targets (e.g. all disks in a zone), then reads IAM for each.
 // Otherwise a single target is built from the list block.
+//
+// When IamMemberListCallConfig.AssetType is set, the list block may instead set scope
+// to list the policies of every target under an organization, folder or project as
+// returned by Cloud Asset (see resource_iam_member_list_asset_search.go).
 
 package tpgiamresource
 
@@ -65,6 +69,14 @@ type IamMemberListCallConfig struct {
 	ParentResourceField string
 	EnableRoleFilter    bool
 	EnableMemberFilter  bool
+
+	// AssetType and AssetNameFormat enable the optional scope field, which lists
+	// members for every policy target under a hierarchy node. AssetType is the
+	// Cloud Asset type of the parent resource (e.g. "storage.googleapis.com/Bucket")
+	// and AssetNameFormat maps its asset name onto list config fields
+	// (e.g. "//storage.googleapis.com/{{bucket}}").
+	AssetType       string
+	AssetNameFormat string
 }
 
 // IamMemberListResource lists IAM member rows by reading IAM policies on one or more policy targets.
@@ -89,6 +101,8 @@ func NewIamMemberListResource(typeName string, memberResource *schema.Resource,
 		{
 			Name: listCallConfig.ParentResourceField,
 			Kind: tpgresource.ListConfigKindString,
+			// With hierarchy-wide listing, either the parent or scope selects the targets.
+			Optional: listCallConfig.AssetType != "",
 		},
 	}
 
@@ -129,6 +143,15 @@ func NewIamMemberListResource(typeName string, memberResource *schema.Resource,
 		}
 	}
 
+	if listCallConfig.AssetType != "" {
+		validateAssetSearchConfig(listCallConfig, iamResourceSchema)
+		listConfigFields = append(listConfigFields, tpgresource.ListConfigField{
+			Name:     assetSearchScopeField,
+			Kind:     tpgresource.ListConfigKindString,
+			Optional: true,
+		})
+	}
+
 	return &IamMemberListResource{
 		ListResourceMetadata: tpgresource.ListResourceMetadata{
 			TypeName:         typeName,
@@ -173,8 +196,25 @@ func (r *IamMemberListResource) RawV5Schemas(ctx context.Context, _ list.RawV5Sc
 	}
 }
 
-// discoverPolicyTargets returns one ResourceData per GCP resource whose IAM policy should be read.
-func (r *IamMemberListResource) discoverPolicyTargets(ctx context.Context, req list.ListRequest) ([]*schema.ResourceData, error) {
+// iamPolicyTarget is one GCP resource whose IAM members are listed. policy is set
+// when discovery already returned the target's policy, so it isn't read again.
+type iamPolicyTarget struct {
+	rd     *schema.ResourceData
+	policy *cloudresourcemanager.Policy
+}
+
+// discoverPolicyTargets returns one target per GCP resource whose IAM policy should be listed.
+func (r *IamMemberListResource) discoverPolicyTargets(ctx context.Context, req list.ListRequest) ([]iamPolicyTarget, error) {
+	if r.listCallConfig.AssetType != "" {
+		scope, err := r.readScope(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		if scope != "" {
+			return r.searchPolicyTargets(scope)
+		}
+	}
+
 	baseRd := r.memberResource.TestResourceData()
 
 	// Set every target-identifying field (parent + scope dimensions like project/region/
@@ -196,14 +236,14 @@ func (r *IamMemberListResource) discoverPolicyTargets(ctx context.Context, req l
 	}
 
 	if r.listCallConfig.ListPagesOptions.Callback == nil {
-		return []*schema.ResourceData{baseRd}, nil
+		return []iamPolicyTarget{{rd: baseRd}}, nil
 	}
 
 	if r.Client == nil {
 		return nil, fmt.Errorf("provider client nil")
 	}
 
-	var targets []*schema.ResourceData
+	var targets []iamPolicyTarget
 
 	listOpts := r.listCallConfig.ListPagesOptions
 	listOpts.Config = r.Client
@@ -217,7 +257,7 @@ func (r *IamMemberListResource) discoverPolicyTargets(ctx context.Context, req l
 
 	listOpts.Callback = func(rd *schema.ResourceData) error {
 		targetRd := r.memberResource.TestResourceData()
-		targets = append(targets, targetRd)
+		targets = append(targets, iamPolicyTarget{rd: targetRd})
 		return nil
 	}
 
@@ -244,10 +284,11 @@ func (r *IamMemberListResource) List(ctx context.Context, req list.ListRequest,
 
 	stream.Results = func(yield func(list.ListResult) bool) {
 		var yielded int64
-		for _, targetRd := range policyTargets {
+		for _, target := range policyTargets {
 			if req.Limit > 0 && yielded >= req.Limit {
 				return
 			}
+			targetRd := target.rd
 			updater, err := r.newUpdater(targetRd, r.Client)
 			if err != nil {
 				res := req.NewListResult(ctx)
@@ -257,14 +298,17 @@ func (r *IamMemberListResource) List(ctx context.Context, req list.ListRequest,
 				}
 				continue
 			}
-			p, err := iamPolicyReadWithRetry(updater)
-			if err != nil {
-				res := req.NewListResult(ctx)
-				res.Diagnostics.AddError("API Error", err.Error())
-				if !yield(res) {
-					return
+			p := target.policy
+			if p == nil {
+				p, err = iamPolicyReadWithRetry(updater)
+				if err != nil {
+					res := req.NewListResult(ctx)
+					res.Diagnostics.AddError("API Error", err.Error())
+					if !yield(res) {
+						return
+					}
+					continue
 				}
-				continue
 			}
 
 			if !r.yieldPolicyMembers(ctx, req, targetRd, updater, p, roleFilter, memberFilter, &yielded, yield) {
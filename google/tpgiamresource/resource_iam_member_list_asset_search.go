@@ -0,0 +1,232 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This code is generated by Magic Modules using the following:
+//
+//     Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/tpgiamresource/resource_iam_member_list_asset_search.go
+//
+//     DO NOT EDIT this file directly. Any changes made to this file will be
+//     overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Hierarchy-wide IAM member listing. When a list block sets scope (an
+// organization, folder or project) instead of the parent field, policy targets
+// are discovered with Cloud Asset searchAllIamPolicies, which returns every
+// resource of the member resource's asset type under that node that has an IAM
+// policy. The policy in each search result is yielded like a single parent's, so
+// every binding becomes an importable google_*_iam_member identity without a
+// getIamPolicy call per target.
+
+package tpgiamresource
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"google.golang.org/api/cloudresourcemanager/v1"
+
+	"github.com/hashicorp/terraform-provider-google/google/cai"
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+)
+
+// assetSearchScopeField is the list config attribute that selects hierarchy-wide
+// listing, e.g. "organizations/123456" or "folders/123456".
+const assetSearchScopeField = "scope"
+
+var assetNameIdentifierRegexp = regexp.MustCompile(`\{\{%?(\w+)\}\}`)
+
+// assetNameRegexp compiles a CAI asset name format such as
+// "//bigquery.googleapis.com/projects/{{project}}/datasets/{{dataset_id}}" into a
+// regexp with one named group per identifier. Every identifier matches a single
+// path segment except the last, which may span several so that formats like
+// "//iam.googleapis.com/{{service_account_id}}" capture the full relative name.
+func assetNameRegexp(format string) (*regexp.Regexp, error) {
+	matches := assetNameIdentifierRegexp.FindAllStringSubmatchIndex(format, -1)
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("asset name format %q has no identifiers", format)
+	}
+
+	var sb strings.Builder
+	sb.WriteString("^")
+	last := 0
+	for i, m := range matches {
+		sb.WriteString(regexp.QuoteMeta(format[last:m[0]]))
+		name := format[m[2]:m[3]]
+		if i == len(matches)-1 {
+			sb.WriteString(fmt.Sprintf("(?P<%s>.+)", name))
+		} else {
+			sb.WriteString(fmt.Sprintf("(?P<%s>[^/]+)", name))
+		}
+		last = m[1]
+	}
+	sb.WriteString(regexp.QuoteMeta(format[last:]))
+	sb.WriteString("$")
+
+	return regexp.Compile(sb.String())
+}
+
+// parseAssetName extracts the identifier values from a CAI asset name, keyed by
+// the identifier names of the format re was compiled from.
+func parseAssetName(re *regexp.Regexp, name string) (map[string]string, bool) {
+	m := re.FindStringSubmatch(name)
+	if m == nil {
+		return nil, false
+	}
+	values := make(map[string]string, len(m)-1)
+	for i, k := range re.SubexpNames() {
+		if k != "" {
+			values[k] = m[i]
+		}
+	}
+	return values, true
+}
+
+// validateAssetSearchConfig panics when a list config's asset name format cannot
+// be mapped onto the member resource's target-identifying fields.
+func validateAssetSearchConfig(listCallConfig IamMemberListCallConfig, iamResourceSchema map[string]*schema.Schema) {
+	if _, err := assetNameRegexp(listCallConfig.AssetNameFormat); err != nil {
+		panic(fmt.Sprintf("tpgiamresource: invalid AssetNameFormat for %s: %s", listCallConfig.AssetType, err))
+	}
+	for _, id := range cai.ExtractIdentifiers(listCallConfig.AssetNameFormat) {
+		if _, ok := iamResourceSchema[id]; !ok {
+			panic(fmt.Sprintf("tpgiamresource: AssetNameFormat identifier %q is not a list config field", id))
+		}
+	}
+}
+
+// readScope returns the scope set on the list block, or "" when the parent field
+// selects a single target instead. The two are mutually exclusive, and one of them
+// is required unless the parent can fall back to a provider default (e.g. project).
+func (r *IamMemberListResource) readScope(ctx context.Context, req list.ListRequest) (string, error) {
+	parentField := r.listCallConfig.ParentResourceField
+
+	var scope, parent types.String
+	if d := req.Config.GetAttribute(ctx, path.Root(assetSearchScopeField), &scope); d.HasError() {
+		return "", fmt.Errorf("%s", d.Errors()[0].Detail())
+	}
+	if d := req.Config.GetAttribute(ctx, path.Root(parentField), &parent); d.HasError() {
+		return "", fmt.Errorf("%s", d.Errors()[0].Detail())
+	}
+
+	hasScope := scope.ValueString() != ""
+	hasParent := parent.ValueString() != ""
+	switch {
+	case hasScope && hasParent:
+		return "", fmt.Errorf("only one of %q or %q may be set", parentField, assetSearchScopeField)
+	case !hasScope && !hasParent && !isScopeDimension(parentField):
+		return "", fmt.Errorf("one of %q or %q must be set", parentField, assetSearchScopeField)
+	}
+	return scope.ValueString(), nil
+}
+
+func isScopeDimension(name string) bool {
+	for _, sf := range supportedScopeFields {
+		if sf.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// searchPolicyTargets returns one target per resource of the configured asset
+// type under scope that has an IAM policy, holding the policy from the search
+// results.
+func (r *IamMemberListResource) searchPolicyTargets(scope string) ([]iamPolicyTarget, error) {
+	if r.Client == nil {
+		return nil, fmt.Errorf("provider client nil")
+	}
+
+	re, err := assetNameRegexp(r.listCallConfig.AssetNameFormat)
+	if err != nil {
+		return nil, err
+	}
+
+	url := fmt.Sprintf("https://cloudasset.googleapis.com/v1/%s:searchAllIamPolicies", scope)
+	url, err = transport_tpg.AddArrayQueryParams(url, "assetTypes", []interface{}{r.listCallConfig.AssetType})
+	if err != nil {
+		return nil, fmt.Errorf("Error setting assetTypes: %s", err)
+	}
+
+	// searchAllIamPolicies is billed to a quota project, which is only sent
+	// when the provider is configured to override it.
+	var billingProject string
+	if r.Client.UserProjectOverride && r.Client.BillingProject != "" {
+		billingProject = r.Client.BillingProject
+	}
+
+	var targets []iamPolicyTarget
+	var assetName string
+	var policy *cloudresourcemanager.Policy
+	byName := make(map[string]*cloudresourcemanager.Policy)
+
+	listOpts := transport_tpg.ListPagesOptions{
+		Config:         r.Client,
+		TempData:       r.memberResource.TestResourceData(),
+		Resource:       r.memberResource,
+		ListURL:        url,
+		BillingProject: billingProject,
+		UserAgent:      r.Client.UserAgent,
+		ItemName:       "results",
+		Flattener: func(item map[string]interface{}, d *schema.ResourceData, _ *transport_tpg.Config) error {
+			assetName, _ = item["resource"].(string)
+			values, ok := parseAssetName(re, assetName)
+			if !ok {
+				return fmt.Errorf("unexpected %s asset name %q", r.listCallConfig.AssetType, assetName)
+			}
+			for k, v := range values {
+				if err := d.Set(k, v); err != nil {
+					return fmt.Errorf("setting %s: %w", k, err)
+				}
+			}
+			policy, err = flattenAssetSearchPolicy(item["policy"])
+			if err != nil {
+				return fmt.Errorf("reading policy of %s: %w", assetName, err)
+			}
+			return nil
+		},
+		Callback: func(rd *schema.ResourceData) error {
+			// A resource's policy is returned once, but merge the bindings of
+			// any repeated result so none of them are dropped.
+			if p, ok := byName[assetName]; ok {
+				p.Bindings = append(p.Bindings, policy.Bindings...)
+				return nil
+			}
+			byName[assetName] = policy
+			targets = append(targets, iamPolicyTarget{rd: rd, policy: policy})
+			return nil
+		},
+	}
+
+	if err := transport_tpg.ListPages(listOpts); err != nil {
+		return nil, fmt.Errorf("searching IAM policies under %s: %w", scope, err)
+	}
+	return targets, nil
+}
+
+// flattenAssetSearchPolicy converts the policy of a searchAllIamPolicies result,
+// which has the same shape as a getIamPolicy response.
+func flattenAssetSearchPolicy(v interface{}) (*cloudresourcemanager.Policy, error) {
+	policy := &cloudresourcemanager.Policy{}
+	if v == nil {
+		return policy, nil
+	}
+	if err := tpgresource.Convert(v, policy); err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
@@ -0,0 +1,148 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This code is generated by Magic Modules using the following:
+//
+//     Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/tpgiamresource/resource_iam_member_list_asset_search_test.go
+//
+//     DO NOT EDIT this file directly. Any changes made to this file will be
+//     overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tpgiamresource
+
+import (
+	"reflect"
+	"testing"
+
+	"google.golang.org/api/cloudresourcemanager/v1"
+)
+
+func TestParseAssetName(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		format string
+		name   string
+		want   map[string]string
+	}{
+		"project": {
+			format: "//cloudresourcemanager.googleapis.com/projects/{{project}}",
+			name:   "//cloudresourcemanager.googleapis.com/projects/123456",
+			want:   map[string]string{"project": "123456"},
+		},
+		"folder keeps its collection": {
+			format: "//cloudresourcemanager.googleapis.com/{{folder}}",
+			name:   "//cloudresourcemanager.googleapis.com/folders/123456",
+			want:   map[string]string{"folder": "folders/123456"},
+		},
+		"service account relative name": {
+			format: "//iam.googleapis.com/{{service_account_id}}",
+			name:   "//iam.googleapis.com/projects/my-project/serviceAccounts/1234567890",
+			want:   map[string]string{"service_account_id": "projects/my-project/serviceAccounts/1234567890"},
+		},
+		"dataset": {
+			format: "//bigquery.googleapis.com/projects/{{project}}/datasets/{{dataset_id}}",
+			name:   "//bigquery.googleapis.com/projects/my-project/datasets/my_dataset",
+			want:   map[string]string{"project": "my-project", "dataset_id": "my_dataset"},
+		},
+		"bucket": {
+			format: "//storage.googleapis.com/{{bucket}}",
+			name:   "//storage.googleapis.com/my-bucket",
+			want:   map[string]string{"bucket": "my-bucket"},
+		},
+		"other service": {
+			format: "//storage.googleapis.com/{{bucket}}",
+			name:   "//bigquery.googleapis.com/projects/my-project/datasets/my_dataset",
+		},
+		"other collection": {
+			format: "//bigquery.googleapis.com/projects/{{project}}/datasets/{{dataset_id}}",
+			name:   "//bigquery.googleapis.com/projects/my-project/tables/my_table",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			re, err := assetNameRegexp(tc.format)
+			if err != nil {
+				t.Fatalf("assetNameRegexp(%q): %s", tc.format, err)
+			}
+			got, ok := parseAssetName(re, tc.name)
+			if ok != (tc.want != nil) {
+				t.Fatalf("parseAssetName(%q) matched = %t, want %t", tc.name, ok, tc.want != nil)
+			}
+			if ok && !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("parseAssetName(%q) = %v, want %v", tc.name, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestAssetNameRegexp_noIdentifiers(t *testing.T) {
+	t.Parallel()
+
+	if _, err := assetNameRegexp("//storage.googleapis.com/buckets"); err == nil {
+		t.Fatal("expected an error for a format without identifiers")
+	}
+}
+
+func TestFlattenAssetSearchPolicy(t *testing.T) {
+	t.Parallel()
+
+	got, err := flattenAssetSearchPolicy(map[string]interface{}{
+		"bindings": []interface{}{
+			map[string]interface{}{
+				"role":    "roles/storage.objectViewer",
+				"members": []interface{}{"user:alice@example.com", "group:admins@example.com"},
+			},
+			map[string]interface{}{
+				"role":    "roles/storage.admin",
+				"members": []interface{}{"user:bob@example.com"},
+				"condition": map[string]interface{}{
+					"title":      "expires",
+					"expression": `request.time < timestamp("2030-01-01T00:00:00Z")`,
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("flattenAssetSearchPolicy: %s", err)
+	}
+	want := &cloudresourcemanager.Policy{
+		Bindings: []*cloudresourcemanager.Binding{
+			{
+				Role:    "roles/storage.objectViewer",
+				Members: []string{"user:alice@example.com", "group:admins@example.com"},
+			},
+			{
+				Role:    "roles/storage.admin",
+				Members: []string{"user:bob@example.com"},
+				Condition: &cloudresourcemanager.Expr{
+					Title:      "expires",
+					Expression: `request.time < timestamp("2030-01-01T00:00:00Z")`,
+				},
+			},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("flattenAssetSearchPolicy() = %+v, want %+v", got, want)
+	}
+
+	empty, err := flattenAssetSearchPolicy(nil)
+	if err != nil {
+		t.Fatalf("flattenAssetSearchPolicy(nil): %s", err)
+	}
+	if len(empty.Bindings) != 0 {
+		t.Errorf("flattenAssetSearchPolicy(nil) has %d bindings, want none", len(empty.Bindings))
+	}
+}
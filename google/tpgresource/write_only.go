@@ -0,0 +1,153 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/tpgresource/write_only.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package tpgresource
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Write-only arguments are named after the sensitive argument they replace, and are updated
+// when the version argument next to them changes, as write-only values aren't kept in state.
+// See https://developer.hashicorp.com/terraform/plugin/sdkv2/resources/write-only-arguments
+const (
+	WriteOnlySuffix        = "_wo"
+	WriteOnlyVersionSuffix = "_wo_version"
+)
+
+// WriteOnlyPair returns the paths of a sensitive argument and of its write-only variant, for use as
+// the ExactlyOneOf or ConflictsWith of both arguments. path is the full path of the sensitive
+// argument, such as "mysql_profile.0.password".
+func WriteOnlyPair(path string) []string {
+	return []string{path, path + WriteOnlySuffix}
+}
+
+// WriteOnlySchema returns the schema of the write-only variant of the sensitive argument at path.
+// When required is true, exactly one of the two arguments must be set, otherwise they conflict.
+func WriteOnlySchema(path, description string, required bool) *schema.Schema {
+	s := &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		WriteOnly:    true,
+		Description:  description,
+		RequiredWith: []string{path + WriteOnlyVersionSuffix},
+	}
+	if required {
+		s.ExactlyOneOf = WriteOnlyPair(path)
+	} else {
+		s.ConflictsWith = []string{path}
+	}
+	return s
+}
+
+// WriteOnlyVersionSchema returns the schema of the version argument that triggers an update of
+// the write-only variant of the sensitive argument at path. forceNew is set when the API can't
+// update the value in place.
+func WriteOnlyVersionSchema(path string, forceNew bool) *schema.Schema {
+	name := path[strings.LastIndex(path, ".")+1:]
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     forceNew,
+		Description:  fmt.Sprintf("Triggers update of '%s%s' write-only. Increment this value when an update to '%s%s' is needed. For more info see [updating write-only arguments](/docs/providers/google/guides/using_write_only_arguments.html#updating-write-only-arguments)", name, WriteOnlySuffix, name, WriteOnlySuffix),
+		RequiredWith: []string{path + WriteOnlySuffix},
+	}
+}
+
+// GetWriteOnlyValue returns the value of the write-only variant of the sensitive argument at
+// path when it is set in the configuration, and the value of the sensitive argument otherwise.
+func GetWriteOnlyValue(d TerraformResourceData, path string, v interface{}) interface{} {
+	rd, ok := d.(*schema.ResourceData)
+	if !ok {
+		return v
+	}
+	if wo := GetRawConfigAttributeAsString(rd, path+WriteOnlySuffix); wo != "" {
+		return wo
+	}
+	return v
+}
+
+// WriteOnlyHasChange reports whether the sensitive argument at path, or the version of its
+// write-only variant, changed.
+func WriteOnlyHasChange(d TerraformResourceData, path string) bool {
+	return d.HasChange(path) || d.HasChange(path+WriteOnlyVersionSuffix)
+}
+
+// ValidateWriteOnlyArguments checks that each write-only argument of a resource schema has a
+// version argument, and that it can't be set together with the sensitive argument it replaces.
+func ValidateWriteOnlyArguments(s map[string]*schema.Schema) error {
+	return validateWriteOnlyArguments(s, "")
+}
+
+func validateWriteOnlyArguments(s map[string]*schema.Schema, prefix string) error {
+	var errs []error
+	keys := make([]string, 0, len(s))
+	for k := range s {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		v := s[k]
+		if r, ok := v.Elem.(*schema.Resource); ok {
+			elemPrefix := prefix + k + ".0."
+			if v.MaxItems != 1 {
+				elemPrefix = prefix + k + ".*."
+			}
+			if err := validateWriteOnlyArguments(r.Schema, elemPrefix); err != nil {
+				errs = append(errs, err)
+			}
+		}
+
+		if !v.WriteOnly {
+			continue
+		}
+		path := prefix + k
+		if !strings.HasSuffix(k, WriteOnlySuffix) {
+			errs = append(errs, fmt.Errorf("%s: write-only arguments must end in %q", path, WriteOnlySuffix))
+			continue
+		}
+		if v.Sensitive {
+			errs = append(errs, fmt.Errorf("%s: write-only arguments are never stored and must not be sensitive", path))
+		}
+		if _, ok := s[k+"_version"]; !ok {
+			errs = append(errs, fmt.Errorf("%s: write-only arguments need a %s argument to trigger updates", path, k+"_version"))
+		}
+
+		base := strings.TrimSuffix(k, WriteOnlySuffix)
+		sensitive, ok := s[base]
+		if !ok {
+			continue
+		}
+		if !sensitive.Sensitive {
+			errs = append(errs, fmt.Errorf("%s: %s must be sensitive", path, prefix+base))
+		}
+		if strings.Contains(prefix, ".*.") {
+			// Arguments in list elements can't reference each other
+			continue
+		}
+		if !slices.Contains(append(v.ConflictsWith, v.ExactlyOneOf...), prefix+base) {
+			errs = append(errs, fmt.Errorf("%s: must conflict with %s", path, prefix+base))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,172 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/tpgresource/write_only_test.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package tpgresource_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+)
+
+func TestValidateWriteOnlyArguments(t *testing.T) {
+	t.Parallel()
+
+	sensitive := func(conflicts ...string) *schema.Schema {
+		return &schema.Schema{Type: schema.TypeString, Optional: true, Sensitive: true, ConflictsWith: conflicts}
+	}
+
+	cases := map[string]struct {
+		Schema map[string]*schema.Schema
+		Errors []string
+	}{
+		"top-level pair": {
+			Schema: map[string]*schema.Schema{
+				"password":            sensitive("password_wo"),
+				"password_wo":         tpgresource.WriteOnlySchema("password", "", false),
+				"password_wo_version": tpgresource.WriteOnlyVersionSchema("password", false),
+			},
+		},
+		"required pair in a nested block": {
+			Schema: map[string]*schema.Schema{
+				"profile": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"password":            {Type: schema.TypeString, Optional: true, Sensitive: true, ExactlyOneOf: tpgresource.WriteOnlyPair("profile.0.password")},
+							"password_wo":         tpgresource.WriteOnlySchema("profile.0.password", "", true),
+							"password_wo_version": tpgresource.WriteOnlyVersionSchema("profile.0.password", true),
+						},
+					},
+				},
+			},
+		},
+		"pair in a list element": {
+			Schema: map[string]*schema.Schema{
+				"users": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"password":            sensitive(),
+							"password_wo":         {Type: schema.TypeString, Optional: true, WriteOnly: true},
+							"password_wo_version": {Type: schema.TypeString, Optional: true},
+						},
+					},
+				},
+			},
+		},
+		"write-only argument without a version": {
+			Schema: map[string]*schema.Schema{
+				"secret_wo": {Type: schema.TypeString, Optional: true, WriteOnly: true},
+			},
+			Errors: []string{"secret_wo: write-only arguments need a secret_wo_version argument"},
+		},
+		"badly named write-only argument": {
+			Schema: map[string]*schema.Schema{
+				"secret": {Type: schema.TypeString, Optional: true, WriteOnly: true},
+			},
+			Errors: []string{`secret: write-only arguments must end in "_wo"`},
+		},
+		"sensitive write-only argument": {
+			Schema: map[string]*schema.Schema{
+				"secret_wo":         {Type: schema.TypeString, Optional: true, WriteOnly: true, Sensitive: true},
+				"secret_wo_version": tpgresource.WriteOnlyVersionSchema("secret", false),
+			},
+			Errors: []string{"secret_wo: write-only arguments are never stored and must not be sensitive"},
+		},
+		"base argument isn't sensitive": {
+			Schema: map[string]*schema.Schema{
+				"secret":            {Type: schema.TypeString, Optional: true, ConflictsWith: []string{"secret_wo"}},
+				"secret_wo":         tpgresource.WriteOnlySchema("secret", "", false),
+				"secret_wo_version": tpgresource.WriteOnlyVersionSchema("secret", false),
+			},
+			Errors: []string{"secret_wo: secret must be sensitive"},
+		},
+		"nested pair without conflict": {
+			Schema: map[string]*schema.Schema{
+				"profile": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"password":            sensitive(),
+							"password_wo":         {Type: schema.TypeString, Optional: true, WriteOnly: true},
+							"password_wo_version": {Type: schema.TypeString, Optional: true},
+						},
+					},
+				},
+			},
+			Errors: []string{"profile.0.password_wo: must conflict with profile.0.password"},
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			err := tpgresource.ValidateWriteOnlyArguments(tc.Schema)
+			if len(tc.Errors) == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("expected errors %q, got none", tc.Errors)
+			}
+			for _, want := range tc.Errors {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("expected error containing %q, got %q", want, err)
+				}
+			}
+		})
+	}
+}
+
+func TestWriteOnlySchema(t *testing.T) {
+	t.Parallel()
+
+	s := tpgresource.WriteOnlySchema("profile.0.password", "The password.", true)
+	if !s.WriteOnly || s.Sensitive || s.Required {
+		t.Errorf("expected an optional, write-only and non-sensitive argument, got %#v", s)
+	}
+	if got, want := strings.Join(s.ExactlyOneOf, ","), "profile.0.password,profile.0.password_wo"; got != want {
+		t.Errorf("expected ExactlyOneOf %q, got %q", want, got)
+	}
+	if got, want := strings.Join(s.RequiredWith, ","), "profile.0.password_wo_version"; got != want {
+		t.Errorf("expected RequiredWith %q, got %q", want, got)
+	}
+
+	s = tpgresource.WriteOnlySchema("password", "The password.", false)
+	if got, want := strings.Join(s.ConflictsWith, ","), "password"; got != want {
+		t.Errorf("expected ConflictsWith %q, got %q", want, got)
+	}
+
+	v := tpgresource.WriteOnlyVersionSchema("profile.0.password", true)
+	if !v.ForceNew || !strings.Contains(v.Description, "'password_wo'") {
+		t.Errorf("unexpected version schema %#v", v)
+	}
+	if got, want := strings.Join(v.RequiredWith, ","), "profile.0.password_wo"; got != want {
+		t.Errorf("expected RequiredWith %q, got %q", want, got)
+	}
+}
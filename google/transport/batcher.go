@@ -18,6 +18,7 @@ package transport
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"sync"
@@ -28,6 +29,11 @@ import (
 
 const DefaultBatchSendIntervalSec = 3
 
+// ErrBatchRequestNotCombinable can be wrapped in an error returned by a CombineF to
+// defer the new request to the next batch, started once the current batch is sent,
+// instead of failing it.
+var ErrBatchRequestNotCombinable = errors.New("request cannot be combined with the started batch")
+
 // RequestBatcher keeps track of batched requests globally.
 // It should be created at a provider level. In general, one
 // should be created per service that requires batching to:
@@ -100,6 +106,10 @@ type startedBatch struct {
 
 	subscribers []batchSubscriber
 
+	// deferred holds the requests that couldn't be combined into this batch. They are
+	// registered again once this batch has been sent.
+	deferred []batchSubscriber
+
 	timer *time.Timer
 }
 
@@ -116,6 +126,8 @@ type batchSubscriber struct {
 type BatchingConfig struct {
 	SendAfter      time.Duration
 	EnableBatching bool
+	// EnableDnsBatching opts DNS record set changes into batching, when EnableBatching is also set.
+	EnableDnsBatching bool
 }
 
 // Initializes a new batcher.
@@ -150,6 +162,9 @@ func (b *RequestBatcher) stop() {
 		for _, l := range batch.subscribers {
 			close(l.respCh)
 		}
+		for _, l := range batch.deferred {
+			close(l.respCh)
+		}
 	}
 }
 
@@ -226,25 +241,39 @@ func (b *RequestBatcher) SendRequestWithTimeout(batchKey string, request *BatchR
 // request into this existing batch. Else, this method manages starting a new
 // batch and adding it to the RequestBatcher's started batches.
 func (b *RequestBatcher) registerBatchRequest(batchKey string, newRequest *BatchRequest) (<-chan batchResponse, error) {
+	// The calling goroutine will need a channel to wait on for a response.
+	respCh := make(chan batchResponse, 1)
+	sub := batchSubscriber{
+		singleRequest: newRequest,
+		respCh:        respCh,
+	}
+	if err := b.registerBatchSubscriber(batchKey, sub); err != nil {
+		return nil, err
+	}
+	return respCh, nil
+}
+
+func (b *RequestBatcher) registerBatchSubscriber(batchKey string, sub batchSubscriber) error {
 	b.Lock()
 	defer b.Unlock()
 
+	newRequest := sub.singleRequest
+
 	// If batch already exists, combine this request into existing request.
 	if batch, ok := b.batches[batchKey]; ok {
-		return batch.addRequest(newRequest)
+		err := batch.addSubscriber(sub)
+		if errors.Is(err, ErrBatchRequestNotCombinable) {
+			log.Printf("[DEBUG] Deferring request %q to the batch after %q: %v", newRequest.DebugId, batchKey, err)
+			batch.deferred = append(batch.deferred, sub)
+			return nil
+		}
+		return err
 	}
 
 	// Batch doesn't exist for given batch key - create a new batch.
 
 	log.Printf("[DEBUG] Creating new batch %q from request %q", newRequest.DebugId, batchKey)
 
-	// The calling goroutine will need a channel to wait on for a response.
-	respCh := make(chan batchResponse, 1)
-	sub := batchSubscriber{
-		singleRequest: newRequest,
-		respCh:        respCh,
-	}
-
 	// Create a new batch with copy of the given batch request.
 	b.batches[batchKey] = &startedBatch{
 		BatchRequest: &BatchRequest{
@@ -263,14 +292,26 @@ func (b *RequestBatcher) registerBatchRequest(batchKey string, newRequest *Batch
 		batch := b.popBatch(batchKey)
 		if batch == nil {
 			log.Printf("[ERROR] batch should have been added to saved batches - just run as single request %q", newRequest.DebugId)
-			respCh <- newRequest.send()
-			close(respCh)
+			sub.respCh <- newRequest.send()
+			close(sub.respCh)
 		} else {
 			b.sendBatchWithSingleRetry(batchKey, batch)
+			b.registerDeferred(batchKey, batch.deferred)
 		}
 	})
 
-	return respCh, nil
+	return nil
+}
+
+// registerDeferred registers the requests deferred by a batch that has been sent, so
+// they're combined into the next batch for batchKey.
+func (b *RequestBatcher) registerDeferred(batchKey string, deferred []batchSubscriber) {
+	for _, sub := range deferred {
+		if err := b.registerBatchSubscriber(batchKey, sub); err != nil {
+			sub.respCh <- batchResponse{err: fmt.Errorf("error adding deferred request to batch: %s", err)}
+			close(sub.respCh)
+		}
+	}
 }
 
 func (b *RequestBatcher) sendBatchWithSingleRetry(batchKey string, batch *startedBatch) {
@@ -319,26 +360,22 @@ func (b *RequestBatcher) popBatch(batchKey string) *startedBatch {
 	return batch
 }
 
-func (batch *startedBatch) addRequest(newRequest *BatchRequest) (<-chan batchResponse, error) {
+func (batch *startedBatch) addSubscriber(sub batchSubscriber) error {
+	newRequest := sub.singleRequest
 	log.Printf("[DEBUG] Adding batch request %q to existing batch %q", newRequest.DebugId, batch.batchKey)
 	if batch.CombineF == nil {
-		return nil, fmt.Errorf("Provider Error: unable to add request %q to batch %q with no CombineF", newRequest.DebugId, batch.batchKey)
+		return fmt.Errorf("Provider Error: unable to add request %q to batch %q with no CombineF", newRequest.DebugId, batch.batchKey)
 	}
 	newBody, err := batch.CombineF(batch.Body, newRequest.Body)
 	if err != nil {
-		return nil, fmt.Errorf("Provider Error: Unable to combine request %q data into existing batch %q: %v", newRequest.DebugId, batch.batchKey, err)
+		return fmt.Errorf("Provider Error: Unable to combine request %q data into existing batch %q: %w", newRequest.DebugId, batch.batchKey, err)
 	}
 	batch.Body = newBody
 
 	log.Printf("[DEBUG] Added batch request %q to batch. New batch body: %v", newRequest.DebugId, batch.Body)
 
-	respCh := make(chan batchResponse, 1)
-	sub := batchSubscriber{
-		singleRequest: newRequest,
-		respCh:        respCh,
-	}
 	batch.subscribers = append(batch.subscribers, sub)
-	return respCh, nil
+	return nil
 }
 
 func (req *BatchRequest) send() batchResponse {
@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"reflect"
 	"strings"
 	"sync"
 	"testing"
@@ -147,6 +148,73 @@ func TestRequestBatcher_errInCombine(t *testing.T) {
 	wg.Wait()
 }
 
+func TestRequestBatcher_notCombinable(t *testing.T) {
+	testBatcher := NewRequestBatcher(
+		"testBatcher",
+		context.Background(),
+		&BatchingConfig{
+			SendAfter:      time.Duration(2) * time.Second,
+			EnableBatching: true,
+		})
+
+	testCombine := func(_ interface{}, _ interface{}) (interface{}, error) {
+		return nil, fmt.Errorf("conflicting bodies: %w", ErrBatchRequestNotCombinable)
+	}
+
+	// sendBatchF records the order bodies are sent in, and returns the body it was sent
+	var sentLock sync.Mutex
+	var sent []string
+	sending := false
+	testSendBatch := func(_ string, body interface{}) (interface{}, error) {
+		sentLock.Lock()
+		if sending {
+			t.Errorf("expected %v to be sent after the previous batch", body)
+		}
+		sending = true
+		sent = append(sent, body.(string))
+		sentLock.Unlock()
+
+		time.Sleep(500 * time.Millisecond)
+
+		sentLock.Lock()
+		sending = false
+		sentLock.Unlock()
+		return body, nil
+	}
+
+	wg := sync.WaitGroup{}
+	wg.Add(2)
+
+	for i, body := range []string{"first", "second"} {
+		go func(i int, body string) {
+			defer wg.Done()
+			time.Sleep(time.Duration(i) * time.Second)
+
+			req := &BatchRequest{
+				DebugId:      "notCombinable " + body,
+				ResourceName: "test-resource",
+				Body:         body,
+				CombineF:     testCombine,
+				SendF:        testSendBatch,
+			}
+
+			resp, err := testBatcher.SendRequestWithTimeout("testNotCombinable", req, time.Duration(10)*time.Second)
+			if err != nil {
+				t.Errorf("expected no error for %s request, got: %s", body, err)
+			} else if resp != body {
+				t.Errorf("expected %s request to be sent on its own, got response %v", body, resp)
+			}
+		}(i, body)
+	}
+
+	wg.Wait()
+
+	// The request that can't be combined is deferred to the next batch
+	if expected := []string{"first", "second"}; !reflect.DeepEqual(sent, expected) {
+		t.Errorf("expected requests to be sent in order %v, got %v", expected, sent)
+	}
+}
+
 func TestRequestBatcher_errInSend(t *testing.T) {
 	testBatcher := NewRequestBatcher(
 		"testBatcher",
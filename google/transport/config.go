@@ -238,6 +238,7 @@ type Config struct {
 
 	RequestBatcherServiceUsage *RequestBatcher
 	RequestBatcherIam          *RequestBatcher
+	RequestBatcherDns          *RequestBatcher
 
 	PreferGlobalEndpoints   bool
 	PreferRegionalEndpoints bool
@@ -389,6 +390,7 @@ func (c *Config) LoadAndValidate(ctx context.Context) error {
 	c.Region = GetRegionFromRegionSelfLink(c.Region)
 	c.RequestBatcherServiceUsage = NewRequestBatcher("Service Usage", ctx, c.BatchingConfig)
 	c.RequestBatcherIam = NewRequestBatcher("IAM", ctx, c.BatchingConfig)
+	c.RequestBatcherDns = NewRequestBatcher("DNS", ctx, c.BatchingConfig.dnsBatchingConfig())
 	// Set default of 10s if unset by user in provider.go or LoadAndValidate was invoked directly
 	if c.PollInterval == 0 {
 		c.PollInterval = 10 * time.Second
@@ -461,9 +463,26 @@ func ExpandProviderBatchingConfig(v interface{}) (*BatchingConfig, error) {
 		config.EnableBatching = enable.(bool)
 	}
 
+	if enable, ok := cfgV["enable_dns_batching"]; ok {
+		config.EnableDnsBatching = enable.(bool)
+	}
+
 	return config, nil
 }
 
+// dnsBatchingConfig returns the configuration of the DNS batcher. Combining record set
+// changes changes how their failures are reported, so they're only batched when
+// enable_dns_batching is set as well as enable_batching.
+func (bc *BatchingConfig) dnsBatchingConfig() *BatchingConfig {
+	if bc == nil {
+		return nil
+	}
+	return &BatchingConfig{
+		SendAfter:      bc.SendAfter,
+		EnableBatching: bc.EnableBatching && bc.EnableDnsBatching,
+	}
+}
+
 func (c *Config) synchronousTimeout() time.Duration {
 	if c.RequestTimeout == 0 {
 		return 120 * time.Second
@@ -427,6 +427,42 @@ func TestConfigLoadAndValidate_defaultBatchingConfig(t *testing.T) {
 			transport_tpg.DefaultBatchSendIntervalSec,
 			config.RequestBatcherServiceUsage.SendAfter)
 	}
+
+	// DNS batching is opt-in
+	if config.RequestBatcherDns.EnableBatching {
+		t.Fatalf("expected DNS batching to be disabled by default")
+	}
+}
+
+func TestConfigLoadAndValidate_dnsBatchingConfig(t *testing.T) {
+	batchCfg, err := transport_tpg.ExpandProviderBatchingConfig([]interface{}{
+		map[string]interface{}{
+			"send_after":          "1s",
+			"enable_dns_batching": true,
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	config := &transport_tpg.Config{
+		Credentials:    transport_tpg.TestFakeCredentialsPath,
+		Project:        "my-gce-project",
+		Region:         "us-central1",
+		BatchingConfig: batchCfg,
+	}
+
+	err = config.LoadAndValidate(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !config.RequestBatcherDns.EnableBatching {
+		t.Fatalf("expected DNS batching to be enabled")
+	}
+	if config.RequestBatcherDns.SendAfter != time.Second {
+		t.Fatalf("expected SendAfter to be 1 second, got %v", config.RequestBatcherDns.SendAfter)
+	}
 }
 
 func TestConfigLoadAndValidate_customBatchingConfig(t *testing.T) {